@@ -0,0 +1,429 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrCollectionVersion is reported when a document has another schema version and the
+// collection has no Upgrade.
+var ErrCollectionVersion = errors.New("unsupported collection schema version")
+
+// CollectionOptions configures a Collection. The zero value stores version 1 JSON documents
+// without indexes.
+type CollectionOptions[T any] struct {
+	// Codec encodes documents. Defaults to JSONCodec.
+	Codec Codec
+	// Version is the schema version stamped on written documents. Defaults to 1.
+	Version int
+	// Upgrade decodes documents of other schema versions; data is encoded with codec. It is
+	// the migration hook: when Version changes, OpenCollection rewrites every document with it.
+	Upgrade func(version int, codec Codec, data []byte) (T, error)
+	// Indexes are the secondary indexes maintained on every write.
+	Indexes []CollectionIndex[T]
+}
+
+// CollectionIndex is a secondary index of a Collection.
+type CollectionIndex[T any] struct {
+	Name string
+	// Keys returns the index values of doc; a document without values is not indexed. Values
+	// are compared as strings, see IndexInt and IndexFloat for numbers.
+	Keys func(doc T) []string
+}
+
+// Doc is a document of a Collection with its ID.
+type Doc[T any] struct {
+	ID    string
+	Value T
+}
+
+// CollectionQuery selects documents of a Collection. Without Index it scans documents by ID.
+type CollectionQuery struct {
+	// Index is the name of the index to query.
+	Index string
+	// Value selects the documents with exactly this index value (or ID). It takes precedence
+	// over Start and End.
+	Value string
+	// Start (inclusive) and End (exclusive) bound the index values (or IDs); an empty End is
+	// unbounded.
+	Start string
+	End   string
+	// Reverse, Limit and Cursor page the results as in KVScan.
+	Reverse bool
+	Limit   int
+	Cursor  string
+}
+
+// CollectionPage is a page of Query results.
+type CollectionPage[T any] struct {
+	Docs []Doc[T]
+	// NextCursor continues the query; empty when there are no more documents.
+	NextCursor string
+}
+
+// Collection stores documents of type T in a KeyValueDB under a name. Documents live under
+// "<name>/d/<id>" and index entries under "<name>/i/<index>/<value>\x00<id>"; a document and its
+// index entries are written in one KVBatcher.Batch, so db must implement KVBatcher. It is safe for concurrent use, also by
+// several plugins sharing the database.
+//
+//	players, err := api.OpenCollection(db, "players", api.CollectionOptions[Player]{
+//		Indexes: []api.CollectionIndex[Player]{{
+//			Name: "balance",
+//			Keys: func(p Player) []string { return []string{api.IndexInt(p.Balance)} },
+//		}},
+//	})
+//	rich, err := players.Query(api.CollectionQuery{Index: "balance", Start: api.IndexInt(1001)})
+type Collection[T any] struct {
+	db      KeyValueDB
+	batcher KVBatcher
+	name    string
+	opts    CollectionOptions[T]
+	indexes map[string]CollectionIndex[T]
+}
+
+// collectionMeta is stored under "<name>/meta".
+type collectionMeta struct {
+	Version int      `json:"version"`
+	Indexes []string `json:"indexes"`
+}
+
+// OpenCollection returns the collection name of db. When the stored schema version or the
+// set of index names differs from opts, every document is rewritten with the current version
+// and the indexes are rebuilt. Rename an index to rebuild it after changing its Keys.
+func OpenCollection[T any](db KeyValueDB, name string, opts CollectionOptions[T]) (*Collection[T], error) {
+	if db == nil {
+		return nil, errors.New("api.OpenCollection: db is nil")
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("api.OpenCollection: invalid collection name %q", name)
+	}
+	batcher, ok := db.(KVBatcher)
+	if !ok {
+		return nil, fmt.Errorf("api.OpenCollection: %w", ErrKVUnsupported)
+	}
+	if opts.Codec == nil {
+		opts.Codec = JSONCodec
+	}
+	if opts.Version <= 0 {
+		opts.Version = 1
+	}
+	c := &Collection[T]{db: db, batcher: batcher, name: name, opts: opts, indexes: map[string]CollectionIndex[T]{}}
+	names := make([]string, 0, len(opts.Indexes))
+	for _, idx := range opts.Indexes {
+		if idx.Name == "" || strings.Contains(idx.Name, "/") || idx.Keys == nil {
+			return nil, fmt.Errorf("api.OpenCollection: invalid index %q", idx.Name)
+		}
+		if _, dup := c.indexes[idx.Name]; dup {
+			return nil, fmt.Errorf("api.OpenCollection: duplicate index %q", idx.Name)
+		}
+		c.indexes[idx.Name] = idx
+		names = append(names, idx.Name)
+	}
+	slices.Sort(names)
+
+	want := collectionMeta{Version: opts.Version, Indexes: names}
+	raw, ok, err := db.Get(c.metaKey())
+	if err != nil {
+		return nil, fmt.Errorf("api.OpenCollection: %w", err)
+	}
+	var have collectionMeta
+	if ok {
+		if err := json.Unmarshal([]byte(raw), &have); err != nil {
+			return nil, fmt.Errorf("api.OpenCollection: meta of %s: %w", name, err)
+		}
+		slices.Sort(have.Indexes)
+	}
+	if ok && have.Version == want.Version && slices.Equal(have.Indexes, want.Indexes) {
+		return c, nil
+	}
+	if ok || len(names) > 0 {
+		if err := c.migrate(); err != nil {
+			return nil, fmt.Errorf("api.OpenCollection: migrate %s: %w", name, err)
+		}
+	}
+	meta, _ := json.Marshal(want)
+	if err := db.Set(c.metaKey(), string(meta)); err != nil {
+		return nil, fmt.Errorf("api.OpenCollection: %w", err)
+	}
+	return c, nil
+}
+
+// Name returns the collection name.
+func (c *Collection[T]) Name() string { return c.name }
+
+func (c *Collection[T]) metaKey() string             { return c.name + "/meta" }
+func (c *Collection[T]) docPrefix() string           { return c.name + "/d/" }
+func (c *Collection[T]) docKey(id string) string     { return c.docPrefix() + id }
+func (c *Collection[T]) indexPrefix(n string) string { return c.name + "/i/" + n + "/" }
+
+func (c *Collection[T]) indexKey(index, value, id string) string {
+	return c.indexPrefix(index) + value + "\x00" + id
+}
+
+// Get returns the document id.
+func (c *Collection[T]) Get(id string) (T, bool, error) {
+	var zero T
+	raw, ok, err := c.db.Get(c.docKey(id))
+	if err != nil || !ok {
+		return zero, false, err
+	}
+	v, err := c.decode(raw)
+	if err != nil {
+		return zero, false, fmt.Errorf("api.Collection.Get: %s/%s: %w", c.name, id, err)
+	}
+	return v, true, nil
+}
+
+// Put stores doc under id and updates its index entries atomically.
+func (c *Collection[T]) Put(id string, doc T) error {
+	return c.Update(id, func(T, bool) (T, bool, error) { return doc, true, nil })
+}
+
+// Delete removes the document id and its index entries atomically.
+func (c *Collection[T]) Delete(id string) error {
+	return c.Update(id, func(old T, _ bool) (T, bool, error) { return old, false, nil })
+}
+
+// Update atomically replaces the document id with the result of fn, which receives the
+// current document. fn returns keep=false to delete the document. When another writer changes
+// the document in between, fn runs again, see UpdateKeyValueDB.
+func (c *Collection[T]) Update(id string, fn func(doc T, exists bool) (T, bool, error)) error {
+	if id == "" {
+		return errors.New("api.Collection.Update: id is empty")
+	}
+	return UpdateKeyValueDB(c.db, func(tx *KVTx) error {
+		var old T
+		raw, exists, err := tx.Get(c.docKey(id))
+		if err != nil {
+			return err
+		}
+		if exists {
+			if old, err = c.decode(raw); err != nil {
+				return fmt.Errorf("api.Collection.Update: %s/%s: %w", c.name, id, err)
+			}
+		}
+		doc, keep, err := fn(old, exists)
+		if err != nil {
+			return err
+		}
+		if exists {
+			for _, key := range c.indexKeys(id, old) {
+				tx.Delete(key)
+			}
+		}
+		if !keep {
+			if exists {
+				tx.Delete(c.docKey(id))
+			}
+			return nil
+		}
+		data, err := c.encode(doc)
+		if err != nil {
+			return fmt.Errorf("api.Collection.Update: encode %s/%s: %w", c.name, id, err)
+		}
+		tx.Set(c.docKey(id), data)
+		for _, key := range c.indexKeys(id, doc) {
+			tx.Set(key, id)
+		}
+		return nil
+	})
+}
+
+// Query returns one page of the documents selected by q.
+func (c *Collection[T]) Query(q CollectionQuery) (CollectionPage[T], error) {
+	scan := KVScan{Reverse: q.Reverse, Limit: q.Limit, Cursor: q.Cursor}
+	base := c.docPrefix()
+	if q.Index != "" {
+		if _, ok := c.indexes[q.Index]; !ok {
+			return CollectionPage[T]{}, fmt.Errorf("api.Collection.Query: unknown index %q", q.Index)
+		}
+		base = c.indexPrefix(q.Index)
+	}
+	scan.Prefix = base
+	switch {
+	case q.Value != "" && q.Index != "":
+		scan.Prefix = base + q.Value + "\x00"
+	case q.Value != "":
+		scan.Start, scan.End = base+q.Value, base+q.Value+"\x00"
+	default:
+		scan.Start = base + q.Start
+		if q.End != "" {
+			scan.End = base + q.End
+		}
+	}
+	page, err := ScanPage(c.db, scan)
+	if err != nil {
+		return CollectionPage[T]{}, err
+	}
+	out := CollectionPage[T]{NextCursor: page.NextCursor}
+	for _, e := range page.Entries {
+		if q.Index == "" {
+			v, err := c.decode(e.Value)
+			if err != nil {
+				return CollectionPage[T]{}, fmt.Errorf("api.Collection.Query: %s: %w", e.Key, err)
+			}
+			out.Docs = append(out.Docs, Doc[T]{ID: strings.TrimPrefix(e.Key, base), Value: v})
+			continue
+		}
+		// The document may have been changed since the index page was read.
+		v, ok, err := c.Get(e.Value)
+		if err != nil {
+			return CollectionPage[T]{}, err
+		}
+		if ok && slices.Contains(c.indexKeys(e.Value, v), e.Key) {
+			out.Docs = append(out.Docs, Doc[T]{ID: e.Value, Value: v})
+		}
+	}
+	return out, nil
+}
+
+// Find returns every document whose index has value.
+func (c *Collection[T]) Find(index, value string) ([]Doc[T], error) {
+	var docs []Doc[T]
+	err := c.Each(CollectionQuery{Index: index, Value: value}, func(d Doc[T]) bool {
+		docs = append(docs, d)
+		return true
+	})
+	return docs, err
+}
+
+// Each calls fn for every document selected by q, a page at a time, until fn returns false.
+func (c *Collection[T]) Each(q CollectionQuery, fn func(Doc[T]) bool) error {
+	for {
+		page, err := c.Query(q)
+		if err != nil {
+			return err
+		}
+		for _, d := range page.Docs {
+			if !fn(d) {
+				return nil
+			}
+		}
+		if page.NextCursor == "" {
+			return nil
+		}
+		q.Cursor = page.NextCursor
+	}
+}
+
+// collectionEnvelope is the stored format of a document. JSON documents are embedded in Data,
+// documents of other codecs are carried in Raw.
+type collectionEnvelope struct {
+	Codec   string          `json:"$codec"`
+	Version int             `json:"$v"`
+	Data    json.RawMessage `json:"data,omitempty"`
+	Raw     []byte          `json:"raw,omitempty"`
+}
+
+func (c *Collection[T]) encode(doc T) (string, error) {
+	data, err := c.opts.Codec.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	env := collectionEnvelope{Codec: c.opts.Codec.Name(), Version: c.opts.Version}
+	if env.Codec == CodecJSON {
+		env.Data = data
+	} else {
+		env.Raw = data
+	}
+	raw, err := json.Marshal(env)
+	return string(raw), err
+}
+
+// decode decodes a stored document, upgrading it from other schema versions.
+func (c *Collection[T]) decode(raw string) (T, error) {
+	var v T
+	var env collectionEnvelope
+	if err := json.Unmarshal([]byte(raw), &env); err != nil {
+		return v, err
+	}
+	codec, ok := LookupCodec(env.Codec)
+	if !ok {
+		return v, fmt.Errorf("%w %q", ErrUnknownCodec, env.Codec)
+	}
+	data := env.Raw
+	if env.Codec == CodecJSON {
+		data = env.Data
+	}
+	if env.Version != c.opts.Version {
+		if c.opts.Upgrade == nil {
+			return v, fmt.Errorf("%w %d (want %d)", ErrCollectionVersion, env.Version, c.opts.Version)
+		}
+		return c.opts.Upgrade(env.Version, codec, data)
+	}
+	if err := codec.Unmarshal(data, &v); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func (c *Collection[T]) indexKeys(id string, doc T) []string {
+	var keys []string
+	for _, idx := range c.opts.Indexes {
+		for _, value := range idx.Keys(doc) {
+			keys = append(keys, c.indexKey(idx.Name, value, id))
+		}
+	}
+	return keys
+}
+
+// migrate rewrites every document with the current version and rebuilds the indexes.
+func (c *Collection[T]) migrate() error {
+	var stale []string
+	if err := IteratePrefix(c.db, c.name+"/i/", func(key, _ string) bool {
+		stale = append(stale, key)
+		return true
+	}); err != nil {
+		return err
+	}
+	for len(stale) > 0 {
+		n := min(len(stale), MaxKVScanLimit)
+		var b KVBatch
+		for _, key := range stale[:n] {
+			b.Delete(key)
+		}
+		if err := c.batcher.Batch(b); err != nil {
+			return err
+		}
+		stale = stale[n:]
+	}
+
+	var ids []string
+	if err := IteratePrefix(c.db, c.docPrefix(), func(key, _ string) bool {
+		ids = append(ids, strings.TrimPrefix(key, c.docPrefix()))
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		err := c.Update(id, func(doc T, exists bool) (T, bool, error) { return doc, exists, nil })
+		if err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// IndexInt encodes n as an index value that sorts in numeric order.
+func IndexInt(n int64) string {
+	return fmt.Sprintf("%016x", uint64(n)^(1<<63))
+}
+
+// IndexFloat encodes f as an index value that sorts in numeric order.
+func IndexFloat(f float64) string {
+	bits := math.Float64bits(f)
+	if bits&(1<<63) != 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	return fmt.Sprintf("%016x", bits)
+}
+
+// IndexBool encodes b as an index value.
+func IndexBool(b bool) string { return strconv.FormatBool(b) }
@@ -1,5 +1,10 @@
 package api
 
+import (
+	"context"
+	"io"
+)
+
 const NameDatabaseModule = "database"
 
 const (
@@ -20,4 +25,22 @@ type DatabaseModule interface {
 	// - "level": leveldb backend
 	// - "json": json file backend
 	KeyValueDB(name string, dbType string) (KeyValueDB, error)
+
+	// SQLDB opens (or creates) the SQLite database ref.Name of the plugin ref.Owner.
+	// Owner is filled in by the host with the ID of the calling plugin; a value set by the plugin
+	// is ignored. Each owner has its own files, see the sqldb package.
+	SQLDB(ref SQLDBRef) (SQLDB, error)
+
+	// ExportDB writes a consistent point-in-time backup of ref to w, see WriteDBBackup for the
+	// format. As with SQLDB, the host fills in ref.Owner.
+	ExportDB(ctx context.Context, ref DBRef, w io.Writer) (DBBackupInfo, error)
+	// ImportDB verifies the backup read from r and replaces the content of ref with it.
+	ImportDB(ctx context.Context, ref DBRef, r io.Reader) (DBBackupInfo, error)
+	// Backup stores a backup of ref in the host's backup store, which keeps a limited number of
+	// backups per database and also takes scheduled ones.
+	Backup(ctx context.Context, ref DBRef) (DBBackupInfo, error)
+	// Backups lists the stored backups of ref, newest first.
+	Backups(ctx context.Context, ref DBRef) ([]DBBackupInfo, error)
+	// RestoreBackup replaces the content of ref with its stored backup id.
+	RestoreBackup(ctx context.Context, ref DBRef, id string) (DBBackupInfo, error)
 }
@@ -0,0 +1,401 @@
+package api
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+// DBKind is the kind of database a DBRef names.
+type DBKind string
+
+const (
+	DBKindKV  DBKind = "kv"
+	DBKindSQL DBKind = "sql"
+)
+
+// DBRef names a database of DatabaseModule for backups.
+type DBRef struct {
+	Kind DBKind
+	// Name is the name passed to KeyValueDB or SQLDB.
+	Name string
+	// DBType is the KeyValueDB backend.
+	DBType string
+	// Owner is the plugin owning a SQL database, filled in by the host like SQLDBRef.Owner.
+	Owner string
+}
+
+// SQLRef returns the SQLDBRef of a SQL database.
+func (r DBRef) SQLRef() SQLDBRef { return SQLDBRef{Owner: r.Owner, Name: r.Name} }
+
+// DBBackupInfo describes a backup.
+type DBBackupInfo struct {
+	// ID identifies a stored backup; empty for exports.
+	ID      string
+	Ref     DBRef
+	Created time.Time
+	// Size is the size of the backup archive in bytes.
+	Size int64
+	// Entries is the number of keys of a KeyValueDB backup.
+	Entries int64
+	// SHA256 is the hex checksum of the data file.
+	SHA256 string
+}
+
+// Backup archive format. A backup is a tar archive holding DBBackupManifestFile followed by one
+// data file: DBBackupKVFile for KeyValueDBs, JSON lines of DBBackupKVEntry, or DBBackupSQLFile
+// for SQL databases, a SQLite database file. The manifest records the size and SHA-256 of the
+// data file, checked before anything is restored.
+const (
+	DBBackupFormat       = "tempest-db-backup"
+	DBBackupVersion      = 1
+	DBBackupManifestFile = "manifest.json"
+	DBBackupKVFile       = "data.jsonl"
+	DBBackupSQLFile      = "data.sqlite"
+)
+
+var (
+	// ErrDBBackupFormat is returned for archives that are not backups of this format.
+	ErrDBBackupFormat = errors.New("invalid database backup")
+	// ErrDBBackupChecksum is returned when the data of a backup does not match its manifest.
+	ErrDBBackupChecksum = errors.New("database backup checksum mismatch")
+)
+
+// DBBackupManifest is the manifest of a backup archive.
+type DBBackupManifest struct {
+	Format  string         `json:"format"`
+	Version int            `json:"version"`
+	Kind    DBKind         `json:"kind"`
+	Name    string         `json:"name"`
+	DBType  string         `json:"db_type,omitempty"`
+	Created time.Time      `json:"created"`
+	Entries int64          `json:"entries,omitempty"`
+	Files   []DBBackupFile `json:"files"`
+}
+
+// DBBackupFile is the checksum of a file of a backup archive.
+type DBBackupFile struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// DBBackupKVEntry is a line of DBBackupKVFile. Keys and values that are not valid UTF-8 are
+// stored base64-encoded in K64 and V64 instead of K and V.
+type DBBackupKVEntry struct {
+	K   string `json:"k,omitempty"`
+	K64 string `json:"k64,omitempty"`
+	V   string `json:"v,omitempty"`
+	V64 string `json:"v64,omitempty"`
+	// Exp is the expiry of the key in Unix milliseconds, 0 if it does not expire.
+	Exp int64 `json:"exp,omitempty"`
+}
+
+func (m DBBackupManifest) info(size int64) DBBackupInfo {
+	info := DBBackupInfo{
+		Ref:     DBRef{Kind: m.Kind, Name: m.Name, DBType: m.DBType},
+		Created: m.Created,
+		Size:    size,
+		Entries: m.Entries,
+	}
+	if len(m.Files) > 0 {
+		info.SHA256 = m.Files[0].SHA256
+	}
+	return info
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// WriteDBBackup writes a backup archive of m with the data file named file to w. data is read
+// twice, once for its checksum. m.Files, Format and Version are filled in, and Created when zero.
+func WriteDBBackup(w io.Writer, m DBBackupManifest, file string, data io.ReadSeeker) (DBBackupInfo, error) {
+	if w == nil || data == nil {
+		return DBBackupInfo{}, errors.New("api.WriteDBBackup: writer or data is nil")
+	}
+	h := sha256.New()
+	size, err := io.Copy(h, data)
+	if err != nil {
+		return DBBackupInfo{}, err
+	}
+	if _, err := data.Seek(0, io.SeekStart); err != nil {
+		return DBBackupInfo{}, err
+	}
+	m.Format, m.Version = DBBackupFormat, DBBackupVersion
+	if m.Created.IsZero() {
+		m.Created = time.Now()
+	}
+	m.Created = m.Created.UTC()
+	m.Files = []DBBackupFile{{Name: file, Size: size, SHA256: hex.EncodeToString(h.Sum(nil))}}
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return DBBackupInfo{}, err
+	}
+
+	cw := &countingWriter{w: w}
+	tw := tar.NewWriter(cw)
+	if err := tw.WriteHeader(&tar.Header{Name: DBBackupManifestFile, Mode: 0o644, Size: int64(len(manifest)), ModTime: m.Created}); err != nil {
+		return DBBackupInfo{}, err
+	}
+	if _, err := tw.Write(manifest); err != nil {
+		return DBBackupInfo{}, err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: file, Mode: 0o644, Size: size, ModTime: m.Created}); err != nil {
+		return DBBackupInfo{}, err
+	}
+	if _, err := io.CopyN(tw, data, size); err != nil {
+		return DBBackupInfo{}, err
+	}
+	if err := tw.Close(); err != nil {
+		return DBBackupInfo{}, err
+	}
+	return m.info(cw.n), nil
+}
+
+// ReadDBBackup reads a backup archive, copying its data file to dst, and checks the data against
+// the manifest. dst must be discarded when an error is returned.
+func ReadDBBackup(r io.Reader, dst io.Writer) (DBBackupManifest, error) {
+	if r == nil || dst == nil {
+		return DBBackupManifest{}, errors.New("api.ReadDBBackup: reader or destination is nil")
+	}
+	tr := tar.NewReader(r)
+	m, err := readDBBackupManifest(tr)
+	if err != nil {
+		return m, err
+	}
+	want := m.Files[0]
+	hdr, err := tr.Next()
+	if err != nil {
+		return m, fmt.Errorf("%w: %v", ErrDBBackupFormat, err)
+	}
+	if hdr.Name != want.Name {
+		return m, fmt.Errorf("%w: data file is %q, want %q", ErrDBBackupFormat, hdr.Name, want.Name)
+	}
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(dst, h), tr)
+	if err != nil {
+		return m, err
+	}
+	if n != want.Size || hex.EncodeToString(h.Sum(nil)) != want.SHA256 {
+		return m, fmt.Errorf("%w: %s", ErrDBBackupChecksum, want.Name)
+	}
+	return m, nil
+}
+
+// ReadDBBackupManifest reads the manifest of a backup archive without reading its data.
+func ReadDBBackupManifest(r io.Reader) (DBBackupManifest, error) {
+	if r == nil {
+		return DBBackupManifest{}, errors.New("api.ReadDBBackupManifest: reader is nil")
+	}
+	return readDBBackupManifest(tar.NewReader(r))
+}
+
+func readDBBackupManifest(tr *tar.Reader) (DBBackupManifest, error) {
+	var m DBBackupManifest
+	hdr, err := tr.Next()
+	if err != nil {
+		return m, fmt.Errorf("%w: %v", ErrDBBackupFormat, err)
+	}
+	if hdr.Name != DBBackupManifestFile {
+		return m, fmt.Errorf("%w: first file is %q", ErrDBBackupFormat, hdr.Name)
+	}
+	if err := json.NewDecoder(io.LimitReader(tr, 1<<20)).Decode(&m); err != nil {
+		return m, fmt.Errorf("%w: manifest: %v", ErrDBBackupFormat, err)
+	}
+	if m.Format != DBBackupFormat || m.Version != DBBackupVersion || len(m.Files) != 1 {
+		return m, fmt.Errorf("%w: unsupported format %q version %d", ErrDBBackupFormat, m.Format, m.Version)
+	}
+	return m, nil
+}
+
+// KVView is a readable KeyValueDB or snapshot. Views implementing KVScanner are read a page at
+// a time, and the expiry of keys is exported from views implementing KVExpirer.
+type KVView interface {
+	Iterate(fn func(key, value string) bool) error
+}
+
+// KVSnapshot is a consistent read-only view of a KeyValueDB at the time it was taken.
+type KVSnapshot interface {
+	KVView
+	Close() error
+}
+
+// KVSnapshotter is implemented by backends that can take consistent snapshots.
+// ExportKeyValueDB uses it so exports are point-in-time.
+type KVSnapshotter interface {
+	Snapshot() (KVSnapshot, error)
+}
+
+// ExportKeyValueDB writes a backup of db to w. When db implements KVSnapshotter the backup is
+// taken from a snapshot; otherwise writes made during the export may or may not be included.
+func ExportKeyValueDB(w io.Writer, db KVView, ref DBRef) (DBBackupInfo, error) {
+	if db == nil {
+		return DBBackupInfo{}, errors.New("api.ExportKeyValueDB: db is nil")
+	}
+	if s, ok := db.(KVSnapshotter); ok {
+		snap, err := s.Snapshot()
+		if err != nil {
+			return DBBackupInfo{}, err
+		}
+		defer snap.Close()
+		db = snap
+	}
+	expirer, _ := db.(KVExpirer)
+	created := time.Now()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	var entries int64
+	q := KVScan{Limit: MaxKVScanLimit}
+	for {
+		page, err := scanView(db, q)
+		if err != nil {
+			return DBBackupInfo{}, err
+		}
+		for _, e := range page.Entries {
+			var ttl time.Duration
+			if expirer != nil {
+				var ok bool
+				if ttl, ok, err = expirer.TTL(e.Key); err != nil {
+					return DBBackupInfo{}, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			line := DBBackupKVEntry{K: e.Key, V: e.Value}
+			if !utf8.ValidString(e.Key) {
+				line.K, line.K64 = "", base64.StdEncoding.EncodeToString([]byte(e.Key))
+			}
+			if !utf8.ValidString(e.Value) {
+				line.V, line.V64 = "", base64.StdEncoding.EncodeToString([]byte(e.Value))
+			}
+			if ttl > 0 {
+				line.Exp = created.Add(ttl).UnixMilli()
+			}
+			if err := enc.Encode(line); err != nil {
+				return DBBackupInfo{}, err
+			}
+			entries++
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+	m := DBBackupManifest{Kind: DBKindKV, Name: ref.Name, DBType: ref.DBType, Created: created, Entries: entries}
+	info, err := WriteDBBackup(w, m, DBBackupKVFile, bytes.NewReader(buf.Bytes()))
+	info.Ref = ref
+	return info, err
+}
+
+// scanView is ScanPage for a KVView.
+func scanView(db KVView, q KVScan) (KVPage, error) {
+	if s, ok := db.(KVScanner); ok {
+		return s.Scan(q)
+	}
+	return scanByIterate(db.Iterate, q)
+}
+
+// ImportKeyValueDB verifies a KeyValueDB backup read from r and replaces the content of db with
+// it in a single Batch, so db must implement KVBatcher, and KVExpirer when the backup has
+// expiring keys. Keys that expired since the backup was taken are skipped.
+func ImportKeyValueDB(r io.Reader, db KeyValueDB) (DBBackupInfo, error) {
+	if r == nil || db == nil {
+		return DBBackupInfo{}, errors.New("api.ImportKeyValueDB: reader or db is nil")
+	}
+	batcher, ok := db.(KVBatcher)
+	if !ok {
+		return DBBackupInfo{}, fmt.Errorf("api.ImportKeyValueDB: %w", ErrKVUnsupported)
+	}
+	cr := &countingReader{r: r}
+	var data bytes.Buffer
+	m, err := ReadDBBackup(cr, &data)
+	if err != nil {
+		return DBBackupInfo{}, err
+	}
+	if m.Kind != DBKindKV {
+		return DBBackupInfo{}, fmt.Errorf("%w: %s backup, want %s", ErrDBBackupFormat, m.Kind, DBKindKV)
+	}
+
+	now := time.Now()
+	var b KVBatch
+	keep := map[string]bool{}
+	sc := bufio.NewScanner(&data)
+	sc.Buffer(nil, 64<<20)
+	for sc.Scan() {
+		var line DBBackupKVEntry
+		if err := json.Unmarshal(sc.Bytes(), &line); err != nil {
+			return DBBackupInfo{}, fmt.Errorf("%w: %v", ErrDBBackupFormat, err)
+		}
+		key, value := line.K, line.V
+		if line.K64 != "" {
+			raw, err := base64.StdEncoding.DecodeString(line.K64)
+			if err != nil {
+				return DBBackupInfo{}, fmt.Errorf("%w: %v", ErrDBBackupFormat, err)
+			}
+			key = string(raw)
+		}
+		if line.V64 != "" {
+			raw, err := base64.StdEncoding.DecodeString(line.V64)
+			if err != nil {
+				return DBBackupInfo{}, fmt.Errorf("%w: %v", ErrDBBackupFormat, err)
+			}
+			value = string(raw)
+		}
+		var ttl time.Duration
+		if line.Exp != 0 {
+			if ttl = time.UnixMilli(line.Exp).Sub(now); ttl <= 0 {
+				continue
+			}
+			if _, ok := db.(KVExpirer); !ok {
+				return DBBackupInfo{}, fmt.Errorf("api.ImportKeyValueDB: expiring key: %w", ErrKVUnsupported)
+			}
+		}
+		keep[key] = true
+		b.Writes = append(b.Writes, KVWrite{Key: key, Value: value, TTL: ttl})
+	}
+	if err := sc.Err(); err != nil {
+		return DBBackupInfo{}, err
+	}
+	err = ScanKeyValueDB(db, KVScan{Limit: MaxKVScanLimit}, func(key, _ string) bool {
+		if !keep[key] {
+			b.Delete(key)
+		}
+		return true
+	})
+	if err != nil {
+		return DBBackupInfo{}, err
+	}
+	if err := batcher.Batch(b); err != nil {
+		return DBBackupInfo{}, err
+	}
+	return m.info(cr.n), nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 )
 
 const NameFlexModule = "flex"
@@ -21,4 +22,67 @@ type FlexModule interface {
 
 	Expose(apiName string, handler func(context.Context, []byte) ([]byte, string)) (func(), error)
 	Call(ctx context.Context, apiName string, argsJSON []byte) ([]byte, string, error)
+
+	// ExposeAPI is Expose with a version. Owner is filled in by the host with the ID of the
+	// exposing plugin; a value set by the plugin is ignored.
+	ExposeAPI(info FlexAPIInfo, handler func(context.Context, []byte) ([]byte, string)) (func(), error)
+	// ListAPIs returns the currently exposed APIs ordered by name.
+	ListAPIs() []FlexAPIInfo
+	// WaitForAPI blocks until apiName is exposed or ctx ends.
+	WaitForAPI(ctx context.Context, apiName string) (FlexAPIInfo, error)
+	// WatchAPIs streams API availability changes until ctx ends.
+	WatchAPIs(ctx context.Context) <-chan FlexAPIEvent
+}
+
+// FlexAPIInfo describes an API exposed through FlexModule.
+type FlexAPIInfo struct {
+	Name string `json:"name"`
+	// Owner is the ID of the exposing plugin, empty for APIs exposed by the host itself.
+	Owner   string `json:"owner,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// FlexAPIEvent reports that an API was exposed (Available) or withdrawn.
+type FlexAPIEvent struct {
+	API       FlexAPIInfo `json:"api"`
+	Available bool        `json:"available"`
+}
+
+// ErrFlexWatchClosed is returned by WaitForFlexAPI when the availability stream ends before the
+// API appears, e.g. because the host does not support API discovery.
+var ErrFlexWatchClosed = errors.New("flex api watch closed")
+
+// WaitForFlexAPI implements FlexModule.WaitForAPI on top of WatchAPIs and ListAPIs.
+func WaitForFlexAPI(ctx context.Context, flex FlexModule, apiName string) (FlexAPIInfo, error) {
+	if flex == nil {
+		return FlexAPIInfo{}, errors.New("api.WaitForFlexAPI: flex module is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Watch before listing so an API exposed in between is not missed.
+	events := flex.WatchAPIs(watchCtx)
+	for _, info := range flex.ListAPIs() {
+		if info.Name == apiName {
+			return info, nil
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return FlexAPIInfo{}, ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return FlexAPIInfo{}, err
+				}
+				return FlexAPIInfo{}, ErrFlexWatchClosed
+			}
+			if ev.Available && ev.API.Name == apiName {
+				return ev.API, nil
+			}
+		}
+	}
 }
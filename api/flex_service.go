@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrorCode classifies the errors of typed Flex services, see ServiceError.
+type ErrorCode string
+
+const (
+	CodeUnknown            ErrorCode = "unknown"
+	CodeInvalidArgument    ErrorCode = "invalid_argument"
+	CodeNotFound           ErrorCode = "not_found"
+	CodeAlreadyExists      ErrorCode = "already_exists"
+	CodePermissionDenied   ErrorCode = "permission_denied"
+	CodeFailedPrecondition ErrorCode = "failed_precondition"
+	CodeUnimplemented      ErrorCode = "unimplemented"
+	CodeUnavailable        ErrorCode = "unavailable"
+	CodeDeadlineExceeded   ErrorCode = "deadline_exceeded"
+	CodeCanceled           ErrorCode = "canceled"
+	CodeInternal           ErrorCode = "internal"
+)
+
+// ServiceError is the structured error of a typed Flex service call.
+// Handlers return it (or any error, reported as CodeUnknown) and callers receive it back,
+// encoded as JSON in the error string of FlexModule.Expose/Call.
+type ServiceError struct {
+	Code    ErrorCode              `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+
+	cause error
+}
+
+// NewServiceError returns a ServiceError with a formatted message.
+func NewServiceError(code ErrorCode, format string, args ...any) *ServiceError {
+	return &ServiceError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *ServiceError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the local cause of errors created by the caller (e.g. context errors).
+func (e *ServiceError) Unwrap() error { return e.cause }
+
+// Is matches another *ServiceError with the same code, so errors.Is(err, &ServiceError{Code: CodeNotFound}) works.
+func (e *ServiceError) Is(target error) bool {
+	t, ok := target.(*ServiceError)
+	return ok && t.Code == e.Code
+}
+
+// ServiceErrorCode returns the code of err, CodeUnknown if it is not a ServiceError, or "" for nil.
+func ServiceErrorCode(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+	var se *ServiceError
+	if errors.As(err, &se) {
+		return se.Code
+	}
+	return CodeUnknown
+}
+
+// toServiceError converts a handler error for the wire.
+func toServiceError(err error) *ServiceError {
+	var se *ServiceError
+	if errors.As(err, &se) {
+		return se
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &ServiceError{Code: CodeDeadlineExceeded, Message: err.Error()}
+	case errors.Is(err, context.Canceled):
+		return &ServiceError{Code: CodeCanceled, Message: err.Error()}
+	default:
+		return &ServiceError{Code: CodeUnknown, Message: err.Error()}
+	}
+}
+
+// parseServiceError decodes the error string of FlexModule.Call. Plain strings returned by
+// handlers that do not use ServiceError become CodeUnknown errors.
+func parseServiceError(s string) *ServiceError {
+	var se ServiceError
+	if err := json.Unmarshal([]byte(s), &se); err == nil && se.Code != "" {
+		return &se
+	}
+	return &ServiceError{Code: CodeUnknown, Message: s}
+}
+
+// ExposeMethod exposes handler on flex as apiName, decoding JSON arguments into Req and encoding
+// the Resp result as JSON. Errors are sent as ServiceError. It is used by flexgen generated code
+// and can be called directly for single methods.
+func ExposeMethod[Req, Resp any](flex FlexModule, apiName string, handler func(ctx context.Context, req Req) (Resp, error)) (func(), error) {
+	if flex == nil {
+		return nil, errors.New("api.ExposeMethod: flex module is nil")
+	}
+	if handler == nil {
+		return nil, errors.New("api.ExposeMethod: handler is nil")
+	}
+	return flex.Expose(apiName, func(ctx context.Context, argsJSON []byte) ([]byte, string) {
+		var req Req
+		if len(argsJSON) > 0 {
+			if err := json.Unmarshal(argsJSON, &req); err != nil {
+				return nil, encodeServiceError(NewServiceError(CodeInvalidArgument, "decode arguments of %s: %v", apiName, err))
+			}
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, encodeServiceError(toServiceError(err))
+		}
+		out, err := json.Marshal(resp)
+		if err != nil {
+			return nil, encodeServiceError(NewServiceError(CodeInternal, "encode result of %s: %v", apiName, err))
+		}
+		return out, ""
+	})
+}
+
+func encodeServiceError(se *ServiceError) string {
+	out, err := json.Marshal(se)
+	if err != nil {
+		return se.Error()
+	}
+	return string(out)
+}
+
+// CallMethod calls apiName on flex with req encoded as JSON and decodes the result into Resp.
+// Every returned error is a *ServiceError; transport failures are reported as CodeUnavailable
+// (or CodeDeadlineExceeded/CodeCanceled when ctx ended) and wrap the original error.
+func CallMethod[Req, Resp any](ctx context.Context, flex FlexModule, apiName string, req Req) (Resp, error) {
+	var resp Resp
+	if flex == nil {
+		return resp, NewServiceError(CodeUnavailable, "flex module is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	args, err := json.Marshal(req)
+	if err != nil {
+		return resp, &ServiceError{Code: CodeInvalidArgument, Message: fmt.Sprintf("encode arguments of %s: %v", apiName, err), cause: err}
+	}
+	out, errStr, err := flex.Call(ctx, apiName, args)
+	if err != nil {
+		se := toServiceError(err)
+		if se.Code == CodeUnknown {
+			se.Code = CodeUnavailable
+		}
+		se.cause = err
+		return resp, se
+	}
+	if errStr != "" {
+		return resp, parseServiceError(errStr)
+	}
+	if len(out) > 0 {
+		if err := json.Unmarshal(out, &resp); err != nil {
+			return resp, &ServiceError{Code: CodeInternal, Message: fmt.Sprintf("decode result of %s: %v", apiName, err), cause: err}
+		}
+	}
+	return resp, nil
+}
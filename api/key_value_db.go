@@ -1,6 +1,17 @@
 package api
 
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
 // KeyValueDB is a simple string key/value database.
+//
+// Backends may implement the optional KVBatcher, KVScanner, KVWatcher and KVExpirer; callers
+// type-assert for them. ScanPage emulates scans over Iterate; operations needing another missing
+// interface return ErrKVUnsupported.
 type KeyValueDB interface {
 	Get(key string) (value string, ok bool, err error)
 	Set(key, value string) error
@@ -8,3 +19,198 @@ type KeyValueDB interface {
 	Iterate(fn func(key, value string) bool) error
 	Close() error
 }
+
+// ErrKVUnsupported is returned for operations the KeyValueDB backend does not implement.
+var ErrKVUnsupported = errors.New("key value db: operation not supported by the backend")
+
+// KVBatcher is implemented by backends with atomic batches.
+type KVBatcher interface {
+	// Batch applies b atomically: if every condition of b holds, all its writes are committed,
+	// otherwise ErrKVConflict is returned and nothing changes. Backends must not leave part of
+	// a batch behind after a crash.
+	Batch(b KVBatch) error
+}
+
+// KVScanner is implemented by backends with ordered range scans. Use ScanPage to scan any
+// KeyValueDB.
+type KVScanner interface {
+	// Scan returns one page of the entries selected by q, in key order.
+	Scan(q KVScan) (KVPage, error)
+}
+
+// KVWatcher is implemented by backends that report their changes.
+type KVWatcher interface {
+	// Watch streams the changes of the keys starting with prefix, made by any writer of the
+	// database, until ctx ends. Changes are dropped while the channel is full.
+	Watch(ctx context.Context, prefix string) (<-chan KVChange, error)
+}
+
+// KVExpirer is implemented by backends with expiring keys, see the kvmaint package.
+type KVExpirer interface {
+	// SetWithTTL sets key to value until ttl has passed; after that the key reads as absent and
+	// is removed by the backend's expiry sweeper, reported to watchers as a delete. A ttl <= 0
+	// sets the key without expiry, like Set. Set and Delete clear the expiry of a key.
+	SetWithTTL(key, value string, ttl time.Duration) error
+	// TTL returns the time left before key expires, 0 if it does not expire; ok is false when
+	// the key is absent.
+	TTL(key string) (ttl time.Duration, ok bool, err error)
+}
+
+// KVCompactor is implemented by backends that can reclaim the space of overwritten, deleted and
+// expired entries, such as the append-only text_log backend. Hosts run it periodically, see the
+// kvmaint package.
+type KVCompactor interface {
+	Compact() error
+}
+
+// KVChangeOp is the kind of a KVChange.
+type KVChangeOp string
+
+const (
+	KVChangeSet    KVChangeOp = "set"
+	KVChangeDelete KVChangeOp = "delete"
+)
+
+// KVChange reports a write to a key. A batch reports one change per write.
+type KVChange struct {
+	Op  KVChangeOp
+	Key string
+	// OldValue is the value before the write, if OldExists.
+	OldValue  string
+	OldExists bool
+	// NewValue is the written value; empty for deletes.
+	NewValue string
+}
+
+// Limits of KVScan.Limit.
+const (
+	DefaultKVScanLimit = 256
+	MaxKVScanLimit     = 4096
+)
+
+// KVScan selects a key range of a KeyValueDB. Zero fields select every key.
+type KVScan struct {
+	// Prefix restricts the scan to keys starting with it.
+	Prefix string
+	// Start is the first key (inclusive) and End the last key (exclusive); an empty End is
+	// unbounded.
+	Start string
+	End   string
+	// Reverse scans from the largest key down.
+	Reverse bool
+	// Limit is the page size, DefaultKVScanLimit when <= 0 and at most MaxKVScanLimit.
+	Limit int
+	// Cursor is KVPage.NextCursor of the previous page; empty for the first page.
+	Cursor string
+}
+
+// KVEntry is a key/value pair returned by KVScanner.Scan.
+type KVEntry struct {
+	Key   string
+	Value string
+}
+
+// KVPage is a page of KVScanner.Scan results.
+type KVPage struct {
+	Entries []KVEntry
+	// NextCursor continues the scan; empty when there are no more entries.
+	NextCursor string
+}
+
+// PageLimit returns the page size of q.
+func (q KVScan) PageLimit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultKVScanLimit
+	case q.Limit > MaxKVScanLimit:
+		return MaxKVScanLimit
+	}
+	return q.Limit
+}
+
+// Contains reports whether key is in the range of q, ignoring the cursor.
+func (q KVScan) Contains(key string) bool {
+	return strings.HasPrefix(key, q.Prefix) && key >= q.Start && (q.End == "" || key < q.End)
+}
+
+// KVCursor returns the cursor continuing a scan after key. Backends set KVPage.NextCursor to
+// the cursor of the last key of a page that is followed by more entries.
+func KVCursor(key string) string { return "@" + key }
+
+// After reports whether key comes after the cursor of q in scan order.
+func (q KVScan) After(key string) bool {
+	cursor, ok := strings.CutPrefix(q.Cursor, "@")
+	switch {
+	case !ok:
+		return true
+	case q.Reverse:
+		return key < cursor
+	default:
+		return key > cursor
+	}
+}
+
+// ErrKVConflict is returned by KVBatcher.Batch when a condition of the batch does not hold.
+var ErrKVConflict = errors.New("key value db: batch condition failed")
+
+// KVBatch is a set of writes committed together by KVBatcher.Batch.
+type KVBatch struct {
+	// Conditions are checked before any write is applied.
+	Conditions []KVCondition
+	// Writes are applied in order; a later write to the same key wins.
+	Writes []KVWrite
+}
+
+// KVCondition requires key to hold Value (Exists) or to be absent (!Exists).
+type KVCondition struct {
+	Key    string
+	Value  string
+	Exists bool
+}
+
+// KVWrite sets Key to Value, or deletes it when Delete is set.
+type KVWrite struct {
+	Key    string
+	Value  string
+	Delete bool
+	// TTL makes a set expire like KVExpirer.SetWithTTL; backends without KVExpirer ignore it.
+	TTL time.Duration
+}
+
+// Set adds a write of value to key.
+func (b *KVBatch) Set(key, value string) *KVBatch {
+	b.Writes = append(b.Writes, KVWrite{Key: key, Value: value})
+	return b
+}
+
+// SetWithTTL adds a write of value to key expiring after ttl.
+func (b *KVBatch) SetWithTTL(key, value string, ttl time.Duration) *KVBatch {
+	b.Writes = append(b.Writes, KVWrite{Key: key, Value: value, TTL: ttl})
+	return b
+}
+
+// Delete adds a delete of key.
+func (b *KVBatch) Delete(key string) *KVBatch {
+	b.Writes = append(b.Writes, KVWrite{Key: key, Delete: true})
+	return b
+}
+
+// Require makes the batch conditional on key holding value.
+func (b *KVBatch) Require(key, value string) *KVBatch {
+	b.Conditions = append(b.Conditions, KVCondition{Key: key, Value: value, Exists: true})
+	return b
+}
+
+// RequireAbsent makes the batch conditional on key being absent.
+func (b *KVBatch) RequireAbsent(key string) *KVBatch {
+	b.Conditions = append(b.Conditions, KVCondition{Key: key})
+	return b
+}
+
+// Holds reports whether c is met by the current state of its key.
+func (c KVCondition) Holds(value string, exists bool) bool {
+	if !c.Exists {
+		return !exists
+	}
+	return exists && value == c.Value
+}
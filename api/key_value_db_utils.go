@@ -1,6 +1,13 @@
 package api
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+)
 
 // MigrateKeyValueDB copies all key/value pairs from src into dst.
 // It stops at the first dst.Set error and returns that error.
@@ -25,3 +32,196 @@ func MigrateKeyValueDB(src KeyValueDB, dst KeyValueDB) error {
 	}
 	return setErr
 }
+
+// CompareAndSwap sets key to newValue if it currently holds oldValue. It reports whether the
+// swap happened. Like the other conditional writes it needs a KVBatcher.
+func CompareAndSwap(db KeyValueDB, key, oldValue, newValue string) (bool, error) {
+	return applyConditional("CompareAndSwap", db, (&KVBatch{}).Require(key, oldValue).Set(key, newValue))
+}
+
+// CompareAndDelete deletes key if it currently holds oldValue. It reports whether key was deleted.
+func CompareAndDelete(db KeyValueDB, key, oldValue string) (bool, error) {
+	return applyConditional("CompareAndDelete", db, (&KVBatch{}).Require(key, oldValue).Delete(key))
+}
+
+// SetIfAbsent sets key to value if it does not exist yet. It reports whether key was set.
+func SetIfAbsent(db KeyValueDB, key, value string) (bool, error) {
+	return applyConditional("SetIfAbsent", db, (&KVBatch{}).RequireAbsent(key).Set(key, value))
+}
+
+func applyConditional(name string, db KeyValueDB, b *KVBatch) (bool, error) {
+	if db == nil {
+		return false, errors.New(name + ": db is nil")
+	}
+	batcher, ok := db.(KVBatcher)
+	if !ok {
+		return false, fmt.Errorf("%s: %w", name, ErrKVUnsupported)
+	}
+	err := batcher.Batch(*b)
+	if errors.Is(err, ErrKVConflict) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// DefaultKVUpdateAttempts is the number of times UpdateKeyValueDB runs fn before giving up.
+const DefaultKVUpdateAttempts = 10
+
+// KVTx is the transaction passed to the function of UpdateKeyValueDB. Reads see the writes
+// made earlier in the transaction; writes are buffered until commit.
+type KVTx struct {
+	db     KeyValueDB
+	reads  map[string]KVCondition
+	writes map[string]KVWrite
+	order  []string
+}
+
+// Get returns the value of key as seen by the transaction.
+func (tx *KVTx) Get(key string) (string, bool, error) {
+	if w, ok := tx.writes[key]; ok {
+		return w.Value, !w.Delete, nil
+	}
+	if c, ok := tx.reads[key]; ok {
+		return c.Value, c.Exists, nil
+	}
+	value, ok, err := tx.db.Get(key)
+	if err != nil {
+		return "", false, err
+	}
+	tx.reads[key] = KVCondition{Key: key, Value: value, Exists: ok}
+	return value, ok, nil
+}
+
+// Set buffers a write of value to key.
+func (tx *KVTx) Set(key, value string) { tx.write(KVWrite{Key: key, Value: value}) }
+
+// Delete buffers a delete of key.
+func (tx *KVTx) Delete(key string) { tx.write(KVWrite{Key: key, Delete: true}) }
+
+func (tx *KVTx) write(w KVWrite) {
+	if _, ok := tx.writes[w.Key]; !ok {
+		tx.order = append(tx.order, w.Key)
+	}
+	tx.writes[w.Key] = w
+}
+
+func (tx *KVTx) batch() KVBatch {
+	var b KVBatch
+	for _, c := range tx.reads {
+		b.Conditions = append(b.Conditions, c)
+	}
+	for _, key := range tx.order {
+		b.Writes = append(b.Writes, tx.writes[key])
+	}
+	return b
+}
+
+// UpdateKeyValueDB runs fn in an optimistic transaction: the values fn reads become the
+// conditions of one Batch carrying its writes, so db must be a KVBatcher. When another writer
+// changed one of them in between, fn runs again after a short random delay, up to
+// DefaultKVUpdateAttempts times before ErrKVConflict is returned.
+// An error returned by fn discards the writes. fn must not have side effects besides tx.
+func UpdateKeyValueDB(db KeyValueDB, fn func(tx *KVTx) error) error {
+	if db == nil {
+		return errors.New("UpdateKeyValueDB: db is nil")
+	}
+	if fn == nil {
+		return nil
+	}
+	batcher, ok := db.(KVBatcher)
+	if !ok {
+		return fmt.Errorf("UpdateKeyValueDB: %w", ErrKVUnsupported)
+	}
+	for attempt := range DefaultKVUpdateAttempts {
+		if attempt > 0 {
+			// Back off so concurrent writers of the same keys do not keep colliding.
+			time.Sleep(rand.N(time.Duration(attempt) * time.Millisecond))
+		}
+		tx := &KVTx{db: db, reads: map[string]KVCondition{}, writes: map[string]KVWrite{}}
+		if err := fn(tx); err != nil {
+			return err
+		}
+		if len(tx.writes) == 0 {
+			return nil
+		}
+		err := batcher.Batch(tx.batch())
+		if !errors.Is(err, ErrKVConflict) {
+			return err
+		}
+	}
+	return ErrKVConflict
+}
+
+// ScanPage returns one page of the entries of db selected by q. Backends that do not implement
+// KVScanner are scanned with Iterate, which reads the whole database for every page.
+func ScanPage(db KeyValueDB, q KVScan) (KVPage, error) {
+	if db == nil {
+		return KVPage{}, errors.New("ScanPage: db is nil")
+	}
+	if s, ok := db.(KVScanner); ok {
+		return s.Scan(q)
+	}
+	return scanByIterate(db.Iterate, q)
+}
+
+// scanByIterate emulates KVScanner.Scan with an Iterate method.
+func scanByIterate(iterate func(fn func(key, value string) bool) error, q KVScan) (KVPage, error) {
+	var entries []KVEntry
+	err := iterate(func(key, value string) bool {
+		if q.Contains(key) && q.After(key) {
+			entries = append(entries, KVEntry{Key: key, Value: value})
+		}
+		return true
+	})
+	if err != nil {
+		return KVPage{}, err
+	}
+	slices.SortFunc(entries, func(a, b KVEntry) int { return strings.Compare(a.Key, b.Key) })
+	if q.Reverse {
+		slices.Reverse(entries)
+	}
+	var page KVPage
+	if limit := q.PageLimit(); len(entries) > limit {
+		entries = entries[:limit]
+		page.NextCursor = KVCursor(entries[limit-1].Key)
+	}
+	page.Entries = entries
+	return page, nil
+}
+
+// ScanKeyValueDB calls fn for every entry selected by q, fetching one page at a time with
+// ScanPage, until fn returns false. The limit of q is the page size.
+func ScanKeyValueDB(db KeyValueDB, q KVScan, fn func(key, value string) bool) error {
+	if db == nil {
+		return errors.New("ScanKeyValueDB: db is nil")
+	}
+	if fn == nil {
+		return nil
+	}
+	for {
+		page, err := ScanPage(db, q)
+		if err != nil {
+			return err
+		}
+		for _, e := range page.Entries {
+			if !fn(e.Key, e.Value) {
+				return nil
+			}
+		}
+		if page.NextCursor == "" {
+			return nil
+		}
+		q.Cursor = page.NextCursor
+	}
+}
+
+// IteratePrefix calls fn for the entries whose key starts with prefix, in key order.
+func IteratePrefix(db KeyValueDB, prefix string, fn func(key, value string) bool) error {
+	return ScanKeyValueDB(db, KVScan{Prefix: prefix}, fn)
+}
+
+// IterateRange calls fn for the entries with start <= key < end, in key order. An empty end is
+// unbounded.
+func IterateRange(db KeyValueDB, start, end string, fn func(key, value string) bool) error {
+	return ScanKeyValueDB(db, KVScan{Start: start, End: end}, fn)
+}
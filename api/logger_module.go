@@ -1,5 +1,14 @@
 package api
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
 const NameLoggerModule = "logger"
 
 // LoggerModule writes log lines to the host's log storage.
@@ -12,4 +21,204 @@ type LoggerModule interface {
 	Warn(scope, msg string)
 	Error(scope, msg string)
 	Success(scope, msg string)
+
+	// LogRecord writes a structured entry. Hosts without structured storage keep
+	// record.Text() as the message.
+	LogRecord(record LogRecord)
+
+	// QueryLogs returns one page of the stored entries matching q.
+	QueryLogs(ctx context.Context, q LogQuery) (LogPage, error)
+	// TailLogs streams the entries matching q as they are written, until ctx ends. Since, Until,
+	// Limit, Cursor and Ascending are ignored.
+	TailLogs(ctx context.Context, q LogQuery) (<-chan LogRecord, error)
+}
+
+// Limits of LogQuery.Limit.
+const (
+	DefaultLogQueryLimit = 100
+	MaxLogQueryLimit     = 1000
+)
+
+// LogQuery selects log entries. Zero fields match every entry.
+type LogQuery struct {
+	// Scopes and Levels match any of their values.
+	Scopes []string
+	Levels []Level
+	// Since is inclusive, Until exclusive.
+	Since time.Time
+	Until time.Time
+	// Text matches entries whose Text() contains it, ignoring case.
+	Text string
+	// Limit is the page size, DefaultLogQueryLimit when <= 0 and at most MaxLogQueryLimit.
+	Limit int
+	// Cursor is LogPage.NextCursor of the previous page; empty for the first page.
+	Cursor string
+	// Ascending returns the oldest entries first; by default the newest come first.
+	Ascending bool
+}
+
+// Match reports whether r matches the filters of q (everything but the paging fields).
+func (q LogQuery) Match(r LogRecord) bool {
+	if len(q.Scopes) > 0 && !slices.Contains(q.Scopes, r.Scope) {
+		return false
+	}
+	if len(q.Levels) > 0 && !slices.Contains(q.Levels, r.Level) {
+		return false
+	}
+	if !q.Since.IsZero() && r.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !r.Time.Before(q.Until) {
+		return false
+	}
+	if q.Text != "" && !strings.Contains(strings.ToLower(r.Text()), strings.ToLower(q.Text)) {
+		return false
+	}
+	return true
+}
+
+// PageLimit returns the page size of q.
+func (q LogQuery) PageLimit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultLogQueryLimit
+	case q.Limit > MaxLogQueryLimit:
+		return MaxLogQueryLimit
+	}
+	return q.Limit
+}
+
+// LogPage is a page of QueryLogs results.
+type LogPage struct {
+	Records []LogRecord
+	// NextCursor continues the query; empty when there are no more entries.
+	NextCursor string
+}
+
+// LogRecord is a structured log entry.
+type LogRecord struct {
+	// Time defaults to the time the host receives the record.
+	Time  time.Time
+	Scope string
+	Level Level
+	Msg   string
+	// Err is the text of the error logged with the entry, if any.
+	Err    string
+	Fields []LogField
+}
+
+// LogField is a key/value pair of a LogRecord. Value is nil, a string, bool, int64, uint64,
+// float64 or time.Time; LogAny converts other values.
+type LogField struct {
+	Key   string
+	Value any
+}
+
+// LogAny returns a field with value converted to one of the LogField value types: integers
+// widen to int64/uint64, errors and fmt.Stringers become their text, durations their string
+// form and anything else its JSON encoding.
+func LogAny(key string, value any) LogField {
+	return LogField{Key: key, Value: normalizeLogValue(value)}
+}
+
+// LogString, LogInt, LogBool and LogErr are shorthands for common fields.
+func LogString(key, value string) LogField    { return LogField{Key: key, Value: value} }
+func LogInt(key string, value int64) LogField { return LogField{Key: key, Value: value} }
+func LogBool(key string, value bool) LogField { return LogField{Key: key, Value: value} }
+func LogErr(err error) LogField               { return LogAny("error", err) }
+
+func normalizeLogValue(v any) any {
+	switch v := v.(type) {
+	case nil, string, bool, int64, uint64, float64, time.Time:
+		return v
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint:
+		return uint64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case float32:
+		return float64(v)
+	case time.Duration:
+		return v.String()
+	case []byte:
+		return string(v)
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// Normalized returns a copy of r whose field values are all LogField value types.
+func (r LogRecord) Normalized() LogRecord {
+	if len(r.Fields) == 0 {
+		return r
+	}
+	fields := make([]LogField, len(r.Fields))
+	for i, f := range r.Fields {
+		fields[i] = LogAny(f.Key, f.Value)
+	}
+	r.Fields = fields
+	return r
+}
+
+// Field returns the value of the first field named key.
+func (r LogRecord) Field(key string) (any, bool) {
+	for _, f := range r.Fields {
+		if f.Key == key {
+			return f.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Text formats the record as a flat message: msg followed by key=value pairs and the error.
+func (r LogRecord) Text() string {
+	var b strings.Builder
+	b.WriteString(r.Msg)
+	for _, f := range r.Fields {
+		b.WriteByte(' ')
+		b.WriteString(f.Key)
+		b.WriteByte('=')
+		b.WriteString(formatLogValue(f.Value))
+	}
+	if r.Err != "" {
+		b.WriteString(" error=")
+		b.WriteString(formatLogValue(r.Err))
+	}
+	return b.String()
+}
+
+func formatLogValue(v any) string {
+	var s string
+	switch v := normalizeLogValue(v).(type) {
+	case nil:
+		return "<nil>"
+	case string:
+		s = v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return fmt.Sprintf("%q", s)
+	}
+	return s
 }
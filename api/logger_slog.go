@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// slog levels of the Levels without an slog equivalent.
+const (
+	SlogLevelTrace   = slog.LevelDebug - 4
+	SlogLevelSuccess = slog.LevelInfo + 2
+)
+
+// SlogLevel maps an slog level to a Level.
+func SlogLevel(l slog.Level) Level {
+	switch {
+	case l < slog.LevelDebug:
+		return LevelTrace
+	case l < slog.LevelInfo:
+		return LevelDebug
+	case l < SlogLevelSuccess:
+		return LevelInfo
+	case l < slog.LevelWarn:
+		return LevelSuccess
+	case l < slog.LevelError:
+		return LevelWarn
+	default:
+		return LevelError
+	}
+}
+
+// SlogHandlerOptions configures NewSlogHandler.
+type SlogHandlerOptions struct {
+	// Level is the minimum level forwarded. Defaults to slog.LevelInfo.
+	Level slog.Leveler
+}
+
+type slogHandler struct {
+	logger LoggerModule
+	scope  string
+	level  slog.Leveler
+	// prefix is the key prefix of the open groups, e.g. "req.".
+	prefix string
+	fields []LogField
+	err    string
+}
+
+// NewSlogHandler returns an slog.Handler writing to logger with LogRecord under scope. Groups
+// prefix keys with "group.", and the first error valued attribute becomes LogRecord.Err.
+//
+//	slog.SetDefault(slog.New(api.NewSlogHandler(logger, "MyPlugin", nil)))
+func NewSlogHandler(logger LoggerModule, scope string, opts *SlogHandlerOptions) slog.Handler {
+	h := &slogHandler{logger: logger, scope: scope, level: slog.LevelInfo}
+	if opts != nil && opts.Level != nil {
+		h.level = opts.Level
+	}
+	return h
+}
+
+func (h *slogHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return h.logger != nil && l >= h.level.Level()
+}
+
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	if h.logger == nil {
+		return nil
+	}
+	rec := LogRecord{
+		Time:   r.Time,
+		Scope:  h.scope,
+		Level:  SlogLevel(r.Level),
+		Msg:    r.Message,
+		Err:    h.err,
+		Fields: slices.Clip(h.fields),
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		rec.Fields, rec.Err = appendSlogAttr(rec.Fields, rec.Err, h.prefix, a)
+		return true
+	})
+	h.logger.LogRecord(rec)
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	h2 := *h
+	h2.fields = slices.Clip(h.fields)
+	for _, a := range attrs {
+		h2.fields, h2.err = appendSlogAttr(h2.fields, h2.err, h.prefix, a)
+	}
+	return &h2
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+func appendSlogAttr(fields []LogField, errText, prefix string, a slog.Attr) ([]LogField, string) {
+	v := a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return fields, errText
+	}
+	if v.Kind() == slog.KindGroup {
+		p := prefix
+		if a.Key != "" {
+			p += a.Key + "."
+		}
+		for _, ga := range v.Group() {
+			fields, errText = appendSlogAttr(fields, errText, p, ga)
+		}
+		return fields, errText
+	}
+	if err, ok := v.Any().(error); ok && errText == "" && v.Kind() == slog.KindAny {
+		return fields, err.Error()
+	}
+	var value any
+	switch v.Kind() {
+	case slog.KindString:
+		value = v.String()
+	case slog.KindInt64:
+		value = v.Int64()
+	case slog.KindUint64:
+		value = v.Uint64()
+	case slog.KindFloat64:
+		value = v.Float64()
+	case slog.KindBool:
+		value = v.Bool()
+	case slog.KindDuration:
+		value = v.Duration().String()
+	case slog.KindTime:
+		value = v.Time()
+	default:
+		value = normalizeLogValue(v.Any())
+	}
+	return append(fields, LogField{Key: prefix + a.Key, Value: value}), errText
+}
@@ -1,6 +1,10 @@
 package api
 
-import "github.com/Yeah114/EmptyDea-plugin-sdk/define"
+import (
+	"log/slog"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
 
 type PluginTool struct {
 	frame          define.Frame
@@ -70,6 +74,11 @@ func (t *PluginTool) LogSuccess(msg string) {
 	t.loggerModule.Success(t.Name(), msg)
 }
 
+// Slog returns an slog.Logger writing to the LoggerModule with the plugin name as scope.
+func (t *PluginTool) Slog() *slog.Logger {
+	return slog.New(NewSlogHandler(t.loggerModule, t.Name(), nil))
+}
+
 func (t *PluginTool) UpgradePluginConfig(config map[string]interface{}) error {
 	if t == nil || t.frame == nil || t.pluginID == "" {
 		return nil
@@ -0,0 +1,311 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// SQLDBRef names a SQL database of a plugin.
+type SQLDBRef struct {
+	// Owner is the ID of the plugin owning the database, filled in by the host.
+	Owner string
+	// Name is the logical database name; it may not contain path separators.
+	Name string
+}
+
+// SQLQueryer runs parameterised statements. Arguments are bound to "?" (or "?NNN", ":name")
+// placeholders and are normalised with NormalizeSQLValue; never format them into query.
+type SQLQueryer interface {
+	Exec(ctx context.Context, query string, args ...any) (SQLResult, error)
+	// Query runs a statement returning rows. The rows must be closed.
+	Query(ctx context.Context, query string, args ...any) (SQLRows, error)
+}
+
+// SQLDB is a SQLite database.
+type SQLDB interface {
+	SQLQueryer
+	// Begin starts a transaction. It is rolled back if ctx ends before Commit.
+	Begin(ctx context.Context) (SQLTx, error)
+	Close() error
+}
+
+// SQLTx is a transaction started by SQLDB.Begin.
+type SQLTx interface {
+	SQLQueryer
+	Commit() error
+	Rollback() error
+}
+
+// SQLResult summarises an Exec.
+type SQLResult struct {
+	RowsAffected int64
+	LastInsertID int64
+}
+
+// SQLRows iterates the result of a query. Over RPC rows are streamed in chunks while iterating.
+//
+//	rows, err := db.Query(ctx, "SELECT name, score FROM scores WHERE score > ?", 10)
+//	if err != nil { ... }
+//	defer rows.Close()
+//	for rows.Next() {
+//		var name string
+//		var score int64
+//		if err := rows.Scan(&name, &score); err != nil { ... }
+//	}
+//	if err := rows.Err(); err != nil { ... }
+type SQLRows interface {
+	Columns() []string
+	// Next advances to the next row. It returns false at the end of the rows or on error, see Err.
+	Next() bool
+	// Values returns the current row, normalised as by NormalizeSQLValue.
+	Values() []any
+	// Scan copies the current row into dest, see ScanSQLValues.
+	Scan(dest ...any) error
+	Err() error
+	Close() error
+}
+
+// NormalizeSQLValue converts v to one of the types carried over RPC: nil, int64, float64, bool,
+// string, []byte or time.Time. Other integer and float types, named types of those kinds,
+// pointers and driver.Valuer values are converted; anything else is an error.
+func NormalizeSQLValue(v any) (any, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		val, err := valuer.Value()
+		if err != nil {
+			return nil, err
+		}
+		v = val
+	}
+	switch val := v.(type) {
+	case nil, int64, float64, bool, string, []byte, time.Time:
+		return val, nil
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return nil, fmt.Errorf("api.NormalizeSQLValue: %d overflows int64", u)
+		}
+		return int64(u), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), nil
+		}
+	case reflect.Struct:
+		if t, ok := rv.Interface().(time.Time); ok {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("api.NormalizeSQLValue: unsupported type %T", v)
+}
+
+// NormalizeSQLArgs applies NormalizeSQLValue to every argument.
+func NormalizeSQLArgs(args []any) ([]any, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	out := make([]any, len(args))
+	for i, a := range args {
+		v, err := NormalizeSQLValue(a)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i+1, err)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// ScanSQLValues copies a row into dest, converting like database/sql does. dest may hold
+// pointers to strings, []byte, integers, floats, bools, time.Time, any, or sql.Scanner
+// implementations. NULL is stored as the zero value unless dest is a *any or a sql.Scanner.
+func ScanSQLValues(values []any, dest ...any) error {
+	if len(dest) != len(values) {
+		return fmt.Errorf("api.ScanSQLValues: expected %d destination arguments, not %d", len(values), len(dest))
+	}
+	for i, d := range dest {
+		if err := scanSQLValue(d, values[i]); err != nil {
+			return fmt.Errorf("api.ScanSQLValues: column %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func scanSQLValue(dest, v any) error {
+	switch d := dest.(type) {
+	case *any:
+		if b, ok := v.([]byte); ok {
+			v = append([]byte(nil), b...)
+		}
+		*d = v
+		return nil
+	case sql.Scanner:
+		return d.Scan(v)
+	case *string:
+		return scanSQLNull(d, v)
+	case *[]byte:
+		if v == nil {
+			*d = nil
+			return nil
+		}
+		return scanSQLNull(d, v)
+	case *int:
+		return scanSQLNull(d, v)
+	case *int8:
+		return scanSQLNull(d, v)
+	case *int16:
+		return scanSQLNull(d, v)
+	case *int32:
+		return scanSQLNull(d, v)
+	case *int64:
+		return scanSQLNull(d, v)
+	case *uint:
+		return scanSQLNull(d, v)
+	case *uint8:
+		return scanSQLNull(d, v)
+	case *uint16:
+		return scanSQLNull(d, v)
+	case *uint32:
+		return scanSQLNull(d, v)
+	case *uint64:
+		return scanSQLNull(d, v)
+	case *float32:
+		return scanSQLNull(d, v)
+	case *float64:
+		return scanSQLNull(d, v)
+	case *bool:
+		return scanSQLNull(d, v)
+	case *time.Time:
+		return scanSQLNull(d, v)
+	case nil:
+		return errors.New("destination is nil")
+	}
+	return fmt.Errorf("unsupported destination type %T", dest)
+}
+
+func scanSQLNull[T any](dest *T, v any) error {
+	var n sql.Null[T]
+	if err := n.Scan(v); err != nil {
+		return err
+	}
+	*dest = n.V
+	return nil
+}
+
+// WithSQLTx runs fn in a transaction of db, committing it if fn returns nil and rolling it back
+// otherwise.
+func WithSQLTx(ctx context.Context, db SQLDB, fn func(tx SQLTx) error) error {
+	if db == nil {
+		return errors.New("api.WithSQLTx: db is nil")
+	}
+	if fn == nil {
+		return errors.New("api.WithSQLTx: fn is nil")
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// SQLMigration is one step of a schema, applied once by MigrateSQLDB.
+type SQLMigration struct {
+	// Version orders the migrations; it must be positive and unique.
+	Version int
+	Name    string
+	// SQL may hold several statements separated by semicolons.
+	SQL string
+}
+
+// SQLMigrationsTable records the applied migrations of a database.
+const SQLMigrationsTable = "schema_migrations"
+
+// MigrateSQLDB applies the migrations not applied to db yet, in version order, each in its own
+// transaction together with its record in SQLMigrationsTable.
+//
+//	err := api.MigrateSQLDB(ctx, db, []api.SQLMigration{
+//		{Version: 1, Name: "scores", SQL: `CREATE TABLE scores (name TEXT PRIMARY KEY, score INTEGER NOT NULL)`},
+//		{Version: 2, Name: "scores_by_score", SQL: `CREATE INDEX scores_by_score ON scores (score)`},
+//	})
+func MigrateSQLDB(ctx context.Context, db SQLDB, migrations []SQLMigration) error {
+	if db == nil {
+		return errors.New("api.MigrateSQLDB: db is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	sorted := append([]SQLMigration(nil), migrations...)
+	seen := map[int]bool{}
+	for _, m := range sorted {
+		if m.Version <= 0 {
+			return fmt.Errorf("api.MigrateSQLDB: invalid version %d", m.Version)
+		}
+		if seen[m.Version] {
+			return fmt.Errorf("api.MigrateSQLDB: duplicate version %d", m.Version)
+		}
+		seen[m.Version] = true
+	}
+	slices.SortFunc(sorted, func(a, b SQLMigration) int { return a.Version - b.Version })
+
+	if _, err := db.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+SQLMigrationsTable+` (
+	version INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at INTEGER NOT NULL
+)`); err != nil {
+		return fmt.Errorf("api.MigrateSQLDB: %w", err)
+	}
+	for _, m := range sorted {
+		err := WithSQLTx(ctx, db, func(tx SQLTx) error {
+			rows, err := tx.Query(ctx, `SELECT 1 FROM `+SQLMigrationsTable+` WHERE version = ?`, m.Version)
+			if err != nil {
+				return err
+			}
+			applied := rows.Next()
+			err = rows.Err()
+			_ = rows.Close()
+			if err != nil || applied {
+				return err
+			}
+			if _, err := tx.Exec(ctx, m.SQL); err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, `INSERT INTO `+SQLMigrationsTable+` (version, name, applied_at) VALUES (?, ?, ?)`, m.Version, m.Name, time.Now().Unix())
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("api.MigrateSQLDB: migration %d %s: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
@@ -14,6 +14,9 @@ const (
 	LevelInfo    Level = "INFO"
 	LevelWarn    Level = "WARN"
 	LevelError   Level = "ERRO"
+	// LevelDebug and LevelTrace are finer than LevelInfo; hosts may not print them on the terminal.
+	LevelDebug Level = "DBUG"
+	LevelTrace Level = "TRAC"
 )
 
 type TerminalModule interface {
@@ -0,0 +1,274 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Codec encodes the events of a Topic.
+type Codec interface {
+	// Name identifies the codec on the wire, see RegisterCodec.
+	Name() string
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// CodecJSON and CodecGob are the names of the built-in codecs.
+const (
+	CodecJSON = "json"
+	CodecGob  = "gob"
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string                       { return CodecJSON }
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+type gobCodec struct{}
+
+func (gobCodec) Name() string { return CodecGob }
+
+func (gobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+var (
+	// JSONCodec is the default Topic codec.
+	JSONCodec Codec = jsonCodec{}
+	// GobCodec is a compact codec for events only shared between Go plugins.
+	GobCodec Codec = gobCodec{}
+)
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[string]Codec{CodecJSON: JSONCodec, CodecGob: GobCodec}
+)
+
+// RegisterCodec makes c available to decode events published with it.
+// Registering a codec with an existing name replaces it.
+func RegisterCodec(c Codec) {
+	if c == nil || c.Name() == "" {
+		return
+	}
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[c.Name()] = c
+}
+
+// LookupCodec returns the codec registered under name.
+func LookupCodec(name string) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	c, ok := codecs[name]
+	return c, ok
+}
+
+var (
+	// ErrUnknownCodec is reported when an event was encoded with a codec that is not registered.
+	ErrUnknownCodec = errors.New("unknown topic codec")
+	// ErrTopicVersion is reported when an event has another schema version and the topic has no Upgrade.
+	ErrTopicVersion = errors.New("unsupported topic schema version")
+)
+
+// TopicDecodeError describes an event a subscriber could not decode.
+type TopicDecodeError struct {
+	Topic   string
+	Codec   string
+	Version int
+	Payload []byte
+	Err     error
+}
+
+func (e *TopicDecodeError) Error() string {
+	return fmt.Sprintf("topic %s: decode %s event (version %d): %v", e.Topic, e.Codec, e.Version, e.Err)
+}
+
+func (e *TopicDecodeError) Unwrap() error { return e.Err }
+
+// TopicOptions configures a Topic. The zero value publishes version 1 JSON events.
+type TopicOptions[T any] struct {
+	// Codec encodes published events. Defaults to JSONCodec.
+	// Subscribers decode with the codec named in each event, so publishers may switch codecs.
+	Codec Codec
+	// Version is the schema version stamped on published events. Defaults to 1.
+	Version int
+	// Upgrade decodes events of other schema versions; data is encoded with codec.
+	// Without it such events are reported with ErrTopicVersion.
+	Upgrade func(version int, codec Codec, data []byte) (T, error)
+	// OnDecodeError receives the events subscribers could not decode; they are dropped otherwise.
+	OnDecodeError func(err *TopicDecodeError)
+	// Buffer is the capacity of subscription channels. Defaults to 64.
+	Buffer int
+}
+
+// Topic is a typed event stream over FlexModule.Publish/Subscribe.
+//
+// Events are wrapped in a small JSON envelope carrying the codec name and schema version.
+// Payloads without an envelope (published with plain FlexModule.Publish) are decoded as JSON
+// of the current version. Name topics "<plugin or domain>:<event>", e.g. "economy:balance_changed".
+//
+//	type BalanceChanged struct {
+//		Player string `json:"player"`
+//		Delta  int64  `json:"delta"`
+//	}
+//
+//	balance := api.NewTopic[BalanceChanged](flex, "economy:balance_changed", api.TopicOptions[BalanceChanged]{})
+//	_ = balance.Publish(ctx, BalanceChanged{Player: "Steve", Delta: 10})
+//	for ev := range balance.Subscribe(ctx) { ... }
+type Topic[T any] struct {
+	flex FlexModule
+	name string
+	opts TopicOptions[T]
+}
+
+// topicEnvelope is the wire format of a Topic event. JSON events are embedded in Data,
+// events of other codecs are carried in Raw.
+type topicEnvelope struct {
+	Codec   string          `json:"$codec"`
+	Version int             `json:"$v"`
+	Data    json.RawMessage `json:"data,omitempty"`
+	Raw     []byte          `json:"raw,omitempty"`
+}
+
+// NewTopic returns the topic name on flex.
+func NewTopic[T any](flex FlexModule, name string, opts TopicOptions[T]) *Topic[T] {
+	if opts.Codec == nil {
+		opts.Codec = JSONCodec
+	}
+	if opts.Version <= 0 {
+		opts.Version = 1
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = 64
+	}
+	return &Topic[T]{flex: flex, name: name, opts: opts}
+}
+
+// Name returns the Flex topic name.
+func (t *Topic[T]) Name() string {
+	if t == nil {
+		return ""
+	}
+	return t.name
+}
+
+// Publish encodes v and publishes it to every subscriber of the topic.
+func (t *Topic[T]) Publish(ctx context.Context, v T) error {
+	if t == nil || t.flex == nil {
+		return errors.New("api.Topic.Publish: flex module is nil")
+	}
+	if t.name == "" {
+		return errors.New("api.Topic.Publish: topic name is empty")
+	}
+	if ctx != nil {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	data, err := t.opts.Codec.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("api.Topic.Publish: encode %s: %w", t.name, err)
+	}
+	env := topicEnvelope{Codec: t.opts.Codec.Name(), Version: t.opts.Version}
+	if env.Codec == CodecJSON {
+		env.Data = data
+	} else {
+		env.Raw = data
+	}
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("api.Topic.Publish: encode %s: %w", t.name, err)
+	}
+	t.flex.Publish(t.name, payload)
+	return nil
+}
+
+// Subscribe streams the decoded events of the topic until ctx ends.
+// Events that cannot be decoded are passed to TopicOptions.OnDecodeError and skipped.
+func (t *Topic[T]) Subscribe(ctx context.Context) <-chan T {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if t == nil || t.flex == nil || t.name == "" {
+		out := make(chan T)
+		close(out)
+		return out
+	}
+	out := make(chan T, t.opts.Buffer)
+	in := t.flex.Subscribe(ctx, t.name)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-in:
+				if !ok {
+					return
+				}
+				v, err := t.decode(payload)
+				if err != nil {
+					if t.opts.OnDecodeError != nil {
+						t.opts.OnDecodeError(err)
+					}
+					continue
+				}
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+func (t *Topic[T]) decode(payload []byte) (T, *TopicDecodeError) {
+	var v T
+	var env topicEnvelope
+	if err := json.Unmarshal(payload, &env); err != nil || env.Codec == "" {
+		// Plain JSON published without a Topic.
+		env = topicEnvelope{Codec: CodecJSON, Version: t.opts.Version, Data: payload}
+	}
+	fail := func(err error) (T, *TopicDecodeError) {
+		return v, &TopicDecodeError{Topic: t.name, Codec: env.Codec, Version: env.Version, Payload: payload, Err: err}
+	}
+
+	codec, ok := LookupCodec(env.Codec)
+	if !ok {
+		return fail(fmt.Errorf("%w %q", ErrUnknownCodec, env.Codec))
+	}
+	data := env.Raw
+	if env.Codec == CodecJSON {
+		data = env.Data
+	}
+	if env.Version != t.opts.Version {
+		if t.opts.Upgrade == nil {
+			return fail(fmt.Errorf("%w %d (want %d)", ErrTopicVersion, env.Version, t.opts.Version))
+		}
+		upgraded, err := t.opts.Upgrade(env.Version, codec, data)
+		if err != nil {
+			return fail(err)
+		}
+		return upgraded, nil
+	}
+	if err := codec.Unmarshal(data, &v); err != nil {
+		return fail(err)
+	}
+	return v, nil
+}
@@ -0,0 +1,528 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// TypedConfig decodes PluginConfig.Config into the struct T.
+//
+// Fields are matched by their `mapstructure` tag (or field name) and may carry:
+//   - `default:"..."`: used when the key is missing. Scalars use their Go syntax, durations
+//     use time.ParseDuration syntax, slices/maps/structs use JSON.
+//   - `validate:"..."`: comma separated rules checked after decoding: required (non-zero),
+//     min=N / max=N (value for numbers and durations, length for strings, slices and maps),
+//     oneof=a b c, and pattern=REGEXP (must be the last rule, it may contain commas).
+//   - `desc:"..."`: description shown in the JSON Schema.
+//
+// For example:
+//
+//	type Config struct {
+//		UserName string        `mapstructure:"名字" default:"Steve" validate:"required"`
+//		Interval time.Duration `mapstructure:"间隔" default:"5s" validate:"min=1s"`
+//	}
+//
+//	cfg, err := api.NewTypedConfig[Config](p.Config())
+//	if err != nil { ... }
+//	_ = cfg.WriteBackDefaults(&p.PluginTool)
+type TypedConfig[T any] struct {
+	value   T
+	config  map[string]interface{}
+	missing map[string]interface{}
+}
+
+// ConfigUpgrader persists a plugin config map, see PluginTool.UpgradePluginConfig.
+type ConfigUpgrader interface {
+	UpgradePluginConfig(config map[string]interface{}) error
+}
+
+// NewTypedConfig fills the defaults of T into config, decodes it and validates the result.
+// config itself is not modified.
+func NewTypedConfig[T any](config map[string]interface{}) (*TypedConfig[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if derefType(t).Kind() != reflect.Struct {
+		return nil, fmt.Errorf("api.NewTypedConfig: %s is not a struct", t)
+	}
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	filled, missing, err := applyConfigDefaults(derefType(t), config, "")
+	if err != nil {
+		return nil, fmt.Errorf("api.NewTypedConfig: %w", err)
+	}
+
+	c := &TypedConfig[T]{config: filled, missing: missing}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     &c.value,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("api.NewTypedConfig: %w", err)
+	}
+	if err := decoder.Decode(filled); err != nil {
+		return nil, fmt.Errorf("api.NewTypedConfig: %w", err)
+	}
+	if err := ValidateConfig(c.value); err != nil {
+		return nil, fmt.Errorf("api.NewTypedConfig: %w", err)
+	}
+	return c, nil
+}
+
+// Value returns the decoded config.
+func (c *TypedConfig[T]) Value() T {
+	if c == nil {
+		var zero T
+		return zero
+	}
+	return c.value
+}
+
+// Config returns the raw config with missing defaults filled in.
+func (c *TypedConfig[T]) Config() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return c.config
+}
+
+// MissingDefaults returns the defaults that were absent from the raw config, nested like the config.
+func (c *TypedConfig[T]) MissingDefaults() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return c.missing
+}
+
+// WriteBackDefaults stores the config with the missing defaults through u, so they show up in
+// the plugin's config file. It does nothing when no default was missing.
+func (c *TypedConfig[T]) WriteBackDefaults(u ConfigUpgrader) error {
+	if c == nil || u == nil || len(c.missing) == 0 {
+		return nil
+	}
+	return u.UpgradePluginConfig(c.config)
+}
+
+// Schema returns the JSON Schema of T, see ConfigSchema. Plugins report it to the host with
+// protocol.WithConfigSchema.
+func (c *TypedConfig[T]) Schema() map[string]interface{} {
+	return ConfigSchema[T]()
+}
+
+// ConfigSchema describes T as a JSON Schema (draft 2020-12) using the tags documented on TypedConfig.
+func ConfigSchema[T any]() map[string]interface{} {
+	schema := configTypeSchema(reflect.TypeOf((*T)(nil)).Elem())
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	return schema
+}
+
+// ValidateConfig checks the `validate` tags of v, which must be a struct or a pointer to one.
+// All violations are joined into the returned error.
+func ValidateConfig(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("api.ValidateConfig: %s is not a struct", rv.Type())
+	}
+	return errors.Join(validateConfigStruct(rv, "")...)
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+type configField struct {
+	key   string
+	index []int
+	field reflect.StructField
+}
+
+// configFields lists the config keys of struct t the way mapstructure maps them.
+func configFields(t reflect.Type) []configField {
+	var out []configField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && strings.Contains(opts, "squash") && derefType(f.Type).Kind() == reflect.Struct {
+			for _, sub := range configFields(derefType(f.Type)) {
+				sub.index = append([]int{i}, sub.index...)
+				out = append(out, sub)
+			}
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		out = append(out, configField{key: name, index: []int{i}, field: f})
+	}
+	return out
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+func joinConfigPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// lookupConfigKey finds key in config, falling back to a case-insensitive match like mapstructure.
+func lookupConfigKey(config map[string]interface{}, key string) (string, bool) {
+	if _, ok := config[key]; ok {
+		return key, true
+	}
+	for k := range config {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// applyConfigDefaults returns a copy of config with the defaults of t filled in,
+// and the filled defaults alone.
+func applyConfigDefaults(t reflect.Type, config map[string]interface{}, path string) (map[string]interface{}, map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		out[k] = v
+	}
+	missing := map[string]interface{}{}
+	var errs []error
+	for _, f := range configFields(t) {
+		ft := derefType(f.field.Type)
+		fieldPath := joinConfigPath(path, f.key)
+		if key, ok := lookupConfigKey(out, f.key); ok {
+			sub, isMap := out[key].(map[string]interface{})
+			if ft.Kind() != reflect.Struct || !isMap {
+				continue
+			}
+			filled, subMissing, err := applyConfigDefaults(ft, sub, fieldPath)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			out[key] = filled
+			if len(subMissing) > 0 {
+				missing[key] = subMissing
+			}
+			continue
+		}
+		if tag, ok := f.field.Tag.Lookup("default"); ok {
+			v, err := parseConfigValue(ft, tag)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: invalid default %q: %w", fieldPath, tag, err))
+				continue
+			}
+			out[f.key] = v
+			missing[f.key] = v
+			continue
+		}
+		if ft.Kind() == reflect.Struct {
+			filled, subMissing, err := applyConfigDefaults(ft, map[string]interface{}{}, fieldPath)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if len(subMissing) > 0 {
+				out[f.key] = filled
+				missing[f.key] = subMissing
+			}
+		}
+	}
+	return out, missing, errors.Join(errs...)
+}
+
+// parseConfigValue parses a tag value into a JSON friendly value for a field of type t.
+func parseConfigValue(t reflect.Type, s string) (interface{}, error) {
+	if t == durationType {
+		if _, err := time.ParseDuration(s); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return s, nil
+	case reflect.Bool:
+		return strconv.ParseBool(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.ParseInt(s, 10, t.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.ParseUint(s, 10, t.Bits())
+	case reflect.Float32, reflect.Float64:
+		return strconv.ParseFloat(s, t.Bits())
+	default:
+		var v interface{}
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+type configRule struct {
+	name string
+	arg  string
+}
+
+// parseConfigRules splits a `validate` tag; pattern swallows the rest of the tag.
+func parseConfigRules(tag string) []configRule {
+	var rules []configRule
+	for tag != "" {
+		var part string
+		if strings.HasPrefix(tag, "pattern=") {
+			part, tag = tag, ""
+		} else {
+			part, tag, _ = strings.Cut(tag, ",")
+		}
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, arg, _ := strings.Cut(part, "=")
+		rules = append(rules, configRule{name: name, arg: arg})
+	}
+	return rules
+}
+
+func validateConfigStruct(v reflect.Value, path string) []error {
+	var errs []error
+	for _, f := range configFields(v.Type()) {
+		fv, ok := fieldByIndex(v, f.index)
+		if !ok {
+			continue
+		}
+		fieldPath := joinConfigPath(path, f.key)
+		for _, rule := range parseConfigRules(f.field.Tag.Get("validate")) {
+			if err := checkConfigRule(fv, rule); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", fieldPath, err))
+			}
+		}
+		for fv.Kind() == reflect.Pointer && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Struct {
+			errs = append(errs, validateConfigStruct(fv, fieldPath)...)
+		}
+	}
+	return errs
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex that stops at nil embedded pointers.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func checkConfigRule(v reflect.Value, rule configRule) error {
+	switch rule.name {
+	case "required":
+		if v.IsZero() {
+			return errors.New("is required")
+		}
+		return nil
+	case "min", "max":
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		got, bound, isLen, err := configRuleOperands(v, rule.arg)
+		if err != nil {
+			return fmt.Errorf("invalid %s rule: %w", rule.name, err)
+		}
+		what := "value"
+		if isLen {
+			what = "length"
+		}
+		if rule.name == "min" && got < bound {
+			return fmt.Errorf("%s must be at least %s", what, rule.arg)
+		}
+		if rule.name == "max" && got > bound {
+			return fmt.Errorf("%s must be at most %s", what, rule.arg)
+		}
+		return nil
+	case "oneof":
+		got := fmt.Sprint(reflect.Indirect(v).Interface())
+		for _, allowed := range strings.Fields(rule.arg) {
+			if got == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of [%s]", rule.arg)
+	case "pattern":
+		re, err := regexp.Compile(rule.arg)
+		if err != nil {
+			return fmt.Errorf("invalid pattern rule: %w", err)
+		}
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.String {
+			return errors.New("pattern rule needs a string field")
+		}
+		if !re.MatchString(v.String()) {
+			return fmt.Errorf("must match %s", rule.arg)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown validate rule %q", rule.name)
+	}
+}
+
+// configRuleOperands returns the compared quantity of v and the parsed bound of a min/max rule.
+func configRuleOperands(v reflect.Value, arg string) (got, bound float64, isLen bool, err error) {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(arg)
+		return float64(v.Int()), float64(d), false, err
+	}
+	bound, err = strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), bound, false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), bound, false, nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), bound, false, nil
+	case reflect.String:
+		return float64(utf8.RuneCountInString(v.String())), bound, true, nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(v.Len()), bound, true, nil
+	default:
+		return 0, 0, false, fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+}
+
+func configTypeSchema(t reflect.Type) map[string]interface{} {
+	t = derefType(t)
+	if t == durationType {
+		return map[string]interface{}{"type": "string", "format": "duration"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": configTypeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": configTypeSchema(t.Elem())}
+	case reflect.Struct:
+		props := map[string]interface{}{}
+		var required []string
+		for _, f := range configFields(t) {
+			props[f.key] = configFieldSchema(f)
+			for _, rule := range parseConfigRules(f.field.Tag.Get("validate")) {
+				if rule.name == "required" {
+					required = append(required, f.key)
+				}
+			}
+		}
+		schema := map[string]interface{}{"type": "object", "properties": props}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func configFieldSchema(f configField) map[string]interface{} {
+	ft := derefType(f.field.Type)
+	schema := configTypeSchema(ft)
+	if desc := f.field.Tag.Get("desc"); desc != "" {
+		schema["description"] = desc
+	}
+	if tag, ok := f.field.Tag.Lookup("default"); ok {
+		if v, err := parseConfigValue(ft, tag); err == nil {
+			schema["default"] = v
+		}
+	}
+	for _, rule := range parseConfigRules(f.field.Tag.Get("validate")) {
+		switch rule.name {
+		case "min", "max":
+			if ft == durationType {
+				continue
+			}
+			n, err := strconv.ParseFloat(rule.arg, 64)
+			if err != nil {
+				continue
+			}
+			if key := configBoundKeyword(ft, rule.name); key != "" {
+				schema[key] = n
+			}
+		case "oneof":
+			var enum []interface{}
+			for _, allowed := range strings.Fields(rule.arg) {
+				if v, err := parseConfigValue(ft, allowed); err == nil {
+					enum = append(enum, v)
+				}
+			}
+			schema["enum"] = enum
+		case "pattern":
+			schema["pattern"] = rule.arg
+		}
+	}
+	return schema
+}
+
+func configBoundKeyword(t reflect.Type, rule string) string {
+	var prefix string
+	switch t.Kind() {
+	case reflect.String:
+		prefix = "Length"
+	case reflect.Slice, reflect.Array:
+		prefix = "Items"
+	case reflect.Map:
+		prefix = "Properties"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if rule == "min" {
+			return "minimum"
+		}
+		return "maximum"
+	default:
+		return ""
+	}
+	return rule + prefix
+}
@@ -0,0 +1,386 @@
+// Command flexgen generates typed FlexModule services from Go interfaces.
+//
+// Annotate an interface whose methods take a context.Context first and return an error last:
+//
+//	//go:generate go run github.com/Yeah114/EmptyDea-plugin-sdk/cmd/flexgen -type EconomyService -prefix economy
+//	type EconomyService interface {
+//		Balance(ctx context.Context, player string) (int64, error)
+//		Transfer(ctx context.Context, from, to string, amount int64) error
+//	}
+//
+// flexgen writes economy_service_flex.go next to it with
+//
+//	func ExposeEconomyService(flex api.FlexModule, impl EconomyService) (func(), error)
+//	func NewEconomyServiceClient(flex api.FlexModule) EconomyService
+//
+// Every method is exposed as "<prefix>.<Method>" with JSON arguments and results, see
+// api.ExposeMethod and api.CallMethod. Errors travel as api.ServiceError.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const sdkAPIPath = "github.com/Yeah114/EmptyDea-plugin-sdk/api"
+
+func main() {
+	typeName := flag.String("type", "", "name of the interface to generate a service for (required)")
+	prefix := flag.String("prefix", "", "Flex API name prefix; defaults to the interface name")
+	output := flag.String("output", "", "output file; defaults to <type_in_snake_case>_flex.go")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: flexgen -type Name [-prefix prefix] [-output file] [dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	if *prefix == "" {
+		*prefix = *typeName
+	}
+	if *output == "" {
+		*output = snakeCase(*typeName) + "_flex.go"
+	}
+	if !filepath.IsAbs(*output) {
+		*output = filepath.Join(dir, *output)
+	}
+
+	svc, err := parseService(dir, *typeName, filepath.Base(*output))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "flexgen:", err)
+		os.Exit(1)
+	}
+	src, err := generate(svc, *prefix)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "flexgen:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "flexgen:", err)
+		os.Exit(1)
+	}
+}
+
+type param struct {
+	Name string
+	Type string
+}
+
+type method struct {
+	Name    string
+	Params  []param // without the leading context.Context
+	Results []param // without the trailing error
+}
+
+type service struct {
+	Package string
+	Name    string
+	Methods []method
+	Imports map[string]string // package name -> import spec
+}
+
+// parseService finds the interface name in the non-test Go files of dir, skipping the previous output.
+func parseService(dir, name, skip string) (*service, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		fn := e.Name()
+		if e.IsDir() || !strings.HasSuffix(fn, ".go") || strings.HasSuffix(fn, "_test.go") || fn == skip {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, fn), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if ts.Name.Name != name {
+					continue
+				}
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok {
+					return nil, fmt.Errorf("%s is not an interface", name)
+				}
+				if ts.TypeParams != nil {
+					return nil, fmt.Errorf("%s: generic interfaces are not supported", name)
+				}
+				return buildService(fset, f, name, it)
+			}
+		}
+	}
+	return nil, fmt.Errorf("interface %s not found in %s", name, dir)
+}
+
+func buildService(fset *token.FileSet, f *ast.File, name string, it *ast.InterfaceType) (*service, error) {
+	svc := &service{Package: f.Name.Name, Name: name, Imports: map[string]string{}}
+	fileImports := map[string]string{}
+	for _, imp := range f.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		pkg := path.Base(p)
+		spec := imp.Path.Value
+		if imp.Name != nil {
+			pkg = imp.Name.Name
+			spec = imp.Name.Name + " " + spec
+		}
+		fileImports[pkg] = spec
+	}
+	typeString := func(expr ast.Expr) (string, error) {
+		var err error
+		ast.Inspect(expr, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if id, ok := sel.X.(*ast.Ident); ok {
+				spec, found := fileImports[id.Name]
+				if !found {
+					err = fmt.Errorf("unknown package %s", id.Name)
+				}
+				svc.Imports[id.Name] = spec
+			}
+			return false
+		})
+		var buf bytes.Buffer
+		if err == nil {
+			err = printer.Fprint(&buf, fset, expr)
+		}
+		return buf.String(), err
+	}
+
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, fmt.Errorf("%s: embedded interfaces are not supported", name)
+		}
+		m := method{Name: field.Names[0].Name}
+		where := name + "." + m.Name
+
+		params, err := fieldParams(ft.Params, typeString, "arg")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", where, err)
+		}
+		if len(params) == 0 || params[0].Type != "context.Context" {
+			return nil, fmt.Errorf("%s: the first parameter must be a context.Context", where)
+		}
+		m.Params = params[1:]
+		for _, p := range m.Params {
+			if strings.HasPrefix(p.Type, "...") {
+				return nil, fmt.Errorf("%s: variadic parameters are not supported", where)
+			}
+		}
+
+		results, err := fieldParams(ft.Results, typeString, "result")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", where, err)
+		}
+		if len(results) == 0 || results[len(results)-1].Type != "error" {
+			return nil, fmt.Errorf("%s: the last result must be an error", where)
+		}
+		m.Results = results[:len(results)-1]
+		svc.Methods = append(svc.Methods, m)
+	}
+	if len(svc.Methods) == 0 {
+		return nil, fmt.Errorf("%s has no methods", name)
+	}
+	delete(svc.Imports, "context")
+	return svc, nil
+}
+
+// fieldParams flattens a field list, naming unnamed fields <base>, <base>1, ...
+func fieldParams(fl *ast.FieldList, typeString func(ast.Expr) (string, error), base string) ([]param, error) {
+	if fl == nil {
+		return nil, nil
+	}
+	var out []param
+	for _, field := range fl.List {
+		typ, err := typeString(field.Type)
+		if err != nil {
+			return nil, err
+		}
+		if len(field.Names) == 0 {
+			out = append(out, param{Type: typ})
+			continue
+		}
+		for _, n := range field.Names {
+			out = append(out, param{Name: n.Name, Type: typ})
+		}
+	}
+	for i := range out {
+		if out[i].Name == "" || out[i].Name == "_" {
+			out[i].Name = base
+			if i > 0 {
+				out[i].Name += strconv.Itoa(i)
+			}
+		}
+	}
+	return out, nil
+}
+
+func generate(svc *service, prefix string) ([]byte, error) {
+	var b bytes.Buffer
+	w := func(format string, args ...any) { fmt.Fprintf(&b, format, args...) }
+	lower := lowerFirst(svc.Name)
+	prefixConst := svc.Name + "FlexPrefix"
+
+	w("// Code generated by flexgen. DO NOT EDIT.\n\n")
+	w("package %s\n\n", svc.Package)
+	w("import (\n\t\"context\"\n\t\"errors\"\n\n\t%q\n", sdkAPIPath)
+	var extra []string
+	for _, spec := range svc.Imports {
+		if spec != strconv.Quote(sdkAPIPath) && spec != `"errors"` {
+			extra = append(extra, spec)
+		}
+	}
+	sort.Strings(extra)
+	for _, spec := range extra {
+		w("\t%s\n", spec)
+	}
+	w(")\n\n")
+
+	w("// %s is the Flex API name prefix of %s; method M is exposed as %s + \".M\".\n", prefixConst, svc.Name, prefixConst)
+	w("const %s = %q\n\n", prefixConst, prefix)
+
+	for _, m := range svc.Methods {
+		w("type %s struct {\n", argsType(lower, m))
+		for _, p := range m.Params {
+			w("\t%s %s `json:%q`\n", exported(p.Name), p.Type, p.Name)
+		}
+		w("}\n\n")
+		w("type %s struct {\n", resultType(lower, m))
+		for _, r := range m.Results {
+			w("\t%s %s `json:%q`\n", exported(r.Name), r.Type, r.Name)
+		}
+		w("}\n\n")
+	}
+
+	w("// Expose%s exposes every method of impl on flex. The returned function withdraws them again.\n", svc.Name)
+	w("func Expose%s(flex api.FlexModule, impl %s) (func(), error) {\n", svc.Name, svc.Name)
+	w("\tif impl == nil {\n\t\treturn nil, errors.New(\"Expose%s: impl is nil\")\n\t}\n", svc.Name)
+	w("\texposes := []func() (func(), error){\n")
+	for _, m := range svc.Methods {
+		w("\t\tfunc() (func(), error) {\n")
+		w("\t\t\treturn api.ExposeMethod(flex, %s+\".%s\", func(ctx context.Context, args %s) (res %s, err error) {\n",
+			prefixConst, m.Name, argsType(lower, m), resultType(lower, m))
+		call := []string{"ctx"}
+		for _, p := range m.Params {
+			call = append(call, "args."+exported(p.Name))
+		}
+		var lhs []string
+		for _, r := range m.Results {
+			lhs = append(lhs, "res."+exported(r.Name))
+		}
+		lhs = append(lhs, "err")
+		w("\t\t\t\t%s = impl.%s(%s)\n\t\t\t\treturn res, err\n\t\t\t})\n\t\t},\n", strings.Join(lhs, ", "), m.Name, strings.Join(call, ", "))
+	}
+	w("\t}\n")
+	w("\tunexposes := make([]func(), 0, len(exposes))\n")
+	w("\tunexposeAll := func() {\n\t\tfor _, unexpose := range unexposes {\n\t\t\tunexpose()\n\t\t}\n\t}\n")
+	w("\tfor _, expose := range exposes {\n\t\tunexpose, err := expose()\n\t\tif err != nil {\n\t\t\tunexposeAll()\n\t\t\treturn nil, err\n\t\t}\n\t\tunexposes = append(unexposes, unexpose)\n\t}\n")
+	w("\treturn unexposeAll, nil\n}\n\n")
+
+	client := lower + "FlexClient"
+	w("type %s struct {\n\tflex api.FlexModule\n}\n\n", client)
+	w("var _ %s = (*%s)(nil)\n\n", svc.Name, client)
+	w("// New%sClient returns a client calling the %s exposed on flex by another plugin.\n", svc.Name, svc.Name)
+	w("// Its errors are *api.ServiceError, see api.ServiceErrorCode.\n")
+	w("func New%sClient(flex api.FlexModule) %s {\n\treturn &%s{flex: flex}\n}\n\n", svc.Name, svc.Name, client)
+	for _, m := range svc.Methods {
+		var params, fields []string
+		params = append(params, "ctx context.Context")
+		for _, p := range m.Params {
+			name := clientName(p.Name)
+			params = append(params, name+" "+p.Type)
+			fields = append(fields, exported(p.Name)+": "+name)
+		}
+		var results, returns []string
+		for _, r := range m.Results {
+			results = append(results, r.Type)
+			returns = append(returns, "flexRes."+exported(r.Name))
+		}
+		results = append(results, "error")
+		returns = append(returns, "flexErr")
+		resultList := strings.Join(results, ", ")
+		if len(results) > 1 {
+			resultList = "(" + resultList + ")"
+		}
+		w("func (c *%s) %s(%s) %s {\n", client, m.Name, strings.Join(params, ", "), resultList)
+		w("\tflexRes, flexErr := api.CallMethod[%s, %s](ctx, c.flex, %s+\".%s\", %s{%s})\n",
+			argsType(lower, m), resultType(lower, m), prefixConst, m.Name, argsType(lower, m), strings.Join(fields, ", "))
+		if len(m.Results) == 0 {
+			w("\t_ = flexRes\n")
+		}
+		w("\treturn %s\n}\n\n", strings.Join(returns, ", "))
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, b.Bytes())
+	}
+	return src, nil
+}
+
+func argsType(lower string, m method) string   { return lower + m.Name + "Args" }
+func resultType(lower string, m method) string { return lower + m.Name + "Result" }
+
+// clientName avoids clashes between parameter names and the identifiers of generated client methods.
+func clientName(name string) string {
+	switch name {
+	case "c", "ctx", "api", "flexRes", "flexErr":
+		return name + "_"
+	}
+	return name
+}
+
+func exported(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	r := []rune(s)
+	for i, c := range r {
+		if unicode.IsUpper(c) {
+			if i > 0 && (unicode.IsLower(r[i-1]) || (i+1 < len(r) && unicode.IsLower(r[i+1]))) {
+				b.WriteByte('_')
+			}
+			c = unicode.ToLower(c)
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
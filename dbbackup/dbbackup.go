@@ -0,0 +1,224 @@
+// Package dbbackup stores rotating backups of plugin databases for hosts.
+//
+// A Store keeps the backups of each database under its own directory and removes the oldest
+// beyond Keep. Hosts serve DatabaseModule.Backup, Backups and RestoreBackup with it and take
+// scheduled backups with a Schedule:
+//
+//	store := dbbackup.NewStore(filepath.Join(dataDir, "backups"), 7)
+//	go dbbackup.Schedule{Store: store, Interval: 24 * time.Hour, Refs: openDatabases, Export: exportDB}.Run(ctx)
+package dbbackup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// Ext is the file extension of stored backups.
+const Ext = ".tar"
+
+// DefaultKeep is the number of backups kept per database when Store.Keep is 0.
+const DefaultKeep = 7
+
+// HostOwner is the directory of databases without an owner.
+const HostOwner = "_host"
+
+// idLayout formats backup IDs; IDs sort in the order the backups were taken.
+const idLayout = "20060102T150405.000000000Z"
+
+// ErrNotFound is returned for unknown backup IDs.
+var ErrNotFound = errors.New("dbbackup: backup not found")
+
+// ExportFunc writes a backup of a database to w, such as DatabaseModule.ExportDB.
+type ExportFunc func(w io.Writer) (api.DBBackupInfo, error)
+
+// Store keeps backups under Dir/<owner>/<kind>/<name>/<id>.tar.
+type Store struct {
+	Dir string
+	// Keep is the number of backups kept per database, DefaultKeep when 0.
+	Keep int
+}
+
+func NewStore(dir string, keep int) *Store {
+	return &Store{Dir: dir, Keep: keep}
+}
+
+func (s *Store) keep() int {
+	if s.Keep <= 0 {
+		return DefaultKeep
+	}
+	return s.Keep
+}
+
+// Path returns the directory of the backups of ref.
+func (s *Store) Path(ref api.DBRef) (string, error) {
+	if s == nil || s.Dir == "" {
+		return "", errors.New("dbbackup.Store.Path: dir is empty")
+	}
+	owner := strings.TrimSpace(ref.Owner)
+	if owner == "" {
+		owner = HostOwner
+	}
+	switch ref.Kind {
+	case api.DBKindKV, api.DBKindSQL:
+	default:
+		return "", fmt.Errorf("dbbackup.Store.Path: unknown kind %q", ref.Kind)
+	}
+	name := ref.Name
+	if ref.Kind == api.DBKindKV && ref.DBType != "" {
+		name = ref.DBType + ":" + name
+	}
+	for _, part := range []string{owner, name} {
+		if part == "" || part == "." || part == ".." {
+			return "", fmt.Errorf("dbbackup.Store.Path: invalid name %q", part)
+		}
+	}
+	return filepath.Join(s.Dir, escapePathSegment(owner), string(ref.Kind), escapePathSegment(name)), nil
+}
+
+// escapePathSegment escapes s for use as a single directory name. url.PathEscape keeps ':',
+// which Windows reads as an alternate data stream separator, so it is escaped as well.
+func escapePathSegment(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), ":", "%3A")
+}
+
+// Save stores the backup written by export, then removes the oldest backups of ref beyond Keep.
+func (s *Store) Save(ref api.DBRef, export ExportFunc) (api.DBBackupInfo, error) {
+	if export == nil {
+		return api.DBBackupInfo{}, errors.New("dbbackup.Store.Save: export is nil")
+	}
+	dir, err := s.Path(ref)
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer os.Remove(f.Name())
+	info, err := export(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	if info.Created.IsZero() {
+		info.Created = time.Now()
+	}
+	info.ID = info.Created.UTC().Format(idLayout)
+	info.Ref = ref
+	if err := os.Rename(f.Name(), filepath.Join(dir, info.ID+Ext)); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return info, s.rotate(dir)
+}
+
+func (s *Store) ids(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), Ext); ok && e.Type().IsRegular() && !strings.HasPrefix(id, ".") {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	slices.Reverse(ids)
+	return ids, nil
+}
+
+func (s *Store) rotate(dir string) error {
+	ids, err := s.ids(dir)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids[min(len(ids), s.keep()):] {
+		if err := os.Remove(filepath.Join(dir, id+Ext)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List returns the stored backups of ref, newest first.
+func (s *Store) List(ref api.DBRef) ([]api.DBBackupInfo, error) {
+	dir, err := s.Path(ref)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.ids(dir)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.DBBackupInfo, 0, len(ids))
+	for _, id := range ids {
+		info, err := s.stat(dir, id)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		info.Ref = ref
+		out = append(out, info)
+	}
+	return out, nil
+}
+
+// stat reads the manifest of backup id.
+func (s *Store) stat(dir, id string) (api.DBBackupInfo, error) {
+	f, err := os.Open(filepath.Join(dir, id+Ext))
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	m, err := api.ReadDBBackupManifest(f)
+	if err != nil {
+		return api.DBBackupInfo{}, fmt.Errorf("dbbackup: %s: %w", id, err)
+	}
+	info := api.DBBackupInfo{ID: id, Created: m.Created, Size: fi.Size(), Entries: m.Entries}
+	if len(m.Files) > 0 {
+		info.SHA256 = m.Files[0].SHA256
+	}
+	return info, nil
+}
+
+// Open opens the stored backup id of ref; the caller closes it.
+func (s *Store) Open(ref api.DBRef, id string) (io.ReadCloser, error) {
+	dir, err := s.Path(ref)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := time.Parse(idLayout, id); err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, id)
+	}
+	f, err := os.Open(filepath.Join(dir, id+Ext))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, id)
+	}
+	return f, err
+}
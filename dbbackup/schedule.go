@@ -0,0 +1,70 @@
+package dbbackup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// DefaultInterval is the period of a Schedule when Interval is 0.
+const DefaultInterval = 24 * time.Hour
+
+// Schedule periodically saves a backup of every database returned by Refs into Store.
+type Schedule struct {
+	Store *Store
+	// Interval is the period between backups, DefaultInterval when 0.
+	Interval time.Duration
+	// Refs returns the databases to back up, typically those the host has opened.
+	Refs func() []api.DBRef
+	// Export writes a backup of ref to w.
+	Export func(ctx context.Context, ref api.DBRef, w io.Writer) (api.DBBackupInfo, error)
+	// OnError receives the error of each failed backup.
+	OnError func(ref api.DBRef, err error)
+}
+
+// Run takes a round of backups right away and then every Interval until ctx is done, so hosts
+// restarted more often than Interval still get backups.
+func (s Schedule) Run(ctx context.Context) error {
+	if s.Store == nil || s.Refs == nil || s.Export == nil {
+		return errors.New("dbbackup.Schedule.Run: store, refs or export is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	s.RunOnce(ctx)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			s.RunOnce(ctx)
+		}
+	}
+}
+
+// RunOnce saves a backup of every database returned by Refs now.
+func (s Schedule) RunOnce(ctx context.Context) {
+	if s.Store == nil || s.Refs == nil || s.Export == nil {
+		return
+	}
+	for _, ref := range s.Refs() {
+		if ctx.Err() != nil {
+			return
+		}
+		_, err := s.Store.Save(ref, func(w io.Writer) (api.DBBackupInfo, error) {
+			return s.Export(ctx, ref, w)
+		})
+		if err != nil && s.OnError != nil {
+			s.OnError(ref, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package define
+
+import "errors"
+
+// ErrCapabilitiesUnsupported is returned by CapabilityFrame.ListCapabilities when the host
+// speaks a protocol version without capability discovery.
+var ErrCapabilitiesUnsupported = errors.New("host does not support capability discovery")
+
+// ModuleCapability describes a module as bridged by the host.
+type ModuleCapability struct {
+	Name string
+	// Kind is the module kind used by the RPC bridge (e.g. "chat"); empty if the host can only
+	// expose the module name.
+	Kind string
+	// Methods lists the RPC methods the host serves for this module, sorted.
+	Methods []string
+}
+
+// Capabilities is the result of a capability query.
+type Capabilities struct {
+	// ProtocolVersion is the negotiated host<->plugin protocol version.
+	ProtocolVersion int
+	// FrameMethods lists the frame RPC methods the host serves, sorted.
+	FrameMethods []string
+	Modules      map[string]ModuleCapability
+}
+
+// HasModule reports whether the host has a module named name.
+func (c Capabilities) HasModule(name string) bool {
+	_, ok := c.Modules[name]
+	return ok
+}
+
+// Supports reports whether the host serves method of module.
+func (c Capabilities) Supports(module, method string) bool {
+	m, ok := c.Modules[module]
+	if !ok {
+		return false
+	}
+	return containsString(m.Methods, method)
+}
+
+// SupportsFrame reports whether the host serves the frame method.
+func (c Capabilities) SupportsFrame(method string) bool {
+	return containsString(c.FrameMethods, method)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// CapabilityFrame is implemented by frames that support capability discovery.
+// A remote plugin can type-assert Frame to this interface.
+type CapabilityFrame interface {
+	ListCapabilities() (Capabilities, error)
+}
@@ -0,0 +1,226 @@
+package define
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrDependencyMissing is matched by DependencyErrors of plugins with unsatisfied dependencies.
+	ErrDependencyMissing = errors.New("missing dependency")
+	// ErrDependencyCycle is matched by DependencyErrors of plugins depending on each other.
+	ErrDependencyCycle = errors.New("dependency cycle")
+)
+
+// DependencyNode is a plugin ordered by ResolveLoadOrder.
+type DependencyNode struct {
+	ID     string
+	Config PluginConfig
+}
+
+// DependencyEnv describes what the host already provides to ResolveLoadOrder.
+type DependencyEnv struct {
+	// Loaded are running plugins; they satisfy dependencies but are not ordered.
+	Loaded []DependencyNode
+	// HasModule reports whether the host has a module. Nil skips RequiredModules checks.
+	HasModule func(name string) bool
+	// HasDaemon reports whether a brain daemon can be enabled. Nil skips RequiredDaemons checks.
+	HasDaemon func(name string) bool
+}
+
+// DependencyError reports why a plugin cannot be loaded.
+type DependencyError struct {
+	ID string
+	// Missing describes the unsatisfied dependencies.
+	Missing []string
+	// Cycle lists the ids of a dependency cycle, starting and ending with the same id.
+	Cycle []string
+	// BlockedBy is the id of a dependency that cannot be loaded itself.
+	BlockedBy string
+}
+
+func (e *DependencyError) Error() string {
+	switch {
+	case len(e.Cycle) > 0:
+		return fmt.Sprintf("plugin %s: %v: %s", e.ID, ErrDependencyCycle, strings.Join(e.Cycle, " -> "))
+	case len(e.Missing) > 0:
+		return fmt.Sprintf("plugin %s: %v: %s", e.ID, ErrDependencyMissing, strings.Join(e.Missing, "; "))
+	default:
+		return fmt.Sprintf("plugin %s: dependency %s cannot be loaded", e.ID, e.BlockedBy)
+	}
+}
+
+func (e *DependencyError) Unwrap() error {
+	if len(e.Cycle) > 0 {
+		return ErrDependencyCycle
+	}
+	return ErrDependencyMissing
+}
+
+// ResolveLoadOrder orders nodes so that every plugin comes after the plugins it depends on
+// (PluginConfig.Depends), keeping the input order otherwise. Plugins with missing dependencies,
+// plugins in a dependency cycle and plugins depending on either are left out; the returned
+// error joins one *DependencyError per plugin left out.
+//
+// A dependency names a plugin by PluginConfig.Name or id and is looked up among nodes first,
+// then env.Loaded. Its version constraint is checked against PluginConfig.Version.
+func ResolveLoadOrder(nodes []DependencyNode, env DependencyEnv) ([]DependencyNode, error) {
+	type state struct {
+		node  DependencyNode
+		edges []int // indexes into nodes
+		err   *DependencyError
+		mark  int // 0 unvisited, 1 visiting, 2 done
+	}
+	states := make([]*state, len(nodes))
+	byKey := map[string]int{}
+	for i, n := range nodes {
+		states[i] = &state{node: n}
+		if _, ok := byKey[n.ID]; !ok {
+			byKey[n.ID] = i
+		}
+	}
+	for i, n := range nodes {
+		if n.Config.Name != "" {
+			if _, ok := byKey[n.Config.Name]; !ok {
+				byKey[n.Config.Name] = i
+			}
+		}
+	}
+	loaded := map[string]DependencyNode{}
+	for _, n := range env.Loaded {
+		loaded[n.ID] = n
+		if n.Config.Name != "" {
+			if _, ok := loaded[n.Config.Name]; !ok {
+				loaded[n.Config.Name] = n
+			}
+		}
+	}
+
+	for _, st := range states {
+		var missing []string
+		for _, dep := range st.node.Config.Depends {
+			var target DependencyNode
+			idx, inSet := byKey[dep.Name]
+			if inSet {
+				target = nodes[idx]
+			} else if l, ok := loaded[dep.Name]; ok {
+				target = l
+			} else {
+				if !dep.Optional {
+					missing = append(missing, fmt.Sprintf("plugin %q not found", dep.Name))
+				}
+				continue
+			}
+			if msg := checkDependencyVersion(dep, target.Config.Version); msg != "" {
+				missing = append(missing, msg)
+				continue
+			}
+			if inSet {
+				st.edges = append(st.edges, idx)
+			}
+		}
+		for _, m := range st.node.Config.RequiredModules {
+			if env.HasModule != nil && !env.HasModule(m) {
+				missing = append(missing, fmt.Sprintf("module %q not provided by host", m))
+			}
+		}
+		for _, d := range st.node.Config.RequiredDaemons {
+			if env.HasDaemon != nil && !env.HasDaemon(d) {
+				missing = append(missing, fmt.Sprintf("daemon %q not available", d))
+			}
+		}
+		if len(missing) > 0 {
+			st.err = &DependencyError{ID: st.node.ID, Missing: missing}
+		}
+	}
+
+	var (
+		order []DependencyNode
+		stack []int
+		visit func(i int)
+	)
+	visit = func(i int) {
+		st := states[i]
+		st.mark = 1
+		stack = append(stack, i)
+		for _, j := range st.edges {
+			dep := states[j]
+			switch dep.mark {
+			case 0:
+				visit(j)
+			case 1:
+				// Back edge: everything on the stack from j up to i forms a cycle.
+				start := len(stack) - 1
+				for stack[start] != j {
+					start--
+				}
+				cycle := make([]string, 0, len(stack)-start+1)
+				for _, k := range stack[start:] {
+					cycle = append(cycle, nodes[k].ID)
+				}
+				cycle = append(cycle, nodes[j].ID)
+				for _, k := range stack[start:] {
+					if states[k].err == nil || len(states[k].err.Cycle) == 0 {
+						states[k].err = &DependencyError{ID: nodes[k].ID, Cycle: rotateCycle(cycle, nodes[k].ID)}
+					}
+				}
+				continue
+			}
+			if dep.err != nil && st.err == nil {
+				st.err = &DependencyError{ID: st.node.ID, BlockedBy: dep.node.ID}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		st.mark = 2
+		if st.err == nil {
+			order = append(order, st.node)
+		}
+	}
+	for i := range states {
+		if states[i].mark == 0 {
+			visit(i)
+		}
+	}
+
+	var errs []error
+	for _, st := range states {
+		if st.err != nil {
+			errs = append(errs, st.err)
+		}
+	}
+	return order, errors.Join(errs...)
+}
+
+// rotateCycle returns cycle (closed, first == last) starting at id.
+func rotateCycle(cycle []string, id string) []string {
+	open := cycle[:len(cycle)-1]
+	for i, v := range open {
+		if v == id {
+			out := append(append([]string{}, open[i:]...), open[:i]...)
+			return append(out, id)
+		}
+	}
+	return cycle
+}
+
+func checkDependencyVersion(dep PluginDependency, version string) string {
+	if dep.Version == "" {
+		return ""
+	}
+	c, err := ParseConstraint(dep.Version)
+	if err != nil {
+		return fmt.Sprintf("plugin %q: %v", dep.Name, err)
+	}
+	if version == "" {
+		return fmt.Sprintf("plugin %q has no version, want %s", dep.Name, c)
+	}
+	v, err := ParseVersion(version)
+	if err != nil {
+		return fmt.Sprintf("plugin %q: %v", dep.Name, err)
+	}
+	if !c.Check(v) {
+		return fmt.Sprintf("plugin %q version %s does not satisfy %s", dep.Name, v, c)
+	}
+	return ""
+}
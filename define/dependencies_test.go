@@ -0,0 +1,157 @@
+package define
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func dependencyNode(id, version string, depends ...PluginDependency) DependencyNode {
+	return DependencyNode{ID: id, Config: PluginConfig{Name: id, Version: version, Depends: depends}}
+}
+
+func TestResolveLoadOrder(t *testing.T) {
+	dep := func(name, version string) PluginDependency { return PluginDependency{Name: name, Version: version} }
+	optional := func(name string) PluginDependency { return PluginDependency{Name: name, Optional: true} }
+
+	tests := []struct {
+		name    string
+		nodes   []DependencyNode
+		env     DependencyEnv
+		want    []string
+		missing []string // ids with ErrDependencyMissing
+		cycles  []string // ids with ErrDependencyCycle
+	}{
+		{
+			name:  "input order without dependencies",
+			nodes: []DependencyNode{dependencyNode("b", ""), dependencyNode("a", "")},
+			want:  []string{"b", "a"},
+		},
+		{
+			name: "dependencies first",
+			nodes: []DependencyNode{
+				dependencyNode("shop", "", dep("economy", "")),
+				dependencyNode("auction", "", dep("shop", ""), dep("economy", "")),
+				dependencyNode("economy", "1.2.0"),
+			},
+			want: []string{"economy", "shop", "auction"},
+		},
+		{
+			name:  "by config name",
+			nodes: []DependencyNode{{ID: "shop", Config: PluginConfig{Depends: []PluginDependency{dep("Economy", "")}}}, {ID: "eco", Config: PluginConfig{Name: "Economy"}}},
+			want:  []string{"eco", "shop"},
+		},
+		{
+			name:  "satisfied by a loaded plugin",
+			nodes: []DependencyNode{dependencyNode("shop", "", dep("economy", "^1"))},
+			env:   DependencyEnv{Loaded: []DependencyNode{dependencyNode("economy", "1.4.0")}},
+			want:  []string{"shop"},
+		},
+		{
+			name:    "missing dependency blocks dependents",
+			nodes:   []DependencyNode{dependencyNode("shop", "", dep("economy", "")), dependencyNode("auction", "", dep("shop", "")), dependencyNode("chat", "")},
+			want:    []string{"chat"},
+			missing: []string{"shop", "auction"},
+		},
+		{
+			name:  "optional dependency",
+			nodes: []DependencyNode{dependencyNode("shop", "", optional("economy")), dependencyNode("stats", "", optional("shop"))},
+			want:  []string{"shop", "stats"},
+		},
+		{
+			name:    "version mismatch",
+			nodes:   []DependencyNode{dependencyNode("shop", "", dep("economy", ">=2")), dependencyNode("economy", "1.9.0")},
+			want:    []string{"economy"},
+			missing: []string{"shop"},
+		},
+		{
+			name:    "dependency without version",
+			nodes:   []DependencyNode{dependencyNode("shop", "", dep("economy", "^1")), dependencyNode("economy", "")},
+			want:    []string{"economy"},
+			missing: []string{"shop"},
+		},
+		{
+			name: "cycle",
+			nodes: []DependencyNode{
+				dependencyNode("a", "", dep("b", "")),
+				dependencyNode("b", "", dep("c", "")),
+				dependencyNode("c", "", dep("a", "")),
+				dependencyNode("d", "", dep("a", "")),
+				dependencyNode("e", ""),
+			},
+			want:    []string{"e"},
+			cycles:  []string{"a", "b", "c"},
+			missing: []string{"d"},
+		},
+		{
+			name:   "self dependency",
+			nodes:  []DependencyNode{dependencyNode("a", "", dep("a", ""))},
+			cycles: []string{"a"},
+		},
+		{
+			name:    "modules and daemons",
+			nodes:   []DependencyNode{{ID: "a", Config: PluginConfig{RequiredModules: []string{"chat"}}}, {ID: "b", Config: PluginConfig{RequiredModules: []string{"gone"}}}, {ID: "c", Config: PluginConfig{RequiredDaemons: []string{"nope"}}}},
+			env:     DependencyEnv{HasModule: func(name string) bool { return name == "chat" }, HasDaemon: func(string) bool { return false }},
+			want:    []string{"a"},
+			missing: []string{"b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, err := ResolveLoadOrder(tt.nodes, tt.env)
+			var got []string
+			for _, n := range order {
+				got = append(got, n.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("order = %v, want %v", got, tt.want)
+			}
+
+			var missing, cycles []string
+			for _, e := range unwrapJoined(err) {
+				var de *DependencyError
+				if !errors.As(e, &de) {
+					t.Fatalf("error %v is not a *DependencyError", e)
+				}
+				switch {
+				case errors.Is(de, ErrDependencyCycle):
+					cycles = append(cycles, de.ID)
+				case errors.Is(de, ErrDependencyMissing):
+					missing = append(missing, de.ID)
+				}
+			}
+			if !slices.Equal(missing, tt.missing) || !slices.Equal(cycles, tt.cycles) {
+				t.Fatalf("missing = %v, cycles = %v, want %v and %v (err: %v)", missing, cycles, tt.missing, tt.cycles, err)
+			}
+		})
+	}
+}
+
+func TestResolveLoadOrderCyclePath(t *testing.T) {
+	dep := func(name string) PluginDependency { return PluginDependency{Name: name} }
+	_, err := ResolveLoadOrder([]DependencyNode{
+		dependencyNode("a", "", dep("b")),
+		dependencyNode("b", "", dep("a")),
+	}, DependencyEnv{})
+	want := map[string][]string{"a": {"a", "b", "a"}, "b": {"b", "a", "b"}}
+	for _, e := range unwrapJoined(err) {
+		de := e.(*DependencyError)
+		if !slices.Equal(de.Cycle, want[de.ID]) {
+			t.Errorf("%s: cycle = %v, want %v", de.ID, de.Cycle, want[de.ID])
+		}
+		delete(want, de.ID)
+	}
+	if len(want) != 0 {
+		t.Errorf("no cycle reported for %v", want)
+	}
+}
+
+func unwrapJoined(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if j, ok := err.(interface{ Unwrap() []error }); ok {
+		return j.Unwrap()
+	}
+	return []error{err}
+}
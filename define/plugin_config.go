@@ -1,6 +1,7 @@
 package define
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,9 +20,26 @@ type PluginConfig struct {
 	Version     string                 `json:"版本,omitempty"`
 	Config      map[string]interface{} `json:"配置"`
 
+	// Depends lists the plugins that must be loaded first, see ResolveLoadOrder.
+	Depends []PluginDependency `json:"依赖插件,omitempty"`
+	// RequiredModules lists the host modules the plugin needs, e.g. "brain".
+	RequiredModules []string `json:"依赖模块,omitempty"`
+	// RequiredDaemons lists the brain daemons the plugin needs.
+	RequiredDaemons []string `json:"依赖守护进程,omitempty"`
+
 	PathValue string `json:"-"`
 }
 
+// PluginDependency declares a dependency on another plugin.
+type PluginDependency struct {
+	// Name is the name (PluginConfig.Name) or id of the plugin.
+	Name string `json:"名称"`
+	// Version is a Constraint on the plugin's version, e.g. ">=1.2.0, <2"; empty accepts any.
+	Version string `json:"版本,omitempty"`
+	// Optional dependencies only affect the load order when the plugin is present.
+	Optional bool `json:"可选,omitempty"`
+}
+
 func (pc *PluginConfig) Path() string {
 	if pc == nil {
 		return ""
@@ -60,3 +78,33 @@ func LoadPluginConfig(filePath string) (*PluginConfig, error) {
 	cfg.PathValue = absPath
 	return &cfg, nil
 }
+
+// SavePluginConfig writes cfg as indented JSON to filePath (PathValue is not written).
+func SavePluginConfig(filePath string, cfg PluginConfig) error {
+	if cfg.Config == nil {
+		cfg.Config = map[string]interface{}{}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(cfg); err != nil {
+		return fmt.Errorf("marshal json failed: %w", err)
+	}
+
+	if dir := filepath.Dir(filePath); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("create directory failed: %w", err)
+		}
+	}
+	tmp := filePath + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write file failed: %w", err)
+	}
+	if err := os.Rename(tmp, filePath); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename file failed: %w", err)
+	}
+	return nil
+}
@@ -11,4 +11,9 @@ type PluginFrame interface {
 	// Activate event is triggered after the framework finishes loading all plugins.
 	RegisterWhenActivate(handler func()) (string, error)
 	UnregisterWhenActivate(listenerID string) bool
+
+	// ConfigChange event is triggered when the config of plugin id is changed outside the plugin,
+	// e.g. an operator edited its JSON file. The plugin's own Upgrade*Config calls do not trigger it.
+	RegisterWhenConfigChange(id string, handler func(oldConfig, newConfig PluginConfig)) (string, error)
+	UnregisterWhenConfigChange(listenerID string) bool
 }
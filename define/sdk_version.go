@@ -0,0 +1,79 @@
+package define
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SDKVersion is the version of this SDK. Plugins report it to the host during Init.
+const SDKVersion = "0.9.0"
+
+var (
+	// ErrIncompatibleSDK is matched by errors of plugins built against an incompatible SDK.
+	ErrIncompatibleSDK = errors.New("incompatible plugin sdk version")
+	// ErrIncompatibleHost is matched by errors of plugins that do not support the host version.
+	ErrIncompatibleHost = errors.New("unsupported host version")
+)
+
+// HostVersionFrame is implemented by frames that know the version of the host application.
+// The host sends it to the plugin during Init.
+type HostVersionFrame interface {
+	HostVersion() string
+}
+
+// PluginVersionInfo is what a plugin reports about its build during Init.
+type PluginVersionInfo struct {
+	// SDKVersion is the SDK version the plugin was built against; empty for plugins built
+	// before the SDK reported it.
+	SDKVersion string
+	// MinHostVersion and MaxHostVersion bound the supported host versions (inclusive);
+	// empty means unbounded.
+	MinHostVersion string
+	MaxHostVersion string
+}
+
+// CheckCompatibility reports whether the plugin can run on a host of hostVersion built against
+// hostSDKVersion. SDK versions are compatible when their major versions match (minor versions
+// for 0.x). Unknown (empty) versions are not checked.
+func (info PluginVersionInfo) CheckCompatibility(hostVersion, hostSDKVersion string) error {
+	if info.SDKVersion != "" && hostSDKVersion != "" {
+		pv, err := ParseVersion(info.SDKVersion)
+		if err != nil {
+			return fmt.Errorf("%w: plugin reports %v", ErrIncompatibleSDK, err)
+		}
+		hv, err := ParseVersion(hostSDKVersion)
+		if err != nil {
+			return fmt.Errorf("%w: host reports %v", ErrIncompatibleSDK, err)
+		}
+		if pv.Major != hv.Major || (pv.Major == 0 && pv.Minor != hv.Minor) {
+			return fmt.Errorf("%w: plugin built with sdk %s, host uses sdk %s", ErrIncompatibleSDK, pv, hv)
+		}
+	}
+
+	if hostVersion == "" || (info.MinHostVersion == "" && info.MaxHostVersion == "") {
+		return nil
+	}
+	hv, err := ParseVersion(hostVersion)
+	if err != nil {
+		return fmt.Errorf("%w: host reports %v", ErrIncompatibleHost, err)
+	}
+	if info.MinHostVersion != "" {
+		minV, err := ParseVersion(info.MinHostVersion)
+		if err != nil {
+			return fmt.Errorf("%w: plugin minimum host version: %v", ErrIncompatibleHost, err)
+		}
+		if hv.Less(minV) {
+			return fmt.Errorf("%w: plugin requires host >= %s, host is %s", ErrIncompatibleHost, minV, hv)
+		}
+	}
+	if info.MaxHostVersion != "" {
+		maxV, err := ParseVersion(info.MaxHostVersion)
+		if err != nil {
+			return fmt.Errorf("%w: plugin maximum host version: %v", ErrIncompatibleHost, err)
+		}
+		if maxV.Less(hv) {
+			return fmt.Errorf("%w: plugin requires host <= %s, host is %s", ErrIncompatibleHost, maxV, hv)
+		}
+	}
+	return nil
+}
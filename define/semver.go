@@ -0,0 +1,335 @@
+package define
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version (https://semver.org). ParseVersion also accepts a leading "v"
+// and omitted minor/patch numbers ("1.2" is 1.2.0).
+type Version struct {
+	Major, Minor, Patch uint64
+	// Prerelease is the dot-separated part after "-", e.g. "rc.1".
+	Prerelease string
+	// Build is the metadata after "+"; it is ignored by comparisons.
+	Build string
+}
+
+// ParseVersion parses s as a semantic version.
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	rest := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if rest == "" {
+		return v, fmt.Errorf("invalid version %q: empty", s)
+	}
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Build, false) {
+			return Version{}, fmt.Errorf("invalid version %q: bad build metadata", s)
+		}
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		v.Prerelease = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Prerelease, true) {
+			return Version{}, fmt.Errorf("invalid version %q: bad prerelease", s)
+		}
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q: too many components", s)
+	}
+	nums := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil || (len(p) > 1 && p[0] == '0') {
+			return Version{}, fmt.Errorf("invalid version %q: bad number %q", s, p)
+		}
+		*nums[i] = n
+	}
+	return v, nil
+}
+
+// MustParseVersion is ParseVersion for constants; it panics on invalid input.
+func MustParseVersion(s string) Version {
+	v, err := ParseVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// validIdentifiers reports whether s is a dot-separated list of identifiers. Numeric
+// prerelease identifiers must not have leading zeros; build metadata may.
+func validIdentifiers(s string, prerelease bool) bool {
+	if s == "" {
+		return false
+	}
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		numeric := true
+		for _, r := range id {
+			if !(r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+				return false
+			}
+			numeric = numeric && r >= '0' && r <= '9'
+		}
+		if prerelease && numeric && len(id) > 1 && id[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 when v is lower than, equal to or higher than o, by semver precedence.
+func (v Version) Compare(o Version) int {
+	for _, d := range [][2]uint64{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if d[0] != d[1] {
+			if d[0] < d[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
+
+// Less reports whether v has lower precedence than o.
+func (v Version) Less(o Version) bool { return v.Compare(o) < 0 }
+
+func (v Version) MarshalText() ([]byte, error) { return []byte(v.String()), nil }
+
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Constraint is a set of version ranges, e.g. ">=1.2.0, <2.0.0 || ^3.1".
+//
+// Comparators joined by "," (or spaces) must all match; alternatives are separated by "||".
+// Supported operators are =, !=, >, >=, <, <=, ~ (same minor, "~1.2.3" is >=1.2.3 <1.3.0) and
+// ^ (same major, or same minor/patch for 0.x versions). A bare version means "=", "1.x" and
+// "1.2.*" match any version with that prefix, and "*" or an empty constraint matches every version.
+type Constraint struct {
+	raw  string
+	sets [][]comparator
+}
+
+type comparator struct {
+	op string
+	v  Version
+}
+
+// ParseConstraint parses a version constraint.
+func ParseConstraint(s string) (Constraint, error) {
+	c := Constraint{raw: strings.TrimSpace(s)}
+	if c.raw == "" || c.raw == "*" {
+		return c, nil
+	}
+	for _, alt := range strings.Split(c.raw, "||") {
+		var set []comparator
+		fields := strings.Fields(strings.ReplaceAll(alt, ",", " "))
+		for i := 0; i < len(fields); i++ {
+			f := fields[i]
+			if f == "*" {
+				continue
+			}
+			op := leadingOperator(f)
+			verStr := f[len(op):]
+			if verStr == "" && i+1 < len(fields) {
+				// Operator separated from its version, e.g. ">= 1.2".
+				i++
+				verStr = fields[i]
+			}
+			if lo, hi, ok := parseWildcard(verStr); ok && (op == "" || op == "=" || op == "==") {
+				set = append(set, comparator{">=", lo}, comparator{"<", hi})
+				continue
+			}
+			v, err := ParseVersion(verStr)
+			if err != nil {
+				return Constraint{}, fmt.Errorf("invalid constraint %q: %w", s, err)
+			}
+			set = append(set, expandComparator(op, v)...)
+		}
+		if len(fields) == 0 {
+			return Constraint{}, fmt.Errorf("invalid constraint %q: empty alternative", s)
+		}
+		c.sets = append(c.sets, set)
+	}
+	return c, nil
+}
+
+// MustParseConstraint is ParseConstraint for constants; it panics on invalid input.
+func MustParseConstraint(s string) Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// parseWildcard parses versions like "1.x" or "1.2.*" into the range [lo, hi).
+func parseWildcard(s string) (lo, hi Version, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) > 3 {
+		return lo, hi, false
+	}
+	var nums []uint64
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			if i == 0 {
+				return lo, hi, false
+			}
+			break
+		}
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return lo, hi, false
+		}
+		nums = append(nums, n)
+	}
+	switch len(nums) {
+	case 1:
+		return Version{Major: nums[0]}, Version{Major: nums[0] + 1}, len(parts) > 1
+	case 2:
+		return Version{Major: nums[0], Minor: nums[1]}, Version{Major: nums[0], Minor: nums[1] + 1}, len(parts) > 2
+	}
+	return lo, hi, false
+}
+
+func leadingOperator(f string) string {
+	for _, op := range []string{">=", "<=", "!=", "==", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(f, op) {
+			return op
+		}
+	}
+	return ""
+}
+
+func expandComparator(op string, v Version) []comparator {
+	switch op {
+	case "~":
+		upper := Version{Major: v.Major, Minor: v.Minor + 1}
+		return []comparator{{">=", v}, {"<", upper}}
+	case "^":
+		var upper Version
+		switch {
+		case v.Major > 0:
+			upper = Version{Major: v.Major + 1}
+		case v.Minor > 0:
+			upper = Version{Minor: v.Minor + 1}
+		default:
+			upper = Version{Patch: v.Patch + 1}
+		}
+		return []comparator{{">=", v}, {"<", upper}}
+	case "", "==":
+		return []comparator{{"=", v}}
+	}
+	return []comparator{{op, v}}
+}
+
+// Check reports whether v satisfies the constraint.
+func (c Constraint) Check(v Version) bool {
+	if len(c.sets) == 0 {
+		return true
+	}
+	for _, set := range c.sets {
+		ok := true
+		for _, cmp := range set {
+			if !cmp.match(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmp comparator) match(v Version) bool {
+	d := v.Compare(cmp.v)
+	switch cmp.op {
+	case "=":
+		return d == 0
+	case "!=":
+		return d != 0
+	case ">":
+		return d > 0
+	case ">=":
+		return d >= 0
+	case "<":
+		return d < 0
+	case "<=":
+		return d <= 0
+	}
+	return false
+}
+
+// String returns the constraint as it was parsed.
+func (c Constraint) String() string {
+	if c.raw == "" {
+		return "*"
+	}
+	return c.raw
+}
@@ -0,0 +1,135 @@
+package define
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Version
+	}{
+		{"1.2.3", Version{Major: 1, Minor: 2, Patch: 3}},
+		{"v1.2.3", Version{Major: 1, Minor: 2, Patch: 3}},
+		{" 1.2 ", Version{Major: 1, Minor: 2}},
+		{"2", Version{Major: 2}},
+		{"1.0.0-rc.1", Version{Major: 1, Prerelease: "rc.1"}},
+		{"1.0.0-0.3.7", Version{Major: 1, Prerelease: "0.3.7"}},
+		{"1.0.0-x-y.01a", Version{Major: 1, Prerelease: "x-y.01a"}},
+		{"1.0.0+001.sha", Version{Major: 1, Build: "001.sha"}},
+		{"1.0.0-beta+exp.sha.5114f85", Version{Major: 1, Prerelease: "beta", Build: "exp.sha.5114f85"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.in)
+		if err != nil {
+			t.Errorf("ParseVersion(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseVersion(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseVersionInvalid(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"v",
+		"1.2.3.4",
+		"01.2.3",
+		"1.02.3",
+		"1.2.x",
+		"-1.2.3",
+		"1.0.0-",
+		"1.0.0-01",
+		"1.0.0-rc.007",
+		"1.0.0-rc..1",
+		"1.0.0-rc_1",
+		"1.0.0+",
+		"1.0.0+a..b",
+	} {
+		if v, err := ParseVersion(in); err == nil {
+			t.Errorf("ParseVersion(%q) = %+v, want an error", in, v)
+		}
+	}
+}
+
+func TestVersionPrecedence(t *testing.T) {
+	// Ascending, from the semver specification.
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+		"10.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			a, b := MustParseVersion(ordered[i]), MustParseVersion(ordered[j])
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := a.Compare(b); got != want {
+				t.Errorf("%s.Compare(%s) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+	if a, b := MustParseVersion("1.0.0+a"), MustParseVersion("1.0.0+b"); a.Compare(b) != 0 {
+		t.Errorf("build metadata affects precedence: %s vs %s", a, b)
+	}
+}
+
+func TestConstraintCheck(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{"", "0.0.1", true},
+		{"*", "3.2.1", true},
+		{"1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">=1.2.0, <2", "1.9.9", true},
+		{">=1.2.0, <2", "2.0.0", false},
+		{">= 1.2 <2", "1.2.0", true},
+		{"<2.0.0", "2.0.0-rc.1", true},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"1.x", "1.5.2", true},
+		{"1.2.*", "1.3.0", false},
+		{"<1 || ^3.1", "3.4.0", true},
+		{"<1 || ^3.1", "2.0.0", false},
+	}
+	for _, tt := range tests {
+		c, err := ParseConstraint(tt.constraint)
+		if err != nil {
+			t.Errorf("ParseConstraint(%q): %v", tt.constraint, err)
+			continue
+		}
+		if got := c.Check(MustParseVersion(tt.version)); got != tt.want {
+			t.Errorf("%q.Check(%s) = %v, want %v", tt.constraint, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestParseConstraintInvalid(t *testing.T) {
+	for _, in := range []string{">=1.2 ||", ">=x", "^1.0.0-01", "1.2.3.4"} {
+		if _, err := ParseConstraint(in); err == nil {
+			t.Errorf("ParseConstraint(%q) succeeded, want an error", in)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
+)
+
+type bank struct {
+	mu       sync.Mutex
+	balances map[string]int64
+}
+
+func (b *bank) Balance(_ context.Context, player string) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	balance, ok := b.balances[player]
+	if !ok {
+		return 0, api.NewServiceError(api.CodeNotFound, "玩家 %s 没有账户", player)
+	}
+	return balance, nil
+}
+
+func (b *bank) Transfer(_ context.Context, from, to string, amount int64) (int64, error) {
+	if amount <= 0 {
+		return 0, api.NewServiceError(api.CodeInvalidArgument, "转账金额必须大于 0")
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.balances[from] < amount {
+		return 0, api.NewServiceError(api.CodeFailedPrecondition, "玩家 %s 余额不足", from)
+	}
+	b.balances[from] -= amount
+	b.balances[to] += amount
+	return b.balances[from], nil
+}
+
+type EconomyPlugin struct {
+	api.BasicPlugin
+	api.FlexModule
+	unexpose func()
+}
+
+func (p *EconomyPlugin) Load(_ context.Context) (err error) {
+	var ok bool
+	p.FlexModule, ok = api.GetModule[api.FlexModule](p.Frame(), api.NameFlexModule)
+	if !ok {
+		return fmt.Errorf("EconomyPlugin.Load: 宿主未提供 Flex 模块")
+	}
+	p.unexpose, err = ExposeEconomyService(p.FlexModule, &bank{balances: map[string]int64{}})
+	if err != nil {
+		return fmt.Errorf("EconomyPlugin.Load: 暴露经济服务时发生错误: %v", err)
+	}
+	return nil
+}
+
+func (p *EconomyPlugin) Unload(_ context.Context) error {
+	if p.unexpose != nil {
+		p.unexpose()
+	}
+	return nil
+}
+
+func main() {
+	protocol.Serve(&EconomyPlugin{})
+}
@@ -0,0 +1,11 @@
+package main
+
+import "context"
+
+//go:generate go run github.com/Yeah114/EmptyDea-plugin-sdk/cmd/flexgen -type EconomyService -prefix economy
+
+// EconomyService 是本插件通过 Flex 对外提供的经济服务，其他插件使用 NewEconomyServiceClient 调用。
+type EconomyService interface {
+	Balance(ctx context.Context, player string) (int64, error)
+	Transfer(ctx context.Context, from, to string, amount int64) (balance int64, err error)
+}
@@ -6,11 +6,10 @@ import (
 
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
-	"github.com/mitchellh/mapstructure"
 )
 
 type HelloPluginConfig struct {
-	UserName string `mapstructure:"名字"`
+	UserName string `mapstructure:"名字" default:"EmptyDea" validate:"required" desc:"打招呼时使用的名字"`
 }
 
 type HelloPlugin struct {
@@ -24,10 +23,14 @@ func (p *HelloPlugin) Load(_ context.Context) (err error) {
 	p.PluginTool = api.NewPluginTool(p)
 	p.TerminalMenuModule, _ = api.GetModule[api.TerminalMenuModule](p.Frame(), api.NameTerminalMenuModule)
 
-	err = mapstructure.Decode(p.Config(), &p.HelloPluginConfig)
+	cfg, err := api.NewTypedConfig[HelloPluginConfig](p.Config())
 	if err != nil {
 		return fmt.Errorf("HelloPlugin.Load: 解析插件配置时发生错误: %v", err)
 	}
+	p.HelloPluginConfig = cfg.Value()
+	if err = cfg.WriteBackDefaults(&p.PluginTool); err != nil {
+		return fmt.Errorf("HelloPlugin.Load: 写回默认配置时发生错误: %v", err)
+	}
 
 	err = p.RegisterTerminalMenuEntry(&api.TerminalMenuEntry{
 		Triggers: []string{"hello"},
@@ -44,5 +47,5 @@ func (p *HelloPlugin) Load(_ context.Context) (err error) {
 }
 
 func main() {
-	protocol.Serve(&HelloPlugin{})
+	protocol.Serve(&HelloPlugin{}, protocol.WithConfigSchema(api.ConfigSchema[HelloPluginConfig]()))
 }
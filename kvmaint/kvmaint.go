@@ -0,0 +1,388 @@
+// Package kvmaint adds expiring keys and periodic maintenance to host KeyValueDB backends.
+//
+// Backends only implement plain storage (Backend); Wrap turns one into an api.KeyValueDB that
+// implements api.KVExpirer, with a background expiry sweeper and, for backends implementing
+// api.KVCompactor such as the append-only text_log backend, periodic compaction. Wrap each
+// opened database once and hand the result to plugins:
+//
+//	db, err := kvmaint.Wrap(openTextLog(path), kvmaint.Options{})
+//	if err != nil { ... }
+//	return db, nil // from DatabaseModule.KeyValueDB
+//
+// Deadlines are stored in the database itself under Options.MetaPrefix, written in the same
+// batch as the key, so they survive restarts and crashes together with the data.
+package kvmaint
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// Backend is the storage a backend implements; see api.KeyValueDB and api.KVBatcher for the
+// semantics. Deadlines are written in the same batch as their keys.
+type Backend interface {
+	api.KeyValueDB
+	api.KVBatcher
+}
+
+// DefaultMetaPrefix is the reserved key prefix of stored deadlines.
+const DefaultMetaPrefix = "\x00ttl\x00"
+
+// Defaults of Options.
+const (
+	DefaultSweepInterval   = time.Minute
+	DefaultCompactInterval = time.Hour
+)
+
+// sweepBatch is the number of expired keys deleted per backend batch.
+const sweepBatch = 256
+
+// ErrReservedKey is returned for writes to keys under Options.MetaPrefix.
+var ErrReservedKey = errors.New("kvmaint: key uses the reserved expiry prefix")
+
+// Options configures Wrap.
+type Options struct {
+	// SweepInterval is the period of the expiry sweeper, DefaultSweepInterval when 0.
+	// A negative interval disables it; expired keys are still hidden from reads.
+	SweepInterval time.Duration
+	// CompactInterval is the period of compaction for backends implementing api.KVCompactor,
+	// DefaultCompactInterval when 0. A negative interval disables it.
+	CompactInterval time.Duration
+	// MetaPrefix is the key prefix of stored deadlines, DefaultMetaPrefix when empty.
+	MetaPrefix string
+	// Now returns the current time; time.Now when nil.
+	Now func() time.Time
+	// OnError receives the errors of background sweeps and compactions.
+	OnError func(err error)
+}
+
+// DB is a Backend with expiring keys. It implements api.KeyValueDB, api.KVBatcher,
+// api.KVScanner, api.KVWatcher, api.KVExpirer and api.KVCompactor.
+type DB struct {
+	base Backend
+	opts Options
+
+	// mu serialises writes so stored deadlines and expires stay in step.
+	mu      sync.Mutex
+	expires map[string]time.Time
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Wrap loads the stored deadlines of base and starts its maintenance.
+func Wrap(base Backend, opts Options) (*DB, error) {
+	if base == nil {
+		return nil, errors.New("kvmaint.Wrap: backend is nil")
+	}
+	if opts.SweepInterval == 0 {
+		opts.SweepInterval = DefaultSweepInterval
+	}
+	if opts.CompactInterval == 0 {
+		opts.CompactInterval = DefaultCompactInterval
+	}
+	if opts.MetaPrefix == "" {
+		opts.MetaPrefix = DefaultMetaPrefix
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	db := &DB{base: base, opts: opts, expires: map[string]time.Time{}, stop: make(chan struct{}), done: make(chan struct{})}
+	q := api.KVScan{Prefix: opts.MetaPrefix, Limit: api.MaxKVScanLimit}
+	for {
+		page, err := api.ScanPage(base, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range page.Entries {
+			ms, err := strconv.ParseInt(e.Value, 10, 64)
+			if err != nil {
+				continue
+			}
+			db.expires[strings.TrimPrefix(e.Key, opts.MetaPrefix)] = time.UnixMilli(ms)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+	go db.loop()
+	return db, nil
+}
+
+func (db *DB) loop() {
+	defer close(db.done)
+	var sweep, compact <-chan time.Time
+	if db.opts.SweepInterval > 0 {
+		t := time.NewTicker(db.opts.SweepInterval)
+		defer t.Stop()
+		sweep = t.C
+	}
+	if _, ok := db.base.(api.KVCompactor); ok && db.opts.CompactInterval > 0 {
+		t := time.NewTicker(db.opts.CompactInterval)
+		defer t.Stop()
+		compact = t.C
+	}
+	for {
+		var err error
+		select {
+		case <-db.stop:
+			return
+		case <-sweep:
+			_, err = db.Sweep()
+		case <-compact:
+			err = db.Compact()
+		}
+		if err != nil && db.opts.OnError != nil {
+			db.opts.OnError(err)
+		}
+	}
+}
+
+func (db *DB) meta(key string) string { return db.opts.MetaPrefix + key }
+
+func (db *DB) reserved(key string) bool { return strings.HasPrefix(key, db.opts.MetaPrefix) }
+
+// expired reports whether key has expired; db.mu must be held.
+func (db *DB) expired(key string, now time.Time) bool {
+	deadline, ok := db.expires[key]
+	return ok && !now.Before(deadline)
+}
+
+func (db *DB) isExpired(key string) bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.expired(key, db.opts.Now())
+}
+
+// remove deletes keys and their deadlines; db.mu must be held.
+func (db *DB) remove(keys []string) error {
+	for len(keys) > 0 {
+		n := min(len(keys), sweepBatch)
+		var b api.KVBatch
+		for _, key := range keys[:n] {
+			b.Delete(key).Delete(db.meta(key))
+		}
+		if err := db.base.Batch(b); err != nil {
+			return err
+		}
+		for _, key := range keys[:n] {
+			delete(db.expires, key)
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// Sweep deletes the expired keys now and returns how many were deleted. Watchers see the
+// deletes like any other.
+func (db *DB) Sweep() (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := db.opts.Now()
+	var keys []string
+	for key, deadline := range db.expires {
+		if !now.Before(deadline) {
+			keys = append(keys, key)
+		}
+	}
+	return len(keys), db.remove(keys)
+}
+
+// Compact sweeps the expired keys and compacts the backend if it implements api.KVCompactor.
+func (db *DB) Compact() error {
+	c, ok := db.base.(api.KVCompactor)
+	if !ok {
+		return nil
+	}
+	if _, err := db.Sweep(); err != nil {
+		return err
+	}
+	return c.Compact()
+}
+
+func (db *DB) Get(key string) (string, bool, error) {
+	if db.reserved(key) {
+		return "", false, nil
+	}
+	value, ok, err := db.base.Get(key)
+	if err != nil || !ok || db.isExpired(key) {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+func (db *DB) Set(key, value string) error {
+	return db.Batch(api.KVBatch{Writes: []api.KVWrite{{Key: key, Value: value}}})
+}
+
+func (db *DB) SetWithTTL(key, value string, ttl time.Duration) error {
+	return db.Batch(api.KVBatch{Writes: []api.KVWrite{{Key: key, Value: value, TTL: ttl}}})
+}
+
+func (db *DB) Delete(key string) error {
+	return db.Batch(api.KVBatch{Writes: []api.KVWrite{{Key: key, Delete: true}}})
+}
+
+func (db *DB) TTL(key string) (time.Duration, bool, error) {
+	if db.reserved(key) {
+		return 0, false, nil
+	}
+	_, ok, err := db.base.Get(key)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := db.opts.Now()
+	deadline, ok := db.expires[key]
+	switch {
+	case !ok:
+		return 0, true, nil
+	case !now.Before(deadline):
+		return 0, false, nil
+	}
+	return deadline.Sub(now), true, nil
+}
+
+// Batch removes the expired keys its conditions depend on, then commits its writes together
+// with their deadlines.
+func (db *DB) Batch(b api.KVBatch) error {
+	for _, c := range b.Conditions {
+		if db.reserved(c.Key) {
+			return ErrReservedKey
+		}
+	}
+	for _, w := range b.Writes {
+		if db.reserved(w.Key) {
+			return ErrReservedKey
+		}
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := db.opts.Now()
+	var stale []string
+	for _, c := range b.Conditions {
+		if db.expired(c.Key, now) {
+			stale = append(stale, c.Key)
+		}
+	}
+	if err := db.remove(stale); err != nil {
+		return err
+	}
+
+	out := api.KVBatch{Conditions: b.Conditions, Writes: make([]api.KVWrite, 0, 2*len(b.Writes))}
+	deadlines := make(map[string]time.Time, len(b.Writes))
+	for _, w := range b.Writes {
+		out.Writes = append(out.Writes, api.KVWrite{Key: w.Key, Value: w.Value, Delete: w.Delete})
+		if !w.Delete && w.TTL > 0 {
+			deadline := now.Add(w.TTL)
+			out.Set(db.meta(w.Key), strconv.FormatInt(deadline.UnixMilli(), 10))
+			deadlines[w.Key] = deadline
+		} else if _, ok := db.expires[w.Key]; ok || !deadlines[w.Key].IsZero() {
+			out.Delete(db.meta(w.Key))
+			deadlines[w.Key] = time.Time{}
+		}
+	}
+	if err := db.base.Batch(out); err != nil {
+		return err
+	}
+	for key, deadline := range deadlines {
+		if deadline.IsZero() {
+			delete(db.expires, key)
+		} else {
+			db.expires[key] = deadline
+		}
+	}
+	return nil
+}
+
+// visible reports whether a stored key is shown to readers.
+func (db *DB) visible(key string, now time.Time) bool {
+	return !db.reserved(key) && !db.expired(key, now)
+}
+
+func (db *DB) Scan(q api.KVScan) (api.KVPage, error) {
+	page, err := api.ScanPage(db.base, q)
+	if err != nil {
+		return page, err
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := db.opts.Now()
+	entries := page.Entries[:0]
+	for _, e := range page.Entries {
+		if db.visible(e.Key, now) {
+			entries = append(entries, e)
+		}
+	}
+	page.Entries = entries
+	return page, nil
+}
+
+func (db *DB) Iterate(fn func(key, value string) bool) error {
+	if fn == nil {
+		return nil
+	}
+	return db.base.Iterate(func(key, value string) bool {
+		if db.reserved(key) || db.isExpired(key) {
+			return true
+		}
+		return fn(key, value)
+	})
+}
+
+// Watch streams the changes of the backend, without the writes of stored deadlines. It returns
+// api.ErrKVUnsupported when the backend does not implement api.KVWatcher.
+func (db *DB) Watch(ctx context.Context, prefix string) (<-chan api.KVChange, error) {
+	w, ok := db.base.(api.KVWatcher)
+	if !ok {
+		return nil, api.ErrKVUnsupported
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	in, err := w.Watch(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan api.KVChange, cap(in))
+	go func() {
+		defer close(out)
+		for c := range in {
+			if db.reserved(c.Key) {
+				continue
+			}
+			select {
+			case out <- c:
+			default:
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Close stops the maintenance and closes the backend.
+func (db *DB) Close() error {
+	db.closeOnce.Do(func() {
+		close(db.stop)
+		<-db.done
+	})
+	return db.base.Close()
+}
+
+var (
+	_ api.KeyValueDB  = (*DB)(nil)
+	_ api.KVBatcher   = (*DB)(nil)
+	_ api.KVScanner   = (*DB)(nil)
+	_ api.KVWatcher   = (*DB)(nil)
+	_ api.KVExpirer   = (*DB)(nil)
+	_ api.KVCompactor = (*DB)(nil)
+)
@@ -0,0 +1,118 @@
+package loader
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
+
+// DefaultConfigPollInterval is the interval WatchConfigs uses when interval <= 0.
+const DefaultConfigPollInterval = 2 * time.Second
+
+type configListener struct {
+	id      string
+	handler func(oldConfig, newConfig define.PluginConfig)
+}
+
+func (l *Loader) registerConfigListener(id string, handler func(oldConfig, newConfig define.PluginConfig)) string {
+	listenerID := uuid.NewString()
+	l.mu.Lock()
+	l.listeners[listenerID] = configListener{id: id, handler: handler}
+	l.mu.Unlock()
+	return listenerID
+}
+
+func (l *Loader) unregisterConfigListener(listenerID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.listeners[listenerID]; !ok {
+		return false
+	}
+	delete(l.listeners, listenerID)
+	return true
+}
+
+// dropConfigListeners forgets the listeners of plugin id once its process is gone.
+func (l *Loader) dropConfigListeners(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for listenerID, cl := range l.listeners {
+		if cl.id == id {
+			delete(l.listeners, listenerID)
+		}
+	}
+}
+
+// WatchConfigs polls the config files of the loaded plugins until ctx ends. When a file changed
+// on disk (and not through the plugin's own UpgradePluginConfig), the new config is served to the
+// plugin and its RegisterWhenConfigChange handlers are called.
+func (l *Loader) WatchConfigs(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultConfigPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			l.ReloadConfigs()
+		}
+	}
+}
+
+// ReloadConfigs re-reads the config file of every loaded plugin once, see WatchConfigs.
+// Files that cannot be read or parsed (e.g. while an editor is writing them) are skipped.
+func (l *Loader) ReloadConfigs() {
+	for _, p := range l.Plugins() {
+		oldConfig, ok := l.pluginConfig(p.ID)
+		if !ok || oldConfig.PathValue == "" {
+			continue
+		}
+		newConfig, err := define.LoadPluginConfig(oldConfig.PathValue)
+		if err != nil {
+			l.opts.Logger.Debug("reload plugin config failed", "plugin", p.ID, "error", err)
+			continue
+		}
+		if newConfig.Config == nil {
+			newConfig.Config = map[string]interface{}{}
+		}
+		newConfig.PathValue = oldConfig.PathValue
+		if sameConfig(oldConfig, *newConfig) {
+			continue
+		}
+
+		l.mu.Lock()
+		if current, ok := l.configs[p.ID]; !ok || !sameConfig(current, oldConfig) {
+			// Unloaded or upgraded by the plugin meanwhile; look again on the next poll.
+			l.mu.Unlock()
+			continue
+		}
+		l.configs[p.ID] = *newConfig
+		var handlers []func(oldConfig, newConfig define.PluginConfig)
+		for _, cl := range l.listeners {
+			if cl.id == p.ID {
+				handlers = append(handlers, cl.handler)
+			}
+		}
+		l.mu.Unlock()
+
+		l.opts.Logger.Info("plugin config changed on disk", "plugin", p.ID)
+		for _, handler := range handlers {
+			handler(oldConfig, *newConfig)
+		}
+	}
+}
+
+// sameConfig compares configs by their JSON form, so numbers read from disk (float64) equal the
+// ints a plugin may have upgraded with.
+func sameConfig(a, b define.PluginConfig) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
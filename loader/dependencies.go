@@ -0,0 +1,59 @@
+package loader
+
+import (
+	"fmt"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
+
+// ResolveOrder orders candidates by their declared dependencies, see define.ResolveLoadOrder.
+// Running plugins satisfy dependencies; modules are looked up in the host Frame and daemons
+// with Options.HasDaemon. Candidates that cannot be loaded are reported in the returned error.
+func (l *Loader) ResolveOrder(candidates []Candidate) ([]Candidate, error) {
+	nodes := make([]define.DependencyNode, 0, len(candidates))
+	byID := make(map[string]Candidate, len(candidates))
+	for _, c := range candidates {
+		nodes = append(nodes, define.DependencyNode{ID: c.ID, Config: c.Config})
+		byID[c.ID] = c
+	}
+	order, err := define.ResolveLoadOrder(nodes, l.dependencyEnv(""))
+	out := make([]Candidate, 0, len(order))
+	for _, n := range order {
+		out = append(out, byID[n.ID])
+	}
+	return out, err
+}
+
+// checkDependencies reports the dependencies of c that are not satisfied by running plugins.
+func (l *Loader) checkDependencies(c Candidate) error {
+	cfg := c.Config
+	if len(cfg.Depends) == 0 && len(cfg.RequiredModules) == 0 && len(cfg.RequiredDaemons) == 0 {
+		return nil
+	}
+	_, err := define.ResolveLoadOrder([]define.DependencyNode{{ID: c.ID, Config: cfg}}, l.dependencyEnv(c.ID))
+	if err != nil {
+		return fmt.Errorf("loader: %w", err)
+	}
+	return nil
+}
+
+// dependencyEnv describes the running plugins (except skipID) and the host modules.
+func (l *Loader) dependencyEnv(skipID string) define.DependencyEnv {
+	env := define.DependencyEnv{
+		HasModule: func(name string) bool {
+			_, ok := l.frame.GetModule(name)
+			return ok
+		},
+		HasDaemon: l.opts.HasDaemon,
+	}
+	if env.HasDaemon == nil {
+		env.HasDaemon = func(string) bool { return env.HasModule(api.NameBrainModule) }
+	}
+	for _, p := range l.Plugins() {
+		if p.ID != skipID {
+			env.Loaded = append(env.Loaded, define.DependencyNode{ID: p.ID, Config: p.Config})
+		}
+	}
+	return env
+}
@@ -0,0 +1,127 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
+
+// DefaultDir is the directory DynamicLoader launches plugin binaries from.
+const DefaultDir = "tempest_storage/lang/DynamicLoader/exe"
+
+// Candidate is a plugin executable found by Discover.
+type Candidate struct {
+	// ID is the plugin id passed to Init; it is the executable name without extension.
+	ID         string
+	ExePath    string
+	ConfigPath string
+	Config     define.PluginConfig
+	// ConfigMissing reports that ConfigPath does not exist yet and Config holds the defaults.
+	// Loader.Load writes them to ConfigPath so operators can edit them afterwards.
+	ConfigMissing bool
+}
+
+// Discover scans dir for plugin executables and loads their configs; it does not write anything.
+// The config of <dir>/<id>[.exe] is read from <configDir>/<id>.json; configDir defaults to dir.
+// Plugins without a config get default values, see Candidate.ConfigMissing.
+func Discover(dir, configDir string) ([]Candidate, error) {
+	if dir == "" {
+		dir = DefaultDir
+	}
+	if configDir == "" {
+		configDir = dir
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("loader.Discover: read dir failed: %w", err)
+	}
+
+	var (
+		out  []Candidate
+		errs []error
+	)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !isExecutable(entry.Name(), info) {
+			continue
+		}
+
+		id := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if runtime.GOOS != "windows" {
+			id = entry.Name()
+		}
+		exePath, err := filepath.Abs(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: get absolute path failed: %w", entry.Name(), err))
+			continue
+		}
+		configPath := filepath.Join(configDir, id+".json")
+		cfg, missing, err := loadConfig(configPath, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+			continue
+		}
+		out = append(out, Candidate{ID: id, ExePath: exePath, ConfigPath: cfg.Path(), Config: *cfg, ConfigMissing: missing})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, errors.Join(errs...)
+}
+
+func isExecutable(name string, info os.FileInfo) bool {
+	if !info.Mode().IsRegular() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(name), ".exe")
+	}
+	if strings.HasPrefix(name, ".") || strings.EqualFold(filepath.Ext(name), ".json") {
+		return false
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+
+// loadConfig reads configPath, or returns the default config of id if it does not exist.
+func loadConfig(configPath, id string) (cfg *define.PluginConfig, missing bool, err error) {
+	if _, err := os.Stat(configPath); errors.Is(err, os.ErrNotExist) {
+		absPath, err := filepath.Abs(configPath)
+		if err != nil {
+			return nil, false, fmt.Errorf("get absolute path failed: %w", err)
+		}
+		return &define.PluginConfig{
+			Name:      id,
+			Source:    "DynamicLoader",
+			Config:    map[string]interface{}{},
+			PathValue: absPath,
+		}, true, nil
+	}
+	cfg, err = define.LoadPluginConfig(configPath)
+	if err != nil {
+		return nil, false, fmt.Errorf("load config failed: %w", err)
+	}
+	if cfg.Config == nil {
+		cfg.Config = map[string]interface{}{}
+	}
+	return cfg, false, nil
+}
+
+// createConfig writes the default config of c unless the file was created meanwhile.
+func createConfig(c Candidate) error {
+	if _, err := os.Stat(c.ConfigPath); !errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err := define.SavePluginConfig(c.ConfigPath, c.Config); err != nil {
+		return fmt.Errorf("create config failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestDiscoverDoesNotWriteConfigs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables need an .exe extension on windows")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "hello"), []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	candidates, err := Discover(dir, "")
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(candidates) != 1 {
+		t.Fatalf("candidates = %+v", candidates)
+	}
+	c := candidates[0]
+	if c.ID != "hello" || !c.ConfigMissing || c.Config.Name != "hello" {
+		t.Fatalf("candidate = %+v", c)
+	}
+	if c.ConfigPath != filepath.Join(dir, "hello.json") {
+		t.Fatalf("ConfigPath = %q", c.ConfigPath)
+	}
+	if _, err := os.Stat(c.ConfigPath); !os.IsNotExist(err) {
+		t.Fatalf("Discover created %s: %v", c.ConfigPath, err)
+	}
+
+	if err := createConfig(c); err != nil {
+		t.Fatalf("createConfig: %v", err)
+	}
+	candidates, err = Discover(dir, "")
+	if err != nil {
+		t.Fatalf("Discover after createConfig: %v", err)
+	}
+	if c := candidates[0]; c.ConfigMissing || c.Config.Name != "hello" || c.Config.Source != "DynamicLoader" {
+		t.Fatalf("candidate after createConfig = %+v", c)
+	}
+}
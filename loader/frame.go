@@ -0,0 +1,109 @@
+package loader
+
+import (
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
+
+// frame is the define.Frame handed to plugins.
+// Modules and activate events come from the host Frame; plugin configs of loader-managed
+// plugins are served from (and written back to) their JSON files, and their config change
+// events come from Loader.WatchConfigs.
+type frame struct {
+	host define.Frame
+	l    *Loader
+}
+
+// HostVersion returns Options.HostVersion or the version of the host Frame.
+func (f *frame) HostVersion() string {
+	if f.l.opts.HostVersion != "" {
+		return f.l.opts.HostVersion
+	}
+	if hv, ok := f.host.(define.HostVersionFrame); ok {
+		return hv.HostVersion()
+	}
+	return ""
+}
+
+func (f *frame) ListModules() map[string]define.Module {
+	if f.host == nil {
+		return map[string]define.Module{}
+	}
+	return f.host.ListModules()
+}
+
+func (f *frame) GetModule(name string) (define.Module, bool) {
+	if f.host == nil {
+		return nil, false
+	}
+	return f.host.GetModule(name)
+}
+
+func (f *frame) GetPluginConfig(id string) (define.PluginConfig, bool) {
+	if cfg, ok := f.l.pluginConfig(id); ok {
+		return cfg, true
+	}
+	if f.host == nil {
+		return define.PluginConfig{}, false
+	}
+	return f.host.GetPluginConfig(id)
+}
+
+func (f *frame) UpgradePluginConfig(id string, config map[string]interface{}) error {
+	if cfg, ok := f.l.pluginConfig(id); ok {
+		cfg.Config = config
+		return f.l.savePluginConfig(id, cfg)
+	}
+	if f.host == nil {
+		return nil
+	}
+	return f.host.UpgradePluginConfig(id, config)
+}
+
+func (f *frame) UpgradePluginFullConfig(id string, config define.PluginConfig) error {
+	if _, ok := f.l.pluginConfig(id); ok {
+		return f.l.savePluginConfig(id, config)
+	}
+	if f.host == nil {
+		return nil
+	}
+	return f.host.UpgradePluginFullConfig(id, config)
+}
+
+func (f *frame) RegisterWhenActivate(handler func()) (string, error) {
+	if f.host == nil {
+		return "", nil
+	}
+	return f.host.RegisterWhenActivate(handler)
+}
+
+func (f *frame) UnregisterWhenActivate(listenerID string) bool {
+	if f.host == nil {
+		return false
+	}
+	return f.host.UnregisterWhenActivate(listenerID)
+}
+
+func (f *frame) RegisterWhenConfigChange(id string, handler func(oldConfig, newConfig define.PluginConfig)) (string, error) {
+	if _, ok := f.l.pluginConfig(id); ok {
+		if handler == nil {
+			return "", nil
+		}
+		return f.l.registerConfigListener(id, handler), nil
+	}
+	if f.host == nil {
+		return "", nil
+	}
+	return f.host.RegisterWhenConfigChange(id, handler)
+}
+
+func (f *frame) UnregisterWhenConfigChange(listenerID string) bool {
+	if f.l.unregisterConfigListener(listenerID) {
+		return true
+	}
+	if f.host == nil {
+		return false
+	}
+	return f.host.UnregisterWhenConfigChange(listenerID)
+}
+
+var _ define.Frame = (*frame)(nil)
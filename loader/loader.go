@@ -0,0 +1,445 @@
+// Package loader is a host-side implementation of EmptyDea's DynamicLoader.
+//
+// It discovers plugin binaries, launches them with protocol.Handshake, wires a host define.Frame
+// and drives RPCPlugin.Init/Load/Unload, so plugins can be embedded into other tools:
+//
+//	l := loader.New(hostFrame, loader.Options{})
+//	if err := l.LoadAll(ctx); err != nil { ... }
+//	defer l.UnloadAll(ctx)
+package loader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
+)
+
+// Options configures a Loader. The zero value uses DefaultDir.
+type Options struct {
+	// Dir contains the plugin executables. Defaults to DefaultDir.
+	Dir string
+	// ConfigDir contains <id>.json plugin configs. Defaults to Dir.
+	ConfigDir string
+	// Logger receives go-plugin and loader logs. Defaults to an error-level stderr logger.
+	Logger hclog.Logger
+	// Env is appended to the environment of every plugin process.
+	Env []string
+	// HostVersion is the host application version sent to plugins and checked against their
+	// supported range. Defaults to the version of the host Frame if it is a define.HostVersionFrame.
+	HostVersion string
+	// HasDaemon reports whether the host can enable a brain daemon required by a plugin
+	// (PluginConfig.RequiredDaemons). Defaults to checking that the host has a brain module.
+	HasDaemon func(name string) bool
+	// LoadTimeout bounds the launch and Load of a plugin relaunched by a Supervisor.
+	// Defaults to 30s.
+	LoadTimeout time.Duration
+}
+
+// Plugin is a running plugin managed by a Loader.
+type Plugin struct {
+	Candidate
+
+	// StartedAt is when the current process finished Load.
+	StartedAt time.Time
+	// Restarts counts how often the supervisor relaunched the plugin.
+	Restarts int
+
+	client *plugin.Client
+	rpc    protocol.RPCPlugin
+}
+
+// Exited reports whether the plugin process has exited.
+func (p *Plugin) Exited() bool {
+	return p == nil || p.client == nil || p.client.Exited()
+}
+
+// ProtocolVersion returns the protocol version negotiated with the plugin process.
+func (p *Plugin) ProtocolVersion() int {
+	if p == nil || p.client == nil {
+		return 0
+	}
+	return p.client.NegotiatedVersion()
+}
+
+// ConfigSchema returns the JSON Schema of the plugin config the plugin reported during Init,
+// or nil, see protocol.WithConfigSchema.
+func (p *Plugin) ConfigSchema() map[string]interface{} {
+	if p == nil || p.rpc == nil {
+		return nil
+	}
+	return p.rpc.ConfigSchema()
+}
+
+// RPC returns the host-side RPC client of the plugin.
+func (p *Plugin) RPC() protocol.RPCPlugin {
+	if p == nil {
+		return nil
+	}
+	return p.rpc
+}
+
+// Loader discovers, launches and manages plugin processes.
+type Loader struct {
+	opts  Options
+	frame *frame
+
+	mu        sync.Mutex
+	plugins   map[string]*Plugin
+	loading   map[string]bool // ids reserved by a Load that is still launching
+	configs   map[string]define.PluginConfig
+	listeners map[string]configListener
+}
+
+// New returns a Loader serving hostFrame's modules to the plugins.
+func New(hostFrame define.Frame, opts Options) *Loader {
+	if opts.Dir == "" {
+		opts.Dir = DefaultDir
+	}
+	if opts.ConfigDir == "" {
+		opts.ConfigDir = opts.Dir
+	}
+	if opts.LoadTimeout <= 0 {
+		opts.LoadTimeout = 30 * time.Second
+	}
+	if opts.Logger == nil {
+		opts.Logger = hclog.New(&hclog.LoggerOptions{
+			Name:   "loader",
+			Level:  hclog.Error,
+			Output: os.Stderr,
+		})
+	}
+	l := &Loader{
+		opts:      opts,
+		plugins:   map[string]*Plugin{},
+		loading:   map[string]bool{},
+		configs:   map[string]define.PluginConfig{},
+		listeners: map[string]configListener{},
+	}
+	l.frame = &frame{host: hostFrame, l: l}
+	return l
+}
+
+// Frame returns the Frame handed to plugins.
+func (l *Loader) Frame() define.Frame {
+	return l.frame
+}
+
+// Discover scans the configured directories, see Discover.
+func (l *Loader) Discover() ([]Candidate, error) {
+	return Discover(l.opts.Dir, l.opts.ConfigDir)
+}
+
+// LoadAll discovers and loads every enabled plugin that is not running yet, dependencies first
+// (see ResolveOrder). Plugins with missing or cyclic dependencies are not loaded. Failures of
+// individual plugins are joined into the returned error; other plugins keep loading.
+func (l *Loader) LoadAll(ctx context.Context) error {
+	candidates, discoverErr := l.Discover()
+	var pending []Candidate
+	for _, c := range candidates {
+		if c.Config.Disable || l.Get(c.ID) != nil {
+			continue
+		}
+		pending = append(pending, c)
+	}
+	ordered, resolveErr := l.ResolveOrder(pending)
+	errs := []error{discoverErr, resolveErr}
+	for _, c := range ordered {
+		if _, err := l.Load(ctx, c); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Load launches c and calls Init and Load on it. A missing config file is created first, see
+// Candidate.ConfigMissing. The dependencies declared in its config must already be running.
+func (l *Loader) Load(ctx context.Context, c Candidate) (*Plugin, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if c.ID == "" || c.ExePath == "" {
+		return nil, errors.New("loader.Load: candidate id or executable path is empty")
+	}
+	if err := l.checkDependencies(c); err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	if _, ok := l.plugins[c.ID]; ok || l.loading[c.ID] {
+		l.mu.Unlock()
+		return nil, fmt.Errorf("loader.Load: plugin %s is already loaded or loading", c.ID)
+	}
+	if c.Config.Config == nil {
+		c.Config.Config = map[string]interface{}{}
+	}
+	c.Config.PathValue = c.ConfigPath
+	l.loading[c.ID] = true
+	l.configs[c.ID] = c.Config
+	l.mu.Unlock()
+
+	var err error
+	if c.ConfigMissing {
+		if err = createConfig(c); err == nil {
+			c.ConfigMissing = false
+		}
+	}
+	var p *Plugin
+	if err == nil {
+		p, err = l.launch(ctx, c)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.loading, c.ID)
+	if err != nil {
+		delete(l.configs, c.ID)
+		return nil, err
+	}
+	l.plugins[c.ID] = p
+	return p, nil
+}
+
+func (l *Loader) launch(ctx context.Context, c Candidate) (*Plugin, error) {
+	cmd := exec.Command(c.ExePath)
+	cmd.Env = append(os.Environ(), l.opts.Env...)
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  protocol.Handshake,
+		VersionedPlugins: protocol.VersionedPlugins(nil),
+		Cmd:              cmd,
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolNetRPC, plugin.ProtocolGRPC},
+		GRPCDialOptions:  protocol.GRPCDialOptions(),
+		Logger:           l.opts.Logger.Named(c.ID),
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("start plugin failed: %w", err)
+	}
+	raw, err := rpcClient.Dispense(protocol.PluginKey)
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("dispense plugin failed: %w", err)
+	}
+	rpcPlugin, ok := raw.(protocol.RPCPlugin)
+	if !ok {
+		client.Kill()
+		return nil, fmt.Errorf("unexpected plugin client type %T", raw)
+	}
+
+	p := &Plugin{Candidate: c, client: client, rpc: rpcPlugin}
+	cfg, _ := l.pluginConfig(c.ID)
+	p.Config = cfg
+	if err := callWithContext(ctx, client, func() error { return rpcPlugin.Init(l.frame, c.ID, cfg.Config) }); err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("init plugin failed: %w", err)
+	}
+	if err := rpcPlugin.VersionInfo().CheckCompatibility(l.frame.HostVersion(), define.SDKVersion); err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("plugin is incompatible with this host: %w", err)
+	}
+	// Load gets the deadline of ctx; a plugin that ignores the cancellation is killed below.
+	if err := rpcPlugin.Load(ctx); err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("load plugin failed: %w", err)
+	}
+	p.StartedAt = time.Now()
+	return p, nil
+}
+
+// relaunch starts a new process for a loaded plugin whose process exited, reusing its id and
+// current config. The old entry is only replaced when the launch succeeds.
+func (l *Loader) relaunch(ctx context.Context, id string) (*Plugin, error) {
+	old := l.Get(id)
+	if old == nil {
+		return nil, fmt.Errorf("loader: plugin %s is not loaded", id)
+	}
+	old.client.Kill()
+	l.dropConfigListeners(id)
+
+	p, err := l.launch(ctx, old.Candidate)
+	if err != nil {
+		return nil, err
+	}
+	p.Restarts = old.Restarts + 1
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.plugins[id] != old {
+		// Unloaded or replaced while we were launching.
+		p.client.Kill()
+		return nil, fmt.Errorf("loader: plugin %s changed during relaunch", id)
+	}
+	l.plugins[id] = p
+	return p, nil
+}
+
+// drop forgets a plugin without calling Unload (its process is already gone).
+func (l *Loader) drop(id string) {
+	l.mu.Lock()
+	p := l.plugins[id]
+	delete(l.plugins, id)
+	delete(l.configs, id)
+	l.mu.Unlock()
+	if p != nil {
+		p.client.Kill()
+	}
+	l.dropConfigListeners(id)
+}
+
+// callWithContext runs fn and kills the plugin process if ctx ends first.
+func callWithContext(ctx context.Context, client *plugin.Client, fn func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		client.Kill()
+		return ctx.Err()
+	}
+}
+
+// Unload calls Unload on the plugin and stops its process.
+// The plugin receives the deadline of ctx; the process is stopped even if Unload returns an error
+// or does not return within ctx and protocol.CancelGracePeriod.
+func (l *Loader) Unload(ctx context.Context, id string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	l.mu.Lock()
+	p := l.plugins[id]
+	delete(l.plugins, id)
+	l.mu.Unlock()
+	if p == nil {
+		return fmt.Errorf("loader.Unload: plugin %s is not loaded", id)
+	}
+
+	var err error
+	if !p.client.Exited() {
+		err = p.rpc.Unload(ctx)
+	}
+	p.client.Kill()
+	l.dropConfigListeners(id)
+
+	l.mu.Lock()
+	delete(l.configs, id)
+	l.mu.Unlock()
+	return err
+}
+
+// UnloadAll unloads every running plugin, dependents before their dependencies (the reverse
+// of ResolveOrder). Plugins whose dependencies are no longer running are unloaded first.
+func (l *Loader) UnloadAll(ctx context.Context) error {
+	var errs []error
+	for _, p := range l.unloadOrder() {
+		if err := l.Unload(ctx, p.ID); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// unloadOrder returns the running plugins in reverse dependency order. Modules and daemons
+// are not checked: they do not change the order among plugins.
+func (l *Loader) unloadOrder() []*Plugin {
+	plugins := l.Plugins()
+	nodes := make([]define.DependencyNode, 0, len(plugins))
+	byID := make(map[string]*Plugin, len(plugins))
+	for _, p := range plugins {
+		nodes = append(nodes, define.DependencyNode{ID: p.ID, Config: p.Config})
+		byID[p.ID] = p
+	}
+	order, _ := define.ResolveLoadOrder(nodes, define.DependencyEnv{})
+	out := make([]*Plugin, 0, len(plugins))
+	ordered := make(map[string]bool, len(order))
+	for _, n := range order {
+		ordered[n.ID] = true
+	}
+	for _, p := range plugins {
+		if !ordered[p.ID] {
+			out = append(out, p)
+		}
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		out = append(out, byID[order[i].ID])
+	}
+	return out
+}
+
+// Restart unloads the plugin, re-reads its config from disk and launches it again.
+func (l *Loader) Restart(ctx context.Context, id string) (*Plugin, error) {
+	p := l.Get(id)
+	if p == nil {
+		return nil, fmt.Errorf("loader.Restart: plugin %s is not loaded", id)
+	}
+	if err := l.Unload(ctx, id); err != nil {
+		l.opts.Logger.Warn("unload before restart failed", "plugin", id, "error", err)
+	}
+
+	c := p.Candidate
+	cfg, err := define.LoadPluginConfig(c.ConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("loader.Restart: %w", err)
+	}
+	c.Config = *cfg
+	return l.Load(ctx, c)
+}
+
+// Get returns the running plugin with id, or nil.
+func (l *Loader) Get(id string) *Plugin {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.plugins[id]
+}
+
+// Plugins returns the running plugins ordered by id.
+func (l *Loader) Plugins() []*Plugin {
+	l.mu.Lock()
+	out := make([]*Plugin, 0, len(l.plugins))
+	for _, p := range l.plugins {
+		out = append(out, p)
+	}
+	l.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func (l *Loader) pluginConfig(id string) (define.PluginConfig, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cfg, ok := l.configs[id]
+	return cfg, ok
+}
+
+func (l *Loader) savePluginConfig(id string, cfg define.PluginConfig) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	prev, ok := l.configs[id]
+	if !ok {
+		return fmt.Errorf("loader: plugin %s is not managed by this loader", id)
+	}
+	if cfg.Config == nil {
+		cfg.Config = map[string]interface{}{}
+	}
+	cfg.PathValue = prev.PathValue
+	if cfg.PathValue != "" {
+		if err := define.SavePluginConfig(cfg.PathValue, cfg); err != nil {
+			return fmt.Errorf("loader: save config of %s failed: %w", id, err)
+		}
+	}
+	l.configs[id] = cfg
+	return nil
+}
@@ -0,0 +1,33 @@
+package loader
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
+
+func TestUnloadOrderReversesDependencies(t *testing.T) {
+	plugin := func(id string, depends ...string) *Plugin {
+		p := &Plugin{Candidate: Candidate{ID: id}}
+		for _, d := range depends {
+			p.Config.Depends = append(p.Config.Depends, define.PluginDependency{Name: d})
+		}
+		return p
+	}
+	l := &Loader{plugins: map[string]*Plugin{
+		"a-shop":    plugin("a-shop", "z-economy"),
+		"m-auction": plugin("m-auction", "a-shop", "z-economy"),
+		"z-economy": plugin("z-economy"),
+		"orphan":    plugin("orphan", "gone"),
+	}}
+
+	var got []string
+	for _, p := range l.unloadOrder() {
+		got = append(got, p.ID)
+	}
+	want := []string{"orphan", "m-auction", "a-shop", "z-economy"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unload order = %v, want %v", got, want)
+	}
+}
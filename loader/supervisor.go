@@ -0,0 +1,230 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// SupervisorOptions configures a Supervisor. Zero values use the defaults noted per field.
+type SupervisorOptions struct {
+	// PollInterval is how often plugin processes are checked. Defaults to 500ms.
+	PollInterval time.Duration
+	// InitialBackoff is the delay before the first restart. Defaults to 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. Defaults to 1m.
+	MaxBackoff time.Duration
+	// MaxRestarts is the number of crashes tolerated within Window before giving up. Defaults to 5.
+	MaxRestarts int
+	// Window is the crash-loop detection window. Defaults to 10m.
+	Window time.Duration
+	// LogScope is the LoggerModule scope used for restart reports. Defaults to "DynamicLoader".
+	LogScope string
+
+	// OnEvent, when set, is called for every crash/restart/give-up.
+	OnEvent func(SupervisorEvent)
+}
+
+// SupervisorEventType classifies a SupervisorEvent.
+type SupervisorEventType string
+
+const (
+	SupervisorEventCrashed       SupervisorEventType = "crashed"
+	SupervisorEventRestarted     SupervisorEventType = "restarted"
+	SupervisorEventRestartFailed SupervisorEventType = "restart_failed"
+	SupervisorEventGaveUp        SupervisorEventType = "gave_up"
+)
+
+// SupervisorEvent reports a supervisor action for one plugin.
+type SupervisorEvent struct {
+	Type     SupervisorEventType
+	PluginID string
+	// Crashes is the number of crashes within the window, including this one.
+	Crashes int
+	// Backoff is the delay before the next restart attempt (crashed/restart_failed only).
+	Backoff time.Duration
+	Err     error
+}
+
+// Supervisor watches the plugins of a Loader and relaunches the ones whose process exited.
+// A relaunched plugin gets the same id, config and Frame, and Load is called again.
+// Restarts use exponential backoff; a plugin that crashes more than MaxRestarts times within
+// Window is dropped from the Loader. Every event is reported through the host LoggerModule.
+type Supervisor struct {
+	l    *Loader
+	opts SupervisorOptions
+
+	mu    sync.Mutex
+	state map[string]*superviseState
+	wg    sync.WaitGroup // relaunches in flight
+}
+
+type superviseState struct {
+	crashes     []time.Time
+	nextAttempt time.Time
+	// relaunching is set while a relaunch runs, so later checks do not start another one.
+	relaunching bool
+}
+
+// NewSupervisor returns a Supervisor for l. Call Run to start supervising.
+func NewSupervisor(l *Loader, opts SupervisorOptions) *Supervisor {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 500 * time.Millisecond
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = time.Minute
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = opts.InitialBackoff
+	}
+	if opts.MaxRestarts <= 0 {
+		opts.MaxRestarts = 5
+	}
+	if opts.Window <= 0 {
+		opts.Window = 10 * time.Minute
+	}
+	if opts.LogScope == "" {
+		opts.LogScope = "DynamicLoader"
+	}
+	return &Supervisor{l: l, opts: opts, state: map[string]*superviseState{}}
+}
+
+// Run supervises until ctx is done and the relaunches it started have returned.
+func (s *Supervisor) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+	defer s.wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.check(ctx, now)
+		}
+	}
+}
+
+func (s *Supervisor) check(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	alive := map[string]bool{}
+	for _, p := range s.l.Plugins() {
+		alive[p.ID] = true
+		if !p.Exited() {
+			continue
+		}
+
+		st := s.state[p.ID]
+		if st == nil {
+			st = &superviseState{}
+			s.state[p.ID] = st
+		}
+		if st.relaunching {
+			continue
+		}
+		if st.nextAttempt.IsZero() {
+			// First time we see this process dead.
+			if !s.recordCrash(p.ID, st, now, nil) {
+				continue
+			}
+		}
+		if now.Before(st.nextAttempt) {
+			continue
+		}
+
+		// A relaunched plugin whose Load hangs must not stall the supervision of the others.
+		st.relaunching = true
+		s.wg.Add(1)
+		go s.relaunch(ctx, p.ID, st)
+	}
+	for id := range s.state {
+		if !alive[id] {
+			delete(s.state, id)
+		}
+	}
+}
+
+// relaunch restarts the plugin id within the loader load timeout and records the outcome in st.
+func (s *Supervisor) relaunch(ctx context.Context, id string, st *superviseState) {
+	defer s.wg.Done()
+	loadCtx, cancel := context.WithTimeout(ctx, s.l.opts.LoadTimeout)
+	np, err := s.l.relaunch(loadCtx, id)
+	cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st.relaunching = false
+	if s.state[id] != st {
+		// The plugin was unloaded meanwhile.
+		return
+	}
+	if err != nil {
+		s.recordCrash(id, st, time.Now(), err)
+		return
+	}
+	st.nextAttempt = time.Time{}
+	s.report(SupervisorEvent{Type: SupervisorEventRestarted, PluginID: id, Crashes: len(st.crashes)},
+		api.LevelSuccess, fmt.Sprintf("插件 %s 已重启（第 %d 次）", id, np.Restarts))
+}
+
+// recordCrash registers a crash (or failed restart) and schedules the next attempt; s.mu must be
+// held. It returns false when the plugin was dropped because of a crash loop.
+func (s *Supervisor) recordCrash(id string, st *superviseState, now time.Time, restartErr error) bool {
+	kept := st.crashes[:0]
+	for _, t := range st.crashes {
+		if now.Sub(t) < s.opts.Window {
+			kept = append(kept, t)
+		}
+	}
+	st.crashes = append(kept, now)
+	n := len(st.crashes)
+
+	if n > s.opts.MaxRestarts {
+		s.l.drop(id)
+		delete(s.state, id)
+		s.report(SupervisorEvent{Type: SupervisorEventGaveUp, PluginID: id, Crashes: n, Err: restartErr},
+			api.LevelError, fmt.Sprintf("插件 %s 在 %s 内崩溃 %d 次，已停止自动重启", id, s.opts.Window, n))
+		return false
+	}
+
+	backoff := s.opts.InitialBackoff
+	for i := 1; i < n && backoff < s.opts.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > s.opts.MaxBackoff {
+		backoff = s.opts.MaxBackoff
+	}
+	st.nextAttempt = now.Add(backoff)
+
+	if restartErr != nil {
+		s.report(SupervisorEvent{Type: SupervisorEventRestartFailed, PluginID: id, Crashes: n, Backoff: backoff, Err: restartErr},
+			api.LevelError, fmt.Sprintf("插件 %s 重启失败: %v，将在 %s 后重试", id, restartErr, backoff))
+	} else {
+		s.report(SupervisorEvent{Type: SupervisorEventCrashed, PluginID: id, Crashes: n, Backoff: backoff},
+			api.LevelWarn, fmt.Sprintf("插件 %s 进程已退出，将在 %s 后重启", id, backoff))
+	}
+	return true
+}
+
+func (s *Supervisor) report(event SupervisorEvent, level api.Level, msg string) {
+	if logger, ok := api.GetModule[api.LoggerModule](s.l.frame, api.NameLoggerModule); ok && logger != nil {
+		logger.Log(s.opts.LogScope, level, msg)
+	}
+	switch level {
+	case api.LevelError:
+		s.l.opts.Logger.Error(msg, "plugin", event.PluginID)
+	case api.LevelWarn:
+		s.l.opts.Logger.Warn(msg, "plugin", event.PluginID)
+	default:
+		s.l.opts.Logger.Info(msg, "plugin", event.PluginID)
+	}
+	if s.opts.OnEvent != nil {
+		s.opts.OnEvent(event)
+	}
+}
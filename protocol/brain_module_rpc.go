@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 
 	"github.com/hashicorp/go-plugin"
@@ -91,21 +90,21 @@ func (s *BrainModuleRPCServer) EnableDaemon(args *BrainEnableDaemonArgs, resp *B
 		resp.DaemonExists = true
 		resp.DaemonKind = "scoreboard"
 		resp.DaemonBrokerID = bid
-		go acceptAndServeMuxBroker(s.broker, bid, &ScoreboardDaemonRPCServer{Impl: sb, broker: s.broker})
+		go acceptAndServeMuxBroker(s.broker, bid, ServiceScoreboardDaemon, &ScoreboardDaemonRPCServer{Impl: sb, broker: s.broker})
 		return nil
 	}
 	if ck, ok := any(dmn).(api.ChunkDaemon); ok {
 		resp.DaemonExists = true
 		resp.DaemonKind = "chunk"
 		resp.DaemonBrokerID = bid
-		go acceptAndServeMuxBroker(s.broker, bid, &ChunkDaemonRPCServer{Impl: ck, broker: s.broker})
+		go acceptAndServeMuxBroker(s.broker, bid, ServiceChunkDaemon, &ChunkDaemonRPCServer{Impl: ck, broker: s.broker})
 		return nil
 	}
 
 	resp.DaemonExists = true
 	resp.DaemonKind = dmn.Name()
 	resp.DaemonBrokerID = bid
-	go acceptAndServeMuxBroker(s.broker, bid, &DaemonRPCServer{Impl: dmn})
+	go acceptAndServeMuxBroker(s.broker, bid, ServiceDaemon, &DaemonRPCServer{Impl: dmn})
 	return nil
 }
 
@@ -119,7 +118,7 @@ func (s *BrainModuleRPCServer) DisableDaemon(args *BrainDisableDaemonArgs, _ *Em
 }
 
 type brainModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -128,7 +127,7 @@ func newBrainModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.BrainM
 	if conn == nil {
 		return nil
 	}
-	return &brainModuleRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &brainModuleRPCClient{c: newRPCConn(conn, broker, api.NameBrainModule), broker: broker}
 }
 
 func (c *brainModuleRPCClient) Name() string {
@@ -194,7 +193,7 @@ func (c *brainModuleRPCClient) EnableDaemon(ctx context.Context, name string, co
 		return resp.ActualConfig, nil, errors.New("brainModuleRPCClient.EnableDaemon: failed to create chunk daemon client")
 	}
 
-	if d := newDaemonRPCClient(conn); d != nil {
+	if d := newDaemonRPCClient(conn, c.broker); d != nil {
 		return resp.ActualConfig, d, nil
 	}
 	_ = conn.Close()
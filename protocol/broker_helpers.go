@@ -1,15 +1,23 @@
 package protocol
 
 import (
-	"net/rpc"
-
 	"github.com/hashicorp/go-plugin"
 )
 
 // acceptAndServeMuxBroker is a quiet version of go-plugin's (*MuxBroker).AcceptAndServe.
 // The upstream helper logs on timeout; we intentionally swallow the timeout because it can happen
 // during frame/plugin restarts and shouldn't be treated as a hard error by plugin authors.
-func acceptAndServeMuxBroker(broker *plugin.MuxBroker, id uint32, v interface{}) {
+// Calls are reported to the server interceptors as service.
+func acceptAndServeMuxBroker(broker *plugin.MuxBroker, id uint32, service string, v interface{}) {
+	acceptAndServe(broker, id, service, false, v)
+}
+
+// acceptAndServeCallback serves a callback registered with service, see CallInfo.Callback.
+func acceptAndServeCallback(broker *plugin.MuxBroker, id uint32, service string, v interface{}) {
+	acceptAndServe(broker, id, service, true, v)
+}
+
+func acceptAndServe(broker *plugin.MuxBroker, id uint32, service string, callback bool, v interface{}) {
 	if broker == nil || id == 0 || v == nil {
 		return
 	}
@@ -17,11 +25,5 @@ func acceptAndServeMuxBroker(broker *plugin.MuxBroker, id uint32, v interface{})
 	if err != nil || conn == nil {
 		return
 	}
-	defer func() { _ = conn.Close() }()
-
-	srv := rpc.NewServer()
-	if err := srv.RegisterName("Plugin", v); err != nil {
-		return
-	}
-	srv.ServeConn(conn)
+	serveRPCConn(conn, broker, service, callback, v)
 }
@@ -0,0 +1,97 @@
+package protocol
+
+import (
+	"reflect"
+	"sort"
+
+	sdkdefine "github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type ListCapabilitiesResp struct {
+	Capabilities sdkdefine.Capabilities
+}
+
+func (s *frameRPCServer) ListCapabilities(_ *Empty, resp *ListCapabilitiesResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.Capabilities = sdkdefine.Capabilities{}
+	if s == nil {
+		return nil
+	}
+	resp.Capabilities = HostCapabilities(s.Frame, s.version)
+	return nil
+}
+
+// HostCapabilities describes what a host serving frame over protocol version speaks.
+// It is what plugins receive from CapabilityFrame.ListCapabilities.
+// Method names are net/rpc methods, or gRPC methods for the gRPC protocol versions.
+func HostCapabilities(frame sdkdefine.Frame, version int) sdkdefine.Capabilities {
+	if version <= 0 {
+		version = ProtocolVersion1
+	}
+	grpcTransport := IsGRPCVersion(version)
+	caps := sdkdefine.Capabilities{
+		ProtocolVersion: version,
+		FrameMethods:    rpcMethodNames(&frameRPCServer{}),
+		Modules:         map[string]sdkdefine.ModuleCapability{},
+	}
+	if grpcTransport {
+		caps.FrameMethods = grpcMethodNames(pb.Frame_ServiceDesc)
+	}
+	if frame == nil {
+		return caps
+	}
+	for name, mod := range frame.ListModules() {
+		if mod == nil {
+			continue
+		}
+		mc := sdkdefine.ModuleCapability{Name: name}
+		if kind, srv := moduleRPCServer(mod, nil, ""); srv != nil {
+			mc.Kind = kind
+			if grpcTransport {
+				mc.Methods = grpcMethodNames(grpcModuleServices[kind]...)
+			} else {
+				mc.Methods = rpcMethodNames(srv)
+			}
+		}
+		caps.Modules[name] = mc
+	}
+	return caps
+}
+
+func (c *frameRPCClient) ListCapabilities() (sdkdefine.Capabilities, error) {
+	if c == nil || c.c == nil {
+		return sdkdefine.Capabilities{}, sdkdefine.ErrCapabilitiesUnsupported
+	}
+	if c.version < ProtocolVersion2 {
+		return sdkdefine.Capabilities{ProtocolVersion: ProtocolVersion1}, sdkdefine.ErrCapabilitiesUnsupported
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var resp ListCapabilitiesResp
+	if err := c.c.Call("Plugin.ListCapabilities", &Empty{}, &resp); err != nil {
+		return sdkdefine.Capabilities{}, err
+	}
+	if resp.Capabilities.Modules == nil {
+		resp.Capabilities.Modules = map[string]sdkdefine.ModuleCapability{}
+	}
+	return resp.Capabilities, nil
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// rpcMethodNames lists the methods of v served over net/rpc, sorted.
+func rpcMethodNames(v interface{}) []string {
+	methods := rpcMethods(v)
+	out := make([]string, 0, len(methods))
+	for name := range methods {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+var _ sdkdefine.CapabilityFrame = (*frameRPCClient)(nil)
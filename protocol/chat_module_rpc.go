@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/rpc"
 	"sync"
 	"sync/atomic"
 
@@ -31,7 +30,7 @@ func (s *chatMsgCallbackServer) OnChatMsg(args *ChatMsgEvent, _ *Empty) error {
 }
 
 type chatMsgCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -113,7 +112,7 @@ func (s *ChatModuleRPCServer) RegisterWhenChatMsg(args *ChatModuleRegisterArgs,
 	if err != nil {
 		return err
 	}
-	cb := &chatMsgCallbackClient{c: rpc.NewClient(conn)}
+	cb := &chatMsgCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameChatModule)}
 
 	listenerID, err := s.Impl.RegisterWhenChatMsg(func(event *api.ChatMsg) {
 		_ = cb.OnChatMsg(event)
@@ -172,7 +171,7 @@ func (s *ChatModuleRPCServer) RegisterWhenReceiveMsgFromSenderNamed(args *ChatMo
 	if err != nil {
 		return err
 	}
-	cb := &chatMsgCallbackClient{c: rpc.NewClient(conn)}
+	cb := &chatMsgCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameChatModule)}
 
 	listenerID, err := s.Impl.RegisterWhenReceiveMsgFromSenderNamed(args.SenderName, func(event *api.ChatMsg) {
 		_ = cb.OnChatMsg(event)
@@ -240,7 +239,7 @@ func (s *ChatModuleRPCServer) InterceptNextMessage(args *ChatModuleRegisterArgs,
 	if err != nil {
 		return err
 	}
-	cb := &chatMsgCallbackClient{c: rpc.NewClient(conn)}
+	cb := &chatMsgCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameChatModule)}
 
 	seq := atomic.AddUint64(&s.interceptSeq, 1)
 	interceptID := fmt.Sprintf("intercept:%d", seq)
@@ -312,7 +311,7 @@ func (s *ChatModuleRPCServer) CancelIntercept(args *ChatModuleCancelInterceptArg
 }
 
 type chatModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -322,7 +321,7 @@ func newChatModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.ChatMod
 		return nil
 	}
 	return &chatModuleRPCClient{
-		c:      rpc.NewClient(conn),
+		c:      newRPCConn(conn, broker, api.NameChatModule),
 		broker: broker,
 	}
 }
@@ -351,7 +350,7 @@ func (c *chatModuleRPCClient) RegisterWhenChatMsg(handler func(event *api.ChatMs
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &chatMsgCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameChatModule, &chatMsgCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -388,7 +387,7 @@ func (c *chatModuleRPCClient) RegisterWhenReceiveMsgFromSenderNamed(name string,
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &chatMsgCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameChatModule, &chatMsgCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -425,7 +424,7 @@ func (c *chatModuleRPCClient) InterceptNextMessage(name string, handler func(*ap
 		return nil, errors.New("chatModuleRPCClient.InterceptNextMessage: name is empty")
 	}
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &chatMsgCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameChatModule, &chatMsgCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	var resp ChatModuleInterceptResp
@@ -3,7 +3,6 @@ package protocol
 import (
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 
 	"github.com/hashicorp/go-plugin"
@@ -45,7 +44,7 @@ func (s *chunkNewChunkCallbackServer) OnEvent(args *ChunkNewChunkEventArgs, _ *E
 }
 
 type chunkNewChunkCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -127,7 +126,7 @@ func (s *ChunkDaemonRPCServer) RegisterWhenNewChunk(args *ChunkRegisterWhenNewCh
 	if err != nil {
 		return err
 	}
-	cb := &chunkNewChunkCallbackClient{c: rpc.NewClient(conn)}
+	cb := &chunkNewChunkCallbackClient{c: newCallbackRPCConn(conn, s.broker, ServiceChunkDaemon)}
 	listenerID, err := s.Impl.RegisterWhenNewChunk(func(event *api.ChunkNewChunkEvent) {
 		_ = cb.OnEvent(event)
 	})
@@ -152,7 +151,7 @@ func (s *ChunkDaemonRPCServer) UnregisterWhenNewChunk(args *ChunkUnregisterArgs,
 }
 
 type chunkDaemonRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -161,7 +160,7 @@ func newChunkDaemonRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.ChunkD
 	if conn == nil {
 		return nil
 	}
-	return &chunkDaemonRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &chunkDaemonRPCClient{c: newRPCConn(conn, broker, ServiceChunkDaemon), broker: broker}
 }
 
 func (c *chunkDaemonRPCClient) Name() string {
@@ -213,7 +212,7 @@ func (c *chunkDaemonRPCClient) RegisterWhenNewChunk(handler func(event *api.Chun
 	}
 
 	bid := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, bid, &chunkNewChunkCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, bid, ServiceChunkDaemon, &chunkNewChunkCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
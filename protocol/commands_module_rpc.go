@@ -3,10 +3,11 @@ package protocol
 import (
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-plugin"
+
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
 
@@ -127,15 +128,15 @@ func (s *CommandsModuleRPCServer) Title(args *CommandsTitleArgs, _ *Empty) error
 }
 
 type commandsModuleRPCClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
-func newCommandsModuleRPCClient(conn net.Conn) api.CommandsModule {
+func newCommandsModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.CommandsModule {
 	if conn == nil {
 		return nil
 	}
-	return &commandsModuleRPCClient{c: rpc.NewClient(conn)}
+	return &commandsModuleRPCClient{c: newRPCConn(conn, broker, api.NameCommandsModule)}
 }
 
 func (c *commandsModuleRPCClient) Name() string { return api.NameCommandsModule }
@@ -0,0 +1,175 @@
+package protocol
+
+import (
+	"context"
+	"net"
+	"net/rpc"
+	"strings"
+	"testing"
+)
+
+// V1InitArgs and V1Empty are the net/rpc types of Plugin.Init as sent by SDKs before protocol v2.
+type V1InitArgs struct {
+	ID            string
+	Config        map[string]interface{}
+	FrameBrokerID uint32
+}
+
+type V1Empty struct{}
+
+// v1Plugin serves Plugin.Init and Plugin.Load like a plugin built with an old SDK.
+type v1Plugin struct{ got chan V1InitArgs }
+
+func (p *v1Plugin) Init(args *V1InitArgs, resp *V1Empty) error {
+	p.got <- *args
+	return nil
+}
+
+func (p *v1Plugin) Load(args *V1Empty, resp *V1Empty) error { return nil }
+
+// currentPlugin serves Plugin.Init and Plugin.Load with the types of this SDK.
+type currentPlugin struct {
+	init chan InitArgs
+	load chan LifecycleArgs
+}
+
+func (p *currentPlugin) Init(args *InitArgs, resp *InitResp) error {
+	p.init <- *args
+	*resp = InitResp{SDKVersion: "1.2.3", MinHostVersion: "1.0.0"}
+	return nil
+}
+
+func (p *currentPlugin) Load(args *LifecycleArgs, resp *Empty) error {
+	p.load <- *args
+	return nil
+}
+
+// servePeer serves rcvr as "Plugin" with the plain net/rpc server go-plugin and old SDKs use,
+// and returns a client connected to it.
+func servePeer(t *testing.T, rcvr any) *rpcConn {
+	t.Helper()
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("Plugin", rcvr); err != nil {
+		t.Fatalf("RegisterName: %v", err)
+	}
+	hostConn, pluginConn := net.Pipe()
+	go srv.ServeConn(pluginConn)
+	c := newRPCConn(hostConn, nil, ServicePlugin)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestGobCompatCurrentHostOldPlugin(t *testing.T) {
+	old := &v1Plugin{got: make(chan V1InitArgs, 1)}
+	c := servePeer(t, old)
+
+	args := &InitArgs{
+		ID:              "legacy",
+		Config:          map[string]interface{}{"greeting": "hi"},
+		FrameBrokerID:   7,
+		ProtocolVersion: ProtocolVersion2,
+		HostVersion:     "2.0.0",
+		SDKVersion:      "2.0.0",
+		Metadata:        map[string]string{"traceparent": "00-1"},
+	}
+	var resp InitResp
+	if err := c.Call("Plugin.Init", args, &resp); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	got := <-old.got
+	if got.ID != "legacy" || got.FrameBrokerID != 7 || got.Config["greeting"] != "hi" {
+		t.Fatalf("old plugin got %+v", got)
+	}
+	if resp != (InitResp{}) {
+		t.Fatalf("resp = %+v, want the zero value", resp)
+	}
+
+	if err := c.Call("Plugin.Load", &LifecycleArgs{CallID: "1", TimeoutMs: 1000}, &Empty{}); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+}
+
+func TestGobCompatOldHostCurrentPlugin(t *testing.T) {
+	cur := &currentPlugin{init: make(chan InitArgs, 1), load: make(chan LifecycleArgs, 1)}
+	c := servePeer(t, cur)
+
+	var resp V1Empty
+	if err := c.Call("Plugin.Init", &V1InitArgs{ID: "legacy", Config: map[string]interface{}{"n": "1"}, FrameBrokerID: 9}, &resp); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	got := <-cur.init
+	if got.ID != "legacy" || got.FrameBrokerID != 9 || got.Config["n"] != "1" {
+		t.Fatalf("plugin got %+v", got)
+	}
+	if got.ProtocolVersion != 0 || got.HostVersion != "" || got.Metadata != nil {
+		t.Fatalf("fields unknown to a v1 host are set: %+v", got)
+	}
+
+	if err := c.Call("Plugin.Load", &V1Empty{}, &V1Empty{}); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if la := <-cur.load; la.CallID != "" || la.TimeoutMs != 0 {
+		t.Fatalf("Load args = %+v, want the zero value", la)
+	}
+}
+
+// echoPlugin stands for any module served by an old SDK with plain net/rpc.
+type echoPlugin struct{}
+
+func (echoPlugin) Echo(args string, reply *string) error {
+	*reply = args
+	return nil
+}
+
+func TestMetadataFallbackToOldPeer(t *testing.T) {
+	c := servePeer(t, echoPlugin{})
+	ctx := AppendCallMetadata(context.Background(), "traceparent", "00-abc")
+
+	for i := 0; i < 2; i++ {
+		var reply string
+		if err := c.CallContext(ctx, "Plugin.Echo", "ping", &reply); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if reply != "ping" {
+			t.Fatalf("call %d: reply = %q", i, reply)
+		}
+		if !c.noMetadata.Load() {
+			t.Fatalf("call %d: noMetadata not set after the old peer rejected the method", i)
+		}
+	}
+
+	var reply string
+	err := c.CallContext(ctx, "Plugin.Missing", "ping", &reply)
+	if se, ok := err.(rpc.ServerError); !ok || !strings.HasPrefix(string(se), "rpc: can't find method Plugin.Missing") {
+		t.Fatalf("unknown method: %v", err)
+	}
+}
+
+// metadataPlugin records the metadata of its calls.
+type metadataPlugin struct{ got chan CallMetadata }
+
+func (p *metadataPlugin) Echo(ctx context.Context, args string, reply *string) error {
+	p.got <- IncomingCallMetadata(ctx)
+	*reply = args
+	return nil
+}
+
+func TestMetadataToCurrentPeer(t *testing.T) {
+	p := &metadataPlugin{got: make(chan CallMetadata, 1)}
+	hostConn, pluginConn := net.Pipe()
+	go serveRPCConn(pluginConn, nil, ServicePlugin, false, p)
+	c := newRPCConn(hostConn, nil, ServicePlugin)
+	defer c.Close()
+
+	ctx := AppendCallMetadata(context.Background(), "traceparent", "00-abc")
+	var reply string
+	if err := c.CallContext(ctx, "Plugin.Echo", "ping", &reply); err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	if md := <-p.got; md["traceparent"] != "00-abc" {
+		t.Fatalf("metadata = %v", md)
+	}
+	if c.noMetadata.Load() {
+		t.Fatal("noMetadata set for a peer that understands metadata")
+	}
+}
@@ -3,10 +3,11 @@ package protocol
 import (
 	"errors"
 	"net"
-	"net/rpc"
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/go-plugin"
+
 	sdkdefine "github.com/Yeah114/EmptyDea-plugin-sdk/define"
 )
 
@@ -67,15 +68,15 @@ func (s *DaemonRPCServer) Config(_ *Empty, resp *DaemonConfigResp) error {
 }
 
 type daemonRPCClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
-func newDaemonRPCClient(conn net.Conn) sdkdefine.Daemon {
+func newDaemonRPCClient(conn net.Conn, broker *plugin.MuxBroker) sdkdefine.Daemon {
 	if conn == nil {
 		return nil
 	}
-	return &daemonRPCClient{c: rpc.NewClient(conn)}
+	return &daemonRPCClient{c: newRPCConn(conn, broker, ServiceDaemon)}
 }
 
 func (c *daemonRPCClient) Name() string {
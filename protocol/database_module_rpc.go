@@ -3,7 +3,6 @@ package protocol
 import (
 	"errors"
 	"net"
-	"net/rpc"
 	"strings"
 	"sync"
 
@@ -30,6 +29,12 @@ type DatabaseModuleKeyValueDBResp struct {
 type DatabaseModuleRPCServer struct {
 	Impl   api.DatabaseModule
 	broker *plugin.MuxBroker
+	// pluginID owns the SQL databases opened through the server.
+	pluginID string
+
+	mu      sync.Mutex
+	seq     uint64
+	streams map[uint64]*dbBackupStream
 }
 
 func (s *DatabaseModuleRPCServer) Name(_ *Empty, resp *DatabaseModuleNameResp) error {
@@ -69,14 +74,39 @@ func (s *DatabaseModuleRPCServer) KeyValueDB(args *DatabaseModuleKeyValueDBArgs,
 	}
 
 	id := s.broker.NextId()
-	go acceptAndServeMuxBroker(s.broker, id, &KeyValueDBRPCServer{Impl: db, broker: s.broker})
+	go acceptAndServeMuxBroker(s.broker, id, ServiceKeyValueDB, &KeyValueDBRPCServer{Impl: db, broker: s.broker})
 	resp.Exists = true
 	resp.DBBrokerID = id
 	return nil
 }
 
+// SQLDB opens the database args.Name of the calling plugin and serves it on a broker connection.
+func (s *DatabaseModuleRPCServer) SQLDB(args *DatabaseModuleSQLDBArgs, resp *DatabaseModuleSQLDBResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.DBBrokerID = 0
+	if s == nil || s.Impl == nil || args == nil {
+		return errors.New("DatabaseModuleRPCServer.SQLDB: module unavailable")
+	}
+	if s.broker == nil {
+		return errors.New("DatabaseModuleRPCServer.SQLDB: broker unavailable")
+	}
+	db, err := s.Impl.SQLDB(api.SQLDBRef{Owner: s.pluginID, Name: strings.TrimSpace(args.Name)})
+	if err != nil {
+		return err
+	}
+	if db == nil {
+		return errors.New("DatabaseModuleRPCServer.SQLDB: database is nil")
+	}
+	id := s.broker.NextId()
+	go serveSQLDB(s.broker, id, db)
+	resp.DBBrokerID = id
+	return nil
+}
+
 type databaseModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -85,7 +115,7 @@ func newDatabaseModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.Dat
 	if conn == nil {
 		return nil
 	}
-	return &databaseModuleRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &databaseModuleRPCClient{c: newRPCConn(conn, broker, api.NameDatabaseModule), broker: broker}
 }
 
 func (c *databaseModuleRPCClient) Name() string { return api.NameDatabaseModule }
@@ -115,3 +145,18 @@ func (c *databaseModuleRPCClient) KeyValueDB(name string, dbType string) (api.Ke
 	}
 	return newKeyValueDBRPCClient(conn, c.broker), nil
 }
+
+func (c *databaseModuleRPCClient) SQLDB(ref api.SQLDBRef) (api.SQLDB, error) {
+	if c == nil || c.c == nil || c.broker == nil {
+		return nil, errors.New("databaseModuleRPCClient.SQLDB: client is not initialised")
+	}
+	var resp DatabaseModuleSQLDBResp
+	if err := c.c.Call("Plugin.SQLDB", &DatabaseModuleSQLDBArgs{Name: ref.Name}, &resp); err != nil {
+		return nil, err
+	}
+	conn, err := c.broker.Dial(resp.DBBrokerID)
+	if err != nil {
+		return nil, err
+	}
+	return newSQLDBRPCClient(conn, c.broker), nil
+}
@@ -0,0 +1,367 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// dbBackupChunk is the number of backup bytes sent per net/rpc call.
+const dbBackupChunk = 256 << 10
+
+type DatabaseModuleBackupArgs struct {
+	Ref       api.DBRef
+	ID        string
+	TimeoutMs int64
+}
+
+type DatabaseModuleBackupResp struct {
+	Info api.DBBackupInfo
+}
+
+type DatabaseModuleBackupsResp struct {
+	Backups []api.DBBackupInfo
+}
+
+type DatabaseModuleStreamResp struct {
+	// StreamID continues an export with ReadStream or an import with WriteStream.
+	StreamID uint64
+}
+
+type DatabaseModuleStreamArgs struct {
+	StreamID uint64
+	Data     []byte
+	// Error aborts the stream with CloseStream.
+	Error string
+}
+
+type DatabaseModuleReadStreamResp struct {
+	Data []byte
+	// Done is set with the final Info once the export has been read completely.
+	Done bool
+	Info api.DBBackupInfo
+}
+
+// dbBackupStream is an export or import running on the host, fed or drained through a pipe by
+// stream calls.
+type dbBackupStream struct {
+	r    *io.PipeReader
+	w    *io.PipeWriter
+	done chan struct{}
+	info api.DBBackupInfo
+	err  error
+}
+
+func (s *DatabaseModuleRPCServer) startStream(timeoutMs int64, run func(ctx context.Context, r *io.PipeReader, w *io.PipeWriter) (api.DBBackupInfo, error)) uint64 {
+	r, w := io.Pipe()
+	st := &dbBackupStream{r: r, w: w, done: make(chan struct{})}
+	s.mu.Lock()
+	if s.streams == nil {
+		s.streams = map[uint64]*dbBackupStream{}
+	}
+	s.seq++
+	id := s.seq
+	s.streams[id] = st
+	s.mu.Unlock()
+
+	go func() {
+		defer close(st.done)
+		ctx, cancel := ctxWithTimeoutMs(context.Background(), timeoutMs)
+		defer cancel()
+		st.info, st.err = run(ctx, r, w)
+	}()
+	return id
+}
+
+func (s *DatabaseModuleRPCServer) stream(id uint64) (*dbBackupStream, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.streams[id]
+	if !ok {
+		return nil, errors.New("DatabaseModuleRPCServer: unknown backup stream")
+	}
+	return st, nil
+}
+
+func (s *DatabaseModuleRPCServer) takeStream(id uint64) *dbBackupStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.streams[id]
+	delete(s.streams, id)
+	return st
+}
+
+// closeStreams aborts the unfinished streams once the connection ends.
+func (s *DatabaseModuleRPCServer) closeStreams() {
+	s.mu.Lock()
+	streams := s.streams
+	s.streams = nil
+	s.mu.Unlock()
+	for _, st := range streams {
+		_ = st.r.CloseWithError(io.ErrClosedPipe)
+		_ = st.w.CloseWithError(io.ErrClosedPipe)
+	}
+}
+
+// ExportDB starts an export of args.Ref, read with ReadStream.
+func (s *DatabaseModuleRPCServer) ExportDB(args *DatabaseModuleBackupArgs, resp *DatabaseModuleStreamResp) error {
+	if s == nil || s.Impl == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.ExportDB: module unavailable")
+	}
+	ref := args.Ref
+	ref.Owner = s.pluginID
+	resp.StreamID = s.startStream(args.TimeoutMs, func(ctx context.Context, _ *io.PipeReader, w *io.PipeWriter) (api.DBBackupInfo, error) {
+		info, err := s.Impl.ExportDB(ctx, ref, w)
+		if err == nil {
+			err = io.EOF
+		}
+		_ = w.CloseWithError(err)
+		return info, nil
+	})
+	return nil
+}
+
+// ReadStream returns the next bytes of an export, and its result once it is done.
+func (s *DatabaseModuleRPCServer) ReadStream(args *DatabaseModuleStreamArgs, resp *DatabaseModuleReadStreamResp) error {
+	if s == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.ReadStream: module unavailable")
+	}
+	st, err := s.stream(args.StreamID)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, dbBackupChunk)
+	n, err := io.ReadFull(st.r, buf)
+	resp.Data = buf[:n]
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		s.takeStream(args.StreamID)
+		<-st.done
+		resp.Done, resp.Info = true, st.info
+		return nil
+	}
+	s.takeStream(args.StreamID)
+	return err
+}
+
+// ImportDB starts an import into args.Ref, fed with WriteStream and finished with FinishStream.
+func (s *DatabaseModuleRPCServer) ImportDB(args *DatabaseModuleBackupArgs, resp *DatabaseModuleStreamResp) error {
+	if s == nil || s.Impl == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.ImportDB: module unavailable")
+	}
+	ref := args.Ref
+	ref.Owner = s.pluginID
+	resp.StreamID = s.startStream(args.TimeoutMs, func(ctx context.Context, r *io.PipeReader, _ *io.PipeWriter) (api.DBBackupInfo, error) {
+		info, err := s.Impl.ImportDB(ctx, ref, r)
+		if err != nil {
+			_ = r.CloseWithError(err)
+		} else {
+			// Drain the tar padding the importer did not read.
+			_, _ = io.Copy(io.Discard, r)
+		}
+		return info, err
+	})
+	return nil
+}
+
+// WriteStream passes the next bytes of a backup to an import.
+func (s *DatabaseModuleRPCServer) WriteStream(args *DatabaseModuleStreamArgs, _ *Empty) error {
+	if s == nil || args == nil {
+		return errors.New("DatabaseModuleRPCServer.WriteStream: module unavailable")
+	}
+	st, err := s.stream(args.StreamID)
+	if err != nil {
+		return err
+	}
+	if _, err := st.w.Write(args.Data); err != nil {
+		s.takeStream(args.StreamID)
+		<-st.done
+		if st.err != nil {
+			return st.err
+		}
+		return err
+	}
+	return nil
+}
+
+// FinishStream ends the input of an import and returns its result.
+func (s *DatabaseModuleRPCServer) FinishStream(args *DatabaseModuleStreamArgs, resp *DatabaseModuleBackupResp) error {
+	if s == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.FinishStream: module unavailable")
+	}
+	st := s.takeStream(args.StreamID)
+	if st == nil {
+		return errors.New("DatabaseModuleRPCServer: unknown backup stream")
+	}
+	_ = st.w.Close()
+	<-st.done
+	resp.Info = st.info
+	return st.err
+}
+
+// CloseStream aborts an export or import.
+func (s *DatabaseModuleRPCServer) CloseStream(args *DatabaseModuleStreamArgs, _ *Empty) error {
+	if s == nil || args == nil {
+		return nil
+	}
+	if st := s.takeStream(args.StreamID); st != nil {
+		err := errors.New(args.Error)
+		if args.Error == "" {
+			err = io.ErrClosedPipe
+		}
+		_ = st.r.CloseWithError(err)
+		_ = st.w.CloseWithError(err)
+	}
+	return nil
+}
+
+func (s *DatabaseModuleRPCServer) Backup(args *DatabaseModuleBackupArgs, resp *DatabaseModuleBackupResp) error {
+	if s == nil || s.Impl == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.Backup: module unavailable")
+	}
+	ctx, cancel := ctxWithTimeoutMs(context.Background(), args.TimeoutMs)
+	defer cancel()
+	ref := args.Ref
+	ref.Owner = s.pluginID
+	info, err := s.Impl.Backup(ctx, ref)
+	resp.Info = info
+	return err
+}
+
+func (s *DatabaseModuleRPCServer) Backups(args *DatabaseModuleBackupArgs, resp *DatabaseModuleBackupsResp) error {
+	if s == nil || s.Impl == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.Backups: module unavailable")
+	}
+	ctx, cancel := ctxWithTimeoutMs(context.Background(), args.TimeoutMs)
+	defer cancel()
+	ref := args.Ref
+	ref.Owner = s.pluginID
+	backups, err := s.Impl.Backups(ctx, ref)
+	resp.Backups = backups
+	return err
+}
+
+func (s *DatabaseModuleRPCServer) RestoreBackup(args *DatabaseModuleBackupArgs, resp *DatabaseModuleBackupResp) error {
+	if s == nil || s.Impl == nil || args == nil || resp == nil {
+		return errors.New("DatabaseModuleRPCServer.RestoreBackup: module unavailable")
+	}
+	ctx, cancel := ctxWithTimeoutMs(context.Background(), args.TimeoutMs)
+	defer cancel()
+	ref := args.Ref
+	ref.Owner = s.pluginID
+	info, err := s.Impl.RestoreBackup(ctx, ref, args.ID)
+	resp.Info = info
+	return err
+}
+
+func (c *databaseModuleRPCClient) ExportDB(ctx context.Context, ref api.DBRef, w io.Writer) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleRPCClient.ExportDB: client is not initialised")
+	}
+	if w == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleRPCClient.ExportDB: writer is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var start DatabaseModuleStreamResp
+	if err := c.c.CallContext(ctx, "Plugin.ExportDB", &DatabaseModuleBackupArgs{Ref: ref, TimeoutMs: timeoutMsFromCtx(ctx)}, &start); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	for {
+		var resp DatabaseModuleReadStreamResp
+		err := ctx.Err()
+		if err == nil {
+			err = c.c.CallContext(ctx, "Plugin.ReadStream", &DatabaseModuleStreamArgs{StreamID: start.StreamID}, &resp)
+		}
+		if err == nil && len(resp.Data) > 0 {
+			_, err = w.Write(resp.Data)
+		}
+		if err != nil {
+			_ = c.c.Call("Plugin.CloseStream", &DatabaseModuleStreamArgs{StreamID: start.StreamID, Error: err.Error()}, &Empty{})
+			return api.DBBackupInfo{}, err
+		}
+		if resp.Done {
+			return resp.Info, nil
+		}
+	}
+}
+
+func (c *databaseModuleRPCClient) ImportDB(ctx context.Context, ref api.DBRef, r io.Reader) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleRPCClient.ImportDB: client is not initialised")
+	}
+	if r == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleRPCClient.ImportDB: reader is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var start DatabaseModuleStreamResp
+	if err := c.c.CallContext(ctx, "Plugin.ImportDB", &DatabaseModuleBackupArgs{Ref: ref, TimeoutMs: timeoutMsFromCtx(ctx)}, &start); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	buf := make([]byte, dbBackupChunk)
+	for {
+		n, err := io.ReadFull(r, buf)
+		done := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if done {
+			err = nil
+		}
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err == nil && n > 0 {
+			err = c.c.CallContext(ctx, "Plugin.WriteStream", &DatabaseModuleStreamArgs{StreamID: start.StreamID, Data: buf[:n]}, &Empty{})
+		}
+		if err != nil {
+			_ = c.c.Call("Plugin.CloseStream", &DatabaseModuleStreamArgs{StreamID: start.StreamID, Error: err.Error()}, &Empty{})
+			return api.DBBackupInfo{}, err
+		}
+		if done {
+			break
+		}
+	}
+	var resp DatabaseModuleBackupResp
+	if err := c.c.CallContext(ctx, "Plugin.FinishStream", &DatabaseModuleStreamArgs{StreamID: start.StreamID}, &resp); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return resp.Info, nil
+}
+
+func (c *databaseModuleRPCClient) Backup(ctx context.Context, ref api.DBRef) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleRPCClient.Backup: client is not initialised")
+	}
+	var resp DatabaseModuleBackupResp
+	if err := c.c.CallContext(ctx, "Plugin.Backup", &DatabaseModuleBackupArgs{Ref: ref, TimeoutMs: timeoutMsFromCtx(ctx)}, &resp); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return resp.Info, nil
+}
+
+func (c *databaseModuleRPCClient) Backups(ctx context.Context, ref api.DBRef) ([]api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("databaseModuleRPCClient.Backups: client is not initialised")
+	}
+	var resp DatabaseModuleBackupsResp
+	if err := c.c.CallContext(ctx, "Plugin.Backups", &DatabaseModuleBackupArgs{Ref: ref, TimeoutMs: timeoutMsFromCtx(ctx)}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Backups, nil
+}
+
+func (c *databaseModuleRPCClient) RestoreBackup(ctx context.Context, ref api.DBRef, id string) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleRPCClient.RestoreBackup: client is not initialised")
+	}
+	var resp DatabaseModuleBackupResp
+	if err := c.c.CallContext(ctx, "Plugin.RestoreBackup", &DatabaseModuleBackupArgs{Ref: ref, ID: id, TimeoutMs: timeoutMsFromCtx(ctx)}, &resp); err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return resp.Info, nil
+}
@@ -5,10 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/rpc"
 	"sync"
 	"sync/atomic"
-	"time"
 
 	"github.com/hashicorp/go-plugin"
 
@@ -68,7 +66,7 @@ func (s *flexTopicCallbackServer) OnEvent(args *FlexTopicEvent, _ *Empty) error
 }
 
 type flexTopicCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -92,6 +90,7 @@ func (c *flexTopicCallbackClient) OnEvent(payload []byte) error {
 
 type FlexExposeArgs struct {
 	APIName         string
+	Version         string
 	HandlerBrokerID uint32
 }
 
@@ -121,7 +120,7 @@ type flexHandlerCallbackServer struct {
 	handler func(context.Context, []byte) ([]byte, string)
 }
 
-func (s *flexHandlerCallbackServer) Handle(args *FlexHandleArgs, resp *FlexHandleResp) error {
+func (s *flexHandlerCallbackServer) Handle(ctx context.Context, args *FlexHandleArgs, resp *FlexHandleResp) error {
 	if resp == nil {
 		return nil
 	}
@@ -130,12 +129,8 @@ func (s *flexHandlerCallbackServer) Handle(args *FlexHandleArgs, resp *FlexHandl
 	if s == nil || s.handler == nil || args == nil {
 		return nil
 	}
-	ctx := context.Background()
-	if args.TimeoutMs > 0 {
-		var cancel func()
-		ctx, cancel = context.WithTimeout(context.Background(), time.Duration(args.TimeoutMs)*time.Millisecond)
-		defer cancel()
-	}
+	ctx, cancel := ctxWithTimeoutMs(ctx, args.TimeoutMs)
+	defer cancel()
 	res, errStr := s.handler(ctx, append([]byte(nil), args.ArgsJSON...))
 	if res == nil {
 		res = []byte("null")
@@ -146,7 +141,7 @@ func (s *flexHandlerCallbackServer) Handle(args *FlexHandleArgs, resp *FlexHandl
 }
 
 type flexHandlerCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -159,19 +154,70 @@ func (c *flexHandlerCallbackClient) Close() error {
 	return c.c.Close()
 }
 
-func (c *flexHandlerCallbackClient) Handle(timeoutMs int64, argsJSON []byte) ([]byte, string, error) {
+func (c *flexHandlerCallbackClient) Handle(ctx context.Context, timeoutMs int64, argsJSON []byte) ([]byte, string, error) {
 	if c == nil || c.c == nil {
 		return nil, "", errors.New("flex handler client unavailable")
 	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	var resp FlexHandleResp
-	if err := c.c.Call("Plugin.Handle", &FlexHandleArgs{TimeoutMs: timeoutMs, ArgsJSON: append([]byte(nil), argsJSON...)}, &resp); err != nil {
+	if err := c.c.CallContext(ctx, "Plugin.Handle", &FlexHandleArgs{TimeoutMs: timeoutMs, ArgsJSON: append([]byte(nil), argsJSON...)}, &resp); err != nil {
 		return nil, "", err
 	}
 	return resp.ResultJSON, resp.ErrStr, nil
 }
 
+type FlexListAPIsResp struct {
+	APIs []api.FlexAPIInfo
+}
+
+type FlexWatchAPIsArgs struct {
+	CallbackBrokerID uint32
+}
+
+type FlexWatchAPIsResp struct {
+	WatchID string
+}
+
+type FlexUnwatchAPIsArgs struct {
+	WatchID string
+}
+
+type flexAPIEventCallbackServer struct {
+	handler func(api.FlexAPIEvent)
+}
+
+func (s *flexAPIEventCallbackServer) OnAPIEvent(args *api.FlexAPIEvent, _ *Empty) error {
+	if s == nil || s.handler == nil || args == nil {
+		return nil
+	}
+	s.handler(*args)
+	return nil
+}
+
+type flexAPIEventCallbackClient struct {
+	c  *rpcConn
+	mu sync.Mutex
+}
+
+func (c *flexAPIEventCallbackClient) Close() error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.Close()
+}
+
+func (c *flexAPIEventCallbackClient) OnAPIEvent(ev api.FlexAPIEvent) error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.Call("Plugin.OnAPIEvent", &ev, &Empty{})
+}
+
 type FlexCallArgs struct {
 	APIName   string
 	TimeoutMs int64
@@ -186,6 +232,8 @@ type FlexCallResp struct {
 type FlexModuleRPCServer struct {
 	Impl   api.FlexModule
 	broker *plugin.MuxBroker
+	// pluginID owns the APIs exposed through this server.
+	pluginID string
 
 	mu          sync.Mutex
 	subCancels  map[string]func()
@@ -250,7 +298,7 @@ func (s *FlexModuleRPCServer) Subscribe(args *FlexSubscribeArgs, resp *FlexSubsc
 	if err != nil {
 		return err
 	}
-	cb := &flexTopicCallbackClient{c: rpc.NewClient(conn)}
+	cb := &flexTopicCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameFlexModule)}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := s.Impl.Subscribe(ctx, args.Topic)
@@ -324,11 +372,12 @@ func (s *FlexModuleRPCServer) Expose(args *FlexExposeArgs, resp *FlexExposeResp)
 	if err != nil {
 		return err
 	}
-	cb := &flexHandlerCallbackClient{c: rpc.NewClient(conn)}
+	cb := &flexHandlerCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameFlexModule)}
 
-	unexpose, err := s.Impl.Expose(apiName, func(ctx context.Context, payload []byte) ([]byte, string) {
+	info := api.FlexAPIInfo{Name: apiName, Owner: s.pluginID, Version: args.Version}
+	unexpose, err := s.Impl.ExposeAPI(info, func(ctx context.Context, payload []byte) ([]byte, string) {
 		timeoutMs := timeoutMsFromContext(ctx)
-		res, errStr, callErr := cb.Handle(timeoutMs, payload)
+		res, errStr, callErr := cb.Handle(ctx, timeoutMs, payload)
 		if callErr != nil {
 			return []byte("null"), callErr.Error()
 		}
@@ -372,7 +421,22 @@ func (s *FlexModuleRPCServer) Unexpose(args *FlexUnexposeArgs, resp *FlexUnexpos
 	return nil
 }
 
-func (s *FlexModuleRPCServer) Call(args *FlexCallArgs, resp *FlexCallResp) error {
+// release withdraws the APIs the plugin exposed and ends its subscriptions and watches once
+// the connection ends, so ListAPIs and WatchAPIs no longer report a dead owner.
+func (s *FlexModuleRPCServer) release() {
+	s.mu.Lock()
+	unexposeFns, subCancels := s.unexposeFns, s.subCancels
+	s.unexposeFns, s.subCancels = nil, nil
+	s.mu.Unlock()
+	for _, fn := range unexposeFns {
+		fn()
+	}
+	for _, cancel := range subCancels {
+		cancel()
+	}
+}
+
+func (s *FlexModuleRPCServer) Call(ctx context.Context, args *FlexCallArgs, resp *FlexCallResp) error {
 	if resp == nil {
 		return nil
 	}
@@ -381,7 +445,7 @@ func (s *FlexModuleRPCServer) Call(args *FlexCallArgs, resp *FlexCallResp) error
 	if s == nil || s.Impl == nil || args == nil {
 		return nil
 	}
-	ctx, cancel := ctxFromTimeoutMs(args.TimeoutMs)
+	ctx, cancel := ctxWithTimeoutMs(ctx, args.TimeoutMs)
 	defer cancel()
 	result, errStr, err := s.Impl.Call(ctx, args.APIName, args.ArgsJSON)
 	if err != nil {
@@ -392,8 +456,84 @@ func (s *FlexModuleRPCServer) Call(args *FlexCallArgs, resp *FlexCallResp) error
 	return nil
 }
 
+func (s *FlexModuleRPCServer) ListAPIs(_ *Empty, resp *FlexListAPIsResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.APIs = nil
+	if s == nil || s.Impl == nil {
+		return nil
+	}
+	resp.APIs = s.Impl.ListAPIs()
+	return nil
+}
+
+func (s *FlexModuleRPCServer) WatchAPIs(args *FlexWatchAPIsArgs, resp *FlexWatchAPIsResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.WatchID = ""
+	if s == nil || s.Impl == nil || s.broker == nil || args == nil {
+		return nil
+	}
+	if args.CallbackBrokerID == 0 {
+		return errors.New("FlexModuleRPCServer.WatchAPIs: callback broker id is 0")
+	}
+
+	conn, err := s.broker.Dial(args.CallbackBrokerID)
+	if err != nil {
+		return err
+	}
+	cb := &flexAPIEventCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameFlexModule)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := s.Impl.WatchAPIs(ctx)
+
+	watchID := "watch:" + fmt.Sprint(s.subSeq.Add(1))
+	s.mu.Lock()
+	if s.subCancels == nil {
+		s.subCancels = make(map[string]func())
+	}
+	s.subCancels[watchID] = cancel
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			_ = cb.Close()
+			cancel()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-ch:
+				if !ok {
+					return
+				}
+				if err := cb.OnAPIEvent(ev); err != nil {
+					// The watcher is gone; stop the watch instead of sending into a dead connection.
+					s.mu.Lock()
+					delete(s.subCancels, watchID)
+					s.mu.Unlock()
+					return
+				}
+			}
+		}
+	}()
+
+	resp.WatchID = watchID
+	return nil
+}
+
+func (s *FlexModuleRPCServer) UnwatchAPIs(args *FlexUnwatchAPIsArgs, resp *BoolResp) error {
+	if args == nil {
+		return s.Unsubscribe(nil, resp)
+	}
+	return s.Unsubscribe(&FlexUnsubscribeArgs{SubID: args.WatchID}, resp)
+}
+
 type flexModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -402,7 +542,7 @@ func newFlexModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.FlexMod
 	if conn == nil {
 		return nil
 	}
-	return &flexModuleRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &flexModuleRPCClient{c: newRPCConn(conn, broker, api.NameFlexModule), broker: broker}
 }
 
 func (c *flexModuleRPCClient) Name() string { return api.NameFlexModule }
@@ -449,7 +589,7 @@ func (c *flexModuleRPCClient) Subscribe(ctx context.Context, topic string) <-cha
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &flexTopicCallbackServer{handler: func(payload []byte) {
+	go acceptAndServeCallback(c.broker, cbID, api.NameFlexModule, &flexTopicCallbackServer{handler: func(payload []byte) {
 		select {
 		case out <- payload:
 		default:
@@ -477,25 +617,30 @@ func (c *flexModuleRPCClient) Subscribe(ctx context.Context, topic string) <-cha
 }
 
 func (c *flexModuleRPCClient) Expose(apiName string, handler func(context.Context, []byte) ([]byte, string)) (func(), error) {
+	return c.ExposeAPI(api.FlexAPIInfo{Name: apiName}, handler)
+}
+
+func (c *flexModuleRPCClient) ExposeAPI(info api.FlexAPIInfo, handler func(context.Context, []byte) ([]byte, string)) (func(), error) {
 	if c == nil || c.c == nil || c.broker == nil {
-		return func() {}, errors.New("flexModuleRPCClient.Expose: client is not initialised")
+		return func() {}, errors.New("flexModuleRPCClient.ExposeAPI: client is not initialised")
 	}
 	if handler == nil {
-		return func() {}, errors.New("flexModuleRPCClient.Expose: handler is nil")
+		return func() {}, errors.New("flexModuleRPCClient.ExposeAPI: handler is nil")
 	}
+	apiName := info.Name
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &flexHandlerCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameFlexModule, &flexHandlerCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	var resp FlexExposeResp
-	err := c.c.Call("Plugin.Expose", &FlexExposeArgs{APIName: apiName, HandlerBrokerID: cbID}, &resp)
+	err := c.c.Call("Plugin.Expose", &FlexExposeArgs{APIName: apiName, Version: info.Version, HandlerBrokerID: cbID}, &resp)
 	c.mu.Unlock()
 	if err != nil {
 		return func() {}, err
 	}
 	if !resp.OK {
-		return func() {}, errors.New("flexModuleRPCClient.Expose: expose failed")
+		return func() {}, errors.New("flexModuleRPCClient.ExposeAPI: expose failed")
 	}
 
 	return func() {
@@ -514,10 +659,87 @@ func (c *flexModuleRPCClient) Call(ctx context.Context, apiName string, argsJSON
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	var resp FlexCallResp
-	if err := c.c.Call("Plugin.Call", &FlexCallArgs{APIName: apiName, TimeoutMs: timeoutMs, ArgsJSON: append([]byte(nil), argsJSON...)}, &resp); err != nil {
+	if err := c.c.CallContext(ctx, "Plugin.Call", &FlexCallArgs{APIName: apiName, TimeoutMs: timeoutMs, ArgsJSON: append([]byte(nil), argsJSON...)}, &resp); err != nil {
 		return nil, "", err
 	}
 	return resp.ResultJSON, resp.ErrStr, nil
 }
 
+func (c *flexModuleRPCClient) ListAPIs() []api.FlexAPIInfo {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var resp FlexListAPIsResp
+	if err := c.c.Call("Plugin.ListAPIs", &Empty{}, &resp); err != nil {
+		return nil
+	}
+	return resp.APIs
+}
+
+func (c *flexModuleRPCClient) WaitForAPI(ctx context.Context, apiName string) (api.FlexAPIInfo, error) {
+	return api.WaitForFlexAPI(ctx, c, apiName)
+}
+
+func (c *flexModuleRPCClient) WatchAPIs(ctx context.Context) <-chan api.FlexAPIEvent {
+	out := make(chan api.FlexAPIEvent, 256)
+	if c == nil || c.c == nil || c.broker == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// Events may still arrive while the watch is being cancelled, so sends and close are serialised.
+	var (
+		outMu  sync.Mutex
+		closed bool
+	)
+	closeOut := func() {
+		outMu.Lock()
+		defer outMu.Unlock()
+		if !closed {
+			closed = true
+			close(out)
+		}
+	}
+	cbID := c.broker.NextId()
+	go func() {
+		acceptAndServeCallback(c.broker, cbID, api.NameFlexModule, &flexAPIEventCallbackServer{handler: func(ev api.FlexAPIEvent) {
+			outMu.Lock()
+			defer outMu.Unlock()
+			if closed {
+				return
+			}
+			select {
+			case out <- ev:
+			default:
+			}
+		}})
+		// The host ended the watch, e.g. because the module went away.
+		closeOut()
+	}()
+
+	c.mu.Lock()
+	var resp FlexWatchAPIsResp
+	err := c.c.Call("Plugin.WatchAPIs", &FlexWatchAPIsArgs{CallbackBrokerID: cbID}, &resp)
+	c.mu.Unlock()
+	if err != nil || resp.WatchID == "" {
+		closeOut()
+		return out
+	}
+
+	context.AfterFunc(ctx, func() {
+		c.mu.Lock()
+		var b BoolResp
+		_ = c.c.Call("Plugin.UnwatchAPIs", &FlexUnwatchAPIsArgs{WatchID: resp.WatchID}, &b)
+		c.mu.Unlock()
+		closeOut()
+	})
+
+	return out
+}
+
 var _ api.FlexModule = (*flexModuleRPCClient)(nil)
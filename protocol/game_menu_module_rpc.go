@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/rpc"
 	"sync"
 	"sync/atomic"
 
@@ -95,7 +94,7 @@ func (s *GameMenuEntryCallbackServer) Stop(_ *Empty, _ *Empty) error {
 }
 
 type GameMenuEntryCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -145,7 +144,7 @@ func (s *GameMenuTriggerCallbackServer) OnTrigger(args *GameMenuTriggerEvent, _
 }
 
 type GameMenuTriggerCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -237,7 +236,7 @@ func (s *GameMenuModuleRPCServer) RegisterMenuEntry(args *GameMenuRegisterEntryA
 		if err != nil {
 			return err
 		}
-		cb = &GameMenuTriggerCallbackClient{c: rpc.NewClient(conn)}
+		cb = &GameMenuTriggerCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameGameMenuModule)}
 		entry.OnTrigger = func(chat *api.ChatMsg) {
 			_ = cb.OnTrigger(chat)
 		}
@@ -298,7 +297,7 @@ func (s *GameMenuModuleRPCServer) SubscribeEntries(args *GameMenuSubscribeArgs,
 	if err != nil {
 		return err
 	}
-	cb := &GameMenuEntryCallbackClient{c: rpc.NewClient(conn)}
+	cb := &GameMenuEntryCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameGameMenuModule)}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	ch, stop, err := s.Impl.SubscribeEntries(ctx)
@@ -405,7 +404,7 @@ func (s *GameMenuModuleRPCServer) TriggerEntry(args *GameMenuTriggerArgs, _ *Emp
 }
 
 type gameMenuModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -415,7 +414,7 @@ func newGameMenuModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.Gam
 		return nil
 	}
 	return &gameMenuModuleRPCClient{
-		c:      rpc.NewClient(conn),
+		c:      newRPCConn(conn, broker, api.NameGameMenuModule),
 		broker: broker,
 	}
 }
@@ -433,7 +432,7 @@ func (c *gameMenuModuleRPCClient) RegisterGameMenuEntry(entry *api.GameMenuEntry
 	var cbID uint32
 	if entry.OnTrigger != nil && c.broker != nil {
 		cbID = c.broker.NextId()
-		go acceptAndServeMuxBroker(c.broker, cbID, &GameMenuTriggerCallbackServer{handler: entry.OnTrigger})
+		go acceptAndServeCallback(c.broker, cbID, api.NameGameMenuModule, &GameMenuTriggerCallbackServer{handler: entry.OnTrigger})
 	}
 
 	c.mu.Lock()
@@ -466,7 +465,7 @@ func (c *gameMenuModuleRPCClient) SubscribeEntries(ctx context.Context) (<-chan
 	out := make(chan *api.GameMenuEntryInfo, 64)
 	cbID := c.broker.NextId()
 	cbSrv := &GameMenuEntryCallbackServer{ch: out}
-	go acceptAndServeMuxBroker(c.broker, cbID, cbSrv)
+	go acceptAndServeCallback(c.broker, cbID, api.NameGameMenuModule, cbSrv)
 
 	c.mu.Lock()
 	var resp GameMenuSubscribeResp
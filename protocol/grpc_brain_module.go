@@ -0,0 +1,447 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	sdkdefine "github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type brainModuleGRPCServer struct {
+	pb.UnimplementedBrainModuleServer
+	host *hostGRPCServer
+}
+
+func (s *brainModuleGRPCServer) EnableDaemon(ctx context.Context, req *pb.EnableDaemonRequest) (*pb.EnableDaemonResponse, error) {
+	mod, err := grpcHostModule[api.BrainModule](ctx, s.host, api.NameBrainModule)
+	if err != nil {
+		return nil, err
+	}
+	callCtx, cancel := ctxFromDuration(req.GetTimeout())
+	defer cancel()
+	config := fromStruct(req.GetConfig())
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	actual, dmn, err := mod.EnableDaemon(callCtx, req.GetName(), config)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.EnableDaemonResponse{ActualConfig: toStruct(actual)}
+	if dmn == nil {
+		return resp, nil
+	}
+	resp.DaemonExists = true
+	// Prefer richer daemon interfaces when available.
+	if _, ok := any(dmn).(api.ScoreboardDaemon); ok {
+		resp.DaemonKind = "scoreboard"
+	} else if _, ok := any(dmn).(api.ChunkDaemon); ok {
+		resp.DaemonKind = "chunk"
+	}
+	resp.DaemonHandle = s.host.putDaemon(grpcModuleName(ctx, api.NameBrainModule), req.GetName(), dmn)
+	return resp, nil
+}
+
+func (s *brainModuleGRPCServer) DisableDaemon(ctx context.Context, req *pb.DisableDaemonRequest) (*emptypb.Empty, error) {
+	mod, err := grpcHostModule[api.BrainModule](ctx, s.host, api.NameBrainModule)
+	if err != nil {
+		return nil, err
+	}
+	callCtx, cancel := ctxFromDuration(req.GetTimeout())
+	defer cancel()
+	return &emptypb.Empty{}, mod.DisableDaemon(callCtx, req.GetName())
+}
+
+// grpcDaemon resolves a daemon handle returned by EnableDaemon to the requested interface.
+func grpcDaemon[T any](h *hostGRPCServer, handle string) (T, error) {
+	var zero T
+	dmn, ok := h.daemon(handle)
+	if !ok {
+		return zero, status.Errorf(codes.NotFound, "daemon %q not found", handle)
+	}
+	typed, ok := any(dmn).(T)
+	if !ok {
+		return zero, status.Errorf(codes.FailedPrecondition, "daemon %q does not support this service", handle)
+	}
+	return typed, nil
+}
+
+type daemonGRPCServer struct {
+	pb.UnimplementedDaemonServer
+	host *hostGRPCServer
+}
+
+func (s *daemonGRPCServer) Name(_ context.Context, req *pb.DaemonRef) (*pb.DaemonNameResponse, error) {
+	dmn, err := grpcDaemon[sdkdefine.Daemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaemonNameResponse{Name: dmn.Name()}, nil
+}
+
+func (s *daemonGRPCServer) ReConfig(_ context.Context, req *pb.DaemonReConfigRequest) (*emptypb.Empty, error) {
+	dmn, err := grpcDaemon[sdkdefine.Daemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return nil, err
+	}
+	cfg := fromStruct(req.GetConfig())
+	if cfg == nil {
+		cfg = map[string]interface{}{}
+	}
+	return &emptypb.Empty{}, dmn.ReConfig(cfg)
+}
+
+func (s *daemonGRPCServer) Config(_ context.Context, req *pb.DaemonRef) (*structpb.Struct, error) {
+	dmn, err := grpcDaemon[sdkdefine.Daemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(dmn.Config()), nil
+}
+
+type scoreboardDaemonGRPCServer struct {
+	pb.UnimplementedScoreboardDaemonServer
+	host *hostGRPCServer
+}
+
+func (s *scoreboardDaemonGRPCServer) WatchScoreUpdate(req *pb.DaemonRef, stream pb.ScoreboardDaemon_WatchScoreUpdateServer) error {
+	dmn, err := grpcDaemon[api.ScoreboardDaemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.ScoreUpdateEvent)) (string, error) {
+			return dmn.RegisterWhenScoreUpdate(func(event *api.ScoreUpdateEvent) {
+				if event != nil {
+					emit(toPBScoreUpdateEvent(event))
+				}
+			})
+		},
+		dmn.UnregisterWhenScoreUpdate,
+		func(id string) *pb.ScoreUpdateEvent { return &pb.ScoreUpdateEvent{ListenerId: id} })
+}
+
+func (s *scoreboardDaemonGRPCServer) QueryScoreByPlayerUUID(_ context.Context, req *pb.QueryScoreByPlayerUUIDRequest) (*pb.PlayerScoreQueryResponse, error) {
+	dmn, err := grpcDaemon[api.ScoreboardDaemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return nil, err
+	}
+	results := dmn.QueryScoreByPlayerUUID(req.GetUuid())
+	if results == nil {
+		return &pb.PlayerScoreQueryResponse{}, nil
+	}
+	resp := &pb.PlayerScoreQueryResponse{Present: true, Results: make([]*pb.PlayerScoreQueryResult, 0, len(*results))}
+	for _, r := range *results {
+		resp.Results = append(resp.Results, &pb.PlayerScoreQueryResult{
+			ScoreboardName: r.ScoreboardName,
+			DisplayName:    r.DisplayName,
+			Score:          int64(r.Score),
+		})
+	}
+	return resp, nil
+}
+
+func (s *scoreboardDaemonGRPCServer) QueryRankByScoreboard(_ context.Context, req *pb.QueryRankByScoreboardRequest) (*pb.RankQueryResponse, error) {
+	dmn, err := grpcDaemon[api.ScoreboardDaemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return nil, err
+	}
+	results := dmn.QueryRankByScoreboard(req.GetScoreboardName(), req.GetDescending(), int(req.GetMaxCount()))
+	if results == nil {
+		return &pb.RankQueryResponse{}, nil
+	}
+	resp := &pb.RankQueryResponse{Present: true, Results: make([]*pb.RankQueryResult, 0, len(*results))}
+	for _, r := range *results {
+		resp.Results = append(resp.Results, &pb.RankQueryResult{
+			PlayerUuid: r.PlayerUUID,
+			PlayerName: r.PlayerName,
+			Score:      int64(r.Score),
+		})
+	}
+	return resp, nil
+}
+
+type chunkDaemonGRPCServer struct {
+	pb.UnimplementedChunkDaemonServer
+	host *hostGRPCServer
+}
+
+func (s *chunkDaemonGRPCServer) WatchNewChunk(req *pb.DaemonRef, stream pb.ChunkDaemon_WatchNewChunkServer) error {
+	dmn, err := grpcDaemon[api.ChunkDaemon](s.host, req.GetDaemonHandle())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.ChunkNewChunkEvent)) (string, error) {
+			return dmn.RegisterWhenNewChunk(func(event *api.ChunkNewChunkEvent) {
+				if event == nil {
+					return
+				}
+				emit(&pb.ChunkNewChunkEvent{
+					Dimension:     event.Dimension,
+					ChunkX:        event.ChunkX,
+					ChunkZ:        event.ChunkZ,
+					SubChunks:     event.SubChunks,
+					Biomes:        event.Biomes,
+					BlockEntities: event.BlockEntities,
+					Timestamp:     event.TimeStamp,
+				})
+			})
+		},
+		dmn.UnregisterWhenNewChunk,
+		func(id string) *pb.ChunkNewChunkEvent { return &pb.ChunkNewChunkEvent{ListenerId: id} })
+}
+
+func toPBScoreUpdateEvent(event *api.ScoreUpdateEvent) *pb.ScoreUpdateEvent {
+	out := &pb.ScoreUpdateEvent{
+		Scores:      make(map[string]*pb.PlayerScores, len(event.Scores)),
+		Players:     event.Players,
+		Scoreboards: event.Scoreboards,
+	}
+	for player, scores := range event.Scores {
+		ps := &pb.PlayerScores{Scores: make(map[string]int64, len(scores))}
+		for board, score := range scores {
+			ps.Scores[board] = int64(score)
+		}
+		out.Scores[player] = ps
+	}
+	return out
+}
+
+func fromPBScoreUpdateEvent(event *pb.ScoreUpdateEvent) *api.ScoreUpdateEvent {
+	out := &api.ScoreUpdateEvent{
+		Scores:      make(map[string]map[string]int, len(event.GetScores())),
+		Players:     event.GetPlayers(),
+		Scoreboards: event.GetScoreboards(),
+	}
+	for player, ps := range event.GetScores() {
+		scores := make(map[string]int, len(ps.GetScores()))
+		for board, score := range ps.GetScores() {
+			scores[board] = int(score)
+		}
+		out.Scores[player] = scores
+	}
+	return out
+}
+
+type brainModuleGRPCClient struct {
+	conn *grpc.ClientConn
+	c    pb.BrainModuleClient
+	name string
+}
+
+func newBrainModuleGRPCClient(conn *grpc.ClientConn, name string) *brainModuleGRPCClient {
+	return &brainModuleGRPCClient{conn: conn, c: pb.NewBrainModuleClient(conn), name: name}
+}
+
+func (c *brainModuleGRPCClient) Name() string { return api.NameBrainModule }
+
+func (c *brainModuleGRPCClient) EnableDaemon(ctx context.Context, name string, config map[string]interface{}) (map[string]interface{}, sdkdefine.Daemon, error) {
+	if c == nil || c.c == nil {
+		return nil, nil, errors.New("brainModuleGRPCClient.EnableDaemon: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	resp, err := c.c.EnableDaemon(withGRPCModule(ctx, c.name), &pb.EnableDaemonRequest{
+		Name:    name,
+		Config:  toStruct(config),
+		Timeout: toDuration(timeoutFromCtx(ctx)),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	actual := fromStruct(resp.GetActualConfig())
+	if actual == nil {
+		actual = map[string]interface{}{}
+	}
+	if !resp.GetDaemonExists() || resp.GetDaemonHandle() == "" {
+		return actual, nil, nil
+	}
+	base := &daemonGRPCClient{c: pb.NewDaemonClient(c.conn), handle: resp.GetDaemonHandle()}
+	if isDaemonKindScoreboard(resp.GetDaemonKind()) {
+		return actual, &scoreboardDaemonGRPCClient{daemonGRPCClient: base, c: pb.NewScoreboardDaemonClient(c.conn)}, nil
+	}
+	if isDaemonKindChunk(resp.GetDaemonKind()) {
+		return actual, &chunkDaemonGRPCClient{daemonGRPCClient: base, c: pb.NewChunkDaemonClient(c.conn)}, nil
+	}
+	return actual, base, nil
+}
+
+func (c *brainModuleGRPCClient) DisableDaemon(ctx context.Context, name string) error {
+	if c == nil || c.c == nil {
+		return errors.New("brainModuleGRPCClient.DisableDaemon: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, err := c.c.DisableDaemon(withGRPCModule(ctx, c.name), &pb.DisableDaemonRequest{Name: name, Timeout: toDuration(timeoutFromCtx(ctx))})
+	return err
+}
+
+type daemonGRPCClient struct {
+	c      pb.DaemonClient
+	handle string
+}
+
+func (c *daemonGRPCClient) ref() *pb.DaemonRef { return &pb.DaemonRef{DaemonHandle: c.handle} }
+
+func (c *daemonGRPCClient) Name() string {
+	if c == nil || c.c == nil {
+		return ""
+	}
+	resp, err := c.c.Name(context.Background(), c.ref())
+	if err != nil {
+		return ""
+	}
+	return resp.GetName()
+}
+
+func (c *daemonGRPCClient) ReConfig(config map[string]interface{}) error {
+	if c == nil || c.c == nil {
+		return errors.New("daemonGRPCClient.ReConfig: client is not initialised")
+	}
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	_, err := c.c.ReConfig(context.Background(), &pb.DaemonReConfigRequest{DaemonHandle: c.handle, Config: toStruct(config)})
+	return err
+}
+
+func (c *daemonGRPCClient) Config() map[string]interface{} {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	resp, err := c.c.Config(context.Background(), c.ref())
+	if err != nil {
+		return nil
+	}
+	return fromStruct(resp)
+}
+
+type scoreboardDaemonGRPCClient struct {
+	*daemonGRPCClient
+	c    pb.ScoreboardDaemonClient
+	subs grpcSubscriptions
+}
+
+func (c *scoreboardDaemonGRPCClient) RegisterWhenScoreUpdate(handler func(event *api.ScoreUpdateEvent)) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("scoreboardDaemonGRPCClient.RegisterWhenScoreUpdate: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("scoreboardDaemonGRPCClient.RegisterWhenScoreUpdate: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.ScoreUpdateEvent], error) {
+			return serverStream[*pb.ScoreUpdateEvent](c.c.WatchScoreUpdate(ctx, c.ref()))
+		},
+		(*pb.ScoreUpdateEvent).GetListenerId,
+		func(ev *pb.ScoreUpdateEvent) { handler(fromPBScoreUpdateEvent(ev)) })
+}
+
+func (c *scoreboardDaemonGRPCClient) UnregisterWhenScoreUpdate(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *scoreboardDaemonGRPCClient) QueryScoreByPlayerUUID(uuid string) *[]api.PlayerScoreQueryResult {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	resp, err := c.c.QueryScoreByPlayerUUID(context.Background(), &pb.QueryScoreByPlayerUUIDRequest{DaemonHandle: c.handle, Uuid: uuid})
+	if err != nil || !resp.GetPresent() {
+		return nil
+	}
+	out := make([]api.PlayerScoreQueryResult, 0, len(resp.GetResults()))
+	for _, r := range resp.GetResults() {
+		out = append(out, api.PlayerScoreQueryResult{
+			ScoreboardName: r.GetScoreboardName(),
+			DisplayName:    r.GetDisplayName(),
+			Score:          int(r.GetScore()),
+		})
+	}
+	return &out
+}
+
+func (c *scoreboardDaemonGRPCClient) QueryRankByScoreboard(scoreboardName string, descending bool, maxCount int) *[]api.RankQueryResult {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	resp, err := c.c.QueryRankByScoreboard(context.Background(), &pb.QueryRankByScoreboardRequest{
+		DaemonHandle:   c.handle,
+		ScoreboardName: scoreboardName,
+		Descending:     descending,
+		MaxCount:       int64(maxCount),
+	})
+	if err != nil || !resp.GetPresent() {
+		return nil
+	}
+	out := make([]api.RankQueryResult, 0, len(resp.GetResults()))
+	for _, r := range resp.GetResults() {
+		out = append(out, api.RankQueryResult{
+			PlayerUUID: r.GetPlayerUuid(),
+			PlayerName: r.GetPlayerName(),
+			Score:      int(r.GetScore()),
+		})
+	}
+	return &out
+}
+
+type chunkDaemonGRPCClient struct {
+	*daemonGRPCClient
+	c    pb.ChunkDaemonClient
+	subs grpcSubscriptions
+}
+
+func (c *chunkDaemonGRPCClient) RegisterWhenNewChunk(handler func(event *api.ChunkNewChunkEvent)) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("chunkDaemonGRPCClient.RegisterWhenNewChunk: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("chunkDaemonGRPCClient.RegisterWhenNewChunk: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.ChunkNewChunkEvent], error) {
+			return serverStream[*pb.ChunkNewChunkEvent](c.c.WatchNewChunk(ctx, c.ref()))
+		},
+		(*pb.ChunkNewChunkEvent).GetListenerId,
+		func(ev *pb.ChunkNewChunkEvent) {
+			handler(&api.ChunkNewChunkEvent{
+				Dimension:     ev.GetDimension(),
+				ChunkX:        ev.GetChunkX(),
+				ChunkZ:        ev.GetChunkZ(),
+				SubChunks:     ev.GetSubChunks(),
+				Biomes:        ev.GetBiomes(),
+				BlockEntities: ev.GetBlockEntities(),
+				TimeStamp:     ev.GetTimestamp(),
+			})
+		})
+}
+
+func (c *chunkDaemonGRPCClient) UnregisterWhenNewChunk(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+var (
+	_ api.BrainModule      = (*brainModuleGRPCClient)(nil)
+	_ sdkdefine.Daemon     = (*daemonGRPCClient)(nil)
+	_ api.ScoreboardDaemon = (*scoreboardDaemonGRPCClient)(nil)
+	_ api.ChunkDaemon      = (*chunkDaemonGRPCClient)(nil)
+)
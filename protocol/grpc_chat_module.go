@@ -0,0 +1,134 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type chatModuleGRPCServer struct {
+	pb.UnimplementedChatModuleServer
+	host *hostGRPCServer
+}
+
+func (s *chatModuleGRPCServer) WatchChatMsg(req *pb.WatchChatMsgRequest, stream pb.ChatModule_WatchChatMsgServer) error {
+	mod, err := grpcHostModule[api.ChatModule](stream.Context(), s.host, api.NameChatModule)
+	if err != nil {
+		return err
+	}
+	register := func(emit func(*pb.ChatMsgEvent)) (string, error) {
+		handler := func(msg *api.ChatMsg) { emit(&pb.ChatMsgEvent{Msg: toPBChatMsg(msg)}) }
+		if req.GetSenderName() != "" {
+			return mod.RegisterWhenReceiveMsgFromSenderNamed(req.GetSenderName(), handler)
+		}
+		return mod.RegisterWhenChatMsg(handler)
+	}
+	unregister := mod.UnregisterWhenChatMsg
+	if req.GetSenderName() != "" {
+		unregister = mod.UnregisterWhenReceiveMsgFromSenderNamed
+	}
+	return serveListener(stream.Context(), stream.Send, register, unregister,
+		func(id string) *pb.ChatMsgEvent { return &pb.ChatMsgEvent{ListenerId: id} })
+}
+
+func (s *chatModuleGRPCServer) InterceptNextMessage(req *pb.InterceptNextMessageRequest, stream pb.ChatModule_InterceptNextMessageServer) error {
+	mod, err := grpcHostModule[api.ChatModule](stream.Context(), s.host, api.NameChatModule)
+	if err != nil {
+		return err
+	}
+	got := make(chan *api.ChatMsg, 1)
+	cancel, err := mod.InterceptNextMessage(req.GetName(), func(msg *api.ChatMsg) {
+		select {
+		case got <- msg:
+		default:
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if cancel != nil {
+		defer cancel()
+	}
+	if err := stream.Send(&pb.ChatMsgEvent{}); err != nil {
+		return err
+	}
+	select {
+	case <-stream.Context().Done():
+		return nil
+	case msg := <-got:
+		return stream.Send(&pb.ChatMsgEvent{Msg: toPBChatMsg(msg)})
+	}
+}
+
+type chatModuleGRPCClient struct {
+	c    pb.ChatModuleClient
+	name string
+	subs grpcSubscriptions
+}
+
+func newChatModuleGRPCClient(conn *grpc.ClientConn, name string) *chatModuleGRPCClient {
+	return &chatModuleGRPCClient{c: pb.NewChatModuleClient(conn), name: name}
+}
+
+func (c *chatModuleGRPCClient) Name() string { return api.NameChatModule }
+
+func (c *chatModuleGRPCClient) watch(senderName string, handler func(event *api.ChatMsg)) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("chatModuleGRPCClient: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("chatModuleGRPCClient: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.ChatMsgEvent], error) {
+			return serverStream[*pb.ChatMsgEvent](c.c.WatchChatMsg(withGRPCModule(ctx, c.name), &pb.WatchChatMsgRequest{SenderName: senderName}))
+		},
+		(*pb.ChatMsgEvent).GetListenerId,
+		func(ev *pb.ChatMsgEvent) { handler(fromPBChatMsg(ev.GetMsg())) })
+}
+
+func (c *chatModuleGRPCClient) RegisterWhenChatMsg(handler func(event *api.ChatMsg)) (string, error) {
+	return c.watch("", handler)
+}
+
+func (c *chatModuleGRPCClient) UnregisterWhenChatMsg(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *chatModuleGRPCClient) RegisterWhenReceiveMsgFromSenderNamed(name string, handler func(event *api.ChatMsg)) (string, error) {
+	if name == "" {
+		return "", errors.New("chatModuleGRPCClient.RegisterWhenReceiveMsgFromSenderNamed: name is empty")
+	}
+	return c.watch(name, handler)
+}
+
+func (c *chatModuleGRPCClient) UnregisterWhenReceiveMsgFromSenderNamed(listenerID string) bool {
+	return c.UnregisterWhenChatMsg(listenerID)
+}
+
+func (c *chatModuleGRPCClient) InterceptNextMessage(name string, handler func(*api.ChatMsg)) (func(), error) {
+	if c == nil || c.c == nil {
+		return func() {}, errors.New("chatModuleGRPCClient.InterceptNextMessage: client is not initialised")
+	}
+	if handler == nil {
+		return func() {}, errors.New("chatModuleGRPCClient.InterceptNextMessage: handler is nil")
+	}
+	_, cancel, err := openListener(context.Background(),
+		func(ctx context.Context) (recvStream[*pb.ChatMsgEvent], error) {
+			return serverStream[*pb.ChatMsgEvent](c.c.InterceptNextMessage(withGRPCModule(ctx, c.name), &pb.InterceptNextMessageRequest{Name: name}))
+		},
+		func(ev *pb.ChatMsgEvent) { handler(fromPBChatMsg(ev.GetMsg())) })
+	if err != nil {
+		return func() {}, err
+	}
+	return func() { cancel() }, nil
+}
+
+var _ api.ChatModule = (*chatModuleGRPCClient)(nil)
@@ -0,0 +1,225 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type commandsModuleGRPCServer struct {
+	pb.UnimplementedCommandsModuleServer
+	host *hostGRPCServer
+}
+
+func (s *commandsModuleGRPCServer) module(ctx context.Context) (api.CommandsModule, error) {
+	return grpcHostModule[api.CommandsModule](ctx, s.host, api.NameCommandsModule)
+}
+
+func (s *commandsModuleGRPCServer) SendSettingsCommand(ctx context.Context, req *pb.SendCommandRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, mod.SendSettingsCommand(req.GetCommand(), req.GetDimensional())
+}
+
+func (s *commandsModuleGRPCServer) SendPlayerCommand(ctx context.Context, req *pb.SendCommandRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, mod.SendPlayerCommand(req.GetCommand())
+}
+
+func (s *commandsModuleGRPCServer) SendWSCommand(ctx context.Context, req *pb.SendCommandRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, mod.SendWSCommand(req.GetCommand())
+}
+
+func (s *commandsModuleGRPCServer) SendPlayerCommandWithResp(ctx context.Context, req *pb.SendCommandWithRespRequest) (*pb.CommandOutputResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := mod.SendPlayerCommandWithResp(req.GetCommand(), fromDuration(req.GetTimeout()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CommandOutputResponse{Output: toPBCommandOutput(out)}, nil
+}
+
+func (s *commandsModuleGRPCServer) SendWSCommandWithResp(ctx context.Context, req *pb.SendCommandWithRespRequest) (*pb.CommandOutputResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := mod.SendWSCommandWithResp(req.GetCommand(), fromDuration(req.GetTimeout()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CommandOutputResponse{Output: toPBCommandOutput(out)}, nil
+}
+
+func (s *commandsModuleGRPCServer) AwaitChangesGeneral(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, mod.AwaitChangesGeneral()
+}
+
+func (s *commandsModuleGRPCServer) SendChat(ctx context.Context, req *pb.SendChatRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, mod.SendChat(req.GetContent())
+}
+
+func (s *commandsModuleGRPCServer) Title(ctx context.Context, req *pb.TitleRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, mod.Title(req.GetMessage())
+}
+
+func toPBCommandOutput(out *api.CommandOutput) *pb.CommandOutput {
+	if out == nil {
+		return nil
+	}
+	msgs := make([]*pb.CommandOutputMessage, 0, len(out.Messages))
+	for _, m := range out.Messages {
+		msgs = append(msgs, &pb.CommandOutputMessage{Success: m.Success, Message: m.Message, Parameters: m.Parameters})
+	}
+	return &pb.CommandOutput{
+		CommandLine: out.CommandLine,
+		Origin: &pb.CommandOriginInfo{
+			Origin:    out.Origin.Origin,
+			Uuid:      out.Origin.UUID,
+			RequestId: out.Origin.RequestID,
+		},
+		OutputType:   uint32(out.OutputType),
+		SuccessCount: out.SuccessCount,
+		Messages:     msgs,
+		DataSet:      out.DataSet,
+	}
+}
+
+func fromPBCommandOutput(out *pb.CommandOutput) *api.CommandOutput {
+	if out == nil {
+		return nil
+	}
+	msgs := make([]api.CommandOutputMessage, 0, len(out.GetMessages()))
+	for _, m := range out.GetMessages() {
+		msgs = append(msgs, api.CommandOutputMessage{Success: m.GetSuccess(), Message: m.GetMessage(), Parameters: m.GetParameters()})
+	}
+	return &api.CommandOutput{
+		CommandLine: out.GetCommandLine(),
+		Origin: api.CommandOriginInfo{
+			Origin:    out.GetOrigin().GetOrigin(),
+			UUID:      out.GetOrigin().GetUuid(),
+			RequestID: out.GetOrigin().GetRequestId(),
+		},
+		OutputType:   byte(out.GetOutputType()),
+		SuccessCount: out.GetSuccessCount(),
+		Messages:     msgs,
+		DataSet:      out.GetDataSet(),
+	}
+}
+
+type commandsModuleGRPCClient struct {
+	c    pb.CommandsModuleClient
+	name string
+}
+
+func newCommandsModuleGRPCClient(conn *grpc.ClientConn, name string) *commandsModuleGRPCClient {
+	return &commandsModuleGRPCClient{c: pb.NewCommandsModuleClient(conn), name: name}
+}
+
+func (c *commandsModuleGRPCClient) Name() string { return api.NameCommandsModule }
+
+func (c *commandsModuleGRPCClient) ctx() context.Context {
+	return withGRPCModule(context.Background(), c.name)
+}
+
+func (c *commandsModuleGRPCClient) SendSettingsCommand(command string, dimensional bool) error {
+	if c == nil || c.c == nil {
+		return errors.New("commandsModuleGRPCClient.SendSettingsCommand: client is not initialised")
+	}
+	_, err := c.c.SendSettingsCommand(c.ctx(), &pb.SendCommandRequest{Command: command, Dimensional: dimensional})
+	return err
+}
+
+func (c *commandsModuleGRPCClient) SendPlayerCommand(command string) error {
+	if c == nil || c.c == nil {
+		return errors.New("commandsModuleGRPCClient.SendPlayerCommand: client is not initialised")
+	}
+	_, err := c.c.SendPlayerCommand(c.ctx(), &pb.SendCommandRequest{Command: command})
+	return err
+}
+
+func (c *commandsModuleGRPCClient) SendWSCommand(command string) error {
+	if c == nil || c.c == nil {
+		return errors.New("commandsModuleGRPCClient.SendWSCommand: client is not initialised")
+	}
+	_, err := c.c.SendWSCommand(c.ctx(), &pb.SendCommandRequest{Command: command})
+	return err
+}
+
+func (c *commandsModuleGRPCClient) SendPlayerCommandWithResp(command string, timeout time.Duration) (*api.CommandOutput, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("commandsModuleGRPCClient.SendPlayerCommandWithResp: client is not initialised")
+	}
+	resp, err := c.c.SendPlayerCommandWithResp(c.ctx(), &pb.SendCommandWithRespRequest{Command: command, Timeout: toDuration(timeout)})
+	if err != nil {
+		return nil, err
+	}
+	return fromPBCommandOutput(resp.GetOutput()), nil
+}
+
+func (c *commandsModuleGRPCClient) SendWSCommandWithResp(command string, timeout time.Duration) (*api.CommandOutput, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("commandsModuleGRPCClient.SendWSCommandWithResp: client is not initialised")
+	}
+	resp, err := c.c.SendWSCommandWithResp(c.ctx(), &pb.SendCommandWithRespRequest{Command: command, Timeout: toDuration(timeout)})
+	if err != nil {
+		return nil, err
+	}
+	return fromPBCommandOutput(resp.GetOutput()), nil
+}
+
+func (c *commandsModuleGRPCClient) AwaitChangesGeneral() error {
+	if c == nil || c.c == nil {
+		return errors.New("commandsModuleGRPCClient.AwaitChangesGeneral: client is not initialised")
+	}
+	_, err := c.c.AwaitChangesGeneral(c.ctx(), &emptypb.Empty{})
+	return err
+}
+
+func (c *commandsModuleGRPCClient) SendChat(content string) error {
+	if c == nil || c.c == nil {
+		return errors.New("commandsModuleGRPCClient.SendChat: client is not initialised")
+	}
+	_, err := c.c.SendChat(c.ctx(), &pb.SendChatRequest{Content: content})
+	return err
+}
+
+func (c *commandsModuleGRPCClient) Title(message string) error {
+	if c == nil || c.c == nil {
+		return errors.New("commandsModuleGRPCClient.Title: client is not initialised")
+	}
+	_, err := c.c.Title(c.ctx(), &pb.TitleRequest{Message: message})
+	return err
+}
+
+var _ api.CommandsModule = (*commandsModuleGRPCClient)(nil)
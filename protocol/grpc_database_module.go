@@ -0,0 +1,424 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type databaseModuleGRPCServer struct {
+	pb.UnimplementedDatabaseModuleServer
+	host *hostGRPCServer
+}
+
+func (s *databaseModuleGRPCServer) OpenKeyValueDB(ctx context.Context, req *pb.OpenKeyValueDBRequest) (*pb.KeyValueDBHandle, error) {
+	mod, err := grpcHostModule[api.DatabaseModule](ctx, s.host, api.NameDatabaseModule)
+	if err != nil {
+		return nil, err
+	}
+	db, err := mod.KeyValueDB(req.GetName(), req.GetDbType())
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, status.Errorf(codes.Internal, "database %s is nil", req.GetName())
+	}
+	return &pb.KeyValueDBHandle{Handle: s.host.openDB(db)}, nil
+}
+
+type keyValueDBGRPCServer struct {
+	pb.UnimplementedKeyValueDBServer
+	host *hostGRPCServer
+}
+
+func (s *keyValueDBGRPCServer) db(handle string) (api.KeyValueDB, error) {
+	db, ok := s.host.db(handle)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "database handle %s not found", handle)
+	}
+	return db, nil
+}
+
+func (s *keyValueDBGRPCServer) Get(_ context.Context, req *pb.KVGetRequest) (*pb.KVGetResponse, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	val, ok, err := db.Get(string(req.GetKey()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KVGetResponse{Value: []byte(val), Ok: ok}, nil
+}
+
+func (s *keyValueDBGRPCServer) Set(_ context.Context, req *pb.KVSetRequest) (*emptypb.Empty, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, db.Set(string(req.GetKey()), string(req.GetValue()))
+}
+
+func (s *keyValueDBGRPCServer) SetWithTTL(_ context.Context, req *pb.KVSetRequest) (*emptypb.Empty, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	e, ok := db.(api.KVExpirer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, api.ErrKVUnsupported.Error())
+	}
+	return &emptypb.Empty{}, e.SetWithTTL(string(req.GetKey()), string(req.GetValue()), time.Duration(req.GetTtlMs())*time.Millisecond)
+}
+
+func (s *keyValueDBGRPCServer) TTL(_ context.Context, req *pb.KVGetRequest) (*pb.KVTTLResponse, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	e, ok := db.(api.KVExpirer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, api.ErrKVUnsupported.Error())
+	}
+	ttl, ok, err := e.TTL(string(req.GetKey()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KVTTLResponse{TtlMs: ttlMs(ttl), Ok: ok}, nil
+}
+
+func (s *keyValueDBGRPCServer) Delete(_ context.Context, req *pb.KVDeleteRequest) (*emptypb.Empty, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, db.Delete(string(req.GetKey()))
+}
+
+func (s *keyValueDBGRPCServer) Batch(_ context.Context, req *pb.KVBatchRequest) (*pb.KVBatchResponse, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	var b api.KVBatch
+	for _, c := range req.GetConditions() {
+		b.Conditions = append(b.Conditions, api.KVCondition{Key: string(c.GetKey()), Value: string(c.GetValue()), Exists: c.GetExists()})
+	}
+	for _, w := range req.GetWrites() {
+		b.Writes = append(b.Writes, api.KVWrite{Key: string(w.GetKey()), Value: string(w.GetValue()), Delete: w.GetDelete(), TTL: time.Duration(w.GetTtlMs()) * time.Millisecond})
+	}
+	batcher, ok := db.(api.KVBatcher)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, api.ErrKVUnsupported.Error())
+	}
+	err = batcher.Batch(b)
+	if errors.Is(err, api.ErrKVConflict) {
+		return &pb.KVBatchResponse{Conflict: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KVBatchResponse{}, nil
+}
+
+func (s *keyValueDBGRPCServer) Scan(_ context.Context, req *pb.KVScanRequest) (*pb.KVPage, error) {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+	// Backends without KVScanner are scanned with Iterate, see api.ScanPage.
+	page, err := api.ScanPage(db, api.KVScan{
+		Prefix:  string(req.GetPrefix()),
+		Start:   string(req.GetStart()),
+		End:     string(req.GetEnd()),
+		Reverse: req.GetReverse(),
+		Limit:   int(req.GetLimit()),
+		Cursor:  string(req.GetCursor()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.KVPage{NextCursor: []byte(page.NextCursor)}
+	for _, e := range page.Entries {
+		resp.Entries = append(resp.Entries, &pb.KVEntry{Key: []byte(e.Key), Value: []byte(e.Value)})
+	}
+	return resp, nil
+}
+
+func (s *keyValueDBGRPCServer) Watch(req *pb.KVWatchRequest, stream pb.KeyValueDB_WatchServer) error {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return err
+	}
+	changes, err := watchKeyValueDB(stream.Context(), db, string(req.GetPrefix()))
+	if errors.Is(err, api.ErrKVUnsupported) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.KVChange{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case c, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pb.KVChange{Op: string(c.Op), Key: []byte(c.Key), OldValue: []byte(c.OldValue), OldExists: c.OldExists, NewValue: []byte(c.NewValue)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *keyValueDBGRPCServer) Iterate(req *pb.KeyValueDBHandle, stream pb.KeyValueDB_IterateServer) error {
+	db, err := s.db(req.GetHandle())
+	if err != nil {
+		return err
+	}
+	var sendErr error
+	err = db.Iterate(func(key, value string) bool {
+		if sendErr = stream.Send(&pb.KVEntry{Key: []byte(key), Value: []byte(value)}); sendErr != nil {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
+
+func (s *keyValueDBGRPCServer) Close(_ context.Context, req *pb.KeyValueDBHandle) (*emptypb.Empty, error) {
+	db, ok := s.host.closeDB(req.GetHandle())
+	if !ok {
+		return &emptypb.Empty{}, nil
+	}
+	return &emptypb.Empty{}, db.Close()
+}
+
+type databaseModuleGRPCClient struct {
+	c    pb.DatabaseModuleClient
+	kv   pb.KeyValueDBClient
+	sql  pb.SQLDBClient
+	name string
+}
+
+func newDatabaseModuleGRPCClient(conn *grpc.ClientConn, name string) *databaseModuleGRPCClient {
+	return &databaseModuleGRPCClient{c: pb.NewDatabaseModuleClient(conn), kv: pb.NewKeyValueDBClient(conn), sql: pb.NewSQLDBClient(conn), name: name}
+}
+
+func (c *databaseModuleGRPCClient) Name() string { return api.NameDatabaseModule }
+
+func (c *databaseModuleGRPCClient) KeyValueDB(name string, dbType string) (api.KeyValueDB, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("databaseModuleGRPCClient.KeyValueDB: client is not initialised")
+	}
+	resp, err := c.c.OpenKeyValueDB(withGRPCModule(context.Background(), c.name), &pb.OpenKeyValueDBRequest{Name: name, DbType: dbType})
+	if err != nil {
+		return nil, err
+	}
+	return &keyValueDBGRPCClient{c: c.kv, handle: resp.GetHandle()}, nil
+}
+
+// kvGRPCError restores api.ErrKVUnsupported from the error of a host call, also for hosts that
+// predate the method.
+func kvGRPCError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return api.ErrKVUnsupported
+	}
+	return err
+}
+
+// keyValueDBGRPCClient implements every optional KeyValueDB interface; the calls the host's
+// backend does not support return api.ErrKVUnsupported.
+type keyValueDBGRPCClient struct {
+	c      pb.KeyValueDBClient
+	handle string
+}
+
+func (c *keyValueDBGRPCClient) Get(key string) (string, bool, error) {
+	if c == nil || c.c == nil {
+		return "", false, errors.New("keyValueDBGRPCClient.Get: client is not initialised")
+	}
+	resp, err := c.c.Get(context.Background(), &pb.KVGetRequest{Handle: c.handle, Key: []byte(key)})
+	if err != nil {
+		return "", false, err
+	}
+	return string(resp.GetValue()), resp.GetOk(), nil
+}
+
+func (c *keyValueDBGRPCClient) Set(key, value string) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBGRPCClient.Set: client is not initialised")
+	}
+	_, err := c.c.Set(context.Background(), &pb.KVSetRequest{Handle: c.handle, Key: []byte(key), Value: []byte(value)})
+	return err
+}
+
+func (c *keyValueDBGRPCClient) SetWithTTL(key, value string, ttl time.Duration) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBGRPCClient.SetWithTTL: client is not initialised")
+	}
+	_, err := c.c.SetWithTTL(context.Background(), &pb.KVSetRequest{Handle: c.handle, Key: []byte(key), Value: []byte(value), TtlMs: ttlMs(ttl)})
+	return kvGRPCError(err)
+}
+
+func (c *keyValueDBGRPCClient) TTL(key string) (time.Duration, bool, error) {
+	if c == nil || c.c == nil {
+		return 0, false, errors.New("keyValueDBGRPCClient.TTL: client is not initialised")
+	}
+	resp, err := c.c.TTL(context.Background(), &pb.KVGetRequest{Handle: c.handle, Key: []byte(key)})
+	if err != nil {
+		return 0, false, kvGRPCError(err)
+	}
+	return time.Duration(resp.GetTtlMs()) * time.Millisecond, resp.GetOk(), nil
+}
+
+func (c *keyValueDBGRPCClient) Delete(key string) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBGRPCClient.Delete: client is not initialised")
+	}
+	_, err := c.c.Delete(context.Background(), &pb.KVDeleteRequest{Handle: c.handle, Key: []byte(key)})
+	return err
+}
+
+func (c *keyValueDBGRPCClient) Batch(b api.KVBatch) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBGRPCClient.Batch: client is not initialised")
+	}
+	req := &pb.KVBatchRequest{Handle: c.handle}
+	for _, cond := range b.Conditions {
+		req.Conditions = append(req.Conditions, &pb.KVCondition{Key: []byte(cond.Key), Value: []byte(cond.Value), Exists: cond.Exists})
+	}
+	for _, w := range b.Writes {
+		req.Writes = append(req.Writes, &pb.KVWrite{Key: []byte(w.Key), Value: []byte(w.Value), Delete: w.Delete, TtlMs: ttlMs(w.TTL)})
+	}
+	resp, err := c.c.Batch(context.Background(), req)
+	if err != nil {
+		return kvGRPCError(err)
+	}
+	if resp.GetConflict() {
+		return api.ErrKVConflict
+	}
+	return nil
+}
+
+func (c *keyValueDBGRPCClient) Scan(q api.KVScan) (api.KVPage, error) {
+	if c == nil || c.c == nil {
+		return api.KVPage{}, errors.New("keyValueDBGRPCClient.Scan: client is not initialised")
+	}
+	resp, err := c.c.Scan(context.Background(), &pb.KVScanRequest{
+		Handle:  c.handle,
+		Prefix:  []byte(q.Prefix),
+		Start:   []byte(q.Start),
+		End:     []byte(q.End),
+		Reverse: q.Reverse,
+		Limit:   int32(min(max(q.Limit, 0), api.MaxKVScanLimit)),
+		Cursor:  []byte(q.Cursor),
+	})
+	if err != nil {
+		return api.KVPage{}, kvGRPCError(err)
+	}
+	page := api.KVPage{NextCursor: string(resp.GetNextCursor())}
+	for _, e := range resp.GetEntries() {
+		page.Entries = append(page.Entries, api.KVEntry{Key: string(e.GetKey()), Value: string(e.GetValue())})
+	}
+	return page, nil
+}
+
+func (c *keyValueDBGRPCClient) Watch(ctx context.Context, prefix string) (<-chan api.KVChange, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("keyValueDBGRPCClient.Watch: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.c.Watch(ctx, &pb.KVWatchRequest{Handle: c.handle, Prefix: []byte(prefix)})
+	if err != nil {
+		return nil, kvGRPCError(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		return nil, kvGRPCError(err)
+	}
+	out := make(chan api.KVChange, 256)
+	go func() {
+		defer close(out)
+		for {
+			c, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case out <- api.KVChange{Op: api.KVChangeOp(c.GetOp()), Key: string(c.GetKey()), OldValue: string(c.GetOldValue()), OldExists: c.GetOldExists(), NewValue: string(c.GetNewValue())}:
+			default:
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (c *keyValueDBGRPCClient) Iterate(fn func(key, value string) bool) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBGRPCClient.Iterate: client is not initialised")
+	}
+	if fn == nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := c.c.Iterate(ctx, &pb.KeyValueDBHandle{Handle: c.handle})
+	if err != nil {
+		return err
+	}
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !fn(string(entry.GetKey()), string(entry.GetValue())) {
+			return nil
+		}
+	}
+}
+
+func (c *keyValueDBGRPCClient) Close() error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	_, err := c.c.Close(context.Background(), &pb.KeyValueDBHandle{Handle: c.handle})
+	return err
+}
+
+// ttlMs converts a TTL to milliseconds, rounding positive TTLs up so they keep expiring.
+func ttlMs(ttl time.Duration) int64 {
+	if ttl <= 0 {
+		return 0
+	}
+	return int64((ttl + time.Millisecond - 1) / time.Millisecond)
+}
+
+var (
+	_ api.DatabaseModule = (*databaseModuleGRPCClient)(nil)
+	_ api.KeyValueDB     = (*keyValueDBGRPCClient)(nil)
+	_ api.KVBatcher      = (*keyValueDBGRPCClient)(nil)
+	_ api.KVScanner      = (*keyValueDBGRPCClient)(nil)
+	_ api.KVWatcher      = (*keyValueDBGRPCClient)(nil)
+	_ api.KVExpirer      = (*keyValueDBGRPCClient)(nil)
+)
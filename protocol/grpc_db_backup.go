@@ -0,0 +1,283 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+// grpcDBBackupChunk is the number of backup bytes per message of ExportDB and ImportDB.
+const grpcDBBackupChunk = 64 << 10
+
+func dbRefFromPB(r *pb.DBRef) api.DBRef {
+	return api.DBRef{Kind: api.DBKind(r.GetKind()), Name: r.GetName(), DBType: r.GetDbType()}
+}
+
+func dbRefToPB(r api.DBRef) *pb.DBRef {
+	return &pb.DBRef{Kind: string(r.Kind), Name: r.Name, DbType: r.DBType}
+}
+
+func dbBackupInfoFromPB(i *pb.DBBackupInfo) api.DBBackupInfo {
+	info := api.DBBackupInfo{
+		ID:      i.GetId(),
+		Ref:     dbRefFromPB(i.GetRef()),
+		Size:    i.GetSize(),
+		Entries: i.GetEntries(),
+		SHA256:  i.GetSha256(),
+	}
+	if i.GetCreated() != nil {
+		info.Created = i.GetCreated().AsTime()
+	}
+	return info
+}
+
+func dbBackupInfoToPB(i api.DBBackupInfo) *pb.DBBackupInfo {
+	out := &pb.DBBackupInfo{Id: i.ID, Ref: dbRefToPB(i.Ref), Size: i.Size, Entries: i.Entries, Sha256: i.SHA256}
+	if !i.Created.IsZero() {
+		out.Created = timestamppb.New(i.Created)
+	}
+	return out
+}
+
+// hostDBRef returns the database ref of the calling plugin.
+func (s *databaseModuleGRPCServer) hostDBRef(r *pb.DBRef) api.DBRef {
+	ref := dbRefFromPB(r)
+	ref.Owner = s.host.pluginID
+	return ref
+}
+
+// grpcChunkWriter sends what is written to it as DBBackupChunks.
+type grpcChunkWriter struct {
+	send func(*pb.DBBackupChunk) error
+}
+
+func (w grpcChunkWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		chunk := p[:min(len(p), grpcDBBackupChunk)]
+		if err := w.send(&pb.DBBackupChunk{Data: append([]byte(nil), chunk...)}); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// grpcChunkReader reads the data of DBBackupChunks received from recv.
+type grpcChunkReader struct {
+	recv func() (*pb.DBBackupChunk, error)
+	buf  []byte
+}
+
+func (r *grpcChunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (s *databaseModuleGRPCServer) ExportDB(req *pb.DBBackupRequest, stream pb.DatabaseModule_ExportDBServer) error {
+	mod, err := grpcHostModule[api.DatabaseModule](stream.Context(), s.host, api.NameDatabaseModule)
+	if err != nil {
+		return err
+	}
+	info, err := mod.ExportDB(stream.Context(), s.hostDBRef(req.GetRef()), grpcChunkWriter{send: stream.Send})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.DBBackupChunk{Info: dbBackupInfoToPB(info)})
+}
+
+func (s *databaseModuleGRPCServer) ImportDB(stream pb.DatabaseModule_ImportDBServer) error {
+	mod, err := grpcHostModule[api.DatabaseModule](stream.Context(), s.host, api.NameDatabaseModule)
+	if err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	r := &grpcChunkReader{recv: stream.Recv, buf: first.GetData()}
+	info, err := mod.ImportDB(stream.Context(), s.hostDBRef(first.GetRef()), r)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(io.Discard, r); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return stream.SendAndClose(dbBackupInfoToPB(info))
+}
+
+func (s *databaseModuleGRPCServer) Backup(ctx context.Context, req *pb.DBBackupRequest) (*pb.DBBackupInfo, error) {
+	mod, err := grpcHostModule[api.DatabaseModule](ctx, s.host, api.NameDatabaseModule)
+	if err != nil {
+		return nil, err
+	}
+	info, err := mod.Backup(ctx, s.hostDBRef(req.GetRef()))
+	if err != nil {
+		return nil, err
+	}
+	return dbBackupInfoToPB(info), nil
+}
+
+func (s *databaseModuleGRPCServer) Backups(ctx context.Context, req *pb.DBBackupRequest) (*pb.DBBackupList, error) {
+	mod, err := grpcHostModule[api.DatabaseModule](ctx, s.host, api.NameDatabaseModule)
+	if err != nil {
+		return nil, err
+	}
+	backups, err := mod.Backups(ctx, s.hostDBRef(req.GetRef()))
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.DBBackupList{}
+	for _, info := range backups {
+		out.Backups = append(out.Backups, dbBackupInfoToPB(info))
+	}
+	return out, nil
+}
+
+func (s *databaseModuleGRPCServer) RestoreBackup(ctx context.Context, req *pb.DBBackupRequest) (*pb.DBBackupInfo, error) {
+	mod, err := grpcHostModule[api.DatabaseModule](ctx, s.host, api.NameDatabaseModule)
+	if err != nil {
+		return nil, err
+	}
+	info, err := mod.RestoreBackup(ctx, s.hostDBRef(req.GetRef()), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return dbBackupInfoToPB(info), nil
+}
+
+func (c *databaseModuleGRPCClient) ExportDB(ctx context.Context, ref api.DBRef, w io.Writer) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleGRPCClient.ExportDB: client is not initialised")
+	}
+	if w == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleGRPCClient.ExportDB: writer is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.c.ExportDB(withGRPCModule(ctx, c.name), &pb.DBBackupRequest{Ref: dbRefToPB(ref)})
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return api.DBBackupInfo{}, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return api.DBBackupInfo{}, err
+		}
+		if len(chunk.GetData()) > 0 {
+			if _, err := w.Write(chunk.GetData()); err != nil {
+				return api.DBBackupInfo{}, err
+			}
+		}
+		if chunk.GetInfo() != nil {
+			return dbBackupInfoFromPB(chunk.GetInfo()), nil
+		}
+	}
+}
+
+func (c *databaseModuleGRPCClient) ImportDB(ctx context.Context, ref api.DBRef, r io.Reader) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleGRPCClient.ImportDB: client is not initialised")
+	}
+	if r == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleGRPCClient.ImportDB: reader is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.c.ImportDB(withGRPCModule(ctx, c.name))
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	buf := make([]byte, grpcDBBackupChunk)
+	chunk := &pb.DBBackupChunk{Ref: dbRefToPB(ref)}
+	for {
+		n, err := io.ReadFull(r, buf)
+		done := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !done {
+			return api.DBBackupInfo{}, err
+		}
+		chunk.Data = buf[:n]
+		if err := stream.Send(chunk); err != nil {
+			// The server failed; its error is returned by CloseAndRecv.
+			break
+		}
+		if done {
+			break
+		}
+		chunk = &pb.DBBackupChunk{}
+	}
+	info, err := stream.CloseAndRecv()
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return dbBackupInfoFromPB(info), nil
+}
+
+func (c *databaseModuleGRPCClient) Backup(ctx context.Context, ref api.DBRef) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleGRPCClient.Backup: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	info, err := c.c.Backup(withGRPCModule(ctx, c.name), &pb.DBBackupRequest{Ref: dbRefToPB(ref)})
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return dbBackupInfoFromPB(info), nil
+}
+
+func (c *databaseModuleGRPCClient) Backups(ctx context.Context, ref api.DBRef) ([]api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("databaseModuleGRPCClient.Backups: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resp, err := c.c.Backups(withGRPCModule(ctx, c.name), &pb.DBBackupRequest{Ref: dbRefToPB(ref)})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.DBBackupInfo, 0, len(resp.GetBackups()))
+	for _, info := range resp.GetBackups() {
+		out = append(out, dbBackupInfoFromPB(info))
+	}
+	return out, nil
+}
+
+func (c *databaseModuleGRPCClient) RestoreBackup(ctx context.Context, ref api.DBRef, id string) (api.DBBackupInfo, error) {
+	if c == nil || c.c == nil {
+		return api.DBBackupInfo{}, errors.New("databaseModuleGRPCClient.RestoreBackup: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	info, err := c.c.RestoreBackup(withGRPCModule(ctx, c.name), &pb.DBBackupRequest{Ref: dbRefToPB(ref), Id: id})
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return dbBackupInfoFromPB(info), nil
+}
@@ -0,0 +1,401 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type flexModuleGRPCServer struct {
+	pb.UnimplementedFlexModuleServer
+	host *hostGRPCServer
+}
+
+func (s *flexModuleGRPCServer) module(ctx context.Context) (api.FlexModule, error) {
+	return grpcHostModule[api.FlexModule](ctx, s.host, api.NameFlexModule)
+}
+
+func (s *flexModuleGRPCServer) Set(ctx context.Context, req *pb.FlexSetRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.Set(req.GetKey(), req.GetValue())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *flexModuleGRPCServer) Get(ctx context.Context, req *pb.FlexGetRequest) (*pb.FlexGetResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	val, ok := mod.Get(req.GetKey())
+	return &pb.FlexGetResponse{Value: val, Ok: ok}, nil
+}
+
+func (s *flexModuleGRPCServer) Publish(ctx context.Context, req *pb.FlexPublishRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.Publish(req.GetTopic(), req.GetPayloadJson())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *flexModuleGRPCServer) Subscribe(req *pb.FlexSubscribeRequest, stream pb.FlexModule_SubscribeServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := mod.Subscribe(stream.Context(), req.GetTopic())
+	if err := stream.Send(&pb.FlexPayload{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case payload, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pb.FlexPayload{PayloadJson: payload}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type flexExposeResult struct {
+	result []byte
+	errStr string
+}
+
+func (s *flexModuleGRPCServer) Expose(stream pb.FlexModule_ExposeServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.GetApiName() == "" {
+		return status.Error(codes.InvalidArgument, "api name is empty")
+	}
+
+	var (
+		sendMu  sync.Mutex
+		mu      sync.Mutex
+		nextID  uint64
+		pending = map[uint64]chan flexExposeResult{}
+	)
+	handler := func(ctx context.Context, args []byte) ([]byte, string) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		ch := make(chan flexExposeResult, 1)
+		mu.Lock()
+		nextID++
+		id := nextID
+		pending[id] = ch
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			delete(pending, id)
+			mu.Unlock()
+		}()
+
+		sendMu.Lock()
+		err := stream.Send(&pb.FlexExposeCall{CallId: id, ArgsJson: args, Timeout: toDuration(timeoutFromCtx(ctx))})
+		sendMu.Unlock()
+		if err != nil {
+			return nil, err.Error()
+		}
+		select {
+		case res := <-ch:
+			return res.result, res.errStr
+		case <-ctx.Done():
+			return nil, ctx.Err().Error()
+		case <-stream.Context().Done():
+			return nil, "flex api provider disconnected"
+		}
+	}
+
+	info := api.FlexAPIInfo{Name: first.GetApiName(), Owner: s.host.pluginID, Version: first.GetVersion()}
+	unexpose, err := mod.ExposeAPI(info, handler)
+	if err != nil {
+		return err
+	}
+	if unexpose != nil {
+		defer unexpose()
+	}
+	sendMu.Lock()
+	err = stream.Send(&pb.FlexExposeCall{})
+	sendMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		mu.Lock()
+		ch := pending[msg.GetCallId()]
+		mu.Unlock()
+		if ch != nil {
+			ch <- flexExposeResult{result: msg.GetResultJson(), errStr: msg.GetError()}
+		}
+	}
+}
+
+func (s *flexModuleGRPCServer) Call(ctx context.Context, req *pb.FlexCallRequest) (*pb.FlexCallResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, errStr, err := mod.Call(ctx, req.GetApiName(), req.GetArgsJson())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FlexCallResponse{ResultJson: result, Error: errStr}, nil
+}
+
+func (s *flexModuleGRPCServer) ListAPIs(ctx context.Context, _ *emptypb.Empty) (*pb.FlexListAPIsResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apis := mod.ListAPIs()
+	resp := &pb.FlexListAPIsResponse{Apis: make([]*pb.FlexAPIInfo, 0, len(apis))}
+	for _, info := range apis {
+		resp.Apis = append(resp.Apis, toPBFlexAPIInfo(info))
+	}
+	return resp, nil
+}
+
+func (s *flexModuleGRPCServer) WatchAPIs(_ *emptypb.Empty, stream pb.FlexModule_WatchAPIsServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := mod.WatchAPIs(stream.Context())
+	if err := stream.Send(&pb.FlexAPIEvent{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pb.FlexAPIEvent{Api: toPBFlexAPIInfo(ev.API), Available: ev.Available}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toPBFlexAPIInfo(info api.FlexAPIInfo) *pb.FlexAPIInfo {
+	return &pb.FlexAPIInfo{Name: info.Name, Owner: info.Owner, Version: info.Version}
+}
+
+func fromPBFlexAPIInfo(info *pb.FlexAPIInfo) api.FlexAPIInfo {
+	return api.FlexAPIInfo{Name: info.GetName(), Owner: info.GetOwner(), Version: info.GetVersion()}
+}
+
+type flexModuleGRPCClient struct {
+	c    pb.FlexModuleClient
+	name string
+}
+
+func newFlexModuleGRPCClient(conn *grpc.ClientConn, name string) *flexModuleGRPCClient {
+	return &flexModuleGRPCClient{c: pb.NewFlexModuleClient(conn), name: name}
+}
+
+func (c *flexModuleGRPCClient) Name() string { return api.NameFlexModule }
+
+func (c *flexModuleGRPCClient) Set(key string, val string) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.Set(withGRPCModule(context.Background(), c.name), &pb.FlexSetRequest{Key: key, Value: val})
+}
+
+func (c *flexModuleGRPCClient) Get(key string) (string, bool) {
+	if c == nil || c.c == nil {
+		return "", false
+	}
+	resp, err := c.c.Get(withGRPCModule(context.Background(), c.name), &pb.FlexGetRequest{Key: key})
+	if err != nil {
+		return "", false
+	}
+	return resp.GetValue(), resp.GetOk()
+}
+
+func (c *flexModuleGRPCClient) Publish(topic string, payloadJSON []byte) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.Publish(withGRPCModule(context.Background(), c.name), &pb.FlexPublishRequest{Topic: topic, PayloadJson: payloadJSON})
+}
+
+func (c *flexModuleGRPCClient) Subscribe(ctx context.Context, topic string) <-chan []byte {
+	out := make(chan []byte, 256)
+	if c == nil || c.c == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.c.Subscribe(withGRPCModule(ctx, c.name), &pb.FlexSubscribeRequest{Topic: topic})
+	if err != nil {
+		close(out)
+		return out
+	}
+	if _, err := stream.Recv(); err != nil {
+		close(out)
+		return out
+	}
+	go func() {
+		defer close(out)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case out <- msg.GetPayloadJson():
+			default:
+			}
+		}
+	}()
+	return out
+}
+
+func (c *flexModuleGRPCClient) Expose(apiName string, handler func(context.Context, []byte) ([]byte, string)) (func(), error) {
+	return c.ExposeAPI(api.FlexAPIInfo{Name: apiName}, handler)
+}
+
+func (c *flexModuleGRPCClient) ExposeAPI(info api.FlexAPIInfo, handler func(context.Context, []byte) ([]byte, string)) (func(), error) {
+	if c == nil || c.c == nil {
+		return func() {}, errors.New("flexModuleGRPCClient.ExposeAPI: client is not initialised")
+	}
+	if handler == nil {
+		return func() {}, errors.New("flexModuleGRPCClient.ExposeAPI: handler is nil")
+	}
+
+	ctx, cancel := context.WithCancel(withGRPCModule(context.Background(), c.name))
+	stream, err := c.c.Expose(ctx)
+	if err != nil {
+		cancel()
+		return func() {}, err
+	}
+	if err := stream.Send(&pb.FlexExposeMessage{ApiName: info.Name, Version: info.Version}); err != nil {
+		cancel()
+		return func() {}, err
+	}
+	if _, err := stream.Recv(); err != nil {
+		cancel()
+		return func() {}, err
+	}
+
+	var sendMu sync.Mutex
+	go func() {
+		defer cancel()
+		for {
+			call, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			go func(call *pb.FlexExposeCall) {
+				callCtx, callCancel := ctxFromDuration(call.GetTimeout())
+				defer callCancel()
+				result, errStr := handler(callCtx, call.GetArgsJson())
+				sendMu.Lock()
+				_ = stream.Send(&pb.FlexExposeMessage{CallId: call.GetCallId(), ResultJson: result, Error: errStr})
+				sendMu.Unlock()
+			}(call)
+		}
+	}()
+	return func() { cancel() }, nil
+}
+
+func (c *flexModuleGRPCClient) Call(ctx context.Context, apiName string, argsJSON []byte) ([]byte, string, error) {
+	if c == nil || c.c == nil {
+		return nil, "", errors.New("flexModuleGRPCClient.Call: client is not initialised")
+	}
+	resp, err := c.c.Call(withGRPCModule(ctx, c.name), &pb.FlexCallRequest{ApiName: apiName, ArgsJson: argsJSON})
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.GetResultJson(), resp.GetError(), nil
+}
+
+func (c *flexModuleGRPCClient) ListAPIs() []api.FlexAPIInfo {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	resp, err := c.c.ListAPIs(withGRPCModule(context.Background(), c.name), &emptypb.Empty{})
+	if err != nil {
+		return nil
+	}
+	out := make([]api.FlexAPIInfo, 0, len(resp.GetApis()))
+	for _, info := range resp.GetApis() {
+		out = append(out, fromPBFlexAPIInfo(info))
+	}
+	return out
+}
+
+func (c *flexModuleGRPCClient) WaitForAPI(ctx context.Context, apiName string) (api.FlexAPIInfo, error) {
+	return api.WaitForFlexAPI(ctx, c, apiName)
+}
+
+func (c *flexModuleGRPCClient) WatchAPIs(ctx context.Context) <-chan api.FlexAPIEvent {
+	out := make(chan api.FlexAPIEvent, 256)
+	if c == nil || c.c == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.c.WatchAPIs(withGRPCModule(ctx, c.name), &emptypb.Empty{})
+	if err != nil {
+		close(out)
+		return out
+	}
+	if _, err := stream.Recv(); err != nil {
+		close(out)
+		return out
+	}
+	go func() {
+		defer close(out)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case out <- api.FlexAPIEvent{API: fromPBFlexAPIInfo(msg.GetApi()), Available: msg.GetAvailable()}:
+			default:
+			}
+		}
+	}()
+	return out
+}
+
+var _ api.FlexModule = (*flexModuleGRPCClient)(nil)
@@ -0,0 +1,483 @@
+package protocol
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	sdkdefine "github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+// hostGRPCServer holds the state shared by the Frame and module services a host serves to one
+// plugin over gRPC: the Frame itself and the handles of opened databases and daemons.
+type hostGRPCServer struct {
+	frame   sdkdefine.Frame
+	version int
+	// pluginID is the plugin the services are served to.
+	pluginID string
+
+	mu      sync.Mutex
+	dbs     map[string]api.KeyValueDB
+	sqlDBs  map[string]api.SQLDB
+	sqlTxs  map[string]*sqlTxHandle
+	daemons map[string]sdkdefine.Daemon
+}
+
+func newHostGRPCServer(frame sdkdefine.Frame, version int, pluginID string) *hostGRPCServer {
+	return &hostGRPCServer{
+		frame:    frame,
+		version:  version,
+		pluginID: pluginID,
+		dbs:      map[string]api.KeyValueDB{},
+		sqlDBs:   map[string]api.SQLDB{},
+		sqlTxs:   map[string]*sqlTxHandle{},
+		daemons:  map[string]sdkdefine.Daemon{},
+	}
+}
+
+func (h *hostGRPCServer) register(s *grpc.Server) {
+	pb.RegisterFrameServer(s, &frameGRPCServer{host: h})
+	pb.RegisterChatModuleServer(s, &chatModuleGRPCServer{host: h})
+	pb.RegisterCommandsModuleServer(s, &commandsModuleGRPCServer{host: h})
+	pb.RegisterPlayersModuleServer(s, &playersModuleGRPCServer{host: h})
+	pb.RegisterPlayerKitServer(s, &playerKitGRPCServer{host: h})
+	pb.RegisterFlexModuleServer(s, &flexModuleGRPCServer{host: h})
+	pb.RegisterDatabaseModuleServer(s, &databaseModuleGRPCServer{host: h})
+	pb.RegisterKeyValueDBServer(s, &keyValueDBGRPCServer{host: h})
+	pb.RegisterSQLDBServer(s, &sqlDBGRPCServer{host: h})
+	pb.RegisterTerminalModuleServer(s, &terminalModuleGRPCServer{host: h})
+	pb.RegisterTerminalMenuModuleServer(s, &terminalMenuModuleGRPCServer{host: h})
+	pb.RegisterGameMenuModuleServer(s, &gameMenuModuleGRPCServer{host: h})
+	pb.RegisterLoggerModuleServer(s, &loggerModuleGRPCServer{host: h})
+	pb.RegisterStoragePathModuleServer(s, &storagePathModuleGRPCServer{host: h})
+	pb.RegisterUQHolderModuleServer(s, &uqholderModuleGRPCServer{host: h})
+	pb.RegisterBrainModuleServer(s, &brainModuleGRPCServer{host: h})
+	pb.RegisterDaemonServer(s, &daemonGRPCServer{host: h})
+	pb.RegisterScoreboardDaemonServer(s, &scoreboardDaemonGRPCServer{host: h})
+	pb.RegisterChunkDaemonServer(s, &chunkDaemonGRPCServer{host: h})
+}
+
+// grpcModuleServices lists the services bridging each module kind (see moduleRPCServer).
+var grpcModuleServices = map[string][]grpc.ServiceDesc{
+	api.NameChatModule:         {pb.ChatModule_ServiceDesc},
+	api.NameCommandsModule:     {pb.CommandsModule_ServiceDesc},
+	api.NameFlexModule:         {pb.FlexModule_ServiceDesc},
+	api.NameUQHolderModule:     {pb.UQHolderModule_ServiceDesc},
+	api.NameGameMenuModule:     {pb.GameMenuModule_ServiceDesc},
+	api.NameTerminalMenuModule: {pb.TerminalMenuModule_ServiceDesc},
+	api.NameTerminalModule:     {pb.TerminalModule_ServiceDesc},
+	api.NamePlayersModule:      {pb.PlayersModule_ServiceDesc, pb.PlayerKit_ServiceDesc},
+	api.NameLoggerModule:       {pb.LoggerModule_ServiceDesc},
+	api.NameDatabaseModule:     {pb.DatabaseModule_ServiceDesc, pb.KeyValueDB_ServiceDesc, pb.SQLDB_ServiceDesc},
+	api.NameStoragePathModule:  {pb.StoragePathModule_ServiceDesc},
+	api.NameBrainModule: {pb.BrainModule_ServiceDesc, pb.Daemon_ServiceDesc,
+		pb.ScoreboardDaemon_ServiceDesc, pb.ChunkDaemon_ServiceDesc},
+}
+
+// grpcMethodNames lists the unary and streaming methods of the services, sorted.
+func grpcMethodNames(descs ...grpc.ServiceDesc) []string {
+	seen := map[string]bool{}
+	for _, desc := range descs {
+		for _, m := range desc.Methods {
+			seen[m.MethodName] = true
+		}
+		for _, st := range desc.Streams {
+			seen[st.StreamName] = true
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (h *hostGRPCServer) openDB(db api.KeyValueDB) string {
+	handle := uuid.NewString()
+	h.mu.Lock()
+	h.dbs[handle] = db
+	h.mu.Unlock()
+	return handle
+}
+
+func (h *hostGRPCServer) db(handle string) (api.KeyValueDB, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	db, ok := h.dbs[handle]
+	return db, ok
+}
+
+func (h *hostGRPCServer) closeDB(handle string) (api.KeyValueDB, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	db, ok := h.dbs[handle]
+	delete(h.dbs, handle)
+	return db, ok
+}
+
+func (h *hostGRPCServer) openSQLDB(db api.SQLDB) string {
+	handle := uuid.NewString()
+	h.mu.Lock()
+	h.sqlDBs[handle] = db
+	h.mu.Unlock()
+	return handle
+}
+
+func (h *hostGRPCServer) sqlDB(handle string) (api.SQLDB, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	db, ok := h.sqlDBs[handle]
+	return db, ok
+}
+
+// closeSQLDB forgets the database handle and the transactions begun on it.
+func (h *hostGRPCServer) closeSQLDB(handle string) (api.SQLDB, []*sqlTxHandle, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	db, ok := h.sqlDBs[handle]
+	delete(h.sqlDBs, handle)
+	var txs []*sqlTxHandle
+	for key, tx := range h.sqlTxs {
+		if strings.HasPrefix(key, handle+"/") {
+			txs = append(txs, tx)
+			delete(h.sqlTxs, key)
+		}
+	}
+	return db, txs, ok
+}
+
+func (h *hostGRPCServer) putSQLTx(handle string, tx *sqlTxHandle) string {
+	id := uuid.NewString()
+	h.mu.Lock()
+	h.sqlTxs[handle+"/"+id] = tx
+	h.mu.Unlock()
+	return id
+}
+
+func (h *hostGRPCServer) sqlTx(handle, id string) (*sqlTxHandle, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	tx, ok := h.sqlTxs[handle+"/"+id]
+	return tx, ok
+}
+
+func (h *hostGRPCServer) takeSQLTx(handle, id string) (*sqlTxHandle, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	tx, ok := h.sqlTxs[handle+"/"+id]
+	delete(h.sqlTxs, handle+"/"+id)
+	return tx, ok
+}
+
+// release rolls back the open transactions and closes the databases opened by the plugin.
+// It is called once the plugin's connection has ended, so a crashed plugin does not keep
+// holding locks on its databases.
+func (h *hostGRPCServer) release() {
+	h.mu.Lock()
+	dbs, sqlDBs, txs := h.dbs, h.sqlDBs, h.sqlTxs
+	h.dbs, h.sqlDBs, h.sqlTxs = map[string]api.KeyValueDB{}, map[string]api.SQLDB{}, map[string]*sqlTxHandle{}
+	h.mu.Unlock()
+	for _, tx := range txs {
+		_ = tx.tx.Rollback()
+		tx.cancel()
+	}
+	for _, db := range sqlDBs {
+		_ = db.Close()
+	}
+	for _, db := range dbs {
+		_ = db.Close()
+	}
+}
+
+// putDaemon stores dmn under a handle derived from the brain module and daemon name,
+// so enabling the same daemon again reuses its handle.
+func (h *hostGRPCServer) putDaemon(module, name string, dmn sdkdefine.Daemon) string {
+	handle := module + "/" + name
+	h.mu.Lock()
+	h.daemons[handle] = dmn
+	h.mu.Unlock()
+	return handle
+}
+
+func (h *hostGRPCServer) daemon(handle string) (sdkdefine.Daemon, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	dmn, ok := h.daemons[handle]
+	return dmn, ok
+}
+
+type frameGRPCServer struct {
+	pb.UnimplementedFrameServer
+	host *hostGRPCServer
+}
+
+func (s *frameGRPCServer) ListModules(context.Context, *emptypb.Empty) (*pb.ListModulesResponse, error) {
+	resp := &pb.ListModulesResponse{}
+	if s.host.frame == nil {
+		return resp, nil
+	}
+	for name := range s.host.frame.ListModules() {
+		resp.Names = append(resp.Names, name)
+	}
+	sort.Strings(resp.Names)
+	return resp, nil
+}
+
+func (s *frameGRPCServer) GetModule(_ context.Context, req *pb.GetModuleRequest) (*pb.GetModuleResponse, error) {
+	resp := &pb.GetModuleResponse{}
+	if s.host.frame == nil {
+		return resp, nil
+	}
+	mod, ok := s.host.frame.GetModule(req.GetName())
+	if !ok || mod == nil {
+		return resp, nil
+	}
+	resp.Exists = true
+	resp.Name = mod.Name()
+	if kind, srv := moduleRPCServer(mod, nil, ""); srv != nil {
+		resp.Kind = kind
+	}
+	return resp, nil
+}
+
+func (s *frameGRPCServer) GetPluginConfig(_ context.Context, req *pb.GetPluginConfigRequest) (*pb.GetPluginConfigResponse, error) {
+	resp := &pb.GetPluginConfigResponse{}
+	if s.host.frame == nil || req.GetId() == "" {
+		return resp, nil
+	}
+	cfg, ok := s.host.frame.GetPluginConfig(req.GetId())
+	if !ok {
+		return resp, nil
+	}
+	resp.Exists = true
+	resp.Config = toPBPluginConfig(cfg)
+	return resp, nil
+}
+
+func (s *frameGRPCServer) UpgradePluginConfig(_ context.Context, req *pb.UpgradePluginConfigRequest) (*emptypb.Empty, error) {
+	if s.host.frame == nil || req.GetId() == "" {
+		return &emptypb.Empty{}, nil
+	}
+	return &emptypb.Empty{}, s.host.frame.UpgradePluginConfig(req.GetId(), fromStruct(req.GetConfig()))
+}
+
+func (s *frameGRPCServer) UpgradePluginFullConfig(_ context.Context, req *pb.UpgradePluginFullConfigRequest) (*emptypb.Empty, error) {
+	if s.host.frame == nil || req.GetId() == "" {
+		return &emptypb.Empty{}, nil
+	}
+	return &emptypb.Empty{}, s.host.frame.UpgradePluginFullConfig(req.GetId(), fromPBPluginConfig(req.GetConfig()))
+}
+
+func (s *frameGRPCServer) WatchActivate(_ *emptypb.Empty, stream pb.Frame_WatchActivateServer) error {
+	if s.host.frame == nil {
+		return nil
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.ActivateEvent)) (string, error) {
+			return s.host.frame.RegisterWhenActivate(func() { emit(&pb.ActivateEvent{}) })
+		},
+		s.host.frame.UnregisterWhenActivate,
+		func(id string) *pb.ActivateEvent { return &pb.ActivateEvent{ListenerId: id} })
+}
+
+func (s *frameGRPCServer) WatchConfigChange(req *pb.WatchConfigChangeRequest, stream pb.Frame_WatchConfigChangeServer) error {
+	if s.host.frame == nil {
+		return nil
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.ConfigChangeEvent)) (string, error) {
+			return s.host.frame.RegisterWhenConfigChange(req.GetId(), func(oldConfig, newConfig sdkdefine.PluginConfig) {
+				emit(&pb.ConfigChangeEvent{OldConfig: toPBPluginConfig(oldConfig), NewConfig: toPBPluginConfig(newConfig)})
+			})
+		},
+		s.host.frame.UnregisterWhenConfigChange,
+		func(id string) *pb.ConfigChangeEvent { return &pb.ConfigChangeEvent{ListenerId: id} })
+}
+
+func (s *frameGRPCServer) ListCapabilities(context.Context, *emptypb.Empty) (*pb.Capabilities, error) {
+	caps := HostCapabilities(s.host.frame, s.host.version)
+	resp := &pb.Capabilities{
+		ProtocolVersion: int32(caps.ProtocolVersion),
+		FrameMethods:    caps.FrameMethods,
+		Modules:         make(map[string]*pb.ModuleCapability, len(caps.Modules)),
+	}
+	for name, mc := range caps.Modules {
+		resp.Modules[name] = &pb.ModuleCapability{Name: mc.Name, Kind: mc.Kind, Methods: mc.Methods}
+	}
+	return resp, nil
+}
+
+// frameGRPCClient is the Frame a plugin receives over the gRPC transport.
+type frameGRPCClient struct {
+	conn    *grpc.ClientConn
+	c       pb.FrameClient
+	version int
+	subs    grpcSubscriptions
+}
+
+func newFrameGRPCClient(conn *grpc.ClientConn, version int) *frameGRPCClient {
+	return &frameGRPCClient{conn: conn, c: pb.NewFrameClient(conn), version: version}
+}
+
+func (c *frameGRPCClient) ListModules() map[string]sdkdefine.Module {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	resp, err := c.c.ListModules(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return nil
+	}
+	out := make(map[string]sdkdefine.Module, len(resp.GetNames()))
+	for _, name := range resp.GetNames() {
+		if name == "" {
+			continue
+		}
+		out[name] = frameModuleStub{name: name}
+	}
+	return out
+}
+
+func (c *frameGRPCClient) GetModule(name string) (sdkdefine.Module, bool) {
+	if c == nil || c.c == nil {
+		return nil, false
+	}
+	resp, err := c.c.GetModule(context.Background(), &pb.GetModuleRequest{Name: name})
+	if err != nil || !resp.GetExists() || resp.GetName() == "" {
+		return nil, false
+	}
+	if m := newModuleGRPCClient(c.conn, resp.GetKind(), resp.GetName()); m != nil {
+		return m, true
+	}
+	return frameModuleStub{name: resp.GetName()}, true
+}
+
+// newModuleGRPCClient returns the client bridging a module of the given kind, or nil.
+func newModuleGRPCClient(conn *grpc.ClientConn, kind, name string) sdkdefine.Module {
+	switch kind {
+	case api.NameChatModule:
+		return newChatModuleGRPCClient(conn, name)
+	case api.NameCommandsModule:
+		return newCommandsModuleGRPCClient(conn, name)
+	case api.NameFlexModule:
+		return newFlexModuleGRPCClient(conn, name)
+	case api.NameUQHolderModule:
+		return newUQHolderModuleGRPCClient(conn, name)
+	case api.NameGameMenuModule:
+		return newGameMenuModuleGRPCClient(conn, name)
+	case api.NameTerminalMenuModule:
+		return newTerminalMenuModuleGRPCClient(conn, name)
+	case api.NameTerminalModule:
+		return newTerminalModuleGRPCClient(conn, name)
+	case api.NamePlayersModule:
+		return newPlayersModuleGRPCClient(conn, name)
+	case api.NameLoggerModule:
+		return newLoggerModuleGRPCClient(conn, name)
+	case api.NameDatabaseModule:
+		return newDatabaseModuleGRPCClient(conn, name)
+	case api.NameStoragePathModule:
+		return newStoragePathModuleGRPCClient(conn, name)
+	case api.NameBrainModule:
+		return newBrainModuleGRPCClient(conn, name)
+	default:
+		return nil
+	}
+}
+
+func (c *frameGRPCClient) GetPluginConfig(id string) (sdkdefine.PluginConfig, bool) {
+	if c == nil || c.c == nil || id == "" {
+		return sdkdefine.PluginConfig{}, false
+	}
+	resp, err := c.c.GetPluginConfig(context.Background(), &pb.GetPluginConfigRequest{Id: id})
+	if err != nil || !resp.GetExists() {
+		return sdkdefine.PluginConfig{}, false
+	}
+	return fromPBPluginConfig(resp.GetConfig()), true
+}
+
+func (c *frameGRPCClient) UpgradePluginConfig(id string, config map[string]interface{}) error {
+	if c == nil || c.c == nil || id == "" {
+		return nil
+	}
+	_, err := c.c.UpgradePluginConfig(context.Background(), &pb.UpgradePluginConfigRequest{Id: id, Config: toStruct(config)})
+	return err
+}
+
+func (c *frameGRPCClient) UpgradePluginFullConfig(id string, config sdkdefine.PluginConfig) error {
+	if c == nil || c.c == nil || id == "" {
+		return nil
+	}
+	_, err := c.c.UpgradePluginFullConfig(context.Background(), &pb.UpgradePluginFullConfigRequest{Id: id, Config: toPBPluginConfig(config)})
+	return err
+}
+
+func (c *frameGRPCClient) RegisterWhenActivate(handler func()) (string, error) {
+	if c == nil || c.c == nil || handler == nil {
+		return "", nil
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.ActivateEvent], error) {
+			return serverStream[*pb.ActivateEvent](c.c.WatchActivate(ctx, &emptypb.Empty{}))
+		},
+		(*pb.ActivateEvent).GetListenerId,
+		func(*pb.ActivateEvent) { handler() })
+}
+
+func (c *frameGRPCClient) UnregisterWhenActivate(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *frameGRPCClient) RegisterWhenConfigChange(id string, handler func(oldConfig, newConfig sdkdefine.PluginConfig)) (string, error) {
+	if c == nil || c.c == nil || handler == nil || id == "" {
+		return "", nil
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.ConfigChangeEvent], error) {
+			return serverStream[*pb.ConfigChangeEvent](c.c.WatchConfigChange(ctx, &pb.WatchConfigChangeRequest{Id: id}))
+		},
+		(*pb.ConfigChangeEvent).GetListenerId,
+		func(ev *pb.ConfigChangeEvent) {
+			handler(fromPBPluginConfig(ev.GetOldConfig()), fromPBPluginConfig(ev.GetNewConfig()))
+		})
+}
+
+func (c *frameGRPCClient) UnregisterWhenConfigChange(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *frameGRPCClient) ListCapabilities() (sdkdefine.Capabilities, error) {
+	if c == nil || c.c == nil {
+		return sdkdefine.Capabilities{}, sdkdefine.ErrCapabilitiesUnsupported
+	}
+	resp, err := c.c.ListCapabilities(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return sdkdefine.Capabilities{}, err
+	}
+	caps := sdkdefine.Capabilities{
+		ProtocolVersion: int(resp.GetProtocolVersion()),
+		FrameMethods:    resp.GetFrameMethods(),
+		Modules:         make(map[string]sdkdefine.ModuleCapability, len(resp.GetModules())),
+	}
+	for name, mc := range resp.GetModules() {
+		caps.Modules[name] = sdkdefine.ModuleCapability{Name: mc.GetName(), Kind: mc.GetKind(), Methods: mc.GetMethods()}
+	}
+	return caps, nil
+}
+
+var (
+	_ sdkdefine.Frame           = (*frameGRPCClient)(nil)
+	_ sdkdefine.CapabilityFrame = (*frameGRPCClient)(nil)
+)
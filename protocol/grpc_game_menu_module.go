@@ -0,0 +1,191 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type gameMenuModuleGRPCServer struct {
+	pb.UnimplementedGameMenuModuleServer
+	host *hostGRPCServer
+}
+
+func (s *gameMenuModuleGRPCServer) module(ctx context.Context) (api.GameMenuModule, error) {
+	return grpcHostModule[api.GameMenuModule](ctx, s.host, api.NameGameMenuModule)
+}
+
+func (s *gameMenuModuleGRPCServer) RegisterEntry(req *pb.GameMenuEntry, stream pb.GameMenuModule_RegisterEntryServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.GameMenuTrigger)) (string, error) {
+			return mod.RegisterGameMenuEntry(&api.GameMenuEntry{
+				Triggers:     req.GetTriggers(),
+				ArgumentHint: req.GetArgumentHint(),
+				Usage:        req.GetUsage(),
+				OnTrigger:    func(chat *api.ChatMsg) { emit(&pb.GameMenuTrigger{Chat: toPBChatMsg(chat)}) },
+			})
+		},
+		func(entryID string) bool {
+			mod.RemoveMenuEntry(entryID)
+			return true
+		},
+		func(entryID string) *pb.GameMenuTrigger { return &pb.GameMenuTrigger{EntryId: entryID} })
+}
+
+func (s *gameMenuModuleGRPCServer) RemoveMenuEntry(ctx context.Context, req *pb.GameMenuEntryRef) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.RemoveMenuEntry(req.GetEntryId())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *gameMenuModuleGRPCServer) SubscribeEntries(_ *emptypb.Empty, stream pb.GameMenuModule_SubscribeEntriesServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	entries, cancel, err := mod.SubscribeEntries(stream.Context())
+	if err != nil {
+		return err
+	}
+	if cancel != nil {
+		defer cancel()
+	}
+	if err := stream.Send(&pb.GameMenuEntryInfo{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case info, ok := <-entries:
+			if !ok {
+				return nil
+			}
+			if info == nil {
+				continue
+			}
+			if err := stream.Send(&pb.GameMenuEntryInfo{
+				EntryId:      info.EntryID,
+				Triggers:     info.Triggers,
+				ArgumentHint: info.ArgumentHint,
+				Usage:        info.Usage,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *gameMenuModuleGRPCServer) TriggerEntry(ctx context.Context, req *pb.TriggerGameMenuEntryRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.TriggerEntry(req.GetEntryId(), fromPBChatMsg(req.GetChat()))
+	return &emptypb.Empty{}, nil
+}
+
+type gameMenuModuleGRPCClient struct {
+	c       pb.GameMenuModuleClient
+	name    string
+	entries grpcSubscriptions
+}
+
+func newGameMenuModuleGRPCClient(conn *grpc.ClientConn, name string) *gameMenuModuleGRPCClient {
+	return &gameMenuModuleGRPCClient{c: pb.NewGameMenuModuleClient(conn), name: name}
+}
+
+func (c *gameMenuModuleGRPCClient) Name() string { return api.NameGameMenuModule }
+
+func (c *gameMenuModuleGRPCClient) RegisterGameMenuEntry(entry *api.GameMenuEntry) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("gameMenuModuleGRPCClient.RegisterGameMenuEntry: client is not initialised")
+	}
+	if entry == nil {
+		return "", errors.New("gameMenuModuleGRPCClient.RegisterGameMenuEntry: entry is nil")
+	}
+	req := &pb.GameMenuEntry{Triggers: entry.Triggers, ArgumentHint: entry.ArgumentHint, Usage: entry.Usage}
+	return registerListener(&c.entries,
+		func(ctx context.Context) (recvStream[*pb.GameMenuTrigger], error) {
+			return serverStream[*pb.GameMenuTrigger](c.c.RegisterEntry(withGRPCModule(ctx, c.name), req))
+		},
+		(*pb.GameMenuTrigger).GetEntryId,
+		func(trigger *pb.GameMenuTrigger) {
+			if entry.OnTrigger != nil {
+				entry.OnTrigger(fromPBChatMsg(trigger.GetChat()))
+			}
+		})
+}
+
+// RemoveMenuEntry closes the registration stream of entries registered through this client
+// (the host removes them when the stream ends) and asks the host to remove any other entry.
+func (c *gameMenuModuleGRPCClient) RemoveMenuEntry(entryID string) {
+	if c == nil || c.c == nil || entryID == "" {
+		return
+	}
+	if c.entries.remove(entryID) {
+		return
+	}
+	_, _ = c.c.RemoveMenuEntry(withGRPCModule(context.Background(), c.name), &pb.GameMenuEntryRef{EntryId: entryID})
+}
+
+func (c *gameMenuModuleGRPCClient) SubscribeEntries(ctx context.Context) (<-chan *api.GameMenuEntryInfo, func(), error) {
+	if c == nil || c.c == nil {
+		return nil, func() {}, errors.New("gameMenuModuleGRPCClient.SubscribeEntries: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.c.SubscribeEntries(withGRPCModule(ctx, c.name), &emptypb.Empty{})
+	if err != nil {
+		cancel()
+		return nil, func() {}, err
+	}
+	if _, err := stream.Recv(); err != nil {
+		cancel()
+		return nil, func() {}, err
+	}
+	out := make(chan *api.GameMenuEntryInfo, 256)
+	go func() {
+		defer close(out)
+		for {
+			info, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case out <- &api.GameMenuEntryInfo{
+				EntryID:      info.GetEntryId(),
+				Triggers:     info.GetTriggers(),
+				ArgumentHint: info.GetArgumentHint(),
+				Usage:        info.GetUsage(),
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, cancel, nil
+}
+
+func (c *gameMenuModuleGRPCClient) TriggerEntry(entryID string, chat *api.ChatMsg) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.TriggerEntry(withGRPCModule(context.Background(), c.name), &pb.TriggerGameMenuEntryRequest{EntryId: entryID, Chat: toPBChatMsg(chat)})
+}
+
+var _ api.GameMenuModule = (*gameMenuModuleGRPCClient)(nil)
@@ -0,0 +1,401 @@
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	sdkdefine "github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+// grpcModuleMetadataKey carries the module name on module service calls.
+// The host falls back to the standard module name of the service when it is missing.
+const grpcModuleMetadataKey = "tempest-module"
+
+func withGRPCModule(ctx context.Context, name string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if name == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, grpcModuleMetadataKey, name)
+}
+
+func grpcModuleName(ctx context.Context, defaultName string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(grpcModuleMetadataKey); len(vals) > 0 && vals[0] != "" {
+			return vals[0]
+		}
+	}
+	return defaultName
+}
+
+// grpcHostModule resolves the module addressed by a module service call.
+func grpcHostModule[T any](ctx context.Context, h *hostGRPCServer, defaultName string) (T, error) {
+	var zero T
+	if h == nil || h.frame == nil {
+		return zero, status.Error(codes.Unavailable, "frame is not available")
+	}
+	name := grpcModuleName(ctx, defaultName)
+	mod, ok := h.frame.GetModule(name)
+	if !ok || mod == nil {
+		return zero, status.Errorf(codes.NotFound, "module %s not found", name)
+	}
+	impl, ok := any(mod).(T)
+	if !ok {
+		return zero, status.Errorf(codes.FailedPrecondition, "module %s does not implement %T", name, (*T)(nil))
+	}
+	return impl, nil
+}
+
+// toStruct converts a JSON-like map to a Struct. Values structpb cannot represent directly are
+// normalised through a JSON round trip, the same shape they would have in a config file.
+func toStruct(m map[string]interface{}) *structpb.Struct {
+	if m == nil {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+	if s, err := structpb.NewStruct(m); err == nil {
+		return s
+	}
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+	var normalised map[string]interface{}
+	if err := json.Unmarshal(raw, &normalised); err != nil {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+	s, err := structpb.NewStruct(normalised)
+	if err != nil {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+	return s
+}
+
+func fromStruct(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return map[string]interface{}{}
+	}
+	return s.AsMap()
+}
+
+func toValue(v any) *structpb.Value {
+	if v == nil {
+		return structpb.NewNullValue()
+	}
+	if val, err := structpb.NewValue(v); err == nil {
+		return val
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return structpb.NewNullValue()
+	}
+	var normalised any
+	if err := json.Unmarshal(raw, &normalised); err != nil {
+		return structpb.NewNullValue()
+	}
+	val, err := structpb.NewValue(normalised)
+	if err != nil {
+		return structpb.NewNullValue()
+	}
+	return val
+}
+
+func fromValue(v *structpb.Value) any {
+	if v == nil {
+		return nil
+	}
+	return v.AsInterface()
+}
+
+func toDuration(d time.Duration) *durationpb.Duration {
+	if d <= 0 {
+		return nil
+	}
+	return durationpb.New(d)
+}
+
+func fromDuration(d *durationpb.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.AsDuration()
+}
+
+func timeoutFromCtx(ctx context.Context) time.Duration {
+	if ctx == nil {
+		return 0
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	d := time.Until(deadline)
+	if d <= 0 {
+		return time.Millisecond
+	}
+	return d
+}
+
+func ctxFromDuration(d *durationpb.Duration) (context.Context, context.CancelFunc) {
+	if timeout := fromDuration(d); timeout > 0 {
+		return context.WithTimeout(context.Background(), timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+func toPBPluginConfig(cfg sdkdefine.PluginConfig) *pb.PluginConfig {
+	return &pb.PluginConfig{
+		Name:        cfg.Name,
+		Description: cfg.Description,
+		Author:      cfg.Author,
+		Source:      cfg.Source,
+		Disable:     cfg.Disable,
+		Version:     cfg.Version,
+		Config:      toStruct(cfg.Config),
+
+		Depends:         toPBPluginDependencies(cfg.Depends),
+		RequiredModules: cfg.RequiredModules,
+		RequiredDaemons: cfg.RequiredDaemons,
+	}
+}
+
+func fromPBPluginConfig(cfg *pb.PluginConfig) sdkdefine.PluginConfig {
+	if cfg == nil {
+		return sdkdefine.PluginConfig{Config: map[string]interface{}{}}
+	}
+	return sdkdefine.PluginConfig{
+		Name:        cfg.GetName(),
+		Description: cfg.GetDescription(),
+		Author:      cfg.GetAuthor(),
+		Source:      cfg.GetSource(),
+		Disable:     cfg.GetDisable(),
+		Version:     cfg.GetVersion(),
+		Config:      fromStruct(cfg.GetConfig()),
+
+		Depends:         fromPBPluginDependencies(cfg.GetDepends()),
+		RequiredModules: cfg.GetRequiredModules(),
+		RequiredDaemons: cfg.GetRequiredDaemons(),
+	}
+}
+
+func toPBPluginDependencies(deps []sdkdefine.PluginDependency) []*pb.PluginDependency {
+	if len(deps) == 0 {
+		return nil
+	}
+	out := make([]*pb.PluginDependency, 0, len(deps))
+	for _, d := range deps {
+		out = append(out, &pb.PluginDependency{Name: d.Name, Version: d.Version, Optional: d.Optional})
+	}
+	return out
+}
+
+func fromPBPluginDependencies(deps []*pb.PluginDependency) []sdkdefine.PluginDependency {
+	if len(deps) == 0 {
+		return nil
+	}
+	out := make([]sdkdefine.PluginDependency, 0, len(deps))
+	for _, d := range deps {
+		out = append(out, sdkdefine.PluginDependency{Name: d.GetName(), Version: d.GetVersion(), Optional: d.GetOptional()})
+	}
+	return out
+}
+
+func toPBChatMsg(msg *api.ChatMsg) *pb.ChatMsg {
+	if msg == nil {
+		return nil
+	}
+	return &pb.ChatMsg{
+		Msg:           msg.Msg,
+		Name:          msg.Name,
+		ParsedMsg:     msg.ParsedMsg,
+		RawMsg:        msg.RawMsg,
+		RawParameters: msg.RawParameters,
+		Type:          uint32(msg.Type),
+		Ud: &pb.ChatUD{
+			Name:          msg.UD.Name,
+			Msg:           msg.UD.Msg,
+			Type:          int32(msg.UD.Type),
+			RawMsg:        msg.UD.RawMsg,
+			RawName:       msg.UD.RawName,
+			RawParameters: msg.UD.RawParameters,
+			Aux:           toValue(msg.UD.Aux),
+			ParsedMsg:     msg.UD.ParsedMsg,
+		},
+	}
+}
+
+func fromPBChatMsg(msg *pb.ChatMsg) *api.ChatMsg {
+	if msg == nil {
+		return nil
+	}
+	ud := msg.GetUd()
+	return &api.ChatMsg{
+		Msg:           msg.GetMsg(),
+		Name:          msg.GetName(),
+		ParsedMsg:     msg.GetParsedMsg(),
+		RawMsg:        msg.GetRawMsg(),
+		RawParameters: msg.GetRawParameters(),
+		Type:          byte(msg.GetType()),
+		UD: api.ChatUD{
+			Name:          ud.GetName(),
+			Msg:           ud.GetMsg(),
+			Type:          int(ud.GetType()),
+			RawMsg:        ud.GetRawMsg(),
+			RawName:       ud.GetRawName(),
+			RawParameters: ud.GetRawParameters(),
+			Aux:           fromValue(ud.GetAux()),
+			ParsedMsg:     ud.GetParsedMsg(),
+		},
+	}
+}
+
+// eventQueue buffers host events for a stream so host callbacks never block on the network.
+type eventQueue[T any] struct {
+	mu     sync.Mutex
+	items  []T
+	signal chan struct{}
+}
+
+func newEventQueue[T any]() *eventQueue[T] {
+	return &eventQueue[T]{signal: make(chan struct{}, 1)}
+}
+
+func (q *eventQueue[T]) push(item T) {
+	q.mu.Lock()
+	q.items = append(q.items, item)
+	q.mu.Unlock()
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (q *eventQueue[T]) drain() []T {
+	q.mu.Lock()
+	items := q.items
+	q.items = nil
+	q.mu.Unlock()
+	return items
+}
+
+// serveListener registers a host listener whose events are sent on a server stream until the
+// client cancels it. The acknowledgement built by ack is sent first.
+func serveListener[T any](ctx context.Context, send func(T) error, register func(emit func(T)) (string, error), unregister func(string) bool, ack func(listenerID string) T) error {
+	q := newEventQueue[T]()
+	id, err := register(q.push)
+	if err != nil {
+		return err
+	}
+	if unregister != nil {
+		defer unregister(id)
+	}
+	if err := send(ack(id)); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-q.signal:
+			for _, item := range q.drain() {
+				if err := send(item); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+type recvStream[T any] interface {
+	Recv() (T, error)
+}
+
+// grpcSubscriptions tracks the streams opened by Register* calls on the client side,
+// so the matching Unregister* can cancel them.
+type grpcSubscriptions struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func (s *grpcSubscriptions) add(id string, cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancels == nil {
+		s.cancels = map[string]context.CancelFunc{}
+	}
+	s.cancels[id] = cancel
+}
+
+func (s *grpcSubscriptions) remove(id string) bool {
+	s.mu.Lock()
+	cancel, ok := s.cancels[id]
+	delete(s.cancels, id)
+	s.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// openListener opens a listener stream, reads its acknowledgement and dispatches the following
+// messages to handle in order until the stream ends.
+// It returns the acknowledgement and the function cancelling the stream.
+func openListener[T any](ctx context.Context, open func(ctx context.Context) (recvStream[T], error), handle func(T)) (T, context.CancelFunc, error) {
+	var zero T
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := open(ctx)
+	if err != nil {
+		cancel()
+		return zero, nil, err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return zero, nil, err
+	}
+	go func() {
+		defer cancel()
+		for {
+			item, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if handle != nil {
+				handle(item)
+			}
+		}
+	}()
+	return first, cancel, nil
+}
+
+// registerListener is openListener for Register*/Unregister* pairs keyed by listener id.
+func registerListener[T any](subs *grpcSubscriptions, open func(ctx context.Context) (recvStream[T], error), listenerID func(T) string, handle func(T)) (string, error) {
+	first, cancel, err := openListener(context.Background(), open, handle)
+	if err != nil {
+		return "", err
+	}
+	id := listenerID(first)
+	subs.add(id, cancel)
+	return id, nil
+}
+
+func serverStream[T any, S recvStream[T]](s S, err error) (recvStream[T], error) {
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,281 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type loggerModuleGRPCServer struct {
+	pb.UnimplementedLoggerModuleServer
+	host *hostGRPCServer
+}
+
+func (s *loggerModuleGRPCServer) Log(ctx context.Context, req *pb.LogRequest) (*emptypb.Empty, error) {
+	mod, err := grpcHostModule[api.LoggerModule](ctx, s.host, api.NameLoggerModule)
+	if err != nil {
+		return nil, err
+	}
+	mod.Log(req.GetScope(), api.Level(req.GetLevel()), req.GetMsg())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *loggerModuleGRPCServer) LogRecord(ctx context.Context, req *pb.LogRecordRequest) (*emptypb.Empty, error) {
+	mod, err := grpcHostModule[api.LoggerModule](ctx, s.host, api.NameLoggerModule)
+	if err != nil {
+		return nil, err
+	}
+	mod.LogRecord(fromPBLogRecord(req))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *loggerModuleGRPCServer) QueryLogs(ctx context.Context, req *pb.LogQuery) (*pb.LogPage, error) {
+	mod, err := grpcHostModule[api.LoggerModule](ctx, s.host, api.NameLoggerModule)
+	if err != nil {
+		return nil, err
+	}
+	page, err := mod.QueryLogs(ctx, fromPBLogQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.LogPage{NextCursor: page.NextCursor}
+	for _, r := range page.Records {
+		resp.Records = append(resp.Records, toPBLogRecord(r))
+	}
+	return resp, nil
+}
+
+func (s *loggerModuleGRPCServer) TailLogs(req *pb.LogQuery, stream pb.LoggerModule_TailLogsServer) error {
+	mod, err := grpcHostModule[api.LoggerModule](stream.Context(), s.host, api.NameLoggerModule)
+	if err != nil {
+		return err
+	}
+	records, err := mod.TailLogs(stream.Context(), fromPBLogQuery(req))
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.LogRecordRequest{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case record, ok := <-records:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toPBLogRecord(record)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type loggerModuleGRPCClient struct {
+	c    pb.LoggerModuleClient
+	name string
+	// flat is set once the host turned out not to implement LogRecord.
+	flat atomic.Bool
+}
+
+func newLoggerModuleGRPCClient(conn *grpc.ClientConn, name string) *loggerModuleGRPCClient {
+	return &loggerModuleGRPCClient{c: pb.NewLoggerModuleClient(conn), name: name}
+}
+
+func (c *loggerModuleGRPCClient) Name() string { return api.NameLoggerModule }
+
+func (c *loggerModuleGRPCClient) Log(scope string, level api.Level, msg string) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.Log(withGRPCModule(context.Background(), c.name), &pb.LogRequest{Scope: scope, Level: string(level), Msg: msg})
+}
+
+func (c *loggerModuleGRPCClient) LogRecord(record api.LogRecord) {
+	if c == nil || c.c == nil {
+		return
+	}
+	if record.Time.IsZero() {
+		record.Time = time.Now()
+	}
+	if !c.flat.Load() {
+		_, err := c.c.LogRecord(withGRPCModule(context.Background(), c.name), toPBLogRecord(record))
+		if status.Code(err) != codes.Unimplemented {
+			return
+		}
+		c.flat.Store(true)
+	}
+	c.Log(record.Scope, record.Level, record.Text())
+}
+
+func (c *loggerModuleGRPCClient) QueryLogs(ctx context.Context, q api.LogQuery) (api.LogPage, error) {
+	if c == nil || c.c == nil {
+		return api.LogPage{}, errors.New("loggerModuleGRPCClient.QueryLogs: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resp, err := c.c.QueryLogs(withGRPCModule(ctx, c.name), toPBLogQuery(q))
+	if err != nil {
+		return api.LogPage{}, err
+	}
+	page := api.LogPage{NextCursor: resp.GetNextCursor()}
+	for _, r := range resp.GetRecords() {
+		page.Records = append(page.Records, fromPBLogRecord(r))
+	}
+	return page, nil
+}
+
+func (c *loggerModuleGRPCClient) TailLogs(ctx context.Context, q api.LogQuery) (<-chan api.LogRecord, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("loggerModuleGRPCClient.TailLogs: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.c.TailLogs(withGRPCModule(ctx, c.name), toPBLogQuery(q))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return nil, err
+	}
+	out := make(chan api.LogRecord, 256)
+	go func() {
+		defer close(out)
+		for {
+			record, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case out <- fromPBLogRecord(record):
+			default:
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (c *loggerModuleGRPCClient) Info(scope, msg string) { c.Log(scope, api.LevelInfo, msg) }
+
+func (c *loggerModuleGRPCClient) Warn(scope, msg string) { c.Log(scope, api.LevelWarn, msg) }
+
+func (c *loggerModuleGRPCClient) Error(scope, msg string) { c.Log(scope, api.LevelError, msg) }
+
+func (c *loggerModuleGRPCClient) Success(scope, msg string) { c.Log(scope, api.LevelSuccess, msg) }
+
+var _ api.LoggerModule = (*loggerModuleGRPCClient)(nil)
+
+func toPBLogRecord(r api.LogRecord) *pb.LogRecordRequest {
+	req := &pb.LogRecordRequest{
+		Scope: r.Scope,
+		Level: string(r.Level),
+		Msg:   r.Msg,
+		Err:   r.Err,
+	}
+	if !r.Time.IsZero() {
+		req.Time = timestamppb.New(r.Time)
+	}
+	for _, f := range r.Normalized().Fields {
+		field := &pb.LogField{Key: f.Key}
+		switch v := f.Value.(type) {
+		case string:
+			field.Value = &pb.LogField_StringValue{StringValue: v}
+		case int64:
+			field.Value = &pb.LogField_IntValue{IntValue: v}
+		case uint64:
+			field.Value = &pb.LogField_UintValue{UintValue: v}
+		case float64:
+			field.Value = &pb.LogField_FloatValue{FloatValue: v}
+		case bool:
+			field.Value = &pb.LogField_BoolValue{BoolValue: v}
+		case time.Time:
+			field.Value = &pb.LogField_TimeValue{TimeValue: timestamppb.New(v)}
+		}
+		req.Fields = append(req.Fields, field)
+	}
+	return req
+}
+
+func fromPBLogRecord(req *pb.LogRecordRequest) api.LogRecord {
+	record := api.LogRecord{
+		Scope: req.GetScope(),
+		Level: api.Level(req.GetLevel()),
+		Msg:   req.GetMsg(),
+		Err:   req.GetErr(),
+	}
+	if req.GetTime() != nil {
+		record.Time = req.GetTime().AsTime()
+	}
+	for _, f := range req.GetFields() {
+		field := api.LogField{Key: f.GetKey()}
+		switch v := f.GetValue().(type) {
+		case *pb.LogField_StringValue:
+			field.Value = v.StringValue
+		case *pb.LogField_IntValue:
+			field.Value = v.IntValue
+		case *pb.LogField_UintValue:
+			field.Value = v.UintValue
+		case *pb.LogField_FloatValue:
+			field.Value = v.FloatValue
+		case *pb.LogField_BoolValue:
+			field.Value = v.BoolValue
+		case *pb.LogField_TimeValue:
+			field.Value = v.TimeValue.AsTime()
+		}
+		record.Fields = append(record.Fields, field)
+	}
+	return record
+}
+
+func toPBLogQuery(q api.LogQuery) *pb.LogQuery {
+	req := &pb.LogQuery{
+		Scopes:    q.Scopes,
+		Text:      q.Text,
+		Limit:     int32(min(max(q.Limit, 0), api.MaxLogQueryLimit)),
+		Cursor:    q.Cursor,
+		Ascending: q.Ascending,
+	}
+	for _, l := range q.Levels {
+		req.Levels = append(req.Levels, string(l))
+	}
+	if !q.Since.IsZero() {
+		req.Since = timestamppb.New(q.Since)
+	}
+	if !q.Until.IsZero() {
+		req.Until = timestamppb.New(q.Until)
+	}
+	return req
+}
+
+func fromPBLogQuery(req *pb.LogQuery) api.LogQuery {
+	q := api.LogQuery{
+		Scopes:    req.GetScopes(),
+		Text:      req.GetText(),
+		Limit:     int(req.GetLimit()),
+		Cursor:    req.GetCursor(),
+		Ascending: req.GetAscending(),
+	}
+	for _, l := range req.GetLevels() {
+		q.Levels = append(q.Levels, api.Level(l))
+	}
+	if req.GetSince() != nil {
+		q.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		q.Until = req.GetUntil().AsTime()
+	}
+	return q
+}
@@ -0,0 +1,656 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type playersModuleGRPCServer struct {
+	pb.UnimplementedPlayersModuleServer
+	host *hostGRPCServer
+}
+
+func (s *playersModuleGRPCServer) module(ctx context.Context) (api.PlayersModule, error) {
+	return grpcHostModule[api.PlayersModule](ctx, s.host, api.NamePlayersModule)
+}
+
+func toPBPlayerRef(kit api.PlayerKit) *pb.PlayerRef {
+	if kit == nil {
+		return nil
+	}
+	return &pb.PlayerRef{Uuid: kit.GetUUIDString(), Name: kit.GetName()}
+}
+
+func (s *playersModuleGRPCServer) GetAllOnlinePlayers(ctx context.Context, _ *emptypb.Empty) (*pb.PlayerList, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kits, err := mod.GetAllOnlinePlayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PlayerList{Players: make([]*pb.PlayerRef, 0, len(kits))}
+	for _, kit := range kits {
+		if ref := toPBPlayerRef(kit); ref != nil {
+			resp.Players = append(resp.Players, ref)
+		}
+	}
+	return resp, nil
+}
+
+func (s *playersModuleGRPCServer) GetPlayerByName(ctx context.Context, req *pb.GetPlayerByNameRequest) (*pb.PlayerLookupResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kit, err := mod.GetPlayerByName(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlayerLookupResponse{Player: toPBPlayerRef(kit)}, nil
+}
+
+func (s *playersModuleGRPCServer) GetPlayerByUUID(ctx context.Context, req *pb.GetPlayerByUUIDRequest) (*pb.PlayerLookupResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kit, err := mod.GetPlayerByUUID(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlayerLookupResponse{Player: toPBPlayerRef(kit)}, nil
+}
+
+func (s *playersModuleGRPCServer) GetPlayerByEntityRuntimeID(ctx context.Context, req *pb.GetPlayerByEntityRuntimeIDRequest) (*pb.PlayerLookupResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kit, err := mod.GetPlayerByEntityRuntimeID(ctx, req.GetRuntimeId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlayerLookupResponse{Player: toPBPlayerRef(kit)}, nil
+}
+
+func (s *playersModuleGRPCServer) WatchPlayerChange(_ *emptypb.Empty, stream pb.PlayersModule_WatchPlayerChangeServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.PlayerChangeEvent)) (string, error) {
+			return mod.RegisterWhenPlayerChange(func(event *api.PlayerChangeEvent) {
+				if event == nil {
+					return
+				}
+				emit(&pb.PlayerChangeEvent{Uuid: event.UUID.String(), Name: event.Name, EventType: event.EventType})
+			})
+		},
+		mod.UnregisterWhenPlayerChange,
+		func(id string) *pb.PlayerChangeEvent { return &pb.PlayerChangeEvent{ListenerId: id} })
+}
+
+func (s *playersModuleGRPCServer) SendMessageTo(ctx context.Context, req *pb.PlayerMessageToRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, text := req.GetTarget(), req.GetText()
+	switch req.GetKind() {
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SAY:
+		err = mod.RawSayTo(target, text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SAY:
+		err = mod.SayTo(target, text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_TITLE:
+		err = mod.RawTitleTo(target, text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_TITLE:
+		err = mod.TitleTo(target, text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SUBTITLE:
+		err = mod.RawSubtitleTo(target, text, req.GetTitle())
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SUBTITLE:
+		err = mod.SubtitleTo(target, text, req.GetTitle())
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_ACTION_BAR:
+		err = mod.ActionBarTo(target, text)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown player message kind %v", req.GetKind())
+	}
+	return &emptypb.Empty{}, err
+}
+
+type playerKitGRPCServer struct {
+	pb.UnimplementedPlayerKitServer
+	host *hostGRPCServer
+}
+
+func (s *playerKitGRPCServer) kit(ctx context.Context, uuid string) (api.PlayerKit, error) {
+	mod, err := grpcHostModule[api.PlayersModule](ctx, s.host, api.NamePlayersModule)
+	if err != nil {
+		return nil, err
+	}
+	kit := mod.NewPlayerKit(uuid)
+	if kit == nil {
+		return nil, status.Errorf(codes.NotFound, "player %s not found", uuid)
+	}
+	return kit, nil
+}
+
+func (s *playerKitGRPCServer) GetProperty(ctx context.Context, req *pb.PlayerPropertyRequest) (*pb.PlayerPropertyValue, error) {
+	kit, err := s.kit(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	boolValue := func(v bool, err error) (*pb.PlayerPropertyValue, error) {
+		return &pb.PlayerPropertyValue{Value: &pb.PlayerPropertyValue_BoolValue{BoolValue: v}}, err
+	}
+	stringValue := func(v string, err error) (*pb.PlayerPropertyValue, error) {
+		return &pb.PlayerPropertyValue{Value: &pb.PlayerPropertyValue_StringValue{StringValue: v}}, err
+	}
+	intValue := func(v int64, err error) (*pb.PlayerPropertyValue, error) {
+		return &pb.PlayerPropertyValue{Value: &pb.PlayerPropertyValue_IntValue{IntValue: v}}, err
+	}
+
+	switch req.GetProperty() {
+	case pb.PlayerProperty_PLAYER_PROPERTY_NAME:
+		return stringValue(kit.GetName(), nil)
+	case pb.PlayerProperty_PLAYER_PROPERTY_ENTITY_UNIQUE_ID:
+		return intValue(kit.GetEntityUniqueID(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_LOGIN_TIME:
+		v, err := kit.GetLoginTime(ctx)
+		return &pb.PlayerPropertyValue{Value: &pb.PlayerPropertyValue_TimeValue{TimeValue: timestamppb.New(v)}}, err
+	case pb.PlayerProperty_PLAYER_PROPERTY_PLATFORM_CHAT_ID:
+		return stringValue(kit.GetPlatformChatID(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_BUILD_PLATFORM:
+		v, err := kit.GetBuildPlatform(ctx)
+		return intValue(int64(v), err)
+	case pb.PlayerProperty_PLAYER_PROPERTY_SKIN_ID:
+		return stringValue(kit.GetSkinID(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_STATUS_INVULNERABLE:
+		return boolValue(kit.GetStatusInvulnerable(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_STATUS_FLYING:
+		return boolValue(kit.GetStatusFlying(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_STATUS_MAY_FLY:
+		return boolValue(kit.GetStatusMayFly(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_DEVICE_ID:
+		return stringValue(kit.GetDeviceID(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_ENTITY_RUNTIME_ID:
+		v, err := kit.GetEntityRuntimeID(ctx)
+		return &pb.PlayerPropertyValue{Value: &pb.PlayerPropertyValue_UintValue{UintValue: v}}, err
+	case pb.PlayerProperty_PLAYER_PROPERTY_ENTITY_METADATA:
+		v, err := kit.GetEntityMetadata(ctx)
+		return &pb.PlayerPropertyValue{Value: &pb.PlayerPropertyValue_MetadataValue{MetadataValue: toPBMetadata(v)}}, err
+	case pb.PlayerProperty_PLAYER_PROPERTY_IS_OP:
+		return boolValue(kit.GetIsOP(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_ONLINE:
+		return boolValue(kit.GetOnline(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_BUILD:
+		return boolValue(kit.GetCanBuild(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_DIG:
+		return boolValue(kit.GetCanDig(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES:
+		return boolValue(kit.GetCanUseDoorsAndSwitches(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_OPEN_CONTAINERS:
+		return boolValue(kit.GetCanOpenContainers(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_PLAYERS:
+		return boolValue(kit.GetCanAttackPlayers(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_MOBS:
+		return boolValue(kit.GetCanAttackMobs(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS:
+		return boolValue(kit.GetCanUseOperatorCommands(ctx))
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_TELEPORT:
+		return boolValue(kit.GetCanTeleport(ctx))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown player property %v", req.GetProperty())
+	}
+}
+
+func (s *playerKitGRPCServer) SetAbility(ctx context.Context, req *pb.SetPlayerAbilityRequest) (*pb.SetPlayerAbilityResponse, error) {
+	kit, err := s.kit(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	allow := req.GetAllow()
+	switch req.GetAbility() {
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_BUILD:
+		err = kit.SetCanBuild(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_DIG:
+		err = kit.SetCanDig(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES:
+		err = kit.SetCanUseDoorsAndSwitches(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_OPEN_CONTAINERS:
+		err = kit.SetCanOpenContainers(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_PLAYERS:
+		err = kit.SetCanAttackPlayers(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_MOBS:
+		err = kit.SetCanAttackMobs(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS:
+		err = kit.SetCanUseOperatorCommands(ctx, allow)
+	case pb.PlayerProperty_PLAYER_PROPERTY_CAN_TELEPORT:
+		v, err := kit.SetCanTeleport(ctx, allow)
+		return &pb.SetPlayerAbilityResponse{Value: v}, err
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "player property %v is not an ability", req.GetAbility())
+	}
+	return &pb.SetPlayerAbilityResponse{}, err
+}
+
+func (s *playerKitGRPCServer) SendMessage(ctx context.Context, req *pb.PlayerMessageRequest) (*emptypb.Empty, error) {
+	kit, err := s.kit(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	text := req.GetText()
+	switch req.GetKind() {
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SAY:
+		err = kit.RawSay(text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SAY:
+		err = kit.Say(text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_TITLE:
+		err = kit.Title(text)
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SUBTITLE:
+		err = kit.Subtitle(text, req.GetTitle())
+	case pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_ACTION_BAR:
+		err = kit.ActionBar(text)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "player message kind %v is not supported by PlayerKit", req.GetKind())
+	}
+	return &emptypb.Empty{}, err
+}
+
+func toPBMetadata(m map[uint32]any) *structpb.Struct {
+	out := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(m))}
+	for k, v := range m {
+		out.Fields[strconv.FormatUint(uint64(k), 10)] = toValue(v)
+	}
+	return out
+}
+
+func fromPBMetadata(s *structpb.Struct) map[uint32]any {
+	out := make(map[uint32]any, len(s.GetFields()))
+	for k, v := range s.GetFields() {
+		key, err := strconv.ParseUint(k, 10, 32)
+		if err != nil {
+			continue
+		}
+		out[uint32(key)] = fromValue(v)
+	}
+	return out
+}
+
+type playersModuleGRPCClient struct {
+	c    pb.PlayersModuleClient
+	kit  pb.PlayerKitClient
+	name string
+	subs grpcSubscriptions
+}
+
+func newPlayersModuleGRPCClient(conn *grpc.ClientConn, name string) *playersModuleGRPCClient {
+	return &playersModuleGRPCClient{c: pb.NewPlayersModuleClient(conn), kit: pb.NewPlayerKitClient(conn), name: name}
+}
+
+func (c *playersModuleGRPCClient) Name() string { return api.NamePlayersModule }
+
+func (c *playersModuleGRPCClient) newKit(ref *pb.PlayerRef) api.PlayerKit {
+	if ref == nil {
+		return nil
+	}
+	return &playerKitGRPCClient{c: c.kit, module: c.name, uuid: ref.GetUuid(), name: ref.GetName()}
+}
+
+func (c *playersModuleGRPCClient) NewPlayerKit(uuid string) api.PlayerKit {
+	if c == nil || c.kit == nil || uuid == "" {
+		return nil
+	}
+	return c.newKit(&pb.PlayerRef{Uuid: uuid})
+}
+
+func (c *playersModuleGRPCClient) GetAllOnlinePlayers(ctx context.Context) ([]api.PlayerKit, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("playersModuleGRPCClient.GetAllOnlinePlayers: client is not initialised")
+	}
+	resp, err := c.c.GetAllOnlinePlayers(withGRPCModule(ctx, c.name), &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.PlayerKit, 0, len(resp.GetPlayers()))
+	for _, ref := range resp.GetPlayers() {
+		out = append(out, c.newKit(ref))
+	}
+	return out, nil
+}
+
+func (c *playersModuleGRPCClient) GetPlayerByName(ctx context.Context, name string) (api.PlayerKit, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("playersModuleGRPCClient.GetPlayerByName: client is not initialised")
+	}
+	resp, err := c.c.GetPlayerByName(withGRPCModule(ctx, c.name), &pb.GetPlayerByNameRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return c.newKit(resp.GetPlayer()), nil
+}
+
+func (c *playersModuleGRPCClient) GetPlayerByUUID(ctx context.Context, uuid string) (api.PlayerKit, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("playersModuleGRPCClient.GetPlayerByUUID: client is not initialised")
+	}
+	resp, err := c.c.GetPlayerByUUID(withGRPCModule(ctx, c.name), &pb.GetPlayerByUUIDRequest{Uuid: uuid})
+	if err != nil {
+		return nil, err
+	}
+	return c.newKit(resp.GetPlayer()), nil
+}
+
+func (c *playersModuleGRPCClient) GetPlayerByEntityRuntimeID(ctx context.Context, runtimeID uint64) (api.PlayerKit, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("playersModuleGRPCClient.GetPlayerByEntityRuntimeID: client is not initialised")
+	}
+	resp, err := c.c.GetPlayerByEntityRuntimeID(withGRPCModule(ctx, c.name), &pb.GetPlayerByEntityRuntimeIDRequest{RuntimeId: runtimeID})
+	if err != nil {
+		return nil, err
+	}
+	return c.newKit(resp.GetPlayer()), nil
+}
+
+func (c *playersModuleGRPCClient) RegisterWhenPlayerChange(handler func(event *api.PlayerChangeEvent)) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("playersModuleGRPCClient.RegisterWhenPlayerChange: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("playersModuleGRPCClient.RegisterWhenPlayerChange: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.PlayerChangeEvent], error) {
+			return serverStream[*pb.PlayerChangeEvent](c.c.WatchPlayerChange(withGRPCModule(ctx, c.name), &emptypb.Empty{}))
+		},
+		(*pb.PlayerChangeEvent).GetListenerId,
+		func(ev *pb.PlayerChangeEvent) {
+			event := &api.PlayerChangeEvent{Name: ev.GetName(), EventType: ev.GetEventType()}
+			if id, err := uuid.Parse(ev.GetUuid()); err == nil {
+				event.UUID = id
+			}
+			handler(event)
+		})
+}
+
+func (c *playersModuleGRPCClient) UnregisterWhenPlayerChange(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *playersModuleGRPCClient) sendTo(kind pb.PlayerMessageKind, target, text, title string) error {
+	if c == nil || c.c == nil {
+		return errors.New("playersModuleGRPCClient: client is not initialised")
+	}
+	_, err := c.c.SendMessageTo(withGRPCModule(context.Background(), c.name), &pb.PlayerMessageToRequest{Target: target, Kind: kind, Text: text, Title: title})
+	return err
+}
+
+func (c *playersModuleGRPCClient) RawSayTo(target string, jsonText string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SAY, target, jsonText, "")
+}
+
+func (c *playersModuleGRPCClient) SayTo(target string, message string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SAY, target, message, "")
+}
+
+func (c *playersModuleGRPCClient) RawTitleTo(target string, jsonText string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_TITLE, target, jsonText, "")
+}
+
+func (c *playersModuleGRPCClient) TitleTo(target string, message string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_TITLE, target, message, "")
+}
+
+func (c *playersModuleGRPCClient) RawSubtitleTo(target string, subtitleJsonText, titleJsonText string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SUBTITLE, target, subtitleJsonText, titleJsonText)
+}
+
+func (c *playersModuleGRPCClient) SubtitleTo(target string, subtitleMessage, titleMessage string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SUBTITLE, target, subtitleMessage, titleMessage)
+}
+
+func (c *playersModuleGRPCClient) ActionBarTo(target string, message string) error {
+	return c.sendTo(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_ACTION_BAR, target, message, "")
+}
+
+// playerKitGRPCClient addresses one player by uuid; the name is fetched lazily when unknown.
+type playerKitGRPCClient struct {
+	c      pb.PlayerKitClient
+	module string
+	uuid   string
+
+	mu   sync.Mutex
+	name string
+}
+
+func (c *playerKitGRPCClient) GetUUIDString() string {
+	if c == nil {
+		return ""
+	}
+	return c.uuid
+}
+
+func (c *playerKitGRPCClient) GetName() string {
+	if c == nil || c.c == nil {
+		return ""
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.name == "" {
+		if v, err := c.property(context.Background(), pb.PlayerProperty_PLAYER_PROPERTY_NAME); err == nil {
+			c.name = v.GetStringValue()
+		}
+	}
+	return c.name
+}
+
+func (c *playerKitGRPCClient) property(ctx context.Context, p pb.PlayerProperty) (*pb.PlayerPropertyValue, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("playerKitGRPCClient: client is not initialised")
+	}
+	return c.c.GetProperty(withGRPCModule(ctx, c.module), &pb.PlayerPropertyRequest{Uuid: c.uuid, Property: p})
+}
+
+func (c *playerKitGRPCClient) boolProperty(ctx context.Context, p pb.PlayerProperty) (bool, error) {
+	v, err := c.property(ctx, p)
+	return v.GetBoolValue(), err
+}
+
+func (c *playerKitGRPCClient) stringProperty(ctx context.Context, p pb.PlayerProperty) (string, error) {
+	v, err := c.property(ctx, p)
+	return v.GetStringValue(), err
+}
+
+func (c *playerKitGRPCClient) setAbility(ctx context.Context, p pb.PlayerProperty, allow bool) (bool, error) {
+	if c == nil || c.c == nil {
+		return false, errors.New("playerKitGRPCClient: client is not initialised")
+	}
+	resp, err := c.c.SetAbility(withGRPCModule(ctx, c.module), &pb.SetPlayerAbilityRequest{Uuid: c.uuid, Ability: p, Allow: allow})
+	return resp.GetValue(), err
+}
+
+func (c *playerKitGRPCClient) send(kind pb.PlayerMessageKind, text, title string) error {
+	if c == nil || c.c == nil {
+		return errors.New("playerKitGRPCClient: client is not initialised")
+	}
+	_, err := c.c.SendMessage(withGRPCModule(context.Background(), c.module), &pb.PlayerMessageRequest{Uuid: c.uuid, Kind: kind, Text: text, Title: title})
+	return err
+}
+
+func (c *playerKitGRPCClient) GetEntityUniqueID(ctx context.Context) (int64, error) {
+	v, err := c.property(ctx, pb.PlayerProperty_PLAYER_PROPERTY_ENTITY_UNIQUE_ID)
+	return v.GetIntValue(), err
+}
+
+func (c *playerKitGRPCClient) GetLoginTime(ctx context.Context) (time.Time, error) {
+	v, err := c.property(ctx, pb.PlayerProperty_PLAYER_PROPERTY_LOGIN_TIME)
+	if err != nil || v.GetTimeValue() == nil {
+		return time.Time{}, err
+	}
+	return v.GetTimeValue().AsTime(), nil
+}
+
+func (c *playerKitGRPCClient) GetPlatformChatID(ctx context.Context) (string, error) {
+	return c.stringProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_PLATFORM_CHAT_ID)
+}
+
+func (c *playerKitGRPCClient) GetBuildPlatform(ctx context.Context) (int32, error) {
+	v, err := c.property(ctx, pb.PlayerProperty_PLAYER_PROPERTY_BUILD_PLATFORM)
+	return int32(v.GetIntValue()), err
+}
+
+func (c *playerKitGRPCClient) GetSkinID(ctx context.Context) (string, error) {
+	return c.stringProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_SKIN_ID)
+}
+
+func (c *playerKitGRPCClient) GetCanBuild(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_BUILD)
+}
+
+func (c *playerKitGRPCClient) SetCanBuild(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_BUILD, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanDig(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_DIG)
+}
+
+func (c *playerKitGRPCClient) SetCanDig(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_DIG, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanUseDoorsAndSwitches(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES)
+}
+
+func (c *playerKitGRPCClient) SetCanUseDoorsAndSwitches(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanOpenContainers(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_OPEN_CONTAINERS)
+}
+
+func (c *playerKitGRPCClient) SetCanOpenContainers(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_OPEN_CONTAINERS, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanAttackPlayers(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_PLAYERS)
+}
+
+func (c *playerKitGRPCClient) SetCanAttackPlayers(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_PLAYERS, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanAttackMobs(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_MOBS)
+}
+
+func (c *playerKitGRPCClient) SetCanAttackMobs(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_MOBS, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanUseOperatorCommands(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS)
+}
+
+func (c *playerKitGRPCClient) SetCanUseOperatorCommands(ctx context.Context, allow bool) error {
+	_, err := c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS, allow)
+	return err
+}
+
+func (c *playerKitGRPCClient) GetCanTeleport(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_TELEPORT)
+}
+
+func (c *playerKitGRPCClient) SetCanTeleport(ctx context.Context, allow bool) (bool, error) {
+	return c.setAbility(ctx, pb.PlayerProperty_PLAYER_PROPERTY_CAN_TELEPORT, allow)
+}
+
+func (c *playerKitGRPCClient) GetStatusInvulnerable(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_STATUS_INVULNERABLE)
+}
+
+func (c *playerKitGRPCClient) GetStatusFlying(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_STATUS_FLYING)
+}
+
+func (c *playerKitGRPCClient) GetStatusMayFly(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_STATUS_MAY_FLY)
+}
+
+func (c *playerKitGRPCClient) GetDeviceID(ctx context.Context) (string, error) {
+	return c.stringProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_DEVICE_ID)
+}
+
+func (c *playerKitGRPCClient) GetEntityRuntimeID(ctx context.Context) (uint64, error) {
+	v, err := c.property(ctx, pb.PlayerProperty_PLAYER_PROPERTY_ENTITY_RUNTIME_ID)
+	return v.GetUintValue(), err
+}
+
+func (c *playerKitGRPCClient) GetEntityMetadata(ctx context.Context) (map[uint32]any, error) {
+	v, err := c.property(ctx, pb.PlayerProperty_PLAYER_PROPERTY_ENTITY_METADATA)
+	if err != nil {
+		return nil, err
+	}
+	return fromPBMetadata(v.GetMetadataValue()), nil
+}
+
+func (c *playerKitGRPCClient) GetIsOP(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_IS_OP)
+}
+
+func (c *playerKitGRPCClient) GetOnline(ctx context.Context) (bool, error) {
+	return c.boolProperty(ctx, pb.PlayerProperty_PLAYER_PROPERTY_ONLINE)
+}
+
+func (c *playerKitGRPCClient) RawSay(jsonText string) error {
+	return c.send(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SAY, jsonText, "")
+}
+
+func (c *playerKitGRPCClient) Say(message string) error {
+	return c.send(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SAY, message, "")
+}
+
+func (c *playerKitGRPCClient) Title(message string) error {
+	return c.send(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_TITLE, message, "")
+}
+
+func (c *playerKitGRPCClient) Subtitle(subtitleMessage, titleMessage string) error {
+	return c.send(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_SUBTITLE, subtitleMessage, titleMessage)
+}
+
+func (c *playerKitGRPCClient) ActionBar(message string) error {
+	return c.send(pb.PlayerMessageKind_PLAYER_MESSAGE_KIND_ACTION_BAR, message, "")
+}
+
+var (
+	_ api.PlayersModule = (*playersModuleGRPCClient)(nil)
+	_ api.PlayerKit     = (*playerKitGRPCClient)(nil)
+)
@@ -0,0 +1,291 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	sdkdefine "github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+// DynamicGRPCPlugin is the go-plugin wrapper for the gRPC transport (ProtocolVersion3).
+// The services are defined in proto/tempest/dynamic/v1, so plugins can be written in any
+// language with gRPC support.
+// - On the plugin (server) side, set Impl.
+// - On the host (client) side, Impl is unused; the dispensed value implements RPCPlugin.
+type DynamicGRPCPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	Impl api.Plugin
+	// ProtocolVersion is the protocol version of the plugin set this instance belongs to.
+	// Zero means ProtocolVersion3.
+	ProtocolVersion int
+	// MinHostVersion and MaxHostVersion are reported to the host during Init, see WithHostVersion.
+	MinHostVersion, MaxHostVersion string
+	// ConfigSchema is reported to the host during Init, see WithConfigSchema.
+	ConfigSchema map[string]interface{}
+
+	// ident receives the plugin ID for the server interceptors of Serve.
+	ident *pluginIdentity
+}
+
+func (p *DynamicGRPCPlugin) version() int {
+	if p == nil || p.ProtocolVersion <= 0 {
+		return ProtocolVersion3
+	}
+	return p.ProtocolVersion
+}
+
+func (p *DynamicGRPCPlugin) GRPCServer(b *plugin.GRPCBroker, s *grpc.Server) error {
+	pb.RegisterPluginServer(s, &pluginGRPCServer{Impl: p.Impl, broker: b, version: p.version(), minHost: p.MinHostVersion, maxHost: p.MaxHostVersion, configSchema: p.ConfigSchema, ident: p.ident})
+	return nil
+}
+
+func (p *DynamicGRPCPlugin) GRPCClient(_ context.Context, b *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &pluginGRPCClient{c: pb.NewPluginClient(c), conn: c, broker: b, version: p.version()}, nil
+}
+
+type pluginGRPCServer struct {
+	pb.UnimplementedPluginServer
+	Impl    api.Plugin
+	broker  *plugin.GRPCBroker
+	version int
+	// minHost and maxHost are the host version bounds reported in Init.
+	minHost, maxHost string
+	configSchema     map[string]interface{}
+	ident            *pluginIdentity
+
+	mu    sync.Mutex
+	calls map[string]context.CancelFunc
+}
+
+func (s *pluginGRPCServer) Init(_ context.Context, req *pb.InitRequest) (*pb.InitResponse, error) {
+	if s == nil || s.Impl == nil {
+		return &pb.InitResponse{}, nil
+	}
+	version := s.version
+	if v := int(req.GetProtocolVersion()); v > 0 && v < version {
+		version = v
+	}
+	pluginID := req.GetId()
+	s.ident.set(pluginID)
+	var frame sdkdefine.Frame
+	if id := req.GetFrameBrokerId(); id != 0 && s.broker != nil {
+		opts := grpcDialOptions(func() string { return pluginID })
+		if conn, err := s.broker.DialWithOptions(id, opts...); err == nil && conn != nil {
+			frame = newFrameGRPCClient(conn, version)
+		}
+	}
+	s.Impl.Init(frame, req.GetId(), fromStruct(req.GetConfig()))
+	resp := &pb.InitResponse{SdkVersion: sdkdefine.SDKVersion, MinHostVersion: s.minHost, MaxHostVersion: s.maxHost}
+	if s.configSchema != nil {
+		resp.ConfigSchema = toStruct(s.configSchema)
+	}
+	return resp, nil
+}
+
+func (s *pluginGRPCServer) Load(parent context.Context, req *pb.LifecycleRequest) (*emptypb.Empty, error) {
+	if s == nil || s.Impl == nil {
+		return &emptypb.Empty{}, nil
+	}
+	ctx, done := s.beginCall(parent, req)
+	defer done()
+	return &emptypb.Empty{}, s.Impl.Load(ctx)
+}
+
+func (s *pluginGRPCServer) Unload(parent context.Context, req *pb.LifecycleRequest) (*emptypb.Empty, error) {
+	if s == nil || s.Impl == nil {
+		return &emptypb.Empty{}, nil
+	}
+	ctx, done := s.beginCall(parent, req)
+	defer done()
+	return &emptypb.Empty{}, s.Impl.Unload(ctx)
+}
+
+// Cancel cancels the context of an in-flight Load/Unload.
+func (s *pluginGRPCServer) Cancel(_ context.Context, req *pb.CancelRequest) (*pb.CancelResponse, error) {
+	if s == nil || req.GetCallId() == "" {
+		return &pb.CancelResponse{}, nil
+	}
+	s.mu.Lock()
+	cancel, ok := s.calls[req.GetCallId()]
+	s.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return &pb.CancelResponse{Ok: ok}, nil
+}
+
+// beginCall is rpcServer.beginCall for the gRPC transport.
+func (s *pluginGRPCServer) beginCall(parent context.Context, req *pb.LifecycleRequest) (context.Context, func()) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if ms := req.GetTimeoutMs(); ms > 0 {
+		ctx, cancel = context.WithTimeout(parent, time.Duration(ms)*time.Millisecond)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+	id := req.GetCallId()
+	if id == "" {
+		return ctx, cancel
+	}
+	s.mu.Lock()
+	if s.calls == nil {
+		s.calls = map[string]context.CancelFunc{}
+	}
+	s.calls[id] = cancel
+	s.mu.Unlock()
+	return ctx, func() {
+		s.mu.Lock()
+		delete(s.calls, id)
+		s.mu.Unlock()
+		cancel()
+	}
+}
+
+// stopOnShutdown stops s once conn is closed. AcceptAndServe only calls GracefulStop, which
+// waits for the plugin's streams, such as a flex Expose, and a plugin that is still running
+// keeps those open.
+func stopOnShutdown(conn *grpc.ClientConn, s *grpc.Server) {
+	for st := conn.GetState(); st != connectivity.Shutdown; st = conn.GetState() {
+		conn.WaitForStateChange(context.Background(), st)
+	}
+	s.Stop()
+}
+
+type pluginGRPCClient struct {
+	c       pb.PluginClient
+	conn    *grpc.ClientConn
+	broker  *plugin.GRPCBroker
+	version int
+	info    sdkdefine.PluginVersionInfo
+	schema  map[string]interface{}
+	// id labels the calls to the plugin for the client interceptors.
+	id string
+}
+
+func (c *pluginGRPCClient) Init(frame sdkdefine.Frame, id string, config map[string]interface{}) error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.id = id
+	var brokerID uint32
+	if c.broker != nil {
+		brokerID = c.broker.NextId()
+		host := newHostGRPCServer(frame, c.version, id)
+		go func() {
+			c.broker.AcceptAndServe(brokerID, func(opts []grpc.ServerOption) *grpc.Server {
+				s := grpc.NewServer(append(opts, grpcServerOptions(func() string { return id })...)...)
+				host.register(s)
+				if c.conn != nil {
+					go stopOnShutdown(c.conn, s)
+				}
+				return s
+			})
+			// Like serveSQLDB on net/rpc: nothing the plugin opened outlives its connection.
+			host.release()
+		}()
+	}
+	resp, err := c.c.Init(withCallPluginID(context.Background(), id), &pb.InitRequest{
+		Id:              id,
+		Config:          toStruct(config),
+		FrameBrokerId:   brokerID,
+		ProtocolVersion: int32(c.version),
+		HostVersion:     hostVersion(frame),
+		SdkVersion:      sdkdefine.SDKVersion,
+	})
+	if err != nil {
+		return err
+	}
+	c.info = sdkdefine.PluginVersionInfo{
+		SDKVersion:     resp.GetSdkVersion(),
+		MinHostVersion: resp.GetMinHostVersion(),
+		MaxHostVersion: resp.GetMaxHostVersion(),
+	}
+	c.schema = nil
+	if resp.GetConfigSchema() != nil {
+		c.schema = fromStruct(resp.GetConfigSchema())
+	}
+	return nil
+}
+
+func (c *pluginGRPCClient) ConfigSchema() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return c.schema
+}
+
+func (c *pluginGRPCClient) VersionInfo() sdkdefine.PluginVersionInfo {
+	if c == nil {
+		return sdkdefine.PluginVersionInfo{}
+	}
+	return c.info
+}
+
+// Load and Unload rely on gRPC to carry the deadline of ctx and to cancel the call on the plugin
+// side when ctx ends; they return as soon as ctx ends.
+func (c *pluginGRPCClient) Load(ctx context.Context) error {
+	return c.lifecycleCall(ctx, "Load", c.c.Load)
+}
+
+func (c *pluginGRPCClient) Unload(ctx context.Context) error {
+	return c.lifecycleCall(ctx, "Unload", c.c.Unload)
+}
+
+// lifecycleCall is rpcClient.lifecycleCall for the gRPC transport. The call itself outlives ctx
+// so the host can tell whether the plugin returned after Cancel; its gRPC deadline, which older
+// plugins use instead of timeout_ms, is the host deadline plus CancelGracePeriod.
+func (c *pluginGRPCClient) lifecycleCall(ctx context.Context, method string, call func(context.Context, *pb.LifecycleRequest, ...grpc.CallOption) (*emptypb.Empty, error)) error {
+	if c == nil || c.c == nil {
+		return fmt.Errorf("pluginGRPCClient.%s: client is not initialised", method)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req := &pb.LifecycleRequest{CallId: uuid.NewString(), TimeoutMs: timeoutMsFromCtx(ctx)}
+	callCtx := withCallPluginID(context.WithoutCancel(ctx), c.id)
+	var cancel context.CancelFunc
+	if deadline, ok := ctx.Deadline(); ok {
+		callCtx, cancel = context.WithDeadline(callCtx, deadline.Add(CancelGracePeriod))
+	} else {
+		callCtx, cancel = context.WithCancel(callCtx)
+	}
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := call(callCtx, req)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	// Older plugins do not implement Cancel; they only see the deadline.
+	go func() { _, _ = c.c.Cancel(callCtx, &pb.CancelRequest{CallId: req.CallId}) }()
+	timer := time.NewTimer(CancelGracePeriod)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		if callCtx.Err() == nil {
+			return err
+		}
+	case <-timer.C:
+	}
+	return fmt.Errorf("pluginGRPCClient.%s: %w: %w", method, ErrCallAbandoned, ctx.Err())
+}
+
+var (
+	_ plugin.GRPCPlugin = (*DynamicGRPCPlugin)(nil)
+	_ RPCPlugin         = (*pluginGRPCClient)(nil)
+)
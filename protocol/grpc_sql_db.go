@@ -0,0 +1,392 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+// grpcSQLRowsChunk is the number of rows per message of a Query stream.
+const grpcSQLRowsChunk = 128
+
+func (s *databaseModuleGRPCServer) OpenSQLDB(ctx context.Context, req *pb.OpenSQLDBRequest) (*pb.SQLDBHandle, error) {
+	mod, err := grpcHostModule[api.DatabaseModule](ctx, s.host, api.NameDatabaseModule)
+	if err != nil {
+		return nil, err
+	}
+	db, err := mod.SQLDB(api.SQLDBRef{Owner: s.host.pluginID, Name: strings.TrimSpace(req.GetName())})
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, status.Errorf(codes.Internal, "database %s is nil", req.GetName())
+	}
+	return &pb.SQLDBHandle{Handle: s.host.openSQLDB(db)}, nil
+}
+
+type sqlDBGRPCServer struct {
+	pb.UnimplementedSQLDBServer
+	host *hostGRPCServer
+}
+
+func (s *sqlDBGRPCServer) queryer(handle, tx string) (api.SQLQueryer, error) {
+	if tx != "" {
+		h, ok := s.host.sqlTx(handle, tx)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "transaction %s not found", tx)
+		}
+		return h.tx, nil
+	}
+	db, ok := s.host.sqlDB(handle)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "database handle %s not found", handle)
+	}
+	return db, nil
+}
+
+func (s *sqlDBGRPCServer) Exec(ctx context.Context, req *pb.SQLExecRequest) (*pb.SQLExecResponse, error) {
+	q, err := s.queryer(req.GetHandle(), req.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	res, err := q.Exec(ctx, req.GetQuery(), fromPBSQLValues(req.GetArgs())...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SQLExecResponse{RowsAffected: res.RowsAffected, LastInsertId: res.LastInsertID}, nil
+}
+
+func (s *sqlDBGRPCServer) Query(req *pb.SQLExecRequest, stream pb.SQLDB_QueryServer) error {
+	q, err := s.queryer(req.GetHandle(), req.GetTx())
+	if err != nil {
+		return err
+	}
+	rows, err := q.Query(stream.Context(), req.GetQuery(), fromPBSQLValues(req.GetArgs())...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if err := stream.Send(&pb.SQLRowsChunk{Columns: rows.Columns()}); err != nil {
+		return err
+	}
+	chunk := &pb.SQLRowsChunk{}
+	for rows.Next() {
+		chunk.Rows = append(chunk.Rows, &pb.SQLRow{Values: toPBSQLValues(rows.Values())})
+		if len(chunk.Rows) == grpcSQLRowsChunk {
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &pb.SQLRowsChunk{}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(chunk.Rows) > 0 {
+		return stream.Send(chunk)
+	}
+	return nil
+}
+
+// Begin starts a transaction that lives until Commit or Rollback; the client rolls it back
+// when its context ends.
+func (s *sqlDBGRPCServer) Begin(_ context.Context, req *pb.SQLDBHandle) (*pb.SQLTxHandle, error) {
+	db, ok := s.host.sqlDB(req.GetHandle())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "database handle %s not found", req.GetHandle())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	id := s.host.putSQLTx(req.GetHandle(), &sqlTxHandle{tx: tx, cancel: cancel})
+	return &pb.SQLTxHandle{Handle: req.GetHandle(), Tx: id}, nil
+}
+
+func (s *sqlDBGRPCServer) Commit(_ context.Context, req *pb.SQLTxHandle) (*emptypb.Empty, error) {
+	h, ok := s.host.takeSQLTx(req.GetHandle(), req.GetTx())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", req.GetTx())
+	}
+	defer h.cancel()
+	return &emptypb.Empty{}, h.tx.Commit()
+}
+
+func (s *sqlDBGRPCServer) Rollback(_ context.Context, req *pb.SQLTxHandle) (*emptypb.Empty, error) {
+	h, ok := s.host.takeSQLTx(req.GetHandle(), req.GetTx())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", req.GetTx())
+	}
+	defer h.cancel()
+	return &emptypb.Empty{}, h.tx.Rollback()
+}
+
+func (s *sqlDBGRPCServer) Close(_ context.Context, req *pb.SQLDBHandle) (*emptypb.Empty, error) {
+	db, txs, ok := s.host.closeSQLDB(req.GetHandle())
+	for _, h := range txs {
+		_ = h.tx.Rollback()
+		h.cancel()
+	}
+	if !ok {
+		return &emptypb.Empty{}, nil
+	}
+	return &emptypb.Empty{}, db.Close()
+}
+
+func (c *databaseModuleGRPCClient) SQLDB(ref api.SQLDBRef) (api.SQLDB, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("databaseModuleGRPCClient.SQLDB: client is not initialised")
+	}
+	resp, err := c.c.OpenSQLDB(withGRPCModule(context.Background(), c.name), &pb.OpenSQLDBRequest{Name: ref.Name})
+	if err != nil {
+		return nil, err
+	}
+	return &sqlDBGRPCClient{c: c.sql, handle: resp.GetHandle()}, nil
+}
+
+type sqlDBGRPCClient struct {
+	c      pb.SQLDBClient
+	handle string
+}
+
+func (c *sqlDBGRPCClient) Exec(ctx context.Context, query string, args ...any) (api.SQLResult, error) {
+	if c == nil || c.c == nil {
+		return api.SQLResult{}, errors.New("sqlDBGRPCClient.Exec: client is not initialised")
+	}
+	return sqlGRPCExec(ctx, c.c, &pb.SQLExecRequest{Handle: c.handle, Query: query}, args)
+}
+
+func (c *sqlDBGRPCClient) Query(ctx context.Context, query string, args ...any) (api.SQLRows, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("sqlDBGRPCClient.Query: client is not initialised")
+	}
+	return sqlGRPCQuery(ctx, c.c, &pb.SQLExecRequest{Handle: c.handle, Query: query}, args)
+}
+
+func (c *sqlDBGRPCClient) Begin(ctx context.Context) (api.SQLTx, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("sqlDBGRPCClient.Begin: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resp, err := c.c.Begin(ctx, &pb.SQLDBHandle{Handle: c.handle})
+	if err != nil {
+		return nil, err
+	}
+	tx := &sqlTxGRPCClient{c: c.c, handle: resp}
+	tx.stop = context.AfterFunc(ctx, func() { _ = tx.Rollback() })
+	return tx, nil
+}
+
+func (c *sqlDBGRPCClient) Close() error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	_, err := c.c.Close(context.Background(), &pb.SQLDBHandle{Handle: c.handle})
+	return err
+}
+
+type sqlTxGRPCClient struct {
+	c      pb.SQLDBClient
+	handle *pb.SQLTxHandle
+	stop   func() bool
+
+	mu   sync.Mutex
+	done bool
+}
+
+func (t *sqlTxGRPCClient) Exec(ctx context.Context, query string, args ...any) (api.SQLResult, error) {
+	return sqlGRPCExec(ctx, t.c, &pb.SQLExecRequest{Handle: t.handle.GetHandle(), Tx: t.handle.GetTx(), Query: query}, args)
+}
+
+func (t *sqlTxGRPCClient) Query(ctx context.Context, query string, args ...any) (api.SQLRows, error) {
+	return sqlGRPCQuery(ctx, t.c, &pb.SQLExecRequest{Handle: t.handle.GetHandle(), Tx: t.handle.GetTx(), Query: query}, args)
+}
+
+func (t *sqlTxGRPCClient) Commit() error {
+	return t.finish(func() error {
+		_, err := t.c.Commit(context.Background(), t.handle)
+		return err
+	})
+}
+
+func (t *sqlTxGRPCClient) Rollback() error {
+	return t.finish(func() error {
+		_, err := t.c.Rollback(context.Background(), t.handle)
+		return err
+	})
+}
+
+func (t *sqlTxGRPCClient) finish(call func() error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return errors.New("sqlTxGRPCClient: transaction has already been committed or rolled back")
+	}
+	t.done = true
+	if t.stop != nil {
+		t.stop()
+	}
+	return call()
+}
+
+func sqlGRPCExec(ctx context.Context, c pb.SQLDBClient, req *pb.SQLExecRequest, args []any) (api.SQLResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	norm, err := api.NormalizeSQLArgs(args)
+	if err != nil {
+		return api.SQLResult{}, err
+	}
+	req.Args = toPBSQLValues(norm)
+	resp, err := c.Exec(ctx, req)
+	if err != nil {
+		return api.SQLResult{}, err
+	}
+	return api.SQLResult{RowsAffected: resp.GetRowsAffected(), LastInsertID: resp.GetLastInsertId()}, nil
+}
+
+func sqlGRPCQuery(ctx context.Context, c pb.SQLDBClient, req *pb.SQLExecRequest, args []any) (api.SQLRows, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	norm, err := api.NormalizeSQLArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	req.Args = toPBSQLValues(norm)
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.Query(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &sqlRowsGRPCClient{stream: stream, cancel: cancel, cols: first.GetColumns()}, nil
+}
+
+// sqlRowsGRPCClient iterates the rows of a Query stream as they arrive.
+type sqlRowsGRPCClient struct {
+	stream pb.SQLDB_QueryClient
+	cancel context.CancelFunc
+	cols   []string
+	buf    []*pb.SQLRow
+	cur    []any
+	done   bool
+	err    error
+}
+
+func (r *sqlRowsGRPCClient) Columns() []string { return r.cols }
+
+func (r *sqlRowsGRPCClient) Next() bool {
+	r.cur = nil
+	for len(r.buf) == 0 {
+		if r.done {
+			return false
+		}
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				r.err = err
+			}
+			r.done = true
+			r.cancel()
+			return false
+		}
+		r.buf = chunk.GetRows()
+	}
+	r.cur = fromPBSQLValues(r.buf[0].GetValues())
+	r.buf = r.buf[1:]
+	return true
+}
+
+func (r *sqlRowsGRPCClient) Values() []any { return r.cur }
+
+func (r *sqlRowsGRPCClient) Scan(dest ...any) error {
+	if r.cur == nil {
+		return errors.New("sqlRowsGRPCClient.Scan: Scan called without calling Next")
+	}
+	return api.ScanSQLValues(r.cur, dest...)
+}
+
+func (r *sqlRowsGRPCClient) Err() error { return r.err }
+
+func (r *sqlRowsGRPCClient) Close() error {
+	r.buf, r.cur = nil, nil
+	r.done = true
+	r.cancel()
+	return nil
+}
+
+// toPBSQLValues converts values normalised by api.NormalizeSQLValue.
+func toPBSQLValues(vals []any) []*pb.SQLValue {
+	out := make([]*pb.SQLValue, len(vals))
+	for i, v := range vals {
+		val := &pb.SQLValue{}
+		switch v := v.(type) {
+		case int64:
+			val.Value = &pb.SQLValue_IntValue{IntValue: v}
+		case float64:
+			val.Value = &pb.SQLValue_FloatValue{FloatValue: v}
+		case bool:
+			val.Value = &pb.SQLValue_BoolValue{BoolValue: v}
+		case string:
+			val.Value = &pb.SQLValue_StringValue{StringValue: v}
+		case []byte:
+			val.Value = &pb.SQLValue_BytesValue{BytesValue: v}
+		case time.Time:
+			val.Value = &pb.SQLValue_TimeValue{TimeValue: timestamppb.New(v)}
+		}
+		out[i] = val
+	}
+	return out
+}
+
+func fromPBSQLValues(vals []*pb.SQLValue) []any {
+	out := make([]any, len(vals))
+	for i, v := range vals {
+		switch v := v.GetValue().(type) {
+		case *pb.SQLValue_IntValue:
+			out[i] = v.IntValue
+		case *pb.SQLValue_FloatValue:
+			out[i] = v.FloatValue
+		case *pb.SQLValue_BoolValue:
+			out[i] = v.BoolValue
+		case *pb.SQLValue_StringValue:
+			out[i] = v.StringValue
+		case *pb.SQLValue_BytesValue:
+			if v.BytesValue == nil {
+				out[i] = []byte{}
+			} else {
+				out[i] = v.BytesValue
+			}
+		case *pb.SQLValue_TimeValue:
+			out[i] = v.TimeValue.AsTime()
+		}
+	}
+	return out
+}
+
+var (
+	_ api.SQLDB   = (*sqlDBGRPCClient)(nil)
+	_ api.SQLTx   = (*sqlTxGRPCClient)(nil)
+	_ api.SQLRows = (*sqlRowsGRPCClient)(nil)
+)
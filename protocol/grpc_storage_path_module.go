@@ -0,0 +1,77 @@
+package protocol
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type storagePathModuleGRPCServer struct {
+	pb.UnimplementedStoragePathModuleServer
+	host *hostGRPCServer
+}
+
+func (s *storagePathModuleGRPCServer) Path(ctx context.Context, req *pb.StoragePathRequest) (*pb.StoragePathResponse, error) {
+	mod, err := grpcHostModule[api.StoragePathModule](ctx, s.host, api.NameStoragePathModule)
+	if err != nil {
+		return nil, err
+	}
+	parts := req.GetParts()
+	switch req.GetKind() {
+	case pb.StoragePathKind_STORAGE_PATH_KIND_CONFIG:
+		return &pb.StoragePathResponse{Path: mod.ConfigPath(parts...)}, nil
+	case pb.StoragePathKind_STORAGE_PATH_KIND_CODE:
+		return &pb.StoragePathResponse{Path: mod.CodePath(parts...)}, nil
+	case pb.StoragePathKind_STORAGE_PATH_KIND_DATA_FILE:
+		return &pb.StoragePathResponse{Path: mod.DataFilePath(parts...)}, nil
+	case pb.StoragePathKind_STORAGE_PATH_KIND_CACHE:
+		return &pb.StoragePathResponse{Path: mod.CachePath(parts...)}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown storage path kind %v", req.GetKind())
+	}
+}
+
+type storagePathModuleGRPCClient struct {
+	c    pb.StoragePathModuleClient
+	name string
+}
+
+func newStoragePathModuleGRPCClient(conn *grpc.ClientConn, name string) *storagePathModuleGRPCClient {
+	return &storagePathModuleGRPCClient{c: pb.NewStoragePathModuleClient(conn), name: name}
+}
+
+func (c *storagePathModuleGRPCClient) Name() string { return api.NameStoragePathModule }
+
+func (c *storagePathModuleGRPCClient) path(kind pb.StoragePathKind, parts []string) string {
+	if c == nil || c.c == nil {
+		return ""
+	}
+	resp, err := c.c.Path(withGRPCModule(context.Background(), c.name), &pb.StoragePathRequest{Kind: kind, Parts: parts})
+	if err != nil {
+		return ""
+	}
+	return resp.GetPath()
+}
+
+func (c *storagePathModuleGRPCClient) ConfigPath(parts ...string) string {
+	return c.path(pb.StoragePathKind_STORAGE_PATH_KIND_CONFIG, parts)
+}
+
+func (c *storagePathModuleGRPCClient) CodePath(parts ...string) string {
+	return c.path(pb.StoragePathKind_STORAGE_PATH_KIND_CODE, parts)
+}
+
+func (c *storagePathModuleGRPCClient) DataFilePath(parts ...string) string {
+	return c.path(pb.StoragePathKind_STORAGE_PATH_KIND_DATA_FILE, parts)
+}
+
+func (c *storagePathModuleGRPCClient) CachePath(parts ...string) string {
+	return c.path(pb.StoragePathKind_STORAGE_PATH_KIND_CACHE, parts)
+}
+
+var _ api.StoragePathModule = (*storagePathModuleGRPCClient)(nil)
@@ -0,0 +1,256 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type terminalMenuModuleGRPCServer struct {
+	pb.UnimplementedTerminalMenuModuleServer
+	host *hostGRPCServer
+}
+
+func (s *terminalMenuModuleGRPCServer) module(ctx context.Context) (api.TerminalMenuModule, error) {
+	return grpcHostModule[api.TerminalMenuModule](ctx, s.host, api.NameTerminalMenuModule)
+}
+
+func (s *terminalMenuModuleGRPCServer) RegisterEntry(req *pb.TerminalMenuEntry, stream pb.TerminalMenuModule_RegisterEntryServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	var entry *api.TerminalMenuEntry
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.TerminalMenuTrigger)) (string, error) {
+			entry = &api.TerminalMenuEntry{
+				Triggers:     req.GetTriggers(),
+				ArgumentHint: req.GetArgumentHint(),
+				Usage:        req.GetUsage(),
+				OnTrigger:    func(args []string) { emit(&pb.TerminalMenuTrigger{Args: args}) },
+			}
+			return "", mod.RegisterTerminalMenuEntry(entry)
+		},
+		func(string) bool { return mod.RemoveTerminalMenuEntry(entry) },
+		func(string) *pb.TerminalMenuTrigger { return &pb.TerminalMenuTrigger{} })
+}
+
+func (s *terminalMenuModuleGRPCServer) PublishTerminalCall(ctx context.Context, req *pb.TerminalCallRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.PublishTerminalCall(req.GetLine())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *terminalMenuModuleGRPCServer) PublishPopBackendMenu(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.PublishPopBackendMenu()
+	return &emptypb.Empty{}, nil
+}
+
+func (s *terminalMenuModuleGRPCServer) WatchAddMenuEntry(_ *emptypb.Empty, stream pb.TerminalMenuModule_WatchAddMenuEntryServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.TerminalMenuEntryEvent)) (string, error) {
+			return mod.RegisterWhenAddMenuEntry(func(entry *api.TerminalMenuEntry) {
+				if entry == nil {
+					return
+				}
+				emit(&pb.TerminalMenuEntryEvent{Entry: &pb.TerminalMenuEntry{
+					Triggers:     entry.Triggers,
+					ArgumentHint: entry.ArgumentHint,
+					Usage:        entry.Usage,
+				}})
+			})
+		},
+		mod.UnregisterWhenAddMenuEntry,
+		func(id string) *pb.TerminalMenuEntryEvent { return &pb.TerminalMenuEntryEvent{ListenerId: id} })
+}
+
+func (s *terminalMenuModuleGRPCServer) WatchTerminalCall(_ *emptypb.Empty, stream pb.TerminalMenuModule_WatchTerminalCallServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.TerminalCallEvent)) (string, error) {
+			return mod.RegisterWhenTerminalCall(func(line string) { emit(&pb.TerminalCallEvent{Line: line}) })
+		},
+		mod.UnregisterWhenTerminalCall,
+		func(id string) *pb.TerminalCallEvent { return &pb.TerminalCallEvent{ListenerId: id} })
+}
+
+func (s *terminalMenuModuleGRPCServer) WatchPopBackendMenu(_ *emptypb.Empty, stream pb.TerminalMenuModule_WatchPopBackendMenuServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	return serveListener(stream.Context(), stream.Send,
+		func(emit func(*pb.PopBackendMenuEvent)) (string, error) {
+			return mod.RegisterWhenPopBackendMenu(func(struct{}) { emit(&pb.PopBackendMenuEvent{}) })
+		},
+		mod.UnregisterWhenPopBackendMenu,
+		func(id string) *pb.PopBackendMenuEvent { return &pb.PopBackendMenuEvent{ListenerId: id} })
+}
+
+type terminalMenuModuleGRPCClient struct {
+	c    pb.TerminalMenuModuleClient
+	name string
+	subs grpcSubscriptions
+
+	mu      sync.Mutex
+	entries map[*api.TerminalMenuEntry]context.CancelFunc
+}
+
+func newTerminalMenuModuleGRPCClient(conn *grpc.ClientConn, name string) *terminalMenuModuleGRPCClient {
+	return &terminalMenuModuleGRPCClient{
+		c:       pb.NewTerminalMenuModuleClient(conn),
+		name:    name,
+		entries: map[*api.TerminalMenuEntry]context.CancelFunc{},
+	}
+}
+
+func (c *terminalMenuModuleGRPCClient) Name() string { return api.NameTerminalMenuModule }
+
+func (c *terminalMenuModuleGRPCClient) RegisterTerminalMenuEntry(entry *api.TerminalMenuEntry) error {
+	if c == nil || c.c == nil {
+		return errors.New("terminalMenuModuleGRPCClient.RegisterTerminalMenuEntry: client is not initialised")
+	}
+	if entry == nil {
+		return errors.New("terminalMenuModuleGRPCClient.RegisterTerminalMenuEntry: entry is nil")
+	}
+	req := &pb.TerminalMenuEntry{Triggers: entry.Triggers, ArgumentHint: entry.ArgumentHint, Usage: entry.Usage}
+	_, cancel, err := openListener(context.Background(),
+		func(ctx context.Context) (recvStream[*pb.TerminalMenuTrigger], error) {
+			return serverStream[*pb.TerminalMenuTrigger](c.c.RegisterEntry(withGRPCModule(ctx, c.name), req))
+		},
+		func(trigger *pb.TerminalMenuTrigger) {
+			if entry.OnTrigger != nil {
+				entry.OnTrigger(trigger.GetArgs())
+			}
+		})
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.entries[entry] = cancel
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *terminalMenuModuleGRPCClient) RemoveTerminalMenuEntry(entry *api.TerminalMenuEntry) bool {
+	if c == nil || entry == nil {
+		return false
+	}
+	c.mu.Lock()
+	cancel, ok := c.entries[entry]
+	delete(c.entries, entry)
+	c.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+func (c *terminalMenuModuleGRPCClient) PublishTerminalCall(line string) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.PublishTerminalCall(withGRPCModule(context.Background(), c.name), &pb.TerminalCallRequest{Line: line})
+}
+
+func (c *terminalMenuModuleGRPCClient) PublishPopBackendMenu() {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.PublishPopBackendMenu(withGRPCModule(context.Background(), c.name), &emptypb.Empty{})
+}
+
+func (c *terminalMenuModuleGRPCClient) RegisterWhenAddMenuEntry(handler func(*api.TerminalMenuEntry)) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("terminalMenuModuleGRPCClient.RegisterWhenAddMenuEntry: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("terminalMenuModuleGRPCClient.RegisterWhenAddMenuEntry: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.TerminalMenuEntryEvent], error) {
+			return serverStream[*pb.TerminalMenuEntryEvent](c.c.WatchAddMenuEntry(withGRPCModule(ctx, c.name), &emptypb.Empty{}))
+		},
+		(*pb.TerminalMenuEntryEvent).GetListenerId,
+		func(ev *pb.TerminalMenuEntryEvent) {
+			entry := ev.GetEntry()
+			handler(&api.TerminalMenuEntry{
+				Triggers:     entry.GetTriggers(),
+				ArgumentHint: entry.GetArgumentHint(),
+				Usage:        entry.GetUsage(),
+			})
+		})
+}
+
+func (c *terminalMenuModuleGRPCClient) UnregisterWhenAddMenuEntry(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *terminalMenuModuleGRPCClient) RegisterWhenTerminalCall(handler func(string)) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("terminalMenuModuleGRPCClient.RegisterWhenTerminalCall: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("terminalMenuModuleGRPCClient.RegisterWhenTerminalCall: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.TerminalCallEvent], error) {
+			return serverStream[*pb.TerminalCallEvent](c.c.WatchTerminalCall(withGRPCModule(ctx, c.name), &emptypb.Empty{}))
+		},
+		(*pb.TerminalCallEvent).GetListenerId,
+		func(ev *pb.TerminalCallEvent) { handler(ev.GetLine()) })
+}
+
+func (c *terminalMenuModuleGRPCClient) UnregisterWhenTerminalCall(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+func (c *terminalMenuModuleGRPCClient) RegisterWhenPopBackendMenu(handler func(struct{})) (string, error) {
+	if c == nil || c.c == nil {
+		return "", errors.New("terminalMenuModuleGRPCClient.RegisterWhenPopBackendMenu: client is not initialised")
+	}
+	if handler == nil {
+		return "", errors.New("terminalMenuModuleGRPCClient.RegisterWhenPopBackendMenu: handler is nil")
+	}
+	return registerListener(&c.subs,
+		func(ctx context.Context) (recvStream[*pb.PopBackendMenuEvent], error) {
+			return serverStream[*pb.PopBackendMenuEvent](c.c.WatchPopBackendMenu(withGRPCModule(ctx, c.name), &emptypb.Empty{}))
+		},
+		(*pb.PopBackendMenuEvent).GetListenerId,
+		func(*pb.PopBackendMenuEvent) { handler(struct{}{}) })
+}
+
+func (c *terminalMenuModuleGRPCClient) UnregisterWhenPopBackendMenu(listenerID string) bool {
+	if c == nil || listenerID == "" {
+		return false
+	}
+	return c.subs.remove(listenerID)
+}
+
+var _ api.TerminalMenuModule = (*terminalMenuModuleGRPCClient)(nil)
@@ -0,0 +1,203 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type terminalModuleGRPCServer struct {
+	pb.UnimplementedTerminalModuleServer
+	host *hostGRPCServer
+}
+
+func (s *terminalModuleGRPCServer) module(ctx context.Context) (api.TerminalModule, error) {
+	return grpcHostModule[api.TerminalModule](ctx, s.host, api.NameTerminalModule)
+}
+
+func (s *terminalModuleGRPCServer) Print(ctx context.Context, req *pb.TerminalPrintRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.Print(api.Level(req.GetLevel()), req.GetScope(), req.GetMsg())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *terminalModuleGRPCServer) Raw(ctx context.Context, req *pb.TerminalRawRequest) (*emptypb.Empty, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mod.Raw(req.GetMsg())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *terminalModuleGRPCServer) ColorTransANSI(ctx context.Context, req *pb.TerminalColorTransRequest) (*pb.TerminalColorTransResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TerminalColorTransResponse{Msg: mod.ColorTransANSI(req.GetMsg())}, nil
+}
+
+func (s *terminalModuleGRPCServer) SubscribeLines(_ *emptypb.Empty, stream pb.TerminalModule_SubscribeLinesServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	lines, err := mod.SubscribeLines(stream.Context())
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.TerminalLine{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case line, ok := <-lines:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pb.TerminalLine{Line: line}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *terminalModuleGRPCServer) InterceptNextLine(req *pb.InterceptNextLineRequest, stream pb.TerminalModule_InterceptNextLineServer) error {
+	mod, err := s.module(stream.Context())
+	if err != nil {
+		return err
+	}
+	got := make(chan string, 1)
+	cancel, err := mod.InterceptNextLine(stream.Context(), fromDuration(req.GetTimeout()), func(line string) {
+		select {
+		case got <- line:
+		default:
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if cancel != nil {
+		defer cancel()
+	}
+	if err := stream.Send(&pb.TerminalLine{}); err != nil {
+		return err
+	}
+	select {
+	case <-stream.Context().Done():
+		return nil
+	case line := <-got:
+		return stream.Send(&pb.TerminalLine{Line: line})
+	}
+}
+
+type terminalModuleGRPCClient struct {
+	c    pb.TerminalModuleClient
+	name string
+}
+
+func newTerminalModuleGRPCClient(conn *grpc.ClientConn, name string) *terminalModuleGRPCClient {
+	return &terminalModuleGRPCClient{c: pb.NewTerminalModuleClient(conn), name: name}
+}
+
+func (c *terminalModuleGRPCClient) Name() string { return api.NameTerminalModule }
+
+func (c *terminalModuleGRPCClient) Print(level api.Level, scope string, msg string) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.Print(withGRPCModule(context.Background(), c.name), &pb.TerminalPrintRequest{Level: string(level), Scope: scope, Msg: msg})
+}
+
+func (c *terminalModuleGRPCClient) Info(scope string, msg string) { c.Print(api.LevelInfo, scope, msg) }
+
+func (c *terminalModuleGRPCClient) Warn(scope string, msg string) { c.Print(api.LevelWarn, scope, msg) }
+
+func (c *terminalModuleGRPCClient) Error(scope string, msg string) {
+	c.Print(api.LevelError, scope, msg)
+}
+
+func (c *terminalModuleGRPCClient) Success(scope string, msg string) {
+	c.Print(api.LevelSuccess, scope, msg)
+}
+
+func (c *terminalModuleGRPCClient) Raw(msg string) {
+	if c == nil || c.c == nil {
+		return
+	}
+	_, _ = c.c.Raw(withGRPCModule(context.Background(), c.name), &pb.TerminalRawRequest{Msg: msg})
+}
+
+func (c *terminalModuleGRPCClient) ColorTransANSI(msg string) string {
+	if c == nil || c.c == nil {
+		return msg
+	}
+	resp, err := c.c.ColorTransANSI(withGRPCModule(context.Background(), c.name), &pb.TerminalColorTransRequest{Msg: msg})
+	if err != nil {
+		return msg
+	}
+	return resp.GetMsg()
+}
+
+func (c *terminalModuleGRPCClient) SubscribeLines(ctx context.Context) (<-chan string, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("terminalModuleGRPCClient.SubscribeLines: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.c.SubscribeLines(withGRPCModule(ctx, c.name), &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return nil, err
+	}
+	out := make(chan string, 256)
+	go func() {
+		defer close(out)
+		for {
+			line, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case out <- line.GetLine():
+			default:
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (c *terminalModuleGRPCClient) InterceptNextLine(ctx context.Context, timeout time.Duration, handler func(string)) (func(), error) {
+	if c == nil || c.c == nil {
+		return func() {}, errors.New("terminalModuleGRPCClient.InterceptNextLine: client is not initialised")
+	}
+	if handler == nil {
+		return func() {}, errors.New("terminalModuleGRPCClient.InterceptNextLine: handler is nil")
+	}
+	_, cancel, err := openListener(ctx,
+		func(ctx context.Context) (recvStream[*pb.TerminalLine], error) {
+			return serverStream[*pb.TerminalLine](c.c.InterceptNextLine(withGRPCModule(ctx, c.name), &pb.InterceptNextLineRequest{Timeout: toDuration(timeout)}))
+		},
+		func(line *pb.TerminalLine) { handler(line.GetLine()) })
+	if err != nil {
+		return func() {}, err
+	}
+	return func() { cancel() }, nil
+}
+
+var _ api.TerminalModule = (*terminalModuleGRPCClient)(nil)
@@ -0,0 +1,324 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+type uqholderModuleGRPCServer struct {
+	pb.UnimplementedUQHolderModuleServer
+	host *hostGRPCServer
+}
+
+func (s *uqholderModuleGRPCServer) module(ctx context.Context) (api.UQHolderModule, error) {
+	return grpcHostModule[api.UQHolderModule](ctx, s.host, api.NameUQHolderModule)
+}
+
+func (s *uqholderModuleGRPCServer) GetBotInfo(ctx context.Context, _ *emptypb.Empty) (*pb.BotInfo, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.BotInfo{}
+	var errs []error
+	collect := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	info.Name, err = mod.BotName(ctx)
+	collect(err)
+	info.RuntimeId, err = mod.BotRuntimeID(ctx)
+	collect(err)
+	info.UniqueId, err = mod.BotUniqueID(ctx)
+	collect(err)
+	info.Identity, err = mod.BotIdentity(ctx)
+	collect(err)
+	info.UuidStr, err = mod.BotUUIDStr(ctx)
+	collect(err)
+	info.Xuid, err = mod.BotXUID(ctx)
+	collect(err)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return info, nil
+}
+
+func (s *uqholderModuleGRPCServer) GetRaw(ctx context.Context, req *pb.UQHolderRawRequest) (*structpb.Struct, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	switch req.GetKind() {
+	case pb.UQHolderRaw_UQHOLDER_RAW_BASIC:
+		raw, err = mod.BasicRaw(ctx)
+	case pb.UQHolderRaw_UQHOLDER_RAW_EXTEND:
+		raw, err = mod.ExtendRaw(ctx)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown uqholder raw kind %v", req.GetKind())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(raw), nil
+}
+
+func (s *uqholderModuleGRPCServer) GetState(ctx context.Context, req *pb.UQHolderStateRequest) (*pb.UQHolderStateValue, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	intValue := func(v int64, ok bool, err error) (*pb.UQHolderStateValue, error) {
+		return &pb.UQHolderStateValue{Ok: ok, Value: &pb.UQHolderStateValue_IntValue{IntValue: v}}, err
+	}
+	uintValue := func(v uint64, ok bool, err error) (*pb.UQHolderStateValue, error) {
+		return &pb.UQHolderStateValue{Ok: ok, Value: &pb.UQHolderStateValue_UintValue{UintValue: v}}, err
+	}
+	floatValue := func(v float32, ok bool, err error) (*pb.UQHolderStateValue, error) {
+		return &pb.UQHolderStateValue{Ok: ok, Value: &pb.UQHolderStateValue_FloatValue{FloatValue: v}}, err
+	}
+	int32Value := func(v int32, ok bool, err error) (*pb.UQHolderStateValue, error) {
+		return intValue(int64(v), ok, err)
+	}
+
+	switch req.GetState() {
+	case pb.UQHolderState_UQHOLDER_STATE_COMPRESS_THRESHOLD:
+		v, ok, err := mod.CompressThreshold(ctx)
+		return uintValue(uint64(v), ok, err)
+	case pb.UQHolderState_UQHOLDER_STATE_WORLD_GAME_MODE:
+		return int32Value(mod.WorldGameMode(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_GAME_MODE:
+		return int32Value(mod.GameMode(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_WORLD_DIFFICULTY:
+		v, ok, err := mod.WorldDifficulty(ctx)
+		return uintValue(uint64(v), ok, err)
+	case pb.UQHolderState_UQHOLDER_STATE_TIME:
+		return int32Value(mod.Time(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_DAY_TIME:
+		return int32Value(mod.DayTime(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_DAY_TIME_PERCENT:
+		return floatValue(mod.DayTimePercent(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_CURRENT_TICK:
+		return intValue(mod.CurrentTick(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_SYNC_RATIO:
+		return floatValue(mod.SyncRatio(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_BOT_DIMENSION:
+		return int32Value(mod.BotDimension(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_BOT_POSITION:
+		v, ok, err := mod.BotPosition(ctx)
+		return &pb.UQHolderStateValue{Ok: ok, Value: &pb.UQHolderStateValue_Vec3Value{Vec3Value: &pb.Vec3{X: v[0], Y: v[1], Z: v[2]}}}, err
+	case pb.UQHolderState_UQHOLDER_STATE_BOT_POSITION_OUT_OF_SYNC_TICK:
+		return intValue(mod.BotPositionOutOfSyncTick(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_CLIENT_DIMENSION:
+		return int32Value(mod.ClientDimension(ctx))
+	case pb.UQHolderState_UQHOLDER_STATE_CLIENT_HOT_BAR_SLOT:
+		v, ok, err := mod.ClientHotBarSlot(ctx)
+		return uintValue(uint64(v), ok, err)
+	case pb.UQHolderState_UQHOLDER_STATE_CLIENT_HOLDING_ITEM:
+		v, ok, err := mod.ClientHoldingItem(ctx)
+		return &pb.UQHolderStateValue{Ok: ok, Value: &pb.UQHolderStateValue_StructValue{StructValue: toStruct(v)}}, err
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown uqholder state %v", req.GetState())
+	}
+}
+
+func (s *uqholderModuleGRPCServer) GameRules(ctx context.Context, _ *emptypb.Empty) (*pb.GameRulesResponse, error) {
+	mod, err := s.module(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := mod.GameRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GameRulesResponse{Rules: make(map[string]*pb.GameRule, len(rules))}
+	for name, rule := range rules {
+		resp.Rules[name] = &pb.GameRule{CanBeModified: rule.CanBeModified, Value: rule.Value}
+	}
+	return resp, nil
+}
+
+type uqholderModuleGRPCClient struct {
+	c    pb.UQHolderModuleClient
+	name string
+}
+
+func newUQHolderModuleGRPCClient(conn *grpc.ClientConn, name string) *uqholderModuleGRPCClient {
+	return &uqholderModuleGRPCClient{c: pb.NewUQHolderModuleClient(conn), name: name}
+}
+
+func (c *uqholderModuleGRPCClient) Name() string { return api.NameUQHolderModule }
+
+func (c *uqholderModuleGRPCClient) botInfo(ctx context.Context) (*pb.BotInfo, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("uqholderModuleGRPCClient: client is not initialised")
+	}
+	return c.c.GetBotInfo(withGRPCModule(ctx, c.name), &emptypb.Empty{})
+}
+
+func (c *uqholderModuleGRPCClient) raw(ctx context.Context, kind pb.UQHolderRaw) (map[string]any, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("uqholderModuleGRPCClient: client is not initialised")
+	}
+	resp, err := c.c.GetRaw(withGRPCModule(ctx, c.name), &pb.UQHolderRawRequest{Kind: kind})
+	if err != nil {
+		return nil, err
+	}
+	return fromStruct(resp), nil
+}
+
+func (c *uqholderModuleGRPCClient) state(ctx context.Context, state pb.UQHolderState) (*pb.UQHolderStateValue, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("uqholderModuleGRPCClient: client is not initialised")
+	}
+	return c.c.GetState(withGRPCModule(ctx, c.name), &pb.UQHolderStateRequest{State: state})
+}
+
+func (c *uqholderModuleGRPCClient) int32State(ctx context.Context, state pb.UQHolderState) (int32, bool, error) {
+	v, err := c.state(ctx, state)
+	return int32(v.GetIntValue()), v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) int64State(ctx context.Context, state pb.UQHolderState) (int64, bool, error) {
+	v, err := c.state(ctx, state)
+	return v.GetIntValue(), v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) float32State(ctx context.Context, state pb.UQHolderState) (float32, bool, error) {
+	v, err := c.state(ctx, state)
+	return v.GetFloatValue(), v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotName(ctx context.Context) (string, error) {
+	info, err := c.botInfo(ctx)
+	return info.GetName(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotRuntimeID(ctx context.Context) (uint64, error) {
+	info, err := c.botInfo(ctx)
+	return info.GetRuntimeId(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotUniqueID(ctx context.Context) (int64, error) {
+	info, err := c.botInfo(ctx)
+	return info.GetUniqueId(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotIdentity(ctx context.Context) (string, error) {
+	info, err := c.botInfo(ctx)
+	return info.GetIdentity(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotUUIDStr(ctx context.Context) (string, error) {
+	info, err := c.botInfo(ctx)
+	return info.GetUuidStr(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotXUID(ctx context.Context) (string, error) {
+	info, err := c.botInfo(ctx)
+	return info.GetXuid(), err
+}
+
+func (c *uqholderModuleGRPCClient) BasicRaw(ctx context.Context) (map[string]any, error) {
+	return c.raw(ctx, pb.UQHolderRaw_UQHOLDER_RAW_BASIC)
+}
+
+func (c *uqholderModuleGRPCClient) ExtendRaw(ctx context.Context) (map[string]any, error) {
+	return c.raw(ctx, pb.UQHolderRaw_UQHOLDER_RAW_EXTEND)
+}
+
+func (c *uqholderModuleGRPCClient) CompressThreshold(ctx context.Context) (uint16, bool, error) {
+	v, err := c.state(ctx, pb.UQHolderState_UQHOLDER_STATE_COMPRESS_THRESHOLD)
+	return uint16(v.GetUintValue()), v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) WorldGameMode(ctx context.Context) (int32, bool, error) {
+	return c.int32State(ctx, pb.UQHolderState_UQHOLDER_STATE_WORLD_GAME_MODE)
+}
+
+func (c *uqholderModuleGRPCClient) GameMode(ctx context.Context) (int32, bool, error) {
+	return c.int32State(ctx, pb.UQHolderState_UQHOLDER_STATE_GAME_MODE)
+}
+
+func (c *uqholderModuleGRPCClient) WorldDifficulty(ctx context.Context) (uint32, bool, error) {
+	v, err := c.state(ctx, pb.UQHolderState_UQHOLDER_STATE_WORLD_DIFFICULTY)
+	return uint32(v.GetUintValue()), v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) Time(ctx context.Context) (int32, bool, error) {
+	return c.int32State(ctx, pb.UQHolderState_UQHOLDER_STATE_TIME)
+}
+
+func (c *uqholderModuleGRPCClient) DayTime(ctx context.Context) (int32, bool, error) {
+	return c.int32State(ctx, pb.UQHolderState_UQHOLDER_STATE_DAY_TIME)
+}
+
+func (c *uqholderModuleGRPCClient) DayTimePercent(ctx context.Context) (float32, bool, error) {
+	return c.float32State(ctx, pb.UQHolderState_UQHOLDER_STATE_DAY_TIME_PERCENT)
+}
+
+func (c *uqholderModuleGRPCClient) CurrentTick(ctx context.Context) (int64, bool, error) {
+	return c.int64State(ctx, pb.UQHolderState_UQHOLDER_STATE_CURRENT_TICK)
+}
+
+func (c *uqholderModuleGRPCClient) SyncRatio(ctx context.Context) (float32, bool, error) {
+	return c.float32State(ctx, pb.UQHolderState_UQHOLDER_STATE_SYNC_RATIO)
+}
+
+func (c *uqholderModuleGRPCClient) BotDimension(ctx context.Context) (int32, bool, error) {
+	return c.int32State(ctx, pb.UQHolderState_UQHOLDER_STATE_BOT_DIMENSION)
+}
+
+func (c *uqholderModuleGRPCClient) BotPosition(ctx context.Context) ([3]float32, bool, error) {
+	v, err := c.state(ctx, pb.UQHolderState_UQHOLDER_STATE_BOT_POSITION)
+	pos := v.GetVec3Value()
+	return [3]float32{pos.GetX(), pos.GetY(), pos.GetZ()}, v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) BotPositionOutOfSyncTick(ctx context.Context) (int64, bool, error) {
+	return c.int64State(ctx, pb.UQHolderState_UQHOLDER_STATE_BOT_POSITION_OUT_OF_SYNC_TICK)
+}
+
+func (c *uqholderModuleGRPCClient) ClientDimension(ctx context.Context) (int32, bool, error) {
+	return c.int32State(ctx, pb.UQHolderState_UQHOLDER_STATE_CLIENT_DIMENSION)
+}
+
+func (c *uqholderModuleGRPCClient) ClientHotBarSlot(ctx context.Context) (byte, bool, error) {
+	v, err := c.state(ctx, pb.UQHolderState_UQHOLDER_STATE_CLIENT_HOT_BAR_SLOT)
+	return byte(v.GetUintValue()), v.GetOk(), err
+}
+
+func (c *uqholderModuleGRPCClient) ClientHoldingItem(ctx context.Context) (map[string]any, bool, error) {
+	v, err := c.state(ctx, pb.UQHolderState_UQHOLDER_STATE_CLIENT_HOLDING_ITEM)
+	if err != nil || !v.GetOk() {
+		return nil, v.GetOk(), err
+	}
+	return fromStruct(v.GetStructValue()), true, nil
+}
+
+func (c *uqholderModuleGRPCClient) GameRules(ctx context.Context) (map[string]api.GameRule, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("uqholderModuleGRPCClient.GameRules: client is not initialised")
+	}
+	resp, err := c.c.GameRules(withGRPCModule(ctx, c.name), &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]api.GameRule, len(resp.GetRules()))
+	for name, rule := range resp.GetRules() {
+		out[name] = api.GameRule{CanBeModified: rule.GetCanBeModified(), Value: rule.GetValue()}
+	}
+	return out, nil
+}
+
+var _ api.UQHolderModule = (*uqholderModuleGRPCClient)(nil)
@@ -0,0 +1,493 @@
+package protocol
+
+import (
+	"bufio"
+	"context"
+	"encoding/gob"
+	"errors"
+	"io"
+	"net/rpc"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb"
+)
+
+// Service names of CallInfo for the net/rpc objects that are not modules; modules use their
+// api.Name*Module constant.
+const (
+	ServicePlugin           = "plugin"
+	ServiceFrame            = "frame"
+	ServiceKeyValueDB       = "kvdb"
+	ServiceSQLDB            = "sqldb"
+	ServicePlayerKit        = "player_kit"
+	ServiceDaemon           = "daemon"
+	ServiceScoreboardDaemon = "scoreboard_daemon"
+	ServiceChunkDaemon      = "chunk_daemon"
+)
+
+// CallInfo describes an intercepted RPC call.
+type CallInfo struct {
+	// Service is the bridged interface: one of the Service* constants, a module name such as
+	// api.NameChatModule, or for gRPC the full proto service name.
+	Service string
+	// Method is the method name without the service, e.g. "SendChat".
+	Method string
+	// Callback reports calls over broker callback connections, which run in the opposite
+	// direction (e.g. the host delivering a chat message to a plugin handler).
+	Callback bool
+	// Stream reports gRPC streaming calls. Interceptors see them once, with nil args and reply.
+	Stream bool
+	// PluginID is the plugin the call is made by or to: the peer plugin on the host side, the
+	// plugin itself on the plugin side. It is empty when not known yet (before Init).
+	PluginID string
+}
+
+// FullMethod returns "Service.Method".
+func (i *CallInfo) FullMethod() string {
+	if i == nil {
+		return ""
+	}
+	return i.Service + "." + i.Method
+}
+
+// ClientInterceptor wraps outgoing calls. It must call invoke to perform the call, and may
+// inspect or change args before and reply after it.
+type ClientInterceptor func(ctx context.Context, info *CallInfo, args, reply any, invoke func(ctx context.Context) error) error
+
+// ServerInterceptor wraps incoming calls before they reach the implementation. It must call
+// handler to run the call; handler returns the reply.
+type ServerInterceptor func(ctx context.Context, info *CallInfo, args any, handler func(ctx context.Context) (any, error)) (any, error)
+
+var (
+	interceptorMu      sync.RWMutex
+	clientInterceptors []ClientInterceptor
+	serverInterceptors []ServerInterceptor
+)
+
+// SetClientInterceptors installs the interceptors wrapping every outgoing call of this process,
+// net/rpc and gRPC, including broker callbacks. The first interceptor is the outermost one.
+// restore reinstalls the previous ones. Plugins usually use WithClientInterceptors instead.
+func SetClientInterceptors(interceptors ...ClientInterceptor) (restore func()) {
+	interceptorMu.Lock()
+	prev := clientInterceptors
+	clientInterceptors = compactInterceptors(interceptors)
+	interceptorMu.Unlock()
+	return func() {
+		interceptorMu.Lock()
+		clientInterceptors = prev
+		interceptorMu.Unlock()
+	}
+}
+
+// SetServerInterceptors is SetClientInterceptors for incoming calls.
+func SetServerInterceptors(interceptors ...ServerInterceptor) (restore func()) {
+	interceptorMu.Lock()
+	prev := serverInterceptors
+	serverInterceptors = compactInterceptors(interceptors)
+	interceptorMu.Unlock()
+	return func() {
+		interceptorMu.Lock()
+		serverInterceptors = prev
+		interceptorMu.Unlock()
+	}
+}
+
+func compactInterceptors[T any](in []T) []T {
+	var out []T
+	for _, i := range in {
+		if !reflect.ValueOf(&i).Elem().IsNil() {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+func currentClientInterceptors() []ClientInterceptor {
+	interceptorMu.RLock()
+	defer interceptorMu.RUnlock()
+	return clientInterceptors
+}
+
+func currentServerInterceptors() []ServerInterceptor {
+	interceptorMu.RLock()
+	defer interceptorMu.RUnlock()
+	return serverInterceptors
+}
+
+// interceptClient runs invoke through the client interceptors.
+func interceptClient(ctx context.Context, info *CallInfo, args, reply any, invoke func(ctx context.Context) error) error {
+	chain := currentClientInterceptors()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var next func(i int) func(ctx context.Context) error
+	next = func(i int) func(ctx context.Context) error {
+		if i == len(chain) {
+			return invoke
+		}
+		return func(ctx context.Context) error {
+			return chain[i](ctx, info, args, reply, next(i+1))
+		}
+	}
+	return next(0)(ctx)
+}
+
+// interceptServer runs handler through the server interceptors.
+func interceptServer(ctx context.Context, info *CallInfo, args any, handler func(ctx context.Context) (any, error)) (any, error) {
+	chain := currentServerInterceptors()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var next func(i int) func(ctx context.Context) (any, error)
+	next = func(i int) func(ctx context.Context) (any, error) {
+		if i == len(chain) {
+			return handler
+		}
+		return func(ctx context.Context) (any, error) {
+			return chain[i](ctx, info, args, next(i+1))
+		}
+	}
+	return next(0)(ctx)
+}
+
+// intercepted runs a net/rpc method that is not served by serveRPCConn (the plugin lifecycle
+// calls, served by go-plugin) through the server interceptors.
+func intercepted(ctx context.Context, info *CallInfo, args, reply any, fn func(ctx context.Context) error) error {
+	_, err := interceptServer(ctx, info, args, func(ctx context.Context) (any, error) {
+		return reply, fn(ctx)
+	})
+	return err
+}
+
+// rpcConn is a net/rpc client whose calls go through the client interceptors.
+type rpcConn struct {
+	*rpc.Client
+	broker   *plugin.MuxBroker
+	service  string
+	callback bool
+	// noMetadata is set once the peer rejected a method name carrying metadata.
+	noMetadata atomic.Bool
+}
+
+// newRPCConn wraps conn, dialed over broker, for calls to service.
+func newRPCConn(conn io.ReadWriteCloser, broker *plugin.MuxBroker, service string) *rpcConn {
+	return &rpcConn{Client: rpc.NewClient(conn), broker: broker, service: service}
+}
+
+// newCallbackRPCConn wraps conn for calls to a callback registered with service.
+func newCallbackRPCConn(conn io.ReadWriteCloser, broker *plugin.MuxBroker, service string) *rpcConn {
+	return &rpcConn{Client: rpc.NewClient(conn), broker: broker, service: service, callback: true}
+}
+
+func (c *rpcConn) info(ctx context.Context, serviceMethod string) *CallInfo {
+	id := brokerPluginID(c.broker)
+	if id == "" && ctx != nil {
+		id = callPluginID(ctx)
+	}
+	return &CallInfo{Service: c.service, Method: strings.TrimPrefix(serviceMethod, "Plugin."), Callback: c.callback, PluginID: id}
+}
+
+// callMetadataCarrier is implemented by args that carry metadata themselves, for methods
+// served by go-plugin's own net/rpc server.
+type callMetadataCarrier interface {
+	setCallMetadata(md CallMetadata)
+}
+
+func (c *rpcConn) Call(serviceMethod string, args, reply any) error {
+	return c.CallContext(context.Background(), serviceMethod, args, reply)
+}
+
+// CallContext is Call with a context for the interceptors. net/rpc itself ignores ctx.
+func (c *rpcConn) CallContext(ctx context.Context, serviceMethod string, args, reply any) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return interceptClient(ctx, c.info(ctx, serviceMethod), args, reply, func(ctx context.Context) error {
+		md := OutgoingCallMetadata(ctx)
+		if carrier, ok := args.(callMetadataCarrier); ok {
+			carrier.setCallMetadata(md)
+			return c.Client.Call(serviceMethod, args, reply)
+		}
+		if len(md) == 0 || c.noMetadata.Load() {
+			return c.Client.Call(serviceMethod, args, reply)
+		}
+		withMetadata := encodeMethodMetadata(serviceMethod, md)
+		err := c.Client.Call(withMetadata, args, reply)
+		// Peers built with an older SDK serve with net/rpc, which rejects the name unprocessed.
+		if se, ok := err.(rpc.ServerError); ok && isUnknownRPCMethod(err) && strings.HasSuffix(string(se), withMetadata) {
+			c.noMetadata.Store(true)
+			return c.Client.Call(serviceMethod, args, reply)
+		}
+		return err
+	})
+}
+
+// isUnknownRPCMethod reports whether err is the net/rpc error for a method the peer does not serve.
+func isUnknownRPCMethod(err error) bool {
+	se, ok := err.(rpc.ServerError)
+	return ok && strings.HasPrefix(string(se), "rpc: can't find ")
+}
+
+func (c *rpcConn) Go(serviceMethod string, args, reply any, done chan *rpc.Call) *rpc.Call {
+	return c.GoContext(context.Background(), serviceMethod, args, reply, done)
+}
+
+// GoContext is Go with a context for the interceptors.
+func (c *rpcConn) GoContext(ctx context.Context, serviceMethod string, args, reply any, done chan *rpc.Call) *rpc.Call {
+	if done == nil {
+		done = make(chan *rpc.Call, 1)
+	}
+	call := &rpc.Call{ServiceMethod: serviceMethod, Args: args, Reply: reply, Done: done}
+	go func() {
+		call.Error = c.CallContext(ctx, serviceMethod, args, reply)
+		select {
+		case call.Done <- call:
+		default:
+		}
+	}()
+	return call
+}
+
+// serveRPCConn serves the exported methods of rcvr on conn like net/rpc (gob codec, service name
+// "Plugin"), running every call through the server interceptors.
+func serveRPCConn(conn io.ReadWriteCloser, broker *plugin.MuxBroker, service string, callback bool, rcvr any) {
+	methods := rpcMethods(rcvr)
+	buf := bufio.NewWriter(conn)
+	dec := gob.NewDecoder(conn)
+	enc := gob.NewEncoder(buf)
+	var (
+		sendMu sync.Mutex
+		wg     sync.WaitGroup
+	)
+	send := func(req *rpc.Request, reply any, errMsg string) {
+		resp := rpc.Response{ServiceMethod: req.ServiceMethod, Seq: req.Seq, Error: errMsg}
+		if errMsg != "" {
+			reply = struct{}{}
+		}
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		if enc.Encode(&resp) != nil || enc.Encode(reply) != nil || buf.Flush() != nil {
+			_ = conn.Close()
+		}
+	}
+	defer func() {
+		wg.Wait()
+		_ = conn.Close()
+	}()
+
+	for {
+		var req rpc.Request
+		if err := dec.Decode(&req); err != nil {
+			return
+		}
+		serviceMethod, md := decodeMethodMetadata(req.ServiceMethod)
+		name, ok := strings.CutPrefix(serviceMethod, "Plugin.")
+		m := methods[name]
+		if !ok || m == nil {
+			if dec.DecodeValue(reflect.Value{}) != nil {
+				return
+			}
+			send(&req, nil, "rpc: can't find method "+req.ServiceMethod)
+			continue
+		}
+		argv := reflect.New(m.argType)
+		if m.argType.Kind() == reflect.Pointer {
+			argv = reflect.New(m.argType.Elem())
+		}
+		if err := dec.Decode(argv.Interface()); err != nil {
+			return
+		}
+		if m.argType.Kind() != reflect.Pointer {
+			argv = argv.Elem()
+		}
+		replyv := reflect.New(m.replyType.Elem())
+		switch m.replyType.Elem().Kind() {
+		case reflect.Map:
+			replyv.Elem().Set(reflect.MakeMap(m.replyType.Elem()))
+		case reflect.Slice:
+			replyv.Elem().Set(reflect.MakeSlice(m.replyType.Elem(), 0, 0))
+		}
+
+		wg.Add(1)
+		go func(req rpc.Request) {
+			defer wg.Done()
+			info := &CallInfo{Service: service, Method: name, Callback: callback, PluginID: brokerPluginID(broker)}
+			ctx := withIncomingCallMetadata(context.Background(), md)
+			reply, err := interceptServer(ctx, info, argv.Interface(), func(ctx context.Context) (any, error) {
+				in := []reflect.Value{reflect.ValueOf(rcvr), argv, replyv}
+				if m.withContext {
+					in = []reflect.Value{reflect.ValueOf(rcvr), reflect.ValueOf(&ctx).Elem(), argv, replyv}
+				}
+				out := m.fn.Func.Call(in)
+				if errv := out[0].Interface(); errv != nil {
+					return replyv.Interface(), errv.(error)
+				}
+				return replyv.Interface(), nil
+			})
+			if err != nil {
+				send(&req, nil, err.Error())
+				return
+			}
+			if reply == nil {
+				reply = replyv.Interface()
+			}
+			send(&req, reply, "")
+		}(req)
+	}
+}
+
+type rpcMethod struct {
+	fn                 reflect.Method
+	argType, replyType reflect.Type
+	// withContext is set for methods taking the context of the call first.
+	withContext bool
+}
+
+var contextType = reflect.TypeFor[context.Context]()
+
+// rpcMethods returns the methods of rcvr that net/rpc would register, plus methods of the form
+// M(ctx context.Context, args T, reply *R) error, which get the context of the call (with its
+// IncomingCallMetadata and whatever the server interceptors added).
+func rpcMethods(rcvr any) map[string]*rpcMethod {
+	methods := map[string]*rpcMethod{}
+	t := reflect.TypeOf(rcvr)
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		mt := m.Type
+		if !m.IsExported() || mt.NumOut() != 1 || mt.Out(0) != errorType {
+			continue
+		}
+		in := 1
+		withContext := mt.NumIn() == 4 && mt.In(1) == contextType
+		if withContext {
+			in = 2
+		} else if mt.NumIn() != 3 {
+			continue
+		}
+		if mt.In(in+1).Kind() != reflect.Pointer {
+			continue
+		}
+		methods[m.Name] = &rpcMethod{fn: m, argType: mt.In(in), replyType: mt.In(in + 1), withContext: withContext}
+	}
+	return methods
+}
+
+// GRPCServerOptions returns the options installing the server interceptors on a gRPC server.
+// Serve and the host side of the broker apply them; hosts serving gRPC elsewhere can add them.
+func GRPCServerOptions() []grpc.ServerOption {
+	return grpcServerOptions(nil)
+}
+
+// grpcServerOptions is GRPCServerOptions for a server whose calls belong to pluginID().
+func grpcServerOptions(pluginID func() string) []grpc.ServerOption {
+	callInfo := func(ctx context.Context, fullMethod string, req any, stream bool) *CallInfo {
+		info := grpcCallInfo(fullMethod, stream)
+		if pluginID != nil {
+			info.PluginID = pluginID()
+		}
+		if info.PluginID == "" {
+			// Init is intercepted before the plugin learns its ID.
+			if init, ok := req.(*pb.InitRequest); ok {
+				info.PluginID = init.GetId()
+			}
+		}
+		return info
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, si *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if isGoPluginMethod(si.FullMethod) {
+				return handler(ctx, req)
+			}
+			ctx = withIncomingCallMetadata(ctx, grpcIncomingMetadata(ctx))
+			return interceptServer(ctx, callInfo(ctx, si.FullMethod, req, false), req, func(ctx context.Context) (any, error) {
+				return handler(ctx, req)
+			})
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, si *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if isGoPluginMethod(si.FullMethod) {
+				return handler(srv, ss)
+			}
+			ctx := withIncomingCallMetadata(ss.Context(), grpcIncomingMetadata(ss.Context()))
+			_, err := interceptServer(ctx, callInfo(ctx, si.FullMethod, nil, true), nil, func(ctx context.Context) (any, error) {
+				if ctx != ss.Context() {
+					ss = &contextServerStream{ServerStream: ss, ctx: ctx}
+				}
+				return nil, handler(srv, ss)
+			})
+			return err
+		}),
+	}
+}
+
+// GRPCDialOptions returns the options installing the client interceptors on a gRPC client.
+// Hosts pass them as plugin.ClientConfig.GRPCDialOptions.
+func GRPCDialOptions() []grpc.DialOption {
+	return grpcDialOptions(nil)
+}
+
+// grpcDialOptions is GRPCDialOptions for a connection whose calls belong to pluginID().
+func grpcDialOptions(pluginID func() string) []grpc.DialOption {
+	callInfo := func(ctx context.Context, method string, stream bool) *CallInfo {
+		info := grpcCallInfo(method, stream)
+		if pluginID != nil {
+			info.PluginID = pluginID()
+		}
+		if info.PluginID == "" {
+			info.PluginID = callPluginID(ctx)
+		}
+		return info
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if isGoPluginMethod(method) {
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}
+			return interceptClient(ctx, callInfo(ctx, method, false), req, reply, func(ctx context.Context) error {
+				return invoker(appendGRPCMetadata(ctx, OutgoingCallMetadata(ctx)), method, req, reply, cc, opts...)
+			})
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			if isGoPluginMethod(method) {
+				return streamer(ctx, desc, cc, method, opts...)
+			}
+			var cs grpc.ClientStream
+			err := interceptClient(ctx, callInfo(ctx, method, true), nil, nil, func(ctx context.Context) error {
+				var err error
+				cs, err = streamer(appendGRPCMetadata(ctx, OutgoingCallMetadata(ctx)), desc, cc, method, opts...)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			if cs == nil {
+				return nil, errors.New("protocol: client interceptor did not invoke the stream")
+			}
+			return cs, nil
+		}),
+	}
+}
+
+// isGoPluginMethod reports the internal services of go-plugin (broker, stdio, controller),
+// which are not intercepted.
+func isGoPluginMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/plugin.")
+}
+
+// grpcCallInfo splits a gRPC method like "/tempest.dynamic.v1.ChatModule/SendChat".
+func grpcCallInfo(fullMethod string, stream bool) *CallInfo {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return &CallInfo{Service: service, Method: method, Stream: stream}
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context { return s.ctx }
@@ -1,10 +1,14 @@
 package protocol
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/rpc"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 
@@ -25,6 +29,17 @@ type KeyValueDBSetArgs struct {
 	Value string
 }
 
+type KeyValueDBSetWithTTLArgs struct {
+	Key   string
+	Value string
+	TTL   time.Duration
+}
+
+type KeyValueDBTTLResp struct {
+	TTL time.Duration
+	OK  bool
+}
+
 type KeyValueDBDeleteArgs struct {
 	Key string
 }
@@ -38,9 +53,45 @@ type KeyValueDBIterateEntryArgs struct {
 	Value string
 }
 
+type KeyValueDBBatchArgs struct {
+	Batch api.KVBatch
+}
+
+type KeyValueDBBatchResp struct {
+	// Conflict reports api.ErrKVConflict.
+	Conflict bool
+}
+
+type KeyValueDBScanArgs struct {
+	Scan api.KVScan
+}
+
+type KeyValueDBScanResp struct {
+	Entries    []api.KVEntry
+	NextCursor string
+}
+
+type KeyValueDBWatchArgs struct {
+	Prefix           string
+	CallbackBrokerID uint32
+}
+
+type KeyValueDBWatchResp struct {
+	WatchID string
+}
+
+type KeyValueDBUnwatchArgs struct {
+	WatchID string
+}
+
 type KeyValueDBRPCServer struct {
 	Impl   api.KeyValueDB
 	broker *plugin.MuxBroker
+
+	mu        sync.Mutex
+	watches   map[string]context.CancelFunc
+	callbacks map[string]*keyValueDBWatchCallbackClient
+	seq       uint64
 }
 
 func (s *KeyValueDBRPCServer) Get(args *KeyValueDBGetArgs, resp *KeyValueDBGetResp) error {
@@ -68,6 +119,39 @@ func (s *KeyValueDBRPCServer) Set(args *KeyValueDBSetArgs, _ *Empty) error {
 	return s.Impl.Set(args.Key, args.Value)
 }
 
+func (s *KeyValueDBRPCServer) SetWithTTL(args *KeyValueDBSetWithTTLArgs, _ *Empty) error {
+	if s == nil || s.Impl == nil || args == nil {
+		return nil
+	}
+	e, ok := s.Impl.(api.KVExpirer)
+	if !ok {
+		return api.ErrKVUnsupported
+	}
+	return e.SetWithTTL(args.Key, args.Value, args.TTL)
+}
+
+func (s *KeyValueDBRPCServer) TTL(args *KeyValueDBGetArgs, resp *KeyValueDBTTLResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.TTL = 0
+	resp.OK = false
+	if s == nil || s.Impl == nil || args == nil {
+		return nil
+	}
+	e, ok := s.Impl.(api.KVExpirer)
+	if !ok {
+		return api.ErrKVUnsupported
+	}
+	ttl, ok, err := e.TTL(args.Key)
+	if err != nil {
+		return err
+	}
+	resp.TTL = ttl
+	resp.OK = ok
+	return nil
+}
+
 func (s *KeyValueDBRPCServer) Delete(args *KeyValueDBDeleteArgs, _ *Empty) error {
 	if s == nil || s.Impl == nil || args == nil {
 		return nil
@@ -75,6 +159,45 @@ func (s *KeyValueDBRPCServer) Delete(args *KeyValueDBDeleteArgs, _ *Empty) error
 	return s.Impl.Delete(args.Key)
 }
 
+func (s *KeyValueDBRPCServer) Batch(args *KeyValueDBBatchArgs, resp *KeyValueDBBatchResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.Conflict = false
+	if s == nil || s.Impl == nil || args == nil {
+		return nil
+	}
+	batcher, ok := s.Impl.(api.KVBatcher)
+	if !ok {
+		return api.ErrKVUnsupported
+	}
+	err := batcher.Batch(args.Batch)
+	if errors.Is(err, api.ErrKVConflict) {
+		resp.Conflict = true
+		return nil
+	}
+	return err
+}
+
+func (s *KeyValueDBRPCServer) Scan(args *KeyValueDBScanArgs, resp *KeyValueDBScanResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.Entries = nil
+	resp.NextCursor = ""
+	if s == nil || s.Impl == nil || args == nil {
+		return nil
+	}
+	// Backends without KVScanner are scanned with Iterate, see api.ScanPage.
+	page, err := api.ScanPage(s.Impl, args.Scan)
+	if err != nil {
+		return err
+	}
+	resp.Entries = page.Entries
+	resp.NextCursor = page.NextCursor
+	return nil
+}
+
 func (s *KeyValueDBRPCServer) Iterate(args *KeyValueDBIterateArgs, resp *BoolResp) error {
 	if resp == nil {
 		return nil
@@ -91,7 +214,7 @@ func (s *KeyValueDBRPCServer) Iterate(args *KeyValueDBIterateArgs, resp *BoolRes
 	if err != nil || conn == nil {
 		return err
 	}
-	client := rpc.NewClient(conn)
+	client := newCallbackRPCConn(conn, s.broker, ServiceKeyValueDB)
 	defer client.Close()
 
 	err = s.Impl.Iterate(func(key, value string) bool {
@@ -109,6 +232,180 @@ func (s *KeyValueDBRPCServer) Iterate(args *KeyValueDBIterateArgs, resp *BoolRes
 	return nil
 }
 
+func (s *KeyValueDBRPCServer) Watch(args *KeyValueDBWatchArgs, resp *KeyValueDBWatchResp) error {
+	if s == nil || s.Impl == nil || args == nil || resp == nil {
+		return nil
+	}
+	if s.broker == nil || args.CallbackBrokerID == 0 {
+		return errors.New("KeyValueDBRPCServer.Watch: callback broker id is 0")
+	}
+
+	conn, err := s.broker.Dial(args.CallbackBrokerID)
+	if err != nil {
+		return err
+	}
+	cb := &keyValueDBWatchCallbackClient{c: newCallbackRPCConn(conn, s.broker, ServiceKeyValueDB)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := watchKeyValueDB(ctx, s.Impl, args.Prefix)
+	if err != nil {
+		cancel()
+		cb.Stop()
+		_ = cb.Close()
+		return err
+	}
+
+	watchID := fmt.Sprintf("watch:%d", atomic.AddUint64(&s.seq, 1))
+
+	s.mu.Lock()
+	if s.watches == nil {
+		s.watches = make(map[string]context.CancelFunc)
+	}
+	if s.callbacks == nil {
+		s.callbacks = make(map[string]*keyValueDBWatchCallbackClient)
+	}
+	s.watches[watchID] = cancel
+	s.callbacks[watchID] = cb
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.watches, watchID)
+			delete(s.callbacks, watchID)
+			s.mu.Unlock()
+
+			cancel()
+			cb.Stop()
+			_ = cb.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case change, ok := <-changes:
+				if !ok {
+					return
+				}
+				if err := cb.OnChange(change); err != nil {
+					// The plugin is gone; the deferred cleanup cancels the watch.
+					return
+				}
+			}
+		}
+	}()
+
+	resp.WatchID = watchID
+	return nil
+}
+
+// watchKeyValueDB watches db, or returns api.ErrKVUnsupported when it is not an api.KVWatcher.
+func watchKeyValueDB(ctx context.Context, db api.KeyValueDB, prefix string) (<-chan api.KVChange, error) {
+	w, ok := db.(api.KVWatcher)
+	if !ok {
+		return nil, api.ErrKVUnsupported
+	}
+	return w.Watch(ctx, prefix)
+}
+
+func (s *KeyValueDBRPCServer) Unwatch(args *KeyValueDBUnwatchArgs, resp *BoolResp) error {
+	if s == nil || args == nil || args.WatchID == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	cancel := s.watches[args.WatchID]
+	delete(s.watches, args.WatchID)
+	cb := s.callbacks[args.WatchID]
+	delete(s.callbacks, args.WatchID)
+	s.mu.Unlock()
+
+	ok := false
+	if cancel != nil {
+		cancel()
+		ok = true
+	}
+	if cb != nil {
+		cb.Stop()
+		_ = cb.Close()
+	}
+	if resp != nil {
+		resp.OK = ok
+	}
+	return nil
+}
+
+type keyValueDBWatchCallbackServer struct {
+	mu     sync.Mutex
+	closed bool
+	ch     chan<- api.KVChange
+}
+
+func (s *keyValueDBWatchCallbackServer) OnChange(args *api.KVChange, _ *Empty) error {
+	if s == nil || args == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || s.ch == nil {
+		return nil
+	}
+	select {
+	case s.ch <- *args:
+	default:
+	}
+	return nil
+}
+
+func (s *keyValueDBWatchCallbackServer) Stop(_ *Empty, _ *Empty) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+	return nil
+}
+
+type keyValueDBWatchCallbackClient struct {
+	c  *rpcConn
+	mu sync.Mutex
+}
+
+func (c *keyValueDBWatchCallbackClient) Close() error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.Close()
+}
+
+func (c *keyValueDBWatchCallbackClient) OnChange(change api.KVChange) error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.Call("Plugin.OnChange", &change, &Empty{})
+}
+
+func (c *keyValueDBWatchCallbackClient) Stop() {
+	if c == nil || c.c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.c.Call("Plugin.Stop", &Empty{}, &Empty{})
+}
+
 type keyValueDBIterateCallbackRPCServer struct {
 	Handler func(key, value string) bool
 }
@@ -125,17 +422,30 @@ func (s *keyValueDBIterateCallbackRPCServer) OnEntry(args *KeyValueDBIterateEntr
 	return nil
 }
 
+// kvRPCError restores api.ErrKVUnsupported from the error of a host call, also for hosts that
+// predate the method.
+func kvRPCError(err error) error {
+	if se, ok := err.(rpc.ServerError); ok && (isUnknownRPCMethod(err) || string(se) == api.ErrKVUnsupported.Error()) {
+		return api.ErrKVUnsupported
+	}
+	return err
+}
+
+// keyValueDBRPCClient implements every optional KeyValueDB interface; the calls the host's
+// backend does not support return api.ErrKVUnsupported.
 type keyValueDBRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
+	// noScan is set once the host turned out not to know Plugin.Scan.
+	noScan atomic.Bool
 }
 
 func newKeyValueDBRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.KeyValueDB {
 	if conn == nil {
 		return nil
 	}
-	return &keyValueDBRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &keyValueDBRPCClient{c: newRPCConn(conn, broker, ServiceKeyValueDB), broker: broker}
 }
 
 func (c *keyValueDBRPCClient) Get(key string) (string, bool, error) {
@@ -161,6 +471,28 @@ func (c *keyValueDBRPCClient) Set(key, value string) error {
 	return c.c.Call("Plugin.Set", &KeyValueDBSetArgs{Key: key, Value: value}, &Empty{})
 }
 
+func (c *keyValueDBRPCClient) SetWithTTL(key, value string, ttl time.Duration) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBRPCClient: client is not initialised")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return kvRPCError(c.c.Call("Plugin.SetWithTTL", &KeyValueDBSetWithTTLArgs{Key: key, Value: value, TTL: ttl}, &Empty{}))
+}
+
+func (c *keyValueDBRPCClient) TTL(key string) (time.Duration, bool, error) {
+	if c == nil || c.c == nil {
+		return 0, false, errors.New("keyValueDBRPCClient: client is not initialised")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var resp KeyValueDBTTLResp
+	if err := c.c.Call("Plugin.TTL", &KeyValueDBGetArgs{Key: key}, &resp); err != nil {
+		return 0, false, kvRPCError(err)
+	}
+	return resp.TTL, resp.OK, nil
+}
+
 func (c *keyValueDBRPCClient) Delete(key string) error {
 	if c == nil || c.c == nil {
 		return errors.New("keyValueDBRPCClient: client is not initialised")
@@ -170,6 +502,36 @@ func (c *keyValueDBRPCClient) Delete(key string) error {
 	return c.c.Call("Plugin.Delete", &KeyValueDBDeleteArgs{Key: key}, &Empty{})
 }
 
+func (c *keyValueDBRPCClient) Batch(b api.KVBatch) error {
+	if c == nil || c.c == nil {
+		return errors.New("keyValueDBRPCClient: client is not initialised")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var resp KeyValueDBBatchResp
+	if err := c.c.Call("Plugin.Batch", &KeyValueDBBatchArgs{Batch: b}, &resp); err != nil {
+		return kvRPCError(err)
+	}
+	if resp.Conflict {
+		return api.ErrKVConflict
+	}
+	return nil
+}
+
+func (c *keyValueDBRPCClient) Scan(q api.KVScan) (api.KVPage, error) {
+	if c == nil || c.c == nil {
+		return api.KVPage{}, errors.New("keyValueDBRPCClient: client is not initialised")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var resp KeyValueDBScanResp
+	if err := c.c.Call("Plugin.Scan", &KeyValueDBScanArgs{Scan: q}, &resp); err != nil {
+		return api.KVPage{}, kvRPCError(err)
+	}
+	return api.KVPage{Entries: resp.Entries, NextCursor: resp.NextCursor}, nil
+}
+
+// Iterate fetches the entries a page per call, or one callback per entry from hosts without Scan.
 func (c *keyValueDBRPCClient) Iterate(fn func(key, value string) bool) error {
 	if c == nil || c.c == nil {
 		return errors.New("keyValueDBRPCClient: client is not initialised")
@@ -177,12 +539,34 @@ func (c *keyValueDBRPCClient) Iterate(fn func(key, value string) bool) error {
 	if fn == nil {
 		return nil
 	}
+	if !c.noScan.Load() {
+		q := api.KVScan{Limit: api.MaxKVScanLimit}
+		for {
+			page, err := c.Scan(q)
+			if errors.Is(err, api.ErrKVUnsupported) && q.Cursor == "" {
+				c.noScan.Store(true)
+				break
+			}
+			if err != nil {
+				return err
+			}
+			for _, e := range page.Entries {
+				if !fn(e.Key, e.Value) {
+					return nil
+				}
+			}
+			if page.NextCursor == "" {
+				return nil
+			}
+			q.Cursor = page.NextCursor
+		}
+	}
 	if c.broker == nil {
 		return errors.New("keyValueDBRPCClient.Iterate: broker unavailable")
 	}
 
 	callbackID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, callbackID, &keyValueDBIterateCallbackRPCServer{Handler: fn})
+	go acceptAndServeCallback(c.broker, callbackID, ServiceKeyValueDB, &keyValueDBIterateCallbackRPCServer{Handler: fn})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -190,6 +574,49 @@ func (c *keyValueDBRPCClient) Iterate(fn func(key, value string) bool) error {
 	return c.c.Call("Plugin.Iterate", &KeyValueDBIterateArgs{CallbackBrokerID: callbackID}, &resp)
 }
 
+func (c *keyValueDBRPCClient) Watch(ctx context.Context, prefix string) (<-chan api.KVChange, error) {
+	if c == nil || c.c == nil || c.broker == nil {
+		return nil, errors.New("keyValueDBRPCClient.Watch: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	out := make(chan api.KVChange, 256)
+	cbID := c.broker.NextId()
+	cbSrv := &keyValueDBWatchCallbackServer{ch: out}
+	go acceptAndServeCallback(c.broker, cbID, ServiceKeyValueDB, cbSrv)
+
+	c.mu.Lock()
+	var resp KeyValueDBWatchResp
+	err := c.c.Call("Plugin.Watch", &KeyValueDBWatchArgs{Prefix: prefix, CallbackBrokerID: cbID}, &resp)
+	c.mu.Unlock()
+	if err != nil {
+		_ = cbSrv.Stop(&Empty{}, &Empty{})
+		return nil, kvRPCError(err)
+	}
+	if resp.WatchID == "" {
+		_ = cbSrv.Stop(&Empty{}, &Empty{})
+		return nil, errors.New("keyValueDBRPCClient.Watch: empty watch id")
+	}
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			c.mu.Lock()
+			_ = c.c.Call("Plugin.Unwatch", &KeyValueDBUnwatchArgs{WatchID: resp.WatchID}, &BoolResp{})
+			c.mu.Unlock()
+			_ = cbSrv.Stop(&Empty{}, &Empty{})
+		})
+	}
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return out, nil
+}
+
 func (c *keyValueDBRPCClient) MigrateTo(dst api.KeyValueDB) error {
 	if dst == nil {
 		return errors.New("keyValueDBRPCClient.MigrateTo: dst is nil")
@@ -213,3 +640,11 @@ func (c *keyValueDBRPCClient) Close() error {
 	defer c.mu.Unlock()
 	return c.c.Close()
 }
+
+var (
+	_ api.KeyValueDB = (*keyValueDBRPCClient)(nil)
+	_ api.KVBatcher  = (*keyValueDBRPCClient)(nil)
+	_ api.KVScanner  = (*keyValueDBRPCClient)(nil)
+	_ api.KVWatcher  = (*keyValueDBRPCClient)(nil)
+	_ api.KVExpirer  = (*keyValueDBRPCClient)(nil)
+)
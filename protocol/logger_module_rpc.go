@@ -1,9 +1,15 @@
 package protocol
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net"
-	"net/rpc"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
 
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
@@ -23,8 +29,205 @@ type LoggerScopeMsgArgs struct {
 	Msg   string
 }
 
+// LoggerFieldArg is an api.LogField on the wire. Kind is "" for nil, or one of "string", "int",
+// "uint", "float", "bool" and "time" naming the value field that is set.
+type LoggerFieldArg struct {
+	Key    string
+	Kind   string
+	String string
+	Int    int64
+	Uint   uint64
+	Float  float64
+	Bool   bool
+	Time   time.Time
+}
+
+type LoggerLogRecordArgs struct {
+	Time   time.Time
+	Scope  string
+	Level  api.Level
+	Msg    string
+	Err    string
+	Fields []LoggerFieldArg
+}
+
+func loggerRecordArgs(r api.LogRecord) LoggerLogRecordArgs {
+	return LoggerLogRecordArgs{
+		Time:   r.Time,
+		Scope:  r.Scope,
+		Level:  r.Level,
+		Msg:    r.Msg,
+		Err:    r.Err,
+		Fields: loggerFieldArgs(r.Fields),
+	}
+}
+
+func (a *LoggerLogRecordArgs) record() api.LogRecord {
+	return api.LogRecord{
+		Time:   a.Time,
+		Scope:  a.Scope,
+		Level:  a.Level,
+		Msg:    a.Msg,
+		Err:    a.Err,
+		Fields: apiLogFields(a.Fields),
+	}
+}
+
+func loggerFieldArgs(fields []api.LogField) []LoggerFieldArg {
+	if len(fields) == 0 {
+		return nil
+	}
+	out := make([]LoggerFieldArg, len(fields))
+	for i, f := range fields {
+		arg := LoggerFieldArg{Key: f.Key}
+		switch v := api.LogAny(f.Key, f.Value).Value.(type) {
+		case string:
+			arg.Kind, arg.String = "string", v
+		case int64:
+			arg.Kind, arg.Int = "int", v
+		case uint64:
+			arg.Kind, arg.Uint = "uint", v
+		case float64:
+			arg.Kind, arg.Float = "float", v
+		case bool:
+			arg.Kind, arg.Bool = "bool", v
+		case time.Time:
+			arg.Kind, arg.Time = "time", v
+		}
+		out[i] = arg
+	}
+	return out
+}
+
+func apiLogFields(args []LoggerFieldArg) []api.LogField {
+	if len(args) == 0 {
+		return nil
+	}
+	out := make([]api.LogField, len(args))
+	for i, a := range args {
+		f := api.LogField{Key: a.Key}
+		switch a.Kind {
+		case "string":
+			f.Value = a.String
+		case "int":
+			f.Value = a.Int
+		case "uint":
+			f.Value = a.Uint
+		case "float":
+			f.Value = a.Float
+		case "bool":
+			f.Value = a.Bool
+		case "time":
+			f.Value = a.Time
+		}
+		out[i] = f
+	}
+	return out
+}
+
+type LoggerQueryArgs struct {
+	Query     api.LogQuery
+	TimeoutMs int64
+}
+
+type LoggerQueryResp struct {
+	Records    []LoggerLogRecordArgs
+	NextCursor string
+}
+
+type LoggerTailArgs struct {
+	Query            api.LogQuery
+	CallbackBrokerID uint32
+}
+
+type LoggerTailResp struct {
+	SubID string
+}
+
+type LoggerUntailArgs struct {
+	SubID string
+}
+
+type loggerTailCallbackServer struct {
+	mu     sync.Mutex
+	closed bool
+	ch     chan<- api.LogRecord
+}
+
+func (s *loggerTailCallbackServer) OnRecord(args *LoggerLogRecordArgs, _ *Empty) error {
+	if s == nil || args == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || s.ch == nil {
+		return nil
+	}
+	select {
+	case s.ch <- args.record():
+	default:
+	}
+	return nil
+}
+
+func (s *loggerTailCallbackServer) Stop(_ *Empty, _ *Empty) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+	return nil
+}
+
+type loggerTailCallbackClient struct {
+	c  *rpcConn
+	mu sync.Mutex
+}
+
+func (c *loggerTailCallbackClient) Close() error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.Close()
+}
+
+func (c *loggerTailCallbackClient) OnRecord(record api.LogRecord) error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	args := loggerRecordArgs(record)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.c.Call("Plugin.OnRecord", &args, &Empty{})
+}
+
+func (c *loggerTailCallbackClient) Stop() {
+	if c == nil || c.c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.c.Call("Plugin.Stop", &Empty{}, &Empty{})
+}
+
 type LoggerModuleRPCServer struct {
-	Impl api.LoggerModule
+	Impl   api.LoggerModule
+	broker *plugin.MuxBroker
+
+	mu        sync.Mutex
+	tails     map[string]context.CancelFunc
+	callbacks map[string]*loggerTailCallbackClient
+	seq       uint64
 }
 
 func (s *LoggerModuleRPCServer) Name(_ *Empty, resp *LoggerModuleNameResp) error {
@@ -47,6 +250,128 @@ func (s *LoggerModuleRPCServer) Log(args *LoggerLogArgs, _ *Empty) error {
 	return nil
 }
 
+func (s *LoggerModuleRPCServer) LogRecord(args *LoggerLogRecordArgs, _ *Empty) error {
+	if s == nil || s.Impl == nil || args == nil {
+		return nil
+	}
+	s.Impl.LogRecord(args.record())
+	return nil
+}
+
+func (s *LoggerModuleRPCServer) QueryLogs(ctx context.Context, args *LoggerQueryArgs, resp *LoggerQueryResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.Records = nil
+	resp.NextCursor = ""
+	if s == nil || s.Impl == nil || args == nil {
+		return nil
+	}
+	ctx, cancel := ctxWithTimeoutMs(ctx, args.TimeoutMs)
+	defer cancel()
+	page, err := s.Impl.QueryLogs(ctx, args.Query)
+	if err != nil {
+		return err
+	}
+	resp.Records = make([]LoggerLogRecordArgs, len(page.Records))
+	for i, r := range page.Records {
+		resp.Records[i] = loggerRecordArgs(r)
+	}
+	resp.NextCursor = page.NextCursor
+	return nil
+}
+
+func (s *LoggerModuleRPCServer) TailLogs(args *LoggerTailArgs, resp *LoggerTailResp) error {
+	if s == nil || s.Impl == nil || s.broker == nil || args == nil || resp == nil {
+		return nil
+	}
+	if args.CallbackBrokerID == 0 {
+		return errors.New("LoggerModuleRPCServer.TailLogs: callback broker id is 0")
+	}
+
+	conn, err := s.broker.Dial(args.CallbackBrokerID)
+	if err != nil {
+		return err
+	}
+	cb := &loggerTailCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameLoggerModule)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	records, err := s.Impl.TailLogs(ctx, args.Query)
+	if err != nil {
+		cancel()
+		cb.Stop()
+		_ = cb.Close()
+		return err
+	}
+
+	subID := fmt.Sprintf("tail:%d", atomic.AddUint64(&s.seq, 1))
+
+	s.mu.Lock()
+	if s.tails == nil {
+		s.tails = make(map[string]context.CancelFunc)
+	}
+	if s.callbacks == nil {
+		s.callbacks = make(map[string]*loggerTailCallbackClient)
+	}
+	s.tails[subID] = cancel
+	s.callbacks[subID] = cb
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.tails, subID)
+			delete(s.callbacks, subID)
+			s.mu.Unlock()
+
+			cancel()
+			cb.Stop()
+			_ = cb.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case record, ok := <-records:
+				if !ok {
+					return
+				}
+				_ = cb.OnRecord(record)
+			}
+		}
+	}()
+
+	resp.SubID = subID
+	return nil
+}
+
+func (s *LoggerModuleRPCServer) UntailLogs(args *LoggerUntailArgs, resp *BoolResp) error {
+	if s == nil || args == nil || args.SubID == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	cancel := s.tails[args.SubID]
+	delete(s.tails, args.SubID)
+	cb := s.callbacks[args.SubID]
+	delete(s.callbacks, args.SubID)
+	s.mu.Unlock()
+
+	ok := false
+	if cancel != nil {
+		cancel()
+		ok = true
+	}
+	if cb != nil {
+		cb.Stop()
+		_ = cb.Close()
+	}
+	if resp != nil {
+		resp.OK = ok
+	}
+	return nil
+}
+
 func (s *LoggerModuleRPCServer) Info(args *LoggerScopeMsgArgs, _ *Empty) error {
 	if s == nil || s.Impl == nil || args == nil {
 		return nil
@@ -80,15 +405,18 @@ func (s *LoggerModuleRPCServer) Success(args *LoggerScopeMsgArgs, _ *Empty) erro
 }
 
 type loggerModuleRPCClient struct {
-	c  *rpc.Client
-	mu sync.Mutex
+	c      *rpcConn
+	broker *plugin.MuxBroker
+	mu     sync.Mutex
+	// flat is set once the host turned out not to know Plugin.LogRecord.
+	flat atomic.Bool
 }
 
-func newLoggerModuleRPCClient(conn net.Conn) api.LoggerModule {
+func newLoggerModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.LoggerModule {
 	if conn == nil {
 		return nil
 	}
-	return &loggerModuleRPCClient{c: rpc.NewClient(conn)}
+	return &loggerModuleRPCClient{c: newRPCConn(conn, broker, api.NameLoggerModule), broker: broker}
 }
 
 func (c *loggerModuleRPCClient) Name() string { return api.NameLoggerModule }
@@ -102,6 +430,26 @@ func (c *loggerModuleRPCClient) Log(scope string, level api.Level, msg string) {
 	_ = c.c.Call("Plugin.Log", &LoggerLogArgs{Scope: scope, Level: level, Msg: msg}, &Empty{})
 }
 
+func (c *loggerModuleRPCClient) LogRecord(record api.LogRecord) {
+	if c == nil || c.c == nil {
+		return
+	}
+	if record.Time.IsZero() {
+		record.Time = time.Now()
+	}
+	if !c.flat.Load() {
+		args := loggerRecordArgs(record)
+		c.mu.Lock()
+		err := c.c.Call("Plugin.LogRecord", &args, &Empty{})
+		c.mu.Unlock()
+		if !isUnknownRPCMethod(err) {
+			return
+		}
+		c.flat.Store(true)
+	}
+	c.Log(record.Scope, record.Level, record.Text())
+}
+
 func (c *loggerModuleRPCClient) Info(scope, msg string) {
 	if c == nil || c.c == nil {
 		return
@@ -138,4 +486,67 @@ func (c *loggerModuleRPCClient) Success(scope, msg string) {
 	_ = c.c.Call("Plugin.Success", &LoggerScopeMsgArgs{Scope: scope, Msg: msg}, &Empty{})
 }
 
+func (c *loggerModuleRPCClient) QueryLogs(ctx context.Context, q api.LogQuery) (api.LogPage, error) {
+	if c == nil || c.c == nil {
+		return api.LogPage{}, errors.New("loggerModuleRPCClient.QueryLogs: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var resp LoggerQueryResp
+	if err := c.c.CallContext(ctx, "Plugin.QueryLogs", &LoggerQueryArgs{Query: q, TimeoutMs: timeoutMsFromCtx(ctx)}, &resp); err != nil {
+		return api.LogPage{}, err
+	}
+	page := api.LogPage{Records: make([]api.LogRecord, len(resp.Records)), NextCursor: resp.NextCursor}
+	for i := range resp.Records {
+		page.Records[i] = resp.Records[i].record()
+	}
+	return page, nil
+}
+
+func (c *loggerModuleRPCClient) TailLogs(ctx context.Context, q api.LogQuery) (<-chan api.LogRecord, error) {
+	if c == nil || c.c == nil || c.broker == nil {
+		return nil, errors.New("loggerModuleRPCClient.TailLogs: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	out := make(chan api.LogRecord, 256)
+	cbID := c.broker.NextId()
+	cbSrv := &loggerTailCallbackServer{ch: out}
+	go acceptAndServeCallback(c.broker, cbID, api.NameLoggerModule, cbSrv)
+
+	c.mu.Lock()
+	var resp LoggerTailResp
+	err := c.c.Call("Plugin.TailLogs", &LoggerTailArgs{Query: q, CallbackBrokerID: cbID}, &resp)
+	c.mu.Unlock()
+	if err != nil {
+		_ = cbSrv.Stop(&Empty{}, &Empty{})
+		return nil, err
+	}
+	if resp.SubID == "" {
+		_ = cbSrv.Stop(&Empty{}, &Empty{})
+		return nil, errors.New("loggerModuleRPCClient.TailLogs: empty sub id")
+	}
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			c.mu.Lock()
+			_ = c.c.Call("Plugin.UntailLogs", &LoggerUntailArgs{SubID: resp.SubID}, &BoolResp{})
+			c.mu.Unlock()
+			_ = cbSrv.Stop(&Empty{}, &Empty{})
+		})
+	}
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return out, nil
+}
+
 var _ api.LoggerModule = (*loggerModuleRPCClient)(nil)
@@ -0,0 +1,185 @@
+package protocol
+
+import (
+	"context"
+	"maps"
+	"net/url"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"weak"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc/metadata"
+)
+
+// CallMetadata is string data sent along with RPC calls, such as trace context.
+// Keys are lower case.
+type CallMetadata map[string]string
+
+type outgoingMetadataKey struct{}
+
+type incomingMetadataKey struct{}
+
+// AppendCallMetadata returns a copy of ctx whose outgoing calls carry the key/value pairs kv in
+// addition to the metadata already in ctx. An odd trailing key is ignored.
+//
+// Metadata reaches the server interceptors of the peer through IncomingCallMetadata. Over
+// net/rpc it is only sent through calls that take a context (client interceptors, Load/Unload,
+// FlexModule.Call, ...); peers built with an older SDK ignore it.
+func AppendCallMetadata(ctx context.Context, kv ...string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	md := OutgoingCallMetadata(ctx)
+	if md == nil {
+		md = CallMetadata{}
+	}
+	for i := 0; i+1 < len(kv); i += 2 {
+		md[strings.ToLower(kv[i])] = kv[i+1]
+	}
+	return context.WithValue(ctx, outgoingMetadataKey{}, md)
+}
+
+// OutgoingCallMetadata returns a copy of the metadata outgoing calls made with ctx carry.
+func OutgoingCallMetadata(ctx context.Context) CallMetadata {
+	if ctx == nil {
+		return nil
+	}
+	md, _ := ctx.Value(outgoingMetadataKey{}).(CallMetadata)
+	return maps.Clone(md)
+}
+
+// IncomingCallMetadata returns a copy of the metadata sent with the call being served. It is
+// available to server interceptors and to Load/Unload.
+func IncomingCallMetadata(ctx context.Context) CallMetadata {
+	if ctx == nil {
+		return nil
+	}
+	md, _ := ctx.Value(incomingMetadataKey{}).(CallMetadata)
+	return maps.Clone(md)
+}
+
+func withIncomingCallMetadata(ctx context.Context, md CallMetadata) context.Context {
+	if len(md) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, incomingMetadataKey{}, md)
+}
+
+// net/rpc carries metadata as a query after the method name ("Plugin.Load?traceparent=...").
+
+func encodeMethodMetadata(serviceMethod string, md CallMetadata) string {
+	q := url.Values{}
+	for k, v := range md {
+		q.Set(k, v)
+	}
+	return serviceMethod + "?" + q.Encode()
+}
+
+func decodeMethodMetadata(serviceMethod string) (string, CallMetadata) {
+	name, query, ok := strings.Cut(serviceMethod, "?")
+	if !ok {
+		return name, nil
+	}
+	q, err := url.ParseQuery(query)
+	if err != nil {
+		return name, nil
+	}
+	md := CallMetadata{}
+	for k := range q {
+		md[k] = q.Get(k)
+	}
+	return name, md
+}
+
+// gRPC carries metadata as headers with this prefix.
+const grpcMetadataPrefix = "tempest-md-"
+
+func appendGRPCMetadata(ctx context.Context, md CallMetadata) context.Context {
+	if len(md) == 0 {
+		return ctx
+	}
+	kv := make([]string, 0, 2*len(md))
+	for k, v := range md {
+		kv = append(kv, grpcMetadataPrefix+k, v)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+func grpcIncomingMetadata(ctx context.Context) CallMetadata {
+	in, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	var md CallMetadata
+	for k, v := range in {
+		if key, ok := strings.CutPrefix(k, grpcMetadataPrefix); ok && len(v) > 0 {
+			if md == nil {
+				md = CallMetadata{}
+			}
+			md[key] = v[0]
+		}
+	}
+	return md
+}
+
+// brokerPlugins maps the broker of a plugin connection to the plugin ID, for CallInfo.PluginID.
+// Entries are dropped when the broker is garbage collected.
+var brokerPlugins sync.Map // weak.Pointer[plugin.MuxBroker] -> string
+
+func setBrokerPluginID(broker *plugin.MuxBroker, id string) {
+	if broker == nil || id == "" {
+		return
+	}
+	key := weak.Make(broker)
+	if _, loaded := brokerPlugins.Swap(key, id); !loaded {
+		runtime.AddCleanup(broker, func(key weak.Pointer[plugin.MuxBroker]) { brokerPlugins.Delete(key) }, key)
+	}
+}
+
+func brokerPluginID(broker *plugin.MuxBroker) string {
+	if broker == nil {
+		return ""
+	}
+	id, _ := brokerPlugins.Load(weak.Make(broker))
+	s, _ := id.(string)
+	return s
+}
+
+type callPluginIDKey struct{}
+
+// withCallPluginID labels the calls made with ctx on connections that do not know their plugin.
+func withCallPluginID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, callPluginIDKey{}, id)
+}
+
+func callPluginID(ctx context.Context) string {
+	id, _ := ctx.Value(callPluginIDKey{}).(string)
+	return id
+}
+
+// pluginIdentity holds the ID of a served plugin once Init ran.
+type pluginIdentity struct {
+	id atomic.Pointer[string]
+}
+
+func (p *pluginIdentity) set(id string) {
+	if p != nil && id != "" {
+		p.id.Store(&id)
+	}
+}
+
+func (p *pluginIdentity) get() string {
+	if p == nil {
+		return ""
+	}
+	if id := p.id.Load(); id != nil {
+		return *id
+	}
+	return ""
+}
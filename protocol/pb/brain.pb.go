@@ -0,0 +1,1099 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/brain.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnableDaemonRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config *structpb.Struct       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// timeout <= 0 (or unset) waits forever.
+	Timeout       *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableDaemonRequest) Reset() {
+	*x = EnableDaemonRequest{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableDaemonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableDaemonRequest) ProtoMessage() {}
+
+func (x *EnableDaemonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableDaemonRequest.ProtoReflect.Descriptor instead.
+func (*EnableDaemonRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnableDaemonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnableDaemonRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *EnableDaemonRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type EnableDaemonResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ActualConfig *structpb.Struct       `protobuf:"bytes,1,opt,name=actual_config,json=actualConfig,proto3" json:"actual_config,omitempty"`
+	DaemonExists bool                   `protobuf:"varint,2,opt,name=daemon_exists,json=daemonExists,proto3" json:"daemon_exists,omitempty"`
+	// daemon_kind is "scoreboard", "chunk" or empty for a plain daemon.
+	DaemonKind    string `protobuf:"bytes,3,opt,name=daemon_kind,json=daemonKind,proto3" json:"daemon_kind,omitempty"`
+	DaemonHandle  string `protobuf:"bytes,4,opt,name=daemon_handle,json=daemonHandle,proto3" json:"daemon_handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableDaemonResponse) Reset() {
+	*x = EnableDaemonResponse{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableDaemonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableDaemonResponse) ProtoMessage() {}
+
+func (x *EnableDaemonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableDaemonResponse.ProtoReflect.Descriptor instead.
+func (*EnableDaemonResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnableDaemonResponse) GetActualConfig() *structpb.Struct {
+	if x != nil {
+		return x.ActualConfig
+	}
+	return nil
+}
+
+func (x *EnableDaemonResponse) GetDaemonExists() bool {
+	if x != nil {
+		return x.DaemonExists
+	}
+	return false
+}
+
+func (x *EnableDaemonResponse) GetDaemonKind() string {
+	if x != nil {
+		return x.DaemonKind
+	}
+	return ""
+}
+
+func (x *EnableDaemonResponse) GetDaemonHandle() string {
+	if x != nil {
+		return x.DaemonHandle
+	}
+	return ""
+}
+
+type DisableDaemonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableDaemonRequest) Reset() {
+	*x = DisableDaemonRequest{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableDaemonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableDaemonRequest) ProtoMessage() {}
+
+func (x *DisableDaemonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableDaemonRequest.ProtoReflect.Descriptor instead.
+func (*DisableDaemonRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DisableDaemonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DisableDaemonRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type DaemonRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DaemonHandle  string                 `protobuf:"bytes,1,opt,name=daemon_handle,json=daemonHandle,proto3" json:"daemon_handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaemonRef) Reset() {
+	*x = DaemonRef{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaemonRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonRef) ProtoMessage() {}
+
+func (x *DaemonRef) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonRef.ProtoReflect.Descriptor instead.
+func (*DaemonRef) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DaemonRef) GetDaemonHandle() string {
+	if x != nil {
+		return x.DaemonHandle
+	}
+	return ""
+}
+
+type DaemonNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaemonNameResponse) Reset() {
+	*x = DaemonNameResponse{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaemonNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonNameResponse) ProtoMessage() {}
+
+func (x *DaemonNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonNameResponse.ProtoReflect.Descriptor instead.
+func (*DaemonNameResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DaemonNameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DaemonReConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DaemonHandle  string                 `protobuf:"bytes,1,opt,name=daemon_handle,json=daemonHandle,proto3" json:"daemon_handle,omitempty"`
+	Config        *structpb.Struct       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaemonReConfigRequest) Reset() {
+	*x = DaemonReConfigRequest{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaemonReConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonReConfigRequest) ProtoMessage() {}
+
+func (x *DaemonReConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonReConfigRequest.ProtoReflect.Descriptor instead.
+func (*DaemonReConfigRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DaemonReConfigRequest) GetDaemonHandle() string {
+	if x != nil {
+		return x.DaemonHandle
+	}
+	return ""
+}
+
+func (x *DaemonReConfigRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PlayerScores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        map[string]int64       `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerScores) Reset() {
+	*x = PlayerScores{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerScores) ProtoMessage() {}
+
+func (x *PlayerScores) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerScores.ProtoReflect.Descriptor instead.
+func (*PlayerScores) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlayerScores) GetScores() map[string]int64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type ScoreUpdateEvent struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ListenerId    string                   `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Scores        map[string]*PlayerScores `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Players       map[string]string        `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Scoreboards   map[string]string        `protobuf:"bytes,4,rep,name=scoreboards,proto3" json:"scoreboards,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreUpdateEvent) Reset() {
+	*x = ScoreUpdateEvent{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreUpdateEvent) ProtoMessage() {}
+
+func (x *ScoreUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ScoreUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScoreUpdateEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *ScoreUpdateEvent) GetScores() map[string]*PlayerScores {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ScoreUpdateEvent) GetPlayers() map[string]string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ScoreUpdateEvent) GetScoreboards() map[string]string {
+	if x != nil {
+		return x.Scoreboards
+	}
+	return nil
+}
+
+type QueryScoreByPlayerUUIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DaemonHandle  string                 `protobuf:"bytes,1,opt,name=daemon_handle,json=daemonHandle,proto3" json:"daemon_handle,omitempty"`
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryScoreByPlayerUUIDRequest) Reset() {
+	*x = QueryScoreByPlayerUUIDRequest{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryScoreByPlayerUUIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryScoreByPlayerUUIDRequest) ProtoMessage() {}
+
+func (x *QueryScoreByPlayerUUIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryScoreByPlayerUUIDRequest.ProtoReflect.Descriptor instead.
+func (*QueryScoreByPlayerUUIDRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryScoreByPlayerUUIDRequest) GetDaemonHandle() string {
+	if x != nil {
+		return x.DaemonHandle
+	}
+	return ""
+}
+
+func (x *QueryScoreByPlayerUUIDRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type PlayerScoreQueryResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ScoreboardName string                 `protobuf:"bytes,1,opt,name=scoreboard_name,json=scoreboardName,proto3" json:"scoreboard_name,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Score          int64                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerScoreQueryResult) Reset() {
+	*x = PlayerScoreQueryResult{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerScoreQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerScoreQueryResult) ProtoMessage() {}
+
+func (x *PlayerScoreQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerScoreQueryResult.ProtoReflect.Descriptor instead.
+func (*PlayerScoreQueryResult) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlayerScoreQueryResult) GetScoreboardName() string {
+	if x != nil {
+		return x.ScoreboardName
+	}
+	return ""
+}
+
+func (x *PlayerScoreQueryResult) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PlayerScoreQueryResult) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type PlayerScoreQueryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// present is false when the daemon returned nil.
+	Present       bool                      `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"`
+	Results       []*PlayerScoreQueryResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerScoreQueryResponse) Reset() {
+	*x = PlayerScoreQueryResponse{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerScoreQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerScoreQueryResponse) ProtoMessage() {}
+
+func (x *PlayerScoreQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerScoreQueryResponse.ProtoReflect.Descriptor instead.
+func (*PlayerScoreQueryResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlayerScoreQueryResponse) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *PlayerScoreQueryResponse) GetResults() []*PlayerScoreQueryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type QueryRankByScoreboardRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DaemonHandle   string                 `protobuf:"bytes,1,opt,name=daemon_handle,json=daemonHandle,proto3" json:"daemon_handle,omitempty"`
+	ScoreboardName string                 `protobuf:"bytes,2,opt,name=scoreboard_name,json=scoreboardName,proto3" json:"scoreboard_name,omitempty"`
+	Descending     bool                   `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	MaxCount       int64                  `protobuf:"varint,4,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryRankByScoreboardRequest) Reset() {
+	*x = QueryRankByScoreboardRequest{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRankByScoreboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRankByScoreboardRequest) ProtoMessage() {}
+
+func (x *QueryRankByScoreboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRankByScoreboardRequest.ProtoReflect.Descriptor instead.
+func (*QueryRankByScoreboardRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryRankByScoreboardRequest) GetDaemonHandle() string {
+	if x != nil {
+		return x.DaemonHandle
+	}
+	return ""
+}
+
+func (x *QueryRankByScoreboardRequest) GetScoreboardName() string {
+	if x != nil {
+		return x.ScoreboardName
+	}
+	return ""
+}
+
+func (x *QueryRankByScoreboardRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *QueryRankByScoreboardRequest) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type RankQueryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Score         int64                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankQueryResult) Reset() {
+	*x = RankQueryResult{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankQueryResult) ProtoMessage() {}
+
+func (x *RankQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankQueryResult.ProtoReflect.Descriptor instead.
+func (*RankQueryResult) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RankQueryResult) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *RankQueryResult) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *RankQueryResult) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type RankQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Present       bool                   `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"`
+	Results       []*RankQueryResult     `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankQueryResponse) Reset() {
+	*x = RankQueryResponse{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankQueryResponse) ProtoMessage() {}
+
+func (x *RankQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankQueryResponse.ProtoReflect.Descriptor instead.
+func (*RankQueryResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RankQueryResponse) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *RankQueryResponse) GetResults() []*RankQueryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ChunkNewChunkEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Dimension     int32                  `protobuf:"varint,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	ChunkX        int32                  `protobuf:"varint,3,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkZ        int32                  `protobuf:"varint,4,opt,name=chunk_z,json=chunkZ,proto3" json:"chunk_z,omitempty"`
+	SubChunks     [][]byte               `protobuf:"bytes,5,rep,name=sub_chunks,json=subChunks,proto3" json:"sub_chunks,omitempty"`
+	Biomes        []byte                 `protobuf:"bytes,6,opt,name=biomes,proto3" json:"biomes,omitempty"`
+	BlockEntities []byte                 `protobuf:"bytes,7,opt,name=block_entities,json=blockEntities,proto3" json:"block_entities,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkNewChunkEvent) Reset() {
+	*x = ChunkNewChunkEvent{}
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkNewChunkEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkNewChunkEvent) ProtoMessage() {}
+
+func (x *ChunkNewChunkEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_brain_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkNewChunkEvent.ProtoReflect.Descriptor instead.
+func (*ChunkNewChunkEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_brain_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChunkNewChunkEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *ChunkNewChunkEvent) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *ChunkNewChunkEvent) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *ChunkNewChunkEvent) GetChunkZ() int32 {
+	if x != nil {
+		return x.ChunkZ
+	}
+	return 0
+}
+
+func (x *ChunkNewChunkEvent) GetSubChunks() [][]byte {
+	if x != nil {
+		return x.SubChunks
+	}
+	return nil
+}
+
+func (x *ChunkNewChunkEvent) GetBiomes() []byte {
+	if x != nil {
+		return x.Biomes
+	}
+	return nil
+}
+
+func (x *ChunkNewChunkEvent) GetBlockEntities() []byte {
+	if x != nil {
+		return x.BlockEntities
+	}
+	return nil
+}
+
+func (x *ChunkNewChunkEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_tempest_dynamic_v1_brain_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_brain_proto_rawDesc = "" +
+	"\n" +
+	"\x1etempest/dynamic/v1/brain.proto\x12\x12tempest.dynamic.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x8f\x01\n" +
+	"\x13EnableDaemonRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x06config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06config\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xbf\x01\n" +
+	"\x14EnableDaemonResponse\x12<\n" +
+	"\ractual_config\x18\x01 \x01(\v2\x17.google.protobuf.StructR\factualConfig\x12#\n" +
+	"\rdaemon_exists\x18\x02 \x01(\bR\fdaemonExists\x12\x1f\n" +
+	"\vdaemon_kind\x18\x03 \x01(\tR\n" +
+	"daemonKind\x12#\n" +
+	"\rdaemon_handle\x18\x04 \x01(\tR\fdaemonHandle\"_\n" +
+	"\x14DisableDaemonRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"0\n" +
+	"\tDaemonRef\x12#\n" +
+	"\rdaemon_handle\x18\x01 \x01(\tR\fdaemonHandle\"(\n" +
+	"\x12DaemonNameResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"m\n" +
+	"\x15DaemonReConfigRequest\x12#\n" +
+	"\rdaemon_handle\x18\x01 \x01(\tR\fdaemonHandle\x12/\n" +
+	"\x06config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06config\"\x8f\x01\n" +
+	"\fPlayerScores\x12D\n" +
+	"\x06scores\x18\x01 \x03(\v2,.tempest.dynamic.v1.PlayerScores.ScoresEntryR\x06scores\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xfc\x03\n" +
+	"\x10ScoreUpdateEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12H\n" +
+	"\x06scores\x18\x02 \x03(\v20.tempest.dynamic.v1.ScoreUpdateEvent.ScoresEntryR\x06scores\x12K\n" +
+	"\aplayers\x18\x03 \x03(\v21.tempest.dynamic.v1.ScoreUpdateEvent.PlayersEntryR\aplayers\x12W\n" +
+	"\vscoreboards\x18\x04 \x03(\v25.tempest.dynamic.v1.ScoreUpdateEvent.ScoreboardsEntryR\vscoreboards\x1a[\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .tempest.dynamic.v1.PlayerScoresR\x05value:\x028\x01\x1a:\n" +
+	"\fPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10ScoreboardsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
+	"\x1dQueryScoreByPlayerUUIDRequest\x12#\n" +
+	"\rdaemon_handle\x18\x01 \x01(\tR\fdaemonHandle\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\"z\n" +
+	"\x16PlayerScoreQueryResult\x12'\n" +
+	"\x0fscoreboard_name\x18\x01 \x01(\tR\x0escoreboardName\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x03R\x05score\"z\n" +
+	"\x18PlayerScoreQueryResponse\x12\x18\n" +
+	"\apresent\x18\x01 \x01(\bR\apresent\x12D\n" +
+	"\aresults\x18\x02 \x03(\v2*.tempest.dynamic.v1.PlayerScoreQueryResultR\aresults\"\xa9\x01\n" +
+	"\x1cQueryRankByScoreboardRequest\x12#\n" +
+	"\rdaemon_handle\x18\x01 \x01(\tR\fdaemonHandle\x12'\n" +
+	"\x0fscoreboard_name\x18\x02 \x01(\tR\x0escoreboardName\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x03 \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tmax_count\x18\x04 \x01(\x03R\bmaxCount\"i\n" +
+	"\x0fRankQueryResult\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x03R\x05score\"l\n" +
+	"\x11RankQueryResponse\x12\x18\n" +
+	"\apresent\x18\x01 \x01(\bR\apresent\x12=\n" +
+	"\aresults\x18\x02 \x03(\v2#.tempest.dynamic.v1.RankQueryResultR\aresults\"\x81\x02\n" +
+	"\x12ChunkNewChunkEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12\x1c\n" +
+	"\tdimension\x18\x02 \x01(\x05R\tdimension\x12\x17\n" +
+	"\achunk_x\x18\x03 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_z\x18\x04 \x01(\x05R\x06chunkZ\x12\x1d\n" +
+	"\n" +
+	"sub_chunks\x18\x05 \x03(\fR\tsubChunks\x12\x16\n" +
+	"\x06biomes\x18\x06 \x01(\fR\x06biomes\x12%\n" +
+	"\x0eblock_entities\x18\a \x01(\fR\rblockEntities\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x03R\ttimestamp2\xc3\x01\n" +
+	"\vBrainModule\x12a\n" +
+	"\fEnableDaemon\x12'.tempest.dynamic.v1.EnableDaemonRequest\x1a(.tempest.dynamic.v1.EnableDaemonResponse\x12Q\n" +
+	"\rDisableDaemon\x12(.tempest.dynamic.v1.DisableDaemonRequest\x1a\x16.google.protobuf.Empty2\xe8\x01\n" +
+	"\x06Daemon\x12M\n" +
+	"\x04Name\x12\x1d.tempest.dynamic.v1.DaemonRef\x1a&.tempest.dynamic.v1.DaemonNameResponse\x12M\n" +
+	"\bReConfig\x12).tempest.dynamic.v1.DaemonReConfigRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x06Config\x12\x1d.tempest.dynamic.v1.DaemonRef\x1a\x17.google.protobuf.Struct2\xda\x02\n" +
+	"\x10ScoreboardDaemon\x12Y\n" +
+	"\x10WatchScoreUpdate\x12\x1d.tempest.dynamic.v1.DaemonRef\x1a$.tempest.dynamic.v1.ScoreUpdateEvent0\x01\x12y\n" +
+	"\x16QueryScoreByPlayerUUID\x121.tempest.dynamic.v1.QueryScoreByPlayerUUIDRequest\x1a,.tempest.dynamic.v1.PlayerScoreQueryResponse\x12p\n" +
+	"\x15QueryRankByScoreboard\x120.tempest.dynamic.v1.QueryRankByScoreboardRequest\x1a%.tempest.dynamic.v1.RankQueryResponse2g\n" +
+	"\vChunkDaemon\x12X\n" +
+	"\rWatchNewChunk\x12\x1d.tempest.dynamic.v1.DaemonRef\x1a&.tempest.dynamic.v1.ChunkNewChunkEvent0\x01B7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_brain_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_brain_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_brain_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_brain_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_brain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_brain_proto_rawDesc), len(file_tempest_dynamic_v1_brain_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_brain_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_brain_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_tempest_dynamic_v1_brain_proto_goTypes = []any{
+	(*EnableDaemonRequest)(nil),           // 0: tempest.dynamic.v1.EnableDaemonRequest
+	(*EnableDaemonResponse)(nil),          // 1: tempest.dynamic.v1.EnableDaemonResponse
+	(*DisableDaemonRequest)(nil),          // 2: tempest.dynamic.v1.DisableDaemonRequest
+	(*DaemonRef)(nil),                     // 3: tempest.dynamic.v1.DaemonRef
+	(*DaemonNameResponse)(nil),            // 4: tempest.dynamic.v1.DaemonNameResponse
+	(*DaemonReConfigRequest)(nil),         // 5: tempest.dynamic.v1.DaemonReConfigRequest
+	(*PlayerScores)(nil),                  // 6: tempest.dynamic.v1.PlayerScores
+	(*ScoreUpdateEvent)(nil),              // 7: tempest.dynamic.v1.ScoreUpdateEvent
+	(*QueryScoreByPlayerUUIDRequest)(nil), // 8: tempest.dynamic.v1.QueryScoreByPlayerUUIDRequest
+	(*PlayerScoreQueryResult)(nil),        // 9: tempest.dynamic.v1.PlayerScoreQueryResult
+	(*PlayerScoreQueryResponse)(nil),      // 10: tempest.dynamic.v1.PlayerScoreQueryResponse
+	(*QueryRankByScoreboardRequest)(nil),  // 11: tempest.dynamic.v1.QueryRankByScoreboardRequest
+	(*RankQueryResult)(nil),               // 12: tempest.dynamic.v1.RankQueryResult
+	(*RankQueryResponse)(nil),             // 13: tempest.dynamic.v1.RankQueryResponse
+	(*ChunkNewChunkEvent)(nil),            // 14: tempest.dynamic.v1.ChunkNewChunkEvent
+	nil,                                   // 15: tempest.dynamic.v1.PlayerScores.ScoresEntry
+	nil,                                   // 16: tempest.dynamic.v1.ScoreUpdateEvent.ScoresEntry
+	nil,                                   // 17: tempest.dynamic.v1.ScoreUpdateEvent.PlayersEntry
+	nil,                                   // 18: tempest.dynamic.v1.ScoreUpdateEvent.ScoreboardsEntry
+	(*structpb.Struct)(nil),               // 19: google.protobuf.Struct
+	(*durationpb.Duration)(nil),           // 20: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                 // 21: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_brain_proto_depIdxs = []int32{
+	19, // 0: tempest.dynamic.v1.EnableDaemonRequest.config:type_name -> google.protobuf.Struct
+	20, // 1: tempest.dynamic.v1.EnableDaemonRequest.timeout:type_name -> google.protobuf.Duration
+	19, // 2: tempest.dynamic.v1.EnableDaemonResponse.actual_config:type_name -> google.protobuf.Struct
+	20, // 3: tempest.dynamic.v1.DisableDaemonRequest.timeout:type_name -> google.protobuf.Duration
+	19, // 4: tempest.dynamic.v1.DaemonReConfigRequest.config:type_name -> google.protobuf.Struct
+	15, // 5: tempest.dynamic.v1.PlayerScores.scores:type_name -> tempest.dynamic.v1.PlayerScores.ScoresEntry
+	16, // 6: tempest.dynamic.v1.ScoreUpdateEvent.scores:type_name -> tempest.dynamic.v1.ScoreUpdateEvent.ScoresEntry
+	17, // 7: tempest.dynamic.v1.ScoreUpdateEvent.players:type_name -> tempest.dynamic.v1.ScoreUpdateEvent.PlayersEntry
+	18, // 8: tempest.dynamic.v1.ScoreUpdateEvent.scoreboards:type_name -> tempest.dynamic.v1.ScoreUpdateEvent.ScoreboardsEntry
+	9,  // 9: tempest.dynamic.v1.PlayerScoreQueryResponse.results:type_name -> tempest.dynamic.v1.PlayerScoreQueryResult
+	12, // 10: tempest.dynamic.v1.RankQueryResponse.results:type_name -> tempest.dynamic.v1.RankQueryResult
+	6,  // 11: tempest.dynamic.v1.ScoreUpdateEvent.ScoresEntry.value:type_name -> tempest.dynamic.v1.PlayerScores
+	0,  // 12: tempest.dynamic.v1.BrainModule.EnableDaemon:input_type -> tempest.dynamic.v1.EnableDaemonRequest
+	2,  // 13: tempest.dynamic.v1.BrainModule.DisableDaemon:input_type -> tempest.dynamic.v1.DisableDaemonRequest
+	3,  // 14: tempest.dynamic.v1.Daemon.Name:input_type -> tempest.dynamic.v1.DaemonRef
+	5,  // 15: tempest.dynamic.v1.Daemon.ReConfig:input_type -> tempest.dynamic.v1.DaemonReConfigRequest
+	3,  // 16: tempest.dynamic.v1.Daemon.Config:input_type -> tempest.dynamic.v1.DaemonRef
+	3,  // 17: tempest.dynamic.v1.ScoreboardDaemon.WatchScoreUpdate:input_type -> tempest.dynamic.v1.DaemonRef
+	8,  // 18: tempest.dynamic.v1.ScoreboardDaemon.QueryScoreByPlayerUUID:input_type -> tempest.dynamic.v1.QueryScoreByPlayerUUIDRequest
+	11, // 19: tempest.dynamic.v1.ScoreboardDaemon.QueryRankByScoreboard:input_type -> tempest.dynamic.v1.QueryRankByScoreboardRequest
+	3,  // 20: tempest.dynamic.v1.ChunkDaemon.WatchNewChunk:input_type -> tempest.dynamic.v1.DaemonRef
+	1,  // 21: tempest.dynamic.v1.BrainModule.EnableDaemon:output_type -> tempest.dynamic.v1.EnableDaemonResponse
+	21, // 22: tempest.dynamic.v1.BrainModule.DisableDaemon:output_type -> google.protobuf.Empty
+	4,  // 23: tempest.dynamic.v1.Daemon.Name:output_type -> tempest.dynamic.v1.DaemonNameResponse
+	21, // 24: tempest.dynamic.v1.Daemon.ReConfig:output_type -> google.protobuf.Empty
+	19, // 25: tempest.dynamic.v1.Daemon.Config:output_type -> google.protobuf.Struct
+	7,  // 26: tempest.dynamic.v1.ScoreboardDaemon.WatchScoreUpdate:output_type -> tempest.dynamic.v1.ScoreUpdateEvent
+	10, // 27: tempest.dynamic.v1.ScoreboardDaemon.QueryScoreByPlayerUUID:output_type -> tempest.dynamic.v1.PlayerScoreQueryResponse
+	13, // 28: tempest.dynamic.v1.ScoreboardDaemon.QueryRankByScoreboard:output_type -> tempest.dynamic.v1.RankQueryResponse
+	14, // 29: tempest.dynamic.v1.ChunkDaemon.WatchNewChunk:output_type -> tempest.dynamic.v1.ChunkNewChunkEvent
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_brain_proto_init() }
+func file_tempest_dynamic_v1_brain_proto_init() {
+	if File_tempest_dynamic_v1_brain_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_brain_proto_rawDesc), len(file_tempest_dynamic_v1_brain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   4,
+		},
+		GoTypes:           file_tempest_dynamic_v1_brain_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_brain_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_brain_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_brain_proto = out.File
+	file_tempest_dynamic_v1_brain_proto_goTypes = nil
+	file_tempest_dynamic_v1_brain_proto_depIdxs = nil
+}
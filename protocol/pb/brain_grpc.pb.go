@@ -0,0 +1,621 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/brain.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BrainModule_EnableDaemon_FullMethodName  = "/tempest.dynamic.v1.BrainModule/EnableDaemon"
+	BrainModule_DisableDaemon_FullMethodName = "/tempest.dynamic.v1.BrainModule/DisableDaemon"
+)
+
+// BrainModuleClient is the client API for BrainModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BrainModuleClient interface {
+	EnableDaemon(ctx context.Context, in *EnableDaemonRequest, opts ...grpc.CallOption) (*EnableDaemonResponse, error)
+	DisableDaemon(ctx context.Context, in *DisableDaemonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type brainModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBrainModuleClient(cc grpc.ClientConnInterface) BrainModuleClient {
+	return &brainModuleClient{cc}
+}
+
+func (c *brainModuleClient) EnableDaemon(ctx context.Context, in *EnableDaemonRequest, opts ...grpc.CallOption) (*EnableDaemonResponse, error) {
+	out := new(EnableDaemonResponse)
+	err := c.cc.Invoke(ctx, BrainModule_EnableDaemon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brainModuleClient) DisableDaemon(ctx context.Context, in *DisableDaemonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BrainModule_DisableDaemon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BrainModuleServer is the server API for BrainModule service.
+// All implementations must embed UnimplementedBrainModuleServer
+// for forward compatibility
+type BrainModuleServer interface {
+	EnableDaemon(context.Context, *EnableDaemonRequest) (*EnableDaemonResponse, error)
+	DisableDaemon(context.Context, *DisableDaemonRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBrainModuleServer()
+}
+
+// UnimplementedBrainModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedBrainModuleServer struct {
+}
+
+func (UnimplementedBrainModuleServer) EnableDaemon(context.Context, *EnableDaemonRequest) (*EnableDaemonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableDaemon not implemented")
+}
+func (UnimplementedBrainModuleServer) DisableDaemon(context.Context, *DisableDaemonRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableDaemon not implemented")
+}
+func (UnimplementedBrainModuleServer) mustEmbedUnimplementedBrainModuleServer() {}
+
+// UnsafeBrainModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrainModuleServer will
+// result in compilation errors.
+type UnsafeBrainModuleServer interface {
+	mustEmbedUnimplementedBrainModuleServer()
+}
+
+func RegisterBrainModuleServer(s grpc.ServiceRegistrar, srv BrainModuleServer) {
+	s.RegisterService(&BrainModule_ServiceDesc, srv)
+}
+
+func _BrainModule_EnableDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableDaemonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainModuleServer).EnableDaemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainModule_EnableDaemon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainModuleServer).EnableDaemon(ctx, req.(*EnableDaemonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrainModule_DisableDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableDaemonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainModuleServer).DisableDaemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainModule_DisableDaemon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainModuleServer).DisableDaemon(ctx, req.(*DisableDaemonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BrainModule_ServiceDesc is the grpc.ServiceDesc for BrainModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BrainModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.BrainModule",
+	HandlerType: (*BrainModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableDaemon",
+			Handler:    _BrainModule_EnableDaemon_Handler,
+		},
+		{
+			MethodName: "DisableDaemon",
+			Handler:    _BrainModule_DisableDaemon_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tempest/dynamic/v1/brain.proto",
+}
+
+const (
+	Daemon_Name_FullMethodName     = "/tempest.dynamic.v1.Daemon/Name"
+	Daemon_ReConfig_FullMethodName = "/tempest.dynamic.v1.Daemon/ReConfig"
+	Daemon_Config_FullMethodName   = "/tempest.dynamic.v1.Daemon/Config"
+)
+
+// DaemonClient is the client API for Daemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DaemonClient interface {
+	Name(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (*DaemonNameResponse, error)
+	ReConfig(ctx context.Context, in *DaemonReConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Config(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (*structpb.Struct, error)
+}
+
+type daemonClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDaemonClient(cc grpc.ClientConnInterface) DaemonClient {
+	return &daemonClient{cc}
+}
+
+func (c *daemonClient) Name(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (*DaemonNameResponse, error) {
+	out := new(DaemonNameResponse)
+	err := c.cc.Invoke(ctx, Daemon_Name_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ReConfig(ctx context.Context, in *DaemonReConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Daemon_ReConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Config(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Daemon_Config_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DaemonServer is the server API for Daemon service.
+// All implementations must embed UnimplementedDaemonServer
+// for forward compatibility
+type DaemonServer interface {
+	Name(context.Context, *DaemonRef) (*DaemonNameResponse, error)
+	ReConfig(context.Context, *DaemonReConfigRequest) (*emptypb.Empty, error)
+	Config(context.Context, *DaemonRef) (*structpb.Struct, error)
+	mustEmbedUnimplementedDaemonServer()
+}
+
+// UnimplementedDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedDaemonServer struct {
+}
+
+func (UnimplementedDaemonServer) Name(context.Context, *DaemonRef) (*DaemonNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Name not implemented")
+}
+func (UnimplementedDaemonServer) ReConfig(context.Context, *DaemonReConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReConfig not implemented")
+}
+func (UnimplementedDaemonServer) Config(context.Context, *DaemonRef) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
+
+// UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DaemonServer will
+// result in compilation errors.
+type UnsafeDaemonServer interface {
+	mustEmbedUnimplementedDaemonServer()
+}
+
+func RegisterDaemonServer(s grpc.ServiceRegistrar, srv DaemonServer) {
+	s.RegisterService(&Daemon_ServiceDesc, srv)
+}
+
+func _Daemon_Name_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Name(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_Name_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Name(ctx, req.(*DaemonRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ReConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonReConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ReConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ReConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ReConfig(ctx, req.(*DaemonReConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_Config_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Config(ctx, req.(*DaemonRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Daemon_ServiceDesc is the grpc.ServiceDesc for Daemon service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Daemon_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.Daemon",
+	HandlerType: (*DaemonServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Name",
+			Handler:    _Daemon_Name_Handler,
+		},
+		{
+			MethodName: "ReConfig",
+			Handler:    _Daemon_ReConfig_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Daemon_Config_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tempest/dynamic/v1/brain.proto",
+}
+
+const (
+	ScoreboardDaemon_WatchScoreUpdate_FullMethodName       = "/tempest.dynamic.v1.ScoreboardDaemon/WatchScoreUpdate"
+	ScoreboardDaemon_QueryScoreByPlayerUUID_FullMethodName = "/tempest.dynamic.v1.ScoreboardDaemon/QueryScoreByPlayerUUID"
+	ScoreboardDaemon_QueryRankByScoreboard_FullMethodName  = "/tempest.dynamic.v1.ScoreboardDaemon/QueryRankByScoreboard"
+)
+
+// ScoreboardDaemonClient is the client API for ScoreboardDaemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScoreboardDaemonClient interface {
+	WatchScoreUpdate(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (ScoreboardDaemon_WatchScoreUpdateClient, error)
+	QueryScoreByPlayerUUID(ctx context.Context, in *QueryScoreByPlayerUUIDRequest, opts ...grpc.CallOption) (*PlayerScoreQueryResponse, error)
+	QueryRankByScoreboard(ctx context.Context, in *QueryRankByScoreboardRequest, opts ...grpc.CallOption) (*RankQueryResponse, error)
+}
+
+type scoreboardDaemonClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScoreboardDaemonClient(cc grpc.ClientConnInterface) ScoreboardDaemonClient {
+	return &scoreboardDaemonClient{cc}
+}
+
+func (c *scoreboardDaemonClient) WatchScoreUpdate(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (ScoreboardDaemon_WatchScoreUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ScoreboardDaemon_ServiceDesc.Streams[0], ScoreboardDaemon_WatchScoreUpdate_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &scoreboardDaemonWatchScoreUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ScoreboardDaemon_WatchScoreUpdateClient interface {
+	Recv() (*ScoreUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type scoreboardDaemonWatchScoreUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *scoreboardDaemonWatchScoreUpdateClient) Recv() (*ScoreUpdateEvent, error) {
+	m := new(ScoreUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *scoreboardDaemonClient) QueryScoreByPlayerUUID(ctx context.Context, in *QueryScoreByPlayerUUIDRequest, opts ...grpc.CallOption) (*PlayerScoreQueryResponse, error) {
+	out := new(PlayerScoreQueryResponse)
+	err := c.cc.Invoke(ctx, ScoreboardDaemon_QueryScoreByPlayerUUID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoreboardDaemonClient) QueryRankByScoreboard(ctx context.Context, in *QueryRankByScoreboardRequest, opts ...grpc.CallOption) (*RankQueryResponse, error) {
+	out := new(RankQueryResponse)
+	err := c.cc.Invoke(ctx, ScoreboardDaemon_QueryRankByScoreboard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScoreboardDaemonServer is the server API for ScoreboardDaemon service.
+// All implementations must embed UnimplementedScoreboardDaemonServer
+// for forward compatibility
+type ScoreboardDaemonServer interface {
+	WatchScoreUpdate(*DaemonRef, ScoreboardDaemon_WatchScoreUpdateServer) error
+	QueryScoreByPlayerUUID(context.Context, *QueryScoreByPlayerUUIDRequest) (*PlayerScoreQueryResponse, error)
+	QueryRankByScoreboard(context.Context, *QueryRankByScoreboardRequest) (*RankQueryResponse, error)
+	mustEmbedUnimplementedScoreboardDaemonServer()
+}
+
+// UnimplementedScoreboardDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedScoreboardDaemonServer struct {
+}
+
+func (UnimplementedScoreboardDaemonServer) WatchScoreUpdate(*DaemonRef, ScoreboardDaemon_WatchScoreUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchScoreUpdate not implemented")
+}
+func (UnimplementedScoreboardDaemonServer) QueryScoreByPlayerUUID(context.Context, *QueryScoreByPlayerUUIDRequest) (*PlayerScoreQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScoreByPlayerUUID not implemented")
+}
+func (UnimplementedScoreboardDaemonServer) QueryRankByScoreboard(context.Context, *QueryRankByScoreboardRequest) (*RankQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRankByScoreboard not implemented")
+}
+func (UnimplementedScoreboardDaemonServer) mustEmbedUnimplementedScoreboardDaemonServer() {}
+
+// UnsafeScoreboardDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScoreboardDaemonServer will
+// result in compilation errors.
+type UnsafeScoreboardDaemonServer interface {
+	mustEmbedUnimplementedScoreboardDaemonServer()
+}
+
+func RegisterScoreboardDaemonServer(s grpc.ServiceRegistrar, srv ScoreboardDaemonServer) {
+	s.RegisterService(&ScoreboardDaemon_ServiceDesc, srv)
+}
+
+func _ScoreboardDaemon_WatchScoreUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DaemonRef)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoreboardDaemonServer).WatchScoreUpdate(m, &scoreboardDaemonWatchScoreUpdateServer{stream})
+}
+
+type ScoreboardDaemon_WatchScoreUpdateServer interface {
+	Send(*ScoreUpdateEvent) error
+	grpc.ServerStream
+}
+
+type scoreboardDaemonWatchScoreUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *scoreboardDaemonWatchScoreUpdateServer) Send(m *ScoreUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ScoreboardDaemon_QueryScoreByPlayerUUID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScoreByPlayerUUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardDaemonServer).QueryScoreByPlayerUUID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardDaemon_QueryScoreByPlayerUUID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardDaemonServer).QueryScoreByPlayerUUID(ctx, req.(*QueryScoreByPlayerUUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoreboardDaemon_QueryRankByScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRankByScoreboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardDaemonServer).QueryRankByScoreboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardDaemon_QueryRankByScoreboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardDaemonServer).QueryRankByScoreboard(ctx, req.(*QueryRankByScoreboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScoreboardDaemon_ServiceDesc is the grpc.ServiceDesc for ScoreboardDaemon service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScoreboardDaemon_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.ScoreboardDaemon",
+	HandlerType: (*ScoreboardDaemonServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryScoreByPlayerUUID",
+			Handler:    _ScoreboardDaemon_QueryScoreByPlayerUUID_Handler,
+		},
+		{
+			MethodName: "QueryRankByScoreboard",
+			Handler:    _ScoreboardDaemon_QueryRankByScoreboard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchScoreUpdate",
+			Handler:       _ScoreboardDaemon_WatchScoreUpdate_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/brain.proto",
+}
+
+const (
+	ChunkDaemon_WatchNewChunk_FullMethodName = "/tempest.dynamic.v1.ChunkDaemon/WatchNewChunk"
+)
+
+// ChunkDaemonClient is the client API for ChunkDaemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChunkDaemonClient interface {
+	WatchNewChunk(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (ChunkDaemon_WatchNewChunkClient, error)
+}
+
+type chunkDaemonClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChunkDaemonClient(cc grpc.ClientConnInterface) ChunkDaemonClient {
+	return &chunkDaemonClient{cc}
+}
+
+func (c *chunkDaemonClient) WatchNewChunk(ctx context.Context, in *DaemonRef, opts ...grpc.CallOption) (ChunkDaemon_WatchNewChunkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChunkDaemon_ServiceDesc.Streams[0], ChunkDaemon_WatchNewChunk_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chunkDaemonWatchNewChunkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChunkDaemon_WatchNewChunkClient interface {
+	Recv() (*ChunkNewChunkEvent, error)
+	grpc.ClientStream
+}
+
+type chunkDaemonWatchNewChunkClient struct {
+	grpc.ClientStream
+}
+
+func (x *chunkDaemonWatchNewChunkClient) Recv() (*ChunkNewChunkEvent, error) {
+	m := new(ChunkNewChunkEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChunkDaemonServer is the server API for ChunkDaemon service.
+// All implementations must embed UnimplementedChunkDaemonServer
+// for forward compatibility
+type ChunkDaemonServer interface {
+	WatchNewChunk(*DaemonRef, ChunkDaemon_WatchNewChunkServer) error
+	mustEmbedUnimplementedChunkDaemonServer()
+}
+
+// UnimplementedChunkDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedChunkDaemonServer struct {
+}
+
+func (UnimplementedChunkDaemonServer) WatchNewChunk(*DaemonRef, ChunkDaemon_WatchNewChunkServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNewChunk not implemented")
+}
+func (UnimplementedChunkDaemonServer) mustEmbedUnimplementedChunkDaemonServer() {}
+
+// UnsafeChunkDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChunkDaemonServer will
+// result in compilation errors.
+type UnsafeChunkDaemonServer interface {
+	mustEmbedUnimplementedChunkDaemonServer()
+}
+
+func RegisterChunkDaemonServer(s grpc.ServiceRegistrar, srv ChunkDaemonServer) {
+	s.RegisterService(&ChunkDaemon_ServiceDesc, srv)
+}
+
+func _ChunkDaemon_WatchNewChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DaemonRef)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChunkDaemonServer).WatchNewChunk(m, &chunkDaemonWatchNewChunkServer{stream})
+}
+
+type ChunkDaemon_WatchNewChunkServer interface {
+	Send(*ChunkNewChunkEvent) error
+	grpc.ServerStream
+}
+
+type chunkDaemonWatchNewChunkServer struct {
+	grpc.ServerStream
+}
+
+func (x *chunkDaemonWatchNewChunkServer) Send(m *ChunkNewChunkEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ChunkDaemon_ServiceDesc is the grpc.ServiceDesc for ChunkDaemon service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChunkDaemon_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.ChunkDaemon",
+	HandlerType: (*ChunkDaemonServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNewChunk",
+			Handler:       _ChunkDaemon_WatchNewChunk_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/brain.proto",
+}
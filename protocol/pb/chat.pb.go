@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/chat.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChatUD struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Msg           []string               `protobuf:"bytes,2,rep,name=msg,proto3" json:"msg,omitempty"`
+	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	RawMsg        string                 `protobuf:"bytes,4,opt,name=raw_msg,json=rawMsg,proto3" json:"raw_msg,omitempty"`
+	RawName       string                 `protobuf:"bytes,5,opt,name=raw_name,json=rawName,proto3" json:"raw_name,omitempty"`
+	RawParameters []string               `protobuf:"bytes,6,rep,name=raw_parameters,json=rawParameters,proto3" json:"raw_parameters,omitempty"`
+	Aux           *structpb.Value        `protobuf:"bytes,7,opt,name=aux,proto3" json:"aux,omitempty"`
+	ParsedMsg     string                 `protobuf:"bytes,8,opt,name=parsed_msg,json=parsedMsg,proto3" json:"parsed_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatUD) Reset() {
+	*x = ChatUD{}
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatUD) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatUD) ProtoMessage() {}
+
+func (x *ChatUD) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatUD.ProtoReflect.Descriptor instead.
+func (*ChatUD) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChatUD) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatUD) GetMsg() []string {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *ChatUD) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ChatUD) GetRawMsg() string {
+	if x != nil {
+		return x.RawMsg
+	}
+	return ""
+}
+
+func (x *ChatUD) GetRawName() string {
+	if x != nil {
+		return x.RawName
+	}
+	return ""
+}
+
+func (x *ChatUD) GetRawParameters() []string {
+	if x != nil {
+		return x.RawParameters
+	}
+	return nil
+}
+
+func (x *ChatUD) GetAux() *structpb.Value {
+	if x != nil {
+		return x.Aux
+	}
+	return nil
+}
+
+func (x *ChatUD) GetParsedMsg() string {
+	if x != nil {
+		return x.ParsedMsg
+	}
+	return ""
+}
+
+type ChatMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           []string               `protobuf:"bytes,1,rep,name=msg,proto3" json:"msg,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParsedMsg     string                 `protobuf:"bytes,3,opt,name=parsed_msg,json=parsedMsg,proto3" json:"parsed_msg,omitempty"`
+	RawMsg        string                 `protobuf:"bytes,4,opt,name=raw_msg,json=rawMsg,proto3" json:"raw_msg,omitempty"`
+	RawParameters []string               `protobuf:"bytes,5,rep,name=raw_parameters,json=rawParameters,proto3" json:"raw_parameters,omitempty"`
+	Type          uint32                 `protobuf:"varint,6,opt,name=type,proto3" json:"type,omitempty"`
+	Ud            *ChatUD                `protobuf:"bytes,7,opt,name=ud,proto3" json:"ud,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMsg) Reset() {
+	*x = ChatMsg{}
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMsg) ProtoMessage() {}
+
+func (x *ChatMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMsg.ProtoReflect.Descriptor instead.
+func (*ChatMsg) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChatMsg) GetMsg() []string {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *ChatMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatMsg) GetParsedMsg() string {
+	if x != nil {
+		return x.ParsedMsg
+	}
+	return ""
+}
+
+func (x *ChatMsg) GetRawMsg() string {
+	if x != nil {
+		return x.RawMsg
+	}
+	return ""
+}
+
+func (x *ChatMsg) GetRawParameters() []string {
+	if x != nil {
+		return x.RawParameters
+	}
+	return nil
+}
+
+func (x *ChatMsg) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ChatMsg) GetUd() *ChatUD {
+	if x != nil {
+		return x.Ud
+	}
+	return nil
+}
+
+type WatchChatMsgRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderName    string                 `protobuf:"bytes,1,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChatMsgRequest) Reset() {
+	*x = WatchChatMsgRequest{}
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChatMsgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChatMsgRequest) ProtoMessage() {}
+
+func (x *WatchChatMsgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChatMsgRequest.ProtoReflect.Descriptor instead.
+func (*WatchChatMsgRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchChatMsgRequest) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+type InterceptNextMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterceptNextMessageRequest) Reset() {
+	*x = InterceptNextMessageRequest{}
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterceptNextMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterceptNextMessageRequest) ProtoMessage() {}
+
+func (x *InterceptNextMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterceptNextMessageRequest.ProtoReflect.Descriptor instead.
+func (*InterceptNextMessageRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InterceptNextMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ChatMsgEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Msg           *ChatMsg               `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMsgEvent) Reset() {
+	*x = ChatMsgEvent{}
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMsgEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMsgEvent) ProtoMessage() {}
+
+func (x *ChatMsgEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMsgEvent.ProtoReflect.Descriptor instead.
+func (*ChatMsgEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChatMsgEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *ChatMsgEvent) GetMsg() *ChatMsg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+var File_tempest_dynamic_v1_chat_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtempest/dynamic/v1/chat.proto\x12\x12tempest.dynamic.v1\x1a\x1cgoogle/protobuf/struct.proto\"\xe6\x01\n" +
+	"\x06ChatUD\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03msg\x18\x02 \x03(\tR\x03msg\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\x12\x17\n" +
+	"\araw_msg\x18\x04 \x01(\tR\x06rawMsg\x12\x19\n" +
+	"\braw_name\x18\x05 \x01(\tR\arawName\x12%\n" +
+	"\x0eraw_parameters\x18\x06 \x03(\tR\rrawParameters\x12(\n" +
+	"\x03aux\x18\a \x01(\v2\x16.google.protobuf.ValueR\x03aux\x12\x1d\n" +
+	"\n" +
+	"parsed_msg\x18\b \x01(\tR\tparsedMsg\"\xce\x01\n" +
+	"\aChatMsg\x12\x10\n" +
+	"\x03msg\x18\x01 \x03(\tR\x03msg\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"parsed_msg\x18\x03 \x01(\tR\tparsedMsg\x12\x17\n" +
+	"\araw_msg\x18\x04 \x01(\tR\x06rawMsg\x12%\n" +
+	"\x0eraw_parameters\x18\x05 \x03(\tR\rrawParameters\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\rR\x04type\x12*\n" +
+	"\x02ud\x18\a \x01(\v2\x1a.tempest.dynamic.v1.ChatUDR\x02ud\"6\n" +
+	"\x13WatchChatMsgRequest\x12\x1f\n" +
+	"\vsender_name\x18\x01 \x01(\tR\n" +
+	"senderName\"1\n" +
+	"\x1bInterceptNextMessageRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"^\n" +
+	"\fChatMsgEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12-\n" +
+	"\x03msg\x18\x02 \x01(\v2\x1b.tempest.dynamic.v1.ChatMsgR\x03msg2\xd6\x01\n" +
+	"\n" +
+	"ChatModule\x12[\n" +
+	"\fWatchChatMsg\x12'.tempest.dynamic.v1.WatchChatMsgRequest\x1a .tempest.dynamic.v1.ChatMsgEvent0\x01\x12k\n" +
+	"\x14InterceptNextMessage\x12/.tempest.dynamic.v1.InterceptNextMessageRequest\x1a .tempest.dynamic.v1.ChatMsgEvent0\x01B7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_chat_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_chat_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_chat_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_chat_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_chat_proto_rawDesc), len(file_tempest_dynamic_v1_chat_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_chat_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tempest_dynamic_v1_chat_proto_goTypes = []any{
+	(*ChatUD)(nil),                      // 0: tempest.dynamic.v1.ChatUD
+	(*ChatMsg)(nil),                     // 1: tempest.dynamic.v1.ChatMsg
+	(*WatchChatMsgRequest)(nil),         // 2: tempest.dynamic.v1.WatchChatMsgRequest
+	(*InterceptNextMessageRequest)(nil), // 3: tempest.dynamic.v1.InterceptNextMessageRequest
+	(*ChatMsgEvent)(nil),                // 4: tempest.dynamic.v1.ChatMsgEvent
+	(*structpb.Value)(nil),              // 5: google.protobuf.Value
+}
+var file_tempest_dynamic_v1_chat_proto_depIdxs = []int32{
+	5, // 0: tempest.dynamic.v1.ChatUD.aux:type_name -> google.protobuf.Value
+	0, // 1: tempest.dynamic.v1.ChatMsg.ud:type_name -> tempest.dynamic.v1.ChatUD
+	1, // 2: tempest.dynamic.v1.ChatMsgEvent.msg:type_name -> tempest.dynamic.v1.ChatMsg
+	2, // 3: tempest.dynamic.v1.ChatModule.WatchChatMsg:input_type -> tempest.dynamic.v1.WatchChatMsgRequest
+	3, // 4: tempest.dynamic.v1.ChatModule.InterceptNextMessage:input_type -> tempest.dynamic.v1.InterceptNextMessageRequest
+	4, // 5: tempest.dynamic.v1.ChatModule.WatchChatMsg:output_type -> tempest.dynamic.v1.ChatMsgEvent
+	4, // 6: tempest.dynamic.v1.ChatModule.InterceptNextMessage:output_type -> tempest.dynamic.v1.ChatMsgEvent
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_chat_proto_init() }
+func file_tempest_dynamic_v1_chat_proto_init() {
+	if File_tempest_dynamic_v1_chat_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_chat_proto_rawDesc), len(file_tempest_dynamic_v1_chat_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_chat_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_chat_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_chat_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_chat_proto = out.File
+	file_tempest_dynamic_v1_chat_proto_goTypes = nil
+	file_tempest_dynamic_v1_chat_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/chat.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ChatModule_WatchChatMsg_FullMethodName         = "/tempest.dynamic.v1.ChatModule/WatchChatMsg"
+	ChatModule_InterceptNextMessage_FullMethodName = "/tempest.dynamic.v1.ChatModule/InterceptNextMessage"
+)
+
+// ChatModuleClient is the client API for ChatModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChatModuleClient interface {
+	// WatchChatMsg registers a chat listener; sender_name restricts it to one sender.
+	WatchChatMsg(ctx context.Context, in *WatchChatMsgRequest, opts ...grpc.CallOption) (ChatModule_WatchChatMsgClient, error)
+	// InterceptNextMessage sends a registration message, then at most one intercepted message.
+	InterceptNextMessage(ctx context.Context, in *InterceptNextMessageRequest, opts ...grpc.CallOption) (ChatModule_InterceptNextMessageClient, error)
+}
+
+type chatModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChatModuleClient(cc grpc.ClientConnInterface) ChatModuleClient {
+	return &chatModuleClient{cc}
+}
+
+func (c *chatModuleClient) WatchChatMsg(ctx context.Context, in *WatchChatMsgRequest, opts ...grpc.CallOption) (ChatModule_WatchChatMsgClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatModule_ServiceDesc.Streams[0], ChatModule_WatchChatMsg_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatModuleWatchChatMsgClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatModule_WatchChatMsgClient interface {
+	Recv() (*ChatMsgEvent, error)
+	grpc.ClientStream
+}
+
+type chatModuleWatchChatMsgClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatModuleWatchChatMsgClient) Recv() (*ChatMsgEvent, error) {
+	m := new(ChatMsgEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatModuleClient) InterceptNextMessage(ctx context.Context, in *InterceptNextMessageRequest, opts ...grpc.CallOption) (ChatModule_InterceptNextMessageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatModule_ServiceDesc.Streams[1], ChatModule_InterceptNextMessage_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatModuleInterceptNextMessageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatModule_InterceptNextMessageClient interface {
+	Recv() (*ChatMsgEvent, error)
+	grpc.ClientStream
+}
+
+type chatModuleInterceptNextMessageClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatModuleInterceptNextMessageClient) Recv() (*ChatMsgEvent, error) {
+	m := new(ChatMsgEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChatModuleServer is the server API for ChatModule service.
+// All implementations must embed UnimplementedChatModuleServer
+// for forward compatibility
+type ChatModuleServer interface {
+	// WatchChatMsg registers a chat listener; sender_name restricts it to one sender.
+	WatchChatMsg(*WatchChatMsgRequest, ChatModule_WatchChatMsgServer) error
+	// InterceptNextMessage sends a registration message, then at most one intercepted message.
+	InterceptNextMessage(*InterceptNextMessageRequest, ChatModule_InterceptNextMessageServer) error
+	mustEmbedUnimplementedChatModuleServer()
+}
+
+// UnimplementedChatModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedChatModuleServer struct {
+}
+
+func (UnimplementedChatModuleServer) WatchChatMsg(*WatchChatMsgRequest, ChatModule_WatchChatMsgServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChatMsg not implemented")
+}
+func (UnimplementedChatModuleServer) InterceptNextMessage(*InterceptNextMessageRequest, ChatModule_InterceptNextMessageServer) error {
+	return status.Errorf(codes.Unimplemented, "method InterceptNextMessage not implemented")
+}
+func (UnimplementedChatModuleServer) mustEmbedUnimplementedChatModuleServer() {}
+
+// UnsafeChatModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatModuleServer will
+// result in compilation errors.
+type UnsafeChatModuleServer interface {
+	mustEmbedUnimplementedChatModuleServer()
+}
+
+func RegisterChatModuleServer(s grpc.ServiceRegistrar, srv ChatModuleServer) {
+	s.RegisterService(&ChatModule_ServiceDesc, srv)
+}
+
+func _ChatModule_WatchChatMsg_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChatMsgRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatModuleServer).WatchChatMsg(m, &chatModuleWatchChatMsgServer{stream})
+}
+
+type ChatModule_WatchChatMsgServer interface {
+	Send(*ChatMsgEvent) error
+	grpc.ServerStream
+}
+
+type chatModuleWatchChatMsgServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatModuleWatchChatMsgServer) Send(m *ChatMsgEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChatModule_InterceptNextMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InterceptNextMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatModuleServer).InterceptNextMessage(m, &chatModuleInterceptNextMessageServer{stream})
+}
+
+type ChatModule_InterceptNextMessageServer interface {
+	Send(*ChatMsgEvent) error
+	grpc.ServerStream
+}
+
+type chatModuleInterceptNextMessageServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatModuleInterceptNextMessageServer) Send(m *ChatMsgEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ChatModule_ServiceDesc is the grpc.ServiceDesc for ChatModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChatModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.ChatModule",
+	HandlerType: (*ChatModuleServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChatMsg",
+			Handler:       _ChatModule_WatchChatMsg_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InterceptNextMessage",
+			Handler:       _ChatModule_InterceptNextMessage_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/chat.proto",
+}
@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/commands.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SendCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Dimensional   bool                   `protobuf:"varint,2,opt,name=dimensional,proto3" json:"dimensional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandRequest) Reset() {
+	*x = SendCommandRequest{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandRequest) ProtoMessage() {}
+
+func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SendCommandRequest) GetDimensional() bool {
+	if x != nil {
+		return x.Dimensional
+	}
+	return false
+}
+
+type SendCommandWithRespRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Command string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	// timeout <= 0 (or unset) waits forever.
+	Timeout       *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandWithRespRequest) Reset() {
+	*x = SendCommandWithRespRequest{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandWithRespRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandWithRespRequest) ProtoMessage() {}
+
+func (x *SendCommandWithRespRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandWithRespRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandWithRespRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendCommandWithRespRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SendCommandWithRespRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type CommandOriginInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Origin        uint32                 `protobuf:"varint,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOriginInfo) Reset() {
+	*x = CommandOriginInfo{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOriginInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOriginInfo) ProtoMessage() {}
+
+func (x *CommandOriginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOriginInfo.ProtoReflect.Descriptor instead.
+func (*CommandOriginInfo) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CommandOriginInfo) GetOrigin() uint32 {
+	if x != nil {
+		return x.Origin
+	}
+	return 0
+}
+
+func (x *CommandOriginInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CommandOriginInfo) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CommandOutputMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Parameters    []string               `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOutputMessage) Reset() {
+	*x = CommandOutputMessage{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOutputMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutputMessage) ProtoMessage() {}
+
+func (x *CommandOutputMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutputMessage.ProtoReflect.Descriptor instead.
+func (*CommandOutputMessage) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CommandOutputMessage) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommandOutputMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommandOutputMessage) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type CommandOutput struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CommandLine   string                  `protobuf:"bytes,1,opt,name=command_line,json=commandLine,proto3" json:"command_line,omitempty"`
+	Origin        *CommandOriginInfo      `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	OutputType    uint32                  `protobuf:"varint,3,opt,name=output_type,json=outputType,proto3" json:"output_type,omitempty"`
+	SuccessCount  uint32                  `protobuf:"varint,4,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	Messages      []*CommandOutputMessage `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+	DataSet       string                  `protobuf:"bytes,6,opt,name=data_set,json=dataSet,proto3" json:"data_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOutput) Reset() {
+	*x = CommandOutput{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutput) ProtoMessage() {}
+
+func (x *CommandOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutput.ProtoReflect.Descriptor instead.
+func (*CommandOutput) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CommandOutput) GetCommandLine() string {
+	if x != nil {
+		return x.CommandLine
+	}
+	return ""
+}
+
+func (x *CommandOutput) GetOrigin() *CommandOriginInfo {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *CommandOutput) GetOutputType() uint32 {
+	if x != nil {
+		return x.OutputType
+	}
+	return 0
+}
+
+func (x *CommandOutput) GetSuccessCount() uint32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *CommandOutput) GetMessages() []*CommandOutputMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *CommandOutput) GetDataSet() string {
+	if x != nil {
+		return x.DataSet
+	}
+	return ""
+}
+
+type CommandOutputResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output is unset when the host returned no output.
+	Output        *CommandOutput `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOutputResponse) Reset() {
+	*x = CommandOutputResponse{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutputResponse) ProtoMessage() {}
+
+func (x *CommandOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutputResponse.ProtoReflect.Descriptor instead.
+func (*CommandOutputResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommandOutputResponse) GetOutput() *CommandOutput {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type SendChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatRequest) Reset() {
+	*x = SendChatRequest{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatRequest) ProtoMessage() {}
+
+func (x *SendChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatRequest.ProtoReflect.Descriptor instead.
+func (*SendChatRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SendChatRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type TitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TitleRequest) Reset() {
+	*x = TitleRequest{}
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TitleRequest) ProtoMessage() {}
+
+func (x *TitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_commands_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TitleRequest.ProtoReflect.Descriptor instead.
+func (*TitleRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_commands_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TitleRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_tempest_dynamic_v1_commands_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_commands_proto_rawDesc = "" +
+	"\n" +
+	"!tempest/dynamic/v1/commands.proto\x12\x12tempest.dynamic.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"P\n" +
+	"\x12SendCommandRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12 \n" +
+	"\vdimensional\x18\x02 \x01(\bR\vdimensional\"k\n" +
+	"\x1aSendCommandWithRespRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"^\n" +
+	"\x11CommandOriginInfo\x12\x16\n" +
+	"\x06origin\x18\x01 \x01(\rR\x06origin\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"j\n" +
+	"\x14CommandOutputMessage\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\tR\n" +
+	"parameters\"\x98\x02\n" +
+	"\rCommandOutput\x12!\n" +
+	"\fcommand_line\x18\x01 \x01(\tR\vcommandLine\x12=\n" +
+	"\x06origin\x18\x02 \x01(\v2%.tempest.dynamic.v1.CommandOriginInfoR\x06origin\x12\x1f\n" +
+	"\voutput_type\x18\x03 \x01(\rR\n" +
+	"outputType\x12#\n" +
+	"\rsuccess_count\x18\x04 \x01(\rR\fsuccessCount\x12D\n" +
+	"\bmessages\x18\x05 \x03(\v2(.tempest.dynamic.v1.CommandOutputMessageR\bmessages\x12\x19\n" +
+	"\bdata_set\x18\x06 \x01(\tR\adataSet\"R\n" +
+	"\x15CommandOutputResponse\x129\n" +
+	"\x06output\x18\x01 \x01(\v2!.tempest.dynamic.v1.CommandOutputR\x06output\"+\n" +
+	"\x0fSendChatRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"(\n" +
+	"\fTitleRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xcc\x05\n" +
+	"\x0eCommandsModule\x12U\n" +
+	"\x13SendSettingsCommand\x12&.tempest.dynamic.v1.SendCommandRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x11SendPlayerCommand\x12&.tempest.dynamic.v1.SendCommandRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\rSendWSCommand\x12&.tempest.dynamic.v1.SendCommandRequest\x1a\x16.google.protobuf.Empty\x12v\n" +
+	"\x19SendPlayerCommandWithResp\x12..tempest.dynamic.v1.SendCommandWithRespRequest\x1a).tempest.dynamic.v1.CommandOutputResponse\x12r\n" +
+	"\x15SendWSCommandWithResp\x12..tempest.dynamic.v1.SendCommandWithRespRequest\x1a).tempest.dynamic.v1.CommandOutputResponse\x12E\n" +
+	"\x13AwaitChangesGeneral\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\bSendChat\x12#.tempest.dynamic.v1.SendChatRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x05Title\x12 .tempest.dynamic.v1.TitleRequest\x1a\x16.google.protobuf.EmptyB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_commands_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_commands_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_commands_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_commands_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_commands_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_commands_proto_rawDesc), len(file_tempest_dynamic_v1_commands_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_commands_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_tempest_dynamic_v1_commands_proto_goTypes = []any{
+	(*SendCommandRequest)(nil),         // 0: tempest.dynamic.v1.SendCommandRequest
+	(*SendCommandWithRespRequest)(nil), // 1: tempest.dynamic.v1.SendCommandWithRespRequest
+	(*CommandOriginInfo)(nil),          // 2: tempest.dynamic.v1.CommandOriginInfo
+	(*CommandOutputMessage)(nil),       // 3: tempest.dynamic.v1.CommandOutputMessage
+	(*CommandOutput)(nil),              // 4: tempest.dynamic.v1.CommandOutput
+	(*CommandOutputResponse)(nil),      // 5: tempest.dynamic.v1.CommandOutputResponse
+	(*SendChatRequest)(nil),            // 6: tempest.dynamic.v1.SendChatRequest
+	(*TitleRequest)(nil),               // 7: tempest.dynamic.v1.TitleRequest
+	(*durationpb.Duration)(nil),        // 8: google.protobuf.Duration
+	(*emptypb.Empty)(nil),              // 9: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_commands_proto_depIdxs = []int32{
+	8,  // 0: tempest.dynamic.v1.SendCommandWithRespRequest.timeout:type_name -> google.protobuf.Duration
+	2,  // 1: tempest.dynamic.v1.CommandOutput.origin:type_name -> tempest.dynamic.v1.CommandOriginInfo
+	3,  // 2: tempest.dynamic.v1.CommandOutput.messages:type_name -> tempest.dynamic.v1.CommandOutputMessage
+	4,  // 3: tempest.dynamic.v1.CommandOutputResponse.output:type_name -> tempest.dynamic.v1.CommandOutput
+	0,  // 4: tempest.dynamic.v1.CommandsModule.SendSettingsCommand:input_type -> tempest.dynamic.v1.SendCommandRequest
+	0,  // 5: tempest.dynamic.v1.CommandsModule.SendPlayerCommand:input_type -> tempest.dynamic.v1.SendCommandRequest
+	0,  // 6: tempest.dynamic.v1.CommandsModule.SendWSCommand:input_type -> tempest.dynamic.v1.SendCommandRequest
+	1,  // 7: tempest.dynamic.v1.CommandsModule.SendPlayerCommandWithResp:input_type -> tempest.dynamic.v1.SendCommandWithRespRequest
+	1,  // 8: tempest.dynamic.v1.CommandsModule.SendWSCommandWithResp:input_type -> tempest.dynamic.v1.SendCommandWithRespRequest
+	9,  // 9: tempest.dynamic.v1.CommandsModule.AwaitChangesGeneral:input_type -> google.protobuf.Empty
+	6,  // 10: tempest.dynamic.v1.CommandsModule.SendChat:input_type -> tempest.dynamic.v1.SendChatRequest
+	7,  // 11: tempest.dynamic.v1.CommandsModule.Title:input_type -> tempest.dynamic.v1.TitleRequest
+	9,  // 12: tempest.dynamic.v1.CommandsModule.SendSettingsCommand:output_type -> google.protobuf.Empty
+	9,  // 13: tempest.dynamic.v1.CommandsModule.SendPlayerCommand:output_type -> google.protobuf.Empty
+	9,  // 14: tempest.dynamic.v1.CommandsModule.SendWSCommand:output_type -> google.protobuf.Empty
+	5,  // 15: tempest.dynamic.v1.CommandsModule.SendPlayerCommandWithResp:output_type -> tempest.dynamic.v1.CommandOutputResponse
+	5,  // 16: tempest.dynamic.v1.CommandsModule.SendWSCommandWithResp:output_type -> tempest.dynamic.v1.CommandOutputResponse
+	9,  // 17: tempest.dynamic.v1.CommandsModule.AwaitChangesGeneral:output_type -> google.protobuf.Empty
+	9,  // 18: tempest.dynamic.v1.CommandsModule.SendChat:output_type -> google.protobuf.Empty
+	9,  // 19: tempest.dynamic.v1.CommandsModule.Title:output_type -> google.protobuf.Empty
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_commands_proto_init() }
+func file_tempest_dynamic_v1_commands_proto_init() {
+	if File_tempest_dynamic_v1_commands_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_commands_proto_rawDesc), len(file_tempest_dynamic_v1_commands_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_commands_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_commands_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_commands_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_commands_proto = out.File
+	file_tempest_dynamic_v1_commands_proto_goTypes = nil
+	file_tempest_dynamic_v1_commands_proto_depIdxs = nil
+}
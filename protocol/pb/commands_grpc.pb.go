@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/commands.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CommandsModule_SendSettingsCommand_FullMethodName       = "/tempest.dynamic.v1.CommandsModule/SendSettingsCommand"
+	CommandsModule_SendPlayerCommand_FullMethodName         = "/tempest.dynamic.v1.CommandsModule/SendPlayerCommand"
+	CommandsModule_SendWSCommand_FullMethodName             = "/tempest.dynamic.v1.CommandsModule/SendWSCommand"
+	CommandsModule_SendPlayerCommandWithResp_FullMethodName = "/tempest.dynamic.v1.CommandsModule/SendPlayerCommandWithResp"
+	CommandsModule_SendWSCommandWithResp_FullMethodName     = "/tempest.dynamic.v1.CommandsModule/SendWSCommandWithResp"
+	CommandsModule_AwaitChangesGeneral_FullMethodName       = "/tempest.dynamic.v1.CommandsModule/AwaitChangesGeneral"
+	CommandsModule_SendChat_FullMethodName                  = "/tempest.dynamic.v1.CommandsModule/SendChat"
+	CommandsModule_Title_FullMethodName                     = "/tempest.dynamic.v1.CommandsModule/Title"
+)
+
+// CommandsModuleClient is the client API for CommandsModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommandsModuleClient interface {
+	SendSettingsCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendPlayerCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendWSCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendPlayerCommandWithResp(ctx context.Context, in *SendCommandWithRespRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error)
+	SendWSCommandWithResp(ctx context.Context, in *SendCommandWithRespRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error)
+	AwaitChangesGeneral(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendChat(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Title(ctx context.Context, in *TitleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type commandsModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommandsModuleClient(cc grpc.ClientConnInterface) CommandsModuleClient {
+	return &commandsModuleClient{cc}
+}
+
+func (c *commandsModuleClient) SendSettingsCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommandsModule_SendSettingsCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) SendPlayerCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommandsModule_SendPlayerCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) SendWSCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommandsModule_SendWSCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) SendPlayerCommandWithResp(ctx context.Context, in *SendCommandWithRespRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error) {
+	out := new(CommandOutputResponse)
+	err := c.cc.Invoke(ctx, CommandsModule_SendPlayerCommandWithResp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) SendWSCommandWithResp(ctx context.Context, in *SendCommandWithRespRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error) {
+	out := new(CommandOutputResponse)
+	err := c.cc.Invoke(ctx, CommandsModule_SendWSCommandWithResp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) AwaitChangesGeneral(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommandsModule_AwaitChangesGeneral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) SendChat(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommandsModule_SendChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsModuleClient) Title(ctx context.Context, in *TitleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommandsModule_Title_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommandsModuleServer is the server API for CommandsModule service.
+// All implementations must embed UnimplementedCommandsModuleServer
+// for forward compatibility
+type CommandsModuleServer interface {
+	SendSettingsCommand(context.Context, *SendCommandRequest) (*emptypb.Empty, error)
+	SendPlayerCommand(context.Context, *SendCommandRequest) (*emptypb.Empty, error)
+	SendWSCommand(context.Context, *SendCommandRequest) (*emptypb.Empty, error)
+	SendPlayerCommandWithResp(context.Context, *SendCommandWithRespRequest) (*CommandOutputResponse, error)
+	SendWSCommandWithResp(context.Context, *SendCommandWithRespRequest) (*CommandOutputResponse, error)
+	AwaitChangesGeneral(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	SendChat(context.Context, *SendChatRequest) (*emptypb.Empty, error)
+	Title(context.Context, *TitleRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCommandsModuleServer()
+}
+
+// UnimplementedCommandsModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedCommandsModuleServer struct {
+}
+
+func (UnimplementedCommandsModuleServer) SendSettingsCommand(context.Context, *SendCommandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSettingsCommand not implemented")
+}
+func (UnimplementedCommandsModuleServer) SendPlayerCommand(context.Context, *SendCommandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPlayerCommand not implemented")
+}
+func (UnimplementedCommandsModuleServer) SendWSCommand(context.Context, *SendCommandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWSCommand not implemented")
+}
+func (UnimplementedCommandsModuleServer) SendPlayerCommandWithResp(context.Context, *SendCommandWithRespRequest) (*CommandOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPlayerCommandWithResp not implemented")
+}
+func (UnimplementedCommandsModuleServer) SendWSCommandWithResp(context.Context, *SendCommandWithRespRequest) (*CommandOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWSCommandWithResp not implemented")
+}
+func (UnimplementedCommandsModuleServer) AwaitChangesGeneral(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AwaitChangesGeneral not implemented")
+}
+func (UnimplementedCommandsModuleServer) SendChat(context.Context, *SendChatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChat not implemented")
+}
+func (UnimplementedCommandsModuleServer) Title(context.Context, *TitleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Title not implemented")
+}
+func (UnimplementedCommandsModuleServer) mustEmbedUnimplementedCommandsModuleServer() {}
+
+// UnsafeCommandsModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommandsModuleServer will
+// result in compilation errors.
+type UnsafeCommandsModuleServer interface {
+	mustEmbedUnimplementedCommandsModuleServer()
+}
+
+func RegisterCommandsModuleServer(s grpc.ServiceRegistrar, srv CommandsModuleServer) {
+	s.RegisterService(&CommandsModule_ServiceDesc, srv)
+}
+
+func _CommandsModule_SendSettingsCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).SendSettingsCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_SendSettingsCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).SendSettingsCommand(ctx, req.(*SendCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_SendPlayerCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).SendPlayerCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_SendPlayerCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).SendPlayerCommand(ctx, req.(*SendCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_SendWSCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).SendWSCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_SendWSCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).SendWSCommand(ctx, req.(*SendCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_SendPlayerCommandWithResp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandWithRespRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).SendPlayerCommandWithResp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_SendPlayerCommandWithResp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).SendPlayerCommandWithResp(ctx, req.(*SendCommandWithRespRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_SendWSCommandWithResp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandWithRespRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).SendWSCommandWithResp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_SendWSCommandWithResp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).SendWSCommandWithResp(ctx, req.(*SendCommandWithRespRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_AwaitChangesGeneral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).AwaitChangesGeneral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_AwaitChangesGeneral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).AwaitChangesGeneral(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_SendChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).SendChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_SendChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).SendChat(ctx, req.(*SendChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandsModule_Title_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsModuleServer).Title(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandsModule_Title_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsModuleServer).Title(ctx, req.(*TitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommandsModule_ServiceDesc is the grpc.ServiceDesc for CommandsModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommandsModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.CommandsModule",
+	HandlerType: (*CommandsModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendSettingsCommand",
+			Handler:    _CommandsModule_SendSettingsCommand_Handler,
+		},
+		{
+			MethodName: "SendPlayerCommand",
+			Handler:    _CommandsModule_SendPlayerCommand_Handler,
+		},
+		{
+			MethodName: "SendWSCommand",
+			Handler:    _CommandsModule_SendWSCommand_Handler,
+		},
+		{
+			MethodName: "SendPlayerCommandWithResp",
+			Handler:    _CommandsModule_SendPlayerCommandWithResp_Handler,
+		},
+		{
+			MethodName: "SendWSCommandWithResp",
+			Handler:    _CommandsModule_SendWSCommandWithResp_Handler,
+		},
+		{
+			MethodName: "AwaitChangesGeneral",
+			Handler:    _CommandsModule_AwaitChangesGeneral_Handler,
+		},
+		{
+			MethodName: "SendChat",
+			Handler:    _CommandsModule_SendChat_Handler,
+		},
+		{
+			MethodName: "Title",
+			Handler:    _CommandsModule_Title_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tempest/dynamic/v1/commands.proto",
+}
@@ -0,0 +1,2059 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/database.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OpenKeyValueDBRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DbType        string                 `protobuf:"bytes,2,opt,name=db_type,json=dbType,proto3" json:"db_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenKeyValueDBRequest) Reset() {
+	*x = OpenKeyValueDBRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenKeyValueDBRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenKeyValueDBRequest) ProtoMessage() {}
+
+func (x *OpenKeyValueDBRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenKeyValueDBRequest.ProtoReflect.Descriptor instead.
+func (*OpenKeyValueDBRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenKeyValueDBRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OpenKeyValueDBRequest) GetDbType() string {
+	if x != nil {
+		return x.DbType
+	}
+	return ""
+}
+
+type KeyValueDBHandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyValueDBHandle) Reset() {
+	*x = KeyValueDBHandle{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyValueDBHandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyValueDBHandle) ProtoMessage() {}
+
+func (x *KeyValueDBHandle) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyValueDBHandle.ProtoReflect.Descriptor instead.
+func (*KeyValueDBHandle) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyValueDBHandle) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type KVGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVGetRequest) Reset() {
+	*x = KVGetRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGetRequest) ProtoMessage() {}
+
+func (x *KVGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVGetRequest.ProtoReflect.Descriptor instead.
+func (*KVGetRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KVGetRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *KVGetRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type KVGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVGetResponse) Reset() {
+	*x = KVGetResponse{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGetResponse) ProtoMessage() {}
+
+func (x *KVGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVGetResponse.ProtoReflect.Descriptor instead.
+func (*KVGetResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KVGetResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVGetResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type KVSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	TtlMs         int64                  `protobuf:"varint,4,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVSetRequest) Reset() {
+	*x = KVSetRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVSetRequest) ProtoMessage() {}
+
+func (x *KVSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVSetRequest.ProtoReflect.Descriptor instead.
+func (*KVSetRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KVSetRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *KVSetRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KVSetRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVSetRequest) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+type KVTTLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ttl_ms is 0 for keys without expiry.
+	TtlMs         int64 `protobuf:"varint,1,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	Ok            bool  `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVTTLResponse) Reset() {
+	*x = KVTTLResponse{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVTTLResponse) ProtoMessage() {}
+
+func (x *KVTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVTTLResponse.ProtoReflect.Descriptor instead.
+func (*KVTTLResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KVTTLResponse) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+func (x *KVTTLResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type KVDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVDeleteRequest) Reset() {
+	*x = KVDeleteRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVDeleteRequest) ProtoMessage() {}
+
+func (x *KVDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVDeleteRequest.ProtoReflect.Descriptor instead.
+func (*KVDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KVDeleteRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *KVDeleteRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type KVEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVEntry) Reset() {
+	*x = KVEntry{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVEntry) ProtoMessage() {}
+
+func (x *KVEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVEntry.ProtoReflect.Descriptor instead.
+func (*KVEntry) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KVEntry) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KVEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type KVCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// exists requires key to hold value; otherwise key must be absent.
+	Exists        bool `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVCondition) Reset() {
+	*x = KVCondition{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVCondition) ProtoMessage() {}
+
+func (x *KVCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVCondition.ProtoReflect.Descriptor instead.
+func (*KVCondition) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KVCondition) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KVCondition) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVCondition) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type KVWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Delete        bool                   `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	TtlMs         int64                  `protobuf:"varint,4,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVWrite) Reset() {
+	*x = KVWrite{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWrite) ProtoMessage() {}
+
+func (x *KVWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWrite.ProtoReflect.Descriptor instead.
+func (*KVWrite) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KVWrite) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KVWrite) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVWrite) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+func (x *KVWrite) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+type KVBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Conditions    []*KVCondition         `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Writes        []*KVWrite             `protobuf:"bytes,3,rep,name=writes,proto3" json:"writes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVBatchRequest) Reset() {
+	*x = KVBatchRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVBatchRequest) ProtoMessage() {}
+
+func (x *KVBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVBatchRequest.ProtoReflect.Descriptor instead.
+func (*KVBatchRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KVBatchRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *KVBatchRequest) GetConditions() []*KVCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *KVBatchRequest) GetWrites() []*KVWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+type KVBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// conflict reports that a condition did not hold and nothing was written.
+	Conflict      bool `protobuf:"varint,1,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVBatchResponse) Reset() {
+	*x = KVBatchResponse{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVBatchResponse) ProtoMessage() {}
+
+func (x *KVBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVBatchResponse.ProtoReflect.Descriptor instead.
+func (*KVBatchResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KVBatchResponse) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+type KVScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Prefix        []byte                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Start         []byte                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           []byte                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Reverse       bool                   `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        []byte                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVScanRequest) Reset() {
+	*x = KVScanRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVScanRequest) ProtoMessage() {}
+
+func (x *KVScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVScanRequest.ProtoReflect.Descriptor instead.
+func (*KVScanRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KVScanRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *KVScanRequest) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *KVScanRequest) GetStart() []byte {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *KVScanRequest) GetEnd() []byte {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *KVScanRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *KVScanRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *KVScanRequest) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type KVPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*KVEntry             `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor    []byte                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVPage) Reset() {
+	*x = KVPage{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVPage) ProtoMessage() {}
+
+func (x *KVPage) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVPage.ProtoReflect.Descriptor instead.
+func (*KVPage) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *KVPage) GetEntries() []*KVEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *KVPage) GetNextCursor() []byte {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+type KVWatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Prefix        []byte                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVWatchRequest) Reset() {
+	*x = KVWatchRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWatchRequest) ProtoMessage() {}
+
+func (x *KVWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWatchRequest.ProtoReflect.Descriptor instead.
+func (*KVWatchRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KVWatchRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *KVWatchRequest) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+type KVChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// op is "set" or "delete".
+	Op            string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Key           []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue      []byte `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	OldExists     bool   `protobuf:"varint,4,opt,name=old_exists,json=oldExists,proto3" json:"old_exists,omitempty"`
+	NewValue      []byte `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVChange) Reset() {
+	*x = KVChange{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVChange) ProtoMessage() {}
+
+func (x *KVChange) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVChange.ProtoReflect.Descriptor instead.
+func (*KVChange) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KVChange) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *KVChange) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KVChange) GetOldValue() []byte {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *KVChange) GetOldExists() bool {
+	if x != nil {
+		return x.OldExists
+	}
+	return false
+}
+
+func (x *KVChange) GetNewValue() []byte {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+type OpenSQLDBRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenSQLDBRequest) Reset() {
+	*x = OpenSQLDBRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenSQLDBRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSQLDBRequest) ProtoMessage() {}
+
+func (x *OpenSQLDBRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSQLDBRequest.ProtoReflect.Descriptor instead.
+func (*OpenSQLDBRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OpenSQLDBRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SQLDBHandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLDBHandle) Reset() {
+	*x = SQLDBHandle{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLDBHandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDBHandle) ProtoMessage() {}
+
+func (x *SQLDBHandle) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDBHandle.ProtoReflect.Descriptor instead.
+func (*SQLDBHandle) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SQLDBHandle) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type SQLTxHandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Tx            string                 `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLTxHandle) Reset() {
+	*x = SQLTxHandle{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLTxHandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLTxHandle) ProtoMessage() {}
+
+func (x *SQLTxHandle) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLTxHandle.ProtoReflect.Descriptor instead.
+func (*SQLTxHandle) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SQLTxHandle) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *SQLTxHandle) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+// SQLValue is NULL when no value is set.
+type SQLValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*SQLValue_IntValue
+	//	*SQLValue_FloatValue
+	//	*SQLValue_BoolValue
+	//	*SQLValue_StringValue
+	//	*SQLValue_BytesValue
+	//	*SQLValue_TimeValue
+	Value         isSQLValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLValue) Reset() {
+	*x = SQLValue{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLValue) ProtoMessage() {}
+
+func (x *SQLValue) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLValue.ProtoReflect.Descriptor instead.
+func (*SQLValue) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SQLValue) GetValue() isSQLValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SQLValue) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*SQLValue_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *SQLValue) GetFloatValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*SQLValue_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *SQLValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*SQLValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *SQLValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*SQLValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *SQLValue) GetBytesValue() []byte {
+	if x != nil {
+		if x, ok := x.Value.(*SQLValue_BytesValue); ok {
+			return x.BytesValue
+		}
+	}
+	return nil
+}
+
+func (x *SQLValue) GetTimeValue() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Value.(*SQLValue_TimeValue); ok {
+			return x.TimeValue
+		}
+	}
+	return nil
+}
+
+type isSQLValue_Value interface {
+	isSQLValue_Value()
+}
+
+type SQLValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,1,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type SQLValue_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,2,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type SQLValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type SQLValue_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type SQLValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type SQLValue_TimeValue struct {
+	TimeValue *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_value,json=timeValue,proto3,oneof"`
+}
+
+func (*SQLValue_IntValue) isSQLValue_Value() {}
+
+func (*SQLValue_FloatValue) isSQLValue_Value() {}
+
+func (*SQLValue_BoolValue) isSQLValue_Value() {}
+
+func (*SQLValue_StringValue) isSQLValue_Value() {}
+
+func (*SQLValue_BytesValue) isSQLValue_Value() {}
+
+func (*SQLValue_TimeValue) isSQLValue_Value() {}
+
+type SQLExecRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Handle string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// tx runs the statement in a transaction started with Begin.
+	Tx            string      `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Query         string      `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Args          []*SQLValue `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLExecRequest) Reset() {
+	*x = SQLExecRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLExecRequest) ProtoMessage() {}
+
+func (x *SQLExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLExecRequest.ProtoReflect.Descriptor instead.
+func (*SQLExecRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SQLExecRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *SQLExecRequest) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *SQLExecRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SQLExecRequest) GetArgs() []*SQLValue {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type SQLExecResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowsAffected  int64                  `protobuf:"varint,1,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	LastInsertId  int64                  `protobuf:"varint,2,opt,name=last_insert_id,json=lastInsertId,proto3" json:"last_insert_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLExecResponse) Reset() {
+	*x = SQLExecResponse{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLExecResponse) ProtoMessage() {}
+
+func (x *SQLExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLExecResponse.ProtoReflect.Descriptor instead.
+func (*SQLExecResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SQLExecResponse) GetRowsAffected() int64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+func (x *SQLExecResponse) GetLastInsertId() int64 {
+	if x != nil {
+		return x.LastInsertId
+	}
+	return 0
+}
+
+type SQLRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*SQLValue            `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLRow) Reset() {
+	*x = SQLRow{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLRow) ProtoMessage() {}
+
+func (x *SQLRow) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLRow.ProtoReflect.Descriptor instead.
+func (*SQLRow) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SQLRow) GetValues() []*SQLValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SQLRowsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          []*SQLRow              `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLRowsChunk) Reset() {
+	*x = SQLRowsChunk{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLRowsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLRowsChunk) ProtoMessage() {}
+
+func (x *SQLRowsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLRowsChunk.ProtoReflect.Descriptor instead.
+func (*SQLRowsChunk) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SQLRowsChunk) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *SQLRowsChunk) GetRows() []*SQLRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// DBRef names a database of the calling plugin.
+type DBRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DbType        string                 `protobuf:"bytes,3,opt,name=db_type,json=dbType,proto3" json:"db_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBRef) Reset() {
+	*x = DBRef{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBRef) ProtoMessage() {}
+
+func (x *DBRef) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBRef.ProtoReflect.Descriptor instead.
+func (*DBRef) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DBRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DBRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBRef) GetDbType() string {
+	if x != nil {
+		return x.DbType
+	}
+	return ""
+}
+
+type DBBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ref   *DBRef                 `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// id is the stored backup of RestoreBackup.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBBackupRequest) Reset() {
+	*x = DBBackupRequest{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBBackupRequest) ProtoMessage() {}
+
+func (x *DBBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBBackupRequest.ProtoReflect.Descriptor instead.
+func (*DBBackupRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DBBackupRequest) GetRef() *DBRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *DBBackupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DBBackupInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ref           *DBRef                 `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Entries       int64                  `protobuf:"varint,5,opt,name=entries,proto3" json:"entries,omitempty"`
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBBackupInfo) Reset() {
+	*x = DBBackupInfo{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBBackupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBBackupInfo) ProtoMessage() {}
+
+func (x *DBBackupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBBackupInfo.ProtoReflect.Descriptor instead.
+func (*DBBackupInfo) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DBBackupInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DBBackupInfo) GetRef() *DBRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *DBBackupInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *DBBackupInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DBBackupInfo) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *DBBackupInfo) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type DBBackupList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backups       []*DBBackupInfo        `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBBackupList) Reset() {
+	*x = DBBackupList{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBBackupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBBackupList) ProtoMessage() {}
+
+func (x *DBBackupList) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBBackupList.ProtoReflect.Descriptor instead.
+func (*DBBackupList) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DBBackupList) GetBackups() []*DBBackupInfo {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+type DBBackupChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ref is set on the first chunk of ImportDB.
+	Ref  *DBRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// info is set on the last chunk of ExportDB.
+	Info          *DBBackupInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBBackupChunk) Reset() {
+	*x = DBBackupChunk{}
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBBackupChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBBackupChunk) ProtoMessage() {}
+
+func (x *DBBackupChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_database_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBBackupChunk.ProtoReflect.Descriptor instead.
+func (*DBBackupChunk) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_database_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DBBackupChunk) GetRef() *DBRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *DBBackupChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DBBackupChunk) GetInfo() *DBBackupInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+var File_tempest_dynamic_v1_database_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_database_proto_rawDesc = "" +
+	"\n" +
+	"!tempest/dynamic/v1/database.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"D\n" +
+	"\x15OpenKeyValueDBRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\adb_type\x18\x02 \x01(\tR\x06dbType\"*\n" +
+	"\x10KeyValueDBHandle\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\"8\n" +
+	"\fKVGetRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\fR\x03key\"5\n" +
+	"\rKVGetResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\"e\n" +
+	"\fKVSetRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\x12\x15\n" +
+	"\x06ttl_ms\x18\x04 \x01(\x03R\x05ttlMs\"6\n" +
+	"\rKVTTLResponse\x12\x15\n" +
+	"\x06ttl_ms\x18\x01 \x01(\x03R\x05ttlMs\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\";\n" +
+	"\x0fKVDeleteRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\fR\x03key\"1\n" +
+	"\aKVEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"M\n" +
+	"\vKVCondition\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x16\n" +
+	"\x06exists\x18\x03 \x01(\bR\x06exists\"`\n" +
+	"\aKVWrite\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x16\n" +
+	"\x06delete\x18\x03 \x01(\bR\x06delete\x12\x15\n" +
+	"\x06ttl_ms\x18\x04 \x01(\x03R\x05ttlMs\"\x9e\x01\n" +
+	"\x0eKVBatchRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12?\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x1f.tempest.dynamic.v1.KVConditionR\n" +
+	"conditions\x123\n" +
+	"\x06writes\x18\x03 \x03(\v2\x1b.tempest.dynamic.v1.KVWriteR\x06writes\"-\n" +
+	"\x0fKVBatchResponse\x12\x1a\n" +
+	"\bconflict\x18\x01 \x01(\bR\bconflict\"\xaf\x01\n" +
+	"\rKVScanRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\fR\x06prefix\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\fR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\fR\x03end\x12\x18\n" +
+	"\areverse\x18\x05 \x01(\bR\areverse\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\fR\x06cursor\"`\n" +
+	"\x06KVPage\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.tempest.dynamic.v1.KVEntryR\aentries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\fR\n" +
+	"nextCursor\"@\n" +
+	"\x0eKVWatchRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\fR\x06prefix\"\x85\x01\n" +
+	"\bKVChange\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\fR\x03key\x12\x1b\n" +
+	"\told_value\x18\x03 \x01(\fR\boldValue\x12\x1d\n" +
+	"\n" +
+	"old_exists\x18\x04 \x01(\bR\toldExists\x12\x1b\n" +
+	"\tnew_value\x18\x05 \x01(\fR\bnewValue\"&\n" +
+	"\x10OpenSQLDBRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"%\n" +
+	"\vSQLDBHandle\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\"5\n" +
+	"\vSQLTxHandle\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x0e\n" +
+	"\x02tx\x18\x02 \x01(\tR\x02tx\"\xfb\x01\n" +
+	"\bSQLValue\x12\x1d\n" +
+	"\tint_value\x18\x01 \x01(\x03H\x00R\bintValue\x12!\n" +
+	"\vfloat_value\x18\x02 \x01(\x01H\x00R\n" +
+	"floatValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x03 \x01(\bH\x00R\tboolValue\x12#\n" +
+	"\fstring_value\x18\x04 \x01(\tH\x00R\vstringValue\x12!\n" +
+	"\vbytes_value\x18\x05 \x01(\fH\x00R\n" +
+	"bytesValue\x12;\n" +
+	"\n" +
+	"time_value\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\ttimeValueB\a\n" +
+	"\x05value\"\x80\x01\n" +
+	"\x0eSQLExecRequest\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x0e\n" +
+	"\x02tx\x18\x02 \x01(\tR\x02tx\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x120\n" +
+	"\x04args\x18\x04 \x03(\v2\x1c.tempest.dynamic.v1.SQLValueR\x04args\"\\\n" +
+	"\x0fSQLExecResponse\x12#\n" +
+	"\rrows_affected\x18\x01 \x01(\x03R\frowsAffected\x12$\n" +
+	"\x0elast_insert_id\x18\x02 \x01(\x03R\flastInsertId\">\n" +
+	"\x06SQLRow\x124\n" +
+	"\x06values\x18\x01 \x03(\v2\x1c.tempest.dynamic.v1.SQLValueR\x06values\"X\n" +
+	"\fSQLRowsChunk\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12.\n" +
+	"\x04rows\x18\x02 \x03(\v2\x1a.tempest.dynamic.v1.SQLRowR\x04rows\"H\n" +
+	"\x05DBRef\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\adb_type\x18\x03 \x01(\tR\x06dbType\"N\n" +
+	"\x0fDBBackupRequest\x12+\n" +
+	"\x03ref\x18\x01 \x01(\v2\x19.tempest.dynamic.v1.DBRefR\x03ref\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc7\x01\n" +
+	"\fDBBackupInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x03ref\x18\x02 \x01(\v2\x19.tempest.dynamic.v1.DBRefR\x03ref\x124\n" +
+	"\acreated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x18\n" +
+	"\aentries\x18\x05 \x01(\x03R\aentries\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\"J\n" +
+	"\fDBBackupList\x12:\n" +
+	"\abackups\x18\x01 \x03(\v2 .tempest.dynamic.v1.DBBackupInfoR\abackups\"\x86\x01\n" +
+	"\rDBBackupChunk\x12+\n" +
+	"\x03ref\x18\x01 \x01(\v2\x19.tempest.dynamic.v1.DBRefR\x03ref\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x124\n" +
+	"\x04info\x18\x03 \x01(\v2 .tempest.dynamic.v1.DBBackupInfoR\x04info2\xeb\x04\n" +
+	"\x0eDatabaseModule\x12a\n" +
+	"\x0eOpenKeyValueDB\x12).tempest.dynamic.v1.OpenKeyValueDBRequest\x1a$.tempest.dynamic.v1.KeyValueDBHandle\x12R\n" +
+	"\tOpenSQLDB\x12$.tempest.dynamic.v1.OpenSQLDBRequest\x1a\x1f.tempest.dynamic.v1.SQLDBHandle\x12T\n" +
+	"\bExportDB\x12#.tempest.dynamic.v1.DBBackupRequest\x1a!.tempest.dynamic.v1.DBBackupChunk0\x01\x12Q\n" +
+	"\bImportDB\x12!.tempest.dynamic.v1.DBBackupChunk\x1a .tempest.dynamic.v1.DBBackupInfo(\x01\x12O\n" +
+	"\x06Backup\x12#.tempest.dynamic.v1.DBBackupRequest\x1a .tempest.dynamic.v1.DBBackupInfo\x12P\n" +
+	"\aBackups\x12#.tempest.dynamic.v1.DBBackupRequest\x1a .tempest.dynamic.v1.DBBackupList\x12V\n" +
+	"\rRestoreBackup\x12#.tempest.dynamic.v1.DBBackupRequest\x1a .tempest.dynamic.v1.DBBackupInfo2\xf1\x05\n" +
+	"\n" +
+	"KeyValueDB\x12J\n" +
+	"\x03Get\x12 .tempest.dynamic.v1.KVGetRequest\x1a!.tempest.dynamic.v1.KVGetResponse\x12?\n" +
+	"\x03Set\x12 .tempest.dynamic.v1.KVSetRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\n" +
+	"SetWithTTL\x12 .tempest.dynamic.v1.KVSetRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x03TTL\x12 .tempest.dynamic.v1.KVGetRequest\x1a!.tempest.dynamic.v1.KVTTLResponse\x12E\n" +
+	"\x06Delete\x12#.tempest.dynamic.v1.KVDeleteRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\aIterate\x12$.tempest.dynamic.v1.KeyValueDBHandle\x1a\x1b.tempest.dynamic.v1.KVEntry0\x01\x12P\n" +
+	"\x05Batch\x12\".tempest.dynamic.v1.KVBatchRequest\x1a#.tempest.dynamic.v1.KVBatchResponse\x12E\n" +
+	"\x04Scan\x12!.tempest.dynamic.v1.KVScanRequest\x1a\x1a.tempest.dynamic.v1.KVPage\x12K\n" +
+	"\x05Watch\x12\".tempest.dynamic.v1.KVWatchRequest\x1a\x1c.tempest.dynamic.v1.KVChange0\x01\x12E\n" +
+	"\x05Close\x12$.tempest.dynamic.v1.KeyValueDBHandle\x1a\x16.google.protobuf.Empty2\xbe\x03\n" +
+	"\x05SQLDB\x12O\n" +
+	"\x04Exec\x12\".tempest.dynamic.v1.SQLExecRequest\x1a#.tempest.dynamic.v1.SQLExecResponse\x12O\n" +
+	"\x05Query\x12\".tempest.dynamic.v1.SQLExecRequest\x1a .tempest.dynamic.v1.SQLRowsChunk0\x01\x12I\n" +
+	"\x05Begin\x12\x1f.tempest.dynamic.v1.SQLDBHandle\x1a\x1f.tempest.dynamic.v1.SQLTxHandle\x12A\n" +
+	"\x06Commit\x12\x1f.tempest.dynamic.v1.SQLTxHandle\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\bRollback\x12\x1f.tempest.dynamic.v1.SQLTxHandle\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x05Close\x12\x1f.tempest.dynamic.v1.SQLDBHandle\x1a\x16.google.protobuf.EmptyB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_database_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_database_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_database_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_database_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_database_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_database_proto_rawDesc), len(file_tempest_dynamic_v1_database_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_database_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_database_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_tempest_dynamic_v1_database_proto_goTypes = []any{
+	(*OpenKeyValueDBRequest)(nil), // 0: tempest.dynamic.v1.OpenKeyValueDBRequest
+	(*KeyValueDBHandle)(nil),      // 1: tempest.dynamic.v1.KeyValueDBHandle
+	(*KVGetRequest)(nil),          // 2: tempest.dynamic.v1.KVGetRequest
+	(*KVGetResponse)(nil),         // 3: tempest.dynamic.v1.KVGetResponse
+	(*KVSetRequest)(nil),          // 4: tempest.dynamic.v1.KVSetRequest
+	(*KVTTLResponse)(nil),         // 5: tempest.dynamic.v1.KVTTLResponse
+	(*KVDeleteRequest)(nil),       // 6: tempest.dynamic.v1.KVDeleteRequest
+	(*KVEntry)(nil),               // 7: tempest.dynamic.v1.KVEntry
+	(*KVCondition)(nil),           // 8: tempest.dynamic.v1.KVCondition
+	(*KVWrite)(nil),               // 9: tempest.dynamic.v1.KVWrite
+	(*KVBatchRequest)(nil),        // 10: tempest.dynamic.v1.KVBatchRequest
+	(*KVBatchResponse)(nil),       // 11: tempest.dynamic.v1.KVBatchResponse
+	(*KVScanRequest)(nil),         // 12: tempest.dynamic.v1.KVScanRequest
+	(*KVPage)(nil),                // 13: tempest.dynamic.v1.KVPage
+	(*KVWatchRequest)(nil),        // 14: tempest.dynamic.v1.KVWatchRequest
+	(*KVChange)(nil),              // 15: tempest.dynamic.v1.KVChange
+	(*OpenSQLDBRequest)(nil),      // 16: tempest.dynamic.v1.OpenSQLDBRequest
+	(*SQLDBHandle)(nil),           // 17: tempest.dynamic.v1.SQLDBHandle
+	(*SQLTxHandle)(nil),           // 18: tempest.dynamic.v1.SQLTxHandle
+	(*SQLValue)(nil),              // 19: tempest.dynamic.v1.SQLValue
+	(*SQLExecRequest)(nil),        // 20: tempest.dynamic.v1.SQLExecRequest
+	(*SQLExecResponse)(nil),       // 21: tempest.dynamic.v1.SQLExecResponse
+	(*SQLRow)(nil),                // 22: tempest.dynamic.v1.SQLRow
+	(*SQLRowsChunk)(nil),          // 23: tempest.dynamic.v1.SQLRowsChunk
+	(*DBRef)(nil),                 // 24: tempest.dynamic.v1.DBRef
+	(*DBBackupRequest)(nil),       // 25: tempest.dynamic.v1.DBBackupRequest
+	(*DBBackupInfo)(nil),          // 26: tempest.dynamic.v1.DBBackupInfo
+	(*DBBackupList)(nil),          // 27: tempest.dynamic.v1.DBBackupList
+	(*DBBackupChunk)(nil),         // 28: tempest.dynamic.v1.DBBackupChunk
+	(*timestamppb.Timestamp)(nil), // 29: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 30: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_database_proto_depIdxs = []int32{
+	8,  // 0: tempest.dynamic.v1.KVBatchRequest.conditions:type_name -> tempest.dynamic.v1.KVCondition
+	9,  // 1: tempest.dynamic.v1.KVBatchRequest.writes:type_name -> tempest.dynamic.v1.KVWrite
+	7,  // 2: tempest.dynamic.v1.KVPage.entries:type_name -> tempest.dynamic.v1.KVEntry
+	29, // 3: tempest.dynamic.v1.SQLValue.time_value:type_name -> google.protobuf.Timestamp
+	19, // 4: tempest.dynamic.v1.SQLExecRequest.args:type_name -> tempest.dynamic.v1.SQLValue
+	19, // 5: tempest.dynamic.v1.SQLRow.values:type_name -> tempest.dynamic.v1.SQLValue
+	22, // 6: tempest.dynamic.v1.SQLRowsChunk.rows:type_name -> tempest.dynamic.v1.SQLRow
+	24, // 7: tempest.dynamic.v1.DBBackupRequest.ref:type_name -> tempest.dynamic.v1.DBRef
+	24, // 8: tempest.dynamic.v1.DBBackupInfo.ref:type_name -> tempest.dynamic.v1.DBRef
+	29, // 9: tempest.dynamic.v1.DBBackupInfo.created:type_name -> google.protobuf.Timestamp
+	26, // 10: tempest.dynamic.v1.DBBackupList.backups:type_name -> tempest.dynamic.v1.DBBackupInfo
+	24, // 11: tempest.dynamic.v1.DBBackupChunk.ref:type_name -> tempest.dynamic.v1.DBRef
+	26, // 12: tempest.dynamic.v1.DBBackupChunk.info:type_name -> tempest.dynamic.v1.DBBackupInfo
+	0,  // 13: tempest.dynamic.v1.DatabaseModule.OpenKeyValueDB:input_type -> tempest.dynamic.v1.OpenKeyValueDBRequest
+	16, // 14: tempest.dynamic.v1.DatabaseModule.OpenSQLDB:input_type -> tempest.dynamic.v1.OpenSQLDBRequest
+	25, // 15: tempest.dynamic.v1.DatabaseModule.ExportDB:input_type -> tempest.dynamic.v1.DBBackupRequest
+	28, // 16: tempest.dynamic.v1.DatabaseModule.ImportDB:input_type -> tempest.dynamic.v1.DBBackupChunk
+	25, // 17: tempest.dynamic.v1.DatabaseModule.Backup:input_type -> tempest.dynamic.v1.DBBackupRequest
+	25, // 18: tempest.dynamic.v1.DatabaseModule.Backups:input_type -> tempest.dynamic.v1.DBBackupRequest
+	25, // 19: tempest.dynamic.v1.DatabaseModule.RestoreBackup:input_type -> tempest.dynamic.v1.DBBackupRequest
+	2,  // 20: tempest.dynamic.v1.KeyValueDB.Get:input_type -> tempest.dynamic.v1.KVGetRequest
+	4,  // 21: tempest.dynamic.v1.KeyValueDB.Set:input_type -> tempest.dynamic.v1.KVSetRequest
+	4,  // 22: tempest.dynamic.v1.KeyValueDB.SetWithTTL:input_type -> tempest.dynamic.v1.KVSetRequest
+	2,  // 23: tempest.dynamic.v1.KeyValueDB.TTL:input_type -> tempest.dynamic.v1.KVGetRequest
+	6,  // 24: tempest.dynamic.v1.KeyValueDB.Delete:input_type -> tempest.dynamic.v1.KVDeleteRequest
+	1,  // 25: tempest.dynamic.v1.KeyValueDB.Iterate:input_type -> tempest.dynamic.v1.KeyValueDBHandle
+	10, // 26: tempest.dynamic.v1.KeyValueDB.Batch:input_type -> tempest.dynamic.v1.KVBatchRequest
+	12, // 27: tempest.dynamic.v1.KeyValueDB.Scan:input_type -> tempest.dynamic.v1.KVScanRequest
+	14, // 28: tempest.dynamic.v1.KeyValueDB.Watch:input_type -> tempest.dynamic.v1.KVWatchRequest
+	1,  // 29: tempest.dynamic.v1.KeyValueDB.Close:input_type -> tempest.dynamic.v1.KeyValueDBHandle
+	20, // 30: tempest.dynamic.v1.SQLDB.Exec:input_type -> tempest.dynamic.v1.SQLExecRequest
+	20, // 31: tempest.dynamic.v1.SQLDB.Query:input_type -> tempest.dynamic.v1.SQLExecRequest
+	17, // 32: tempest.dynamic.v1.SQLDB.Begin:input_type -> tempest.dynamic.v1.SQLDBHandle
+	18, // 33: tempest.dynamic.v1.SQLDB.Commit:input_type -> tempest.dynamic.v1.SQLTxHandle
+	18, // 34: tempest.dynamic.v1.SQLDB.Rollback:input_type -> tempest.dynamic.v1.SQLTxHandle
+	17, // 35: tempest.dynamic.v1.SQLDB.Close:input_type -> tempest.dynamic.v1.SQLDBHandle
+	1,  // 36: tempest.dynamic.v1.DatabaseModule.OpenKeyValueDB:output_type -> tempest.dynamic.v1.KeyValueDBHandle
+	17, // 37: tempest.dynamic.v1.DatabaseModule.OpenSQLDB:output_type -> tempest.dynamic.v1.SQLDBHandle
+	28, // 38: tempest.dynamic.v1.DatabaseModule.ExportDB:output_type -> tempest.dynamic.v1.DBBackupChunk
+	26, // 39: tempest.dynamic.v1.DatabaseModule.ImportDB:output_type -> tempest.dynamic.v1.DBBackupInfo
+	26, // 40: tempest.dynamic.v1.DatabaseModule.Backup:output_type -> tempest.dynamic.v1.DBBackupInfo
+	27, // 41: tempest.dynamic.v1.DatabaseModule.Backups:output_type -> tempest.dynamic.v1.DBBackupList
+	26, // 42: tempest.dynamic.v1.DatabaseModule.RestoreBackup:output_type -> tempest.dynamic.v1.DBBackupInfo
+	3,  // 43: tempest.dynamic.v1.KeyValueDB.Get:output_type -> tempest.dynamic.v1.KVGetResponse
+	30, // 44: tempest.dynamic.v1.KeyValueDB.Set:output_type -> google.protobuf.Empty
+	30, // 45: tempest.dynamic.v1.KeyValueDB.SetWithTTL:output_type -> google.protobuf.Empty
+	5,  // 46: tempest.dynamic.v1.KeyValueDB.TTL:output_type -> tempest.dynamic.v1.KVTTLResponse
+	30, // 47: tempest.dynamic.v1.KeyValueDB.Delete:output_type -> google.protobuf.Empty
+	7,  // 48: tempest.dynamic.v1.KeyValueDB.Iterate:output_type -> tempest.dynamic.v1.KVEntry
+	11, // 49: tempest.dynamic.v1.KeyValueDB.Batch:output_type -> tempest.dynamic.v1.KVBatchResponse
+	13, // 50: tempest.dynamic.v1.KeyValueDB.Scan:output_type -> tempest.dynamic.v1.KVPage
+	15, // 51: tempest.dynamic.v1.KeyValueDB.Watch:output_type -> tempest.dynamic.v1.KVChange
+	30, // 52: tempest.dynamic.v1.KeyValueDB.Close:output_type -> google.protobuf.Empty
+	21, // 53: tempest.dynamic.v1.SQLDB.Exec:output_type -> tempest.dynamic.v1.SQLExecResponse
+	23, // 54: tempest.dynamic.v1.SQLDB.Query:output_type -> tempest.dynamic.v1.SQLRowsChunk
+	18, // 55: tempest.dynamic.v1.SQLDB.Begin:output_type -> tempest.dynamic.v1.SQLTxHandle
+	30, // 56: tempest.dynamic.v1.SQLDB.Commit:output_type -> google.protobuf.Empty
+	30, // 57: tempest.dynamic.v1.SQLDB.Rollback:output_type -> google.protobuf.Empty
+	30, // 58: tempest.dynamic.v1.SQLDB.Close:output_type -> google.protobuf.Empty
+	36, // [36:59] is the sub-list for method output_type
+	13, // [13:36] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_database_proto_init() }
+func file_tempest_dynamic_v1_database_proto_init() {
+	if File_tempest_dynamic_v1_database_proto != nil {
+		return
+	}
+	file_tempest_dynamic_v1_database_proto_msgTypes[19].OneofWrappers = []any{
+		(*SQLValue_IntValue)(nil),
+		(*SQLValue_FloatValue)(nil),
+		(*SQLValue_BoolValue)(nil),
+		(*SQLValue_StringValue)(nil),
+		(*SQLValue_BytesValue)(nil),
+		(*SQLValue_TimeValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_database_proto_rawDesc), len(file_tempest_dynamic_v1_database_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   29,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_tempest_dynamic_v1_database_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_database_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_database_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_database_proto = out.File
+	file_tempest_dynamic_v1_database_proto_goTypes = nil
+	file_tempest_dynamic_v1_database_proto_depIdxs = nil
+}
@@ -0,0 +1,1191 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/database.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DatabaseModule_OpenKeyValueDB_FullMethodName = "/tempest.dynamic.v1.DatabaseModule/OpenKeyValueDB"
+	DatabaseModule_OpenSQLDB_FullMethodName      = "/tempest.dynamic.v1.DatabaseModule/OpenSQLDB"
+	DatabaseModule_ExportDB_FullMethodName       = "/tempest.dynamic.v1.DatabaseModule/ExportDB"
+	DatabaseModule_ImportDB_FullMethodName       = "/tempest.dynamic.v1.DatabaseModule/ImportDB"
+	DatabaseModule_Backup_FullMethodName         = "/tempest.dynamic.v1.DatabaseModule/Backup"
+	DatabaseModule_Backups_FullMethodName        = "/tempest.dynamic.v1.DatabaseModule/Backups"
+	DatabaseModule_RestoreBackup_FullMethodName  = "/tempest.dynamic.v1.DatabaseModule/RestoreBackup"
+)
+
+// DatabaseModuleClient is the client API for DatabaseModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DatabaseModuleClient interface {
+	OpenKeyValueDB(ctx context.Context, in *OpenKeyValueDBRequest, opts ...grpc.CallOption) (*KeyValueDBHandle, error)
+	// OpenSQLDB opens a SQL database of the calling plugin.
+	OpenSQLDB(ctx context.Context, in *OpenSQLDBRequest, opts ...grpc.CallOption) (*SQLDBHandle, error)
+	// ExportDB streams a backup archive of a database, ending with a chunk carrying its info.
+	ExportDB(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (DatabaseModule_ExportDBClient, error)
+	// ImportDB restores the backup archive streamed after a first chunk naming the database.
+	ImportDB(ctx context.Context, opts ...grpc.CallOption) (DatabaseModule_ImportDBClient, error)
+	Backup(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (*DBBackupInfo, error)
+	Backups(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (*DBBackupList, error)
+	RestoreBackup(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (*DBBackupInfo, error)
+}
+
+type databaseModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatabaseModuleClient(cc grpc.ClientConnInterface) DatabaseModuleClient {
+	return &databaseModuleClient{cc}
+}
+
+func (c *databaseModuleClient) OpenKeyValueDB(ctx context.Context, in *OpenKeyValueDBRequest, opts ...grpc.CallOption) (*KeyValueDBHandle, error) {
+	out := new(KeyValueDBHandle)
+	err := c.cc.Invoke(ctx, DatabaseModule_OpenKeyValueDB_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseModuleClient) OpenSQLDB(ctx context.Context, in *OpenSQLDBRequest, opts ...grpc.CallOption) (*SQLDBHandle, error) {
+	out := new(SQLDBHandle)
+	err := c.cc.Invoke(ctx, DatabaseModule_OpenSQLDB_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseModuleClient) ExportDB(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (DatabaseModule_ExportDBClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DatabaseModule_ServiceDesc.Streams[0], DatabaseModule_ExportDB_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseModuleExportDBClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DatabaseModule_ExportDBClient interface {
+	Recv() (*DBBackupChunk, error)
+	grpc.ClientStream
+}
+
+type databaseModuleExportDBClient struct {
+	grpc.ClientStream
+}
+
+func (x *databaseModuleExportDBClient) Recv() (*DBBackupChunk, error) {
+	m := new(DBBackupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *databaseModuleClient) ImportDB(ctx context.Context, opts ...grpc.CallOption) (DatabaseModule_ImportDBClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DatabaseModule_ServiceDesc.Streams[1], DatabaseModule_ImportDB_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseModuleImportDBClient{stream}
+	return x, nil
+}
+
+type DatabaseModule_ImportDBClient interface {
+	Send(*DBBackupChunk) error
+	CloseAndRecv() (*DBBackupInfo, error)
+	grpc.ClientStream
+}
+
+type databaseModuleImportDBClient struct {
+	grpc.ClientStream
+}
+
+func (x *databaseModuleImportDBClient) Send(m *DBBackupChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *databaseModuleImportDBClient) CloseAndRecv() (*DBBackupInfo, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(DBBackupInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *databaseModuleClient) Backup(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (*DBBackupInfo, error) {
+	out := new(DBBackupInfo)
+	err := c.cc.Invoke(ctx, DatabaseModule_Backup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseModuleClient) Backups(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (*DBBackupList, error) {
+	out := new(DBBackupList)
+	err := c.cc.Invoke(ctx, DatabaseModule_Backups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseModuleClient) RestoreBackup(ctx context.Context, in *DBBackupRequest, opts ...grpc.CallOption) (*DBBackupInfo, error) {
+	out := new(DBBackupInfo)
+	err := c.cc.Invoke(ctx, DatabaseModule_RestoreBackup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatabaseModuleServer is the server API for DatabaseModule service.
+// All implementations must embed UnimplementedDatabaseModuleServer
+// for forward compatibility
+type DatabaseModuleServer interface {
+	OpenKeyValueDB(context.Context, *OpenKeyValueDBRequest) (*KeyValueDBHandle, error)
+	// OpenSQLDB opens a SQL database of the calling plugin.
+	OpenSQLDB(context.Context, *OpenSQLDBRequest) (*SQLDBHandle, error)
+	// ExportDB streams a backup archive of a database, ending with a chunk carrying its info.
+	ExportDB(*DBBackupRequest, DatabaseModule_ExportDBServer) error
+	// ImportDB restores the backup archive streamed after a first chunk naming the database.
+	ImportDB(DatabaseModule_ImportDBServer) error
+	Backup(context.Context, *DBBackupRequest) (*DBBackupInfo, error)
+	Backups(context.Context, *DBBackupRequest) (*DBBackupList, error)
+	RestoreBackup(context.Context, *DBBackupRequest) (*DBBackupInfo, error)
+	mustEmbedUnimplementedDatabaseModuleServer()
+}
+
+// UnimplementedDatabaseModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedDatabaseModuleServer struct {
+}
+
+func (UnimplementedDatabaseModuleServer) OpenKeyValueDB(context.Context, *OpenKeyValueDBRequest) (*KeyValueDBHandle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenKeyValueDB not implemented")
+}
+func (UnimplementedDatabaseModuleServer) OpenSQLDB(context.Context, *OpenSQLDBRequest) (*SQLDBHandle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSQLDB not implemented")
+}
+func (UnimplementedDatabaseModuleServer) ExportDB(*DBBackupRequest, DatabaseModule_ExportDBServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDB not implemented")
+}
+func (UnimplementedDatabaseModuleServer) ImportDB(DatabaseModule_ImportDBServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportDB not implemented")
+}
+func (UnimplementedDatabaseModuleServer) Backup(context.Context, *DBBackupRequest) (*DBBackupInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedDatabaseModuleServer) Backups(context.Context, *DBBackupRequest) (*DBBackupList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backups not implemented")
+}
+func (UnimplementedDatabaseModuleServer) RestoreBackup(context.Context, *DBBackupRequest) (*DBBackupInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
+func (UnimplementedDatabaseModuleServer) mustEmbedUnimplementedDatabaseModuleServer() {}
+
+// UnsafeDatabaseModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatabaseModuleServer will
+// result in compilation errors.
+type UnsafeDatabaseModuleServer interface {
+	mustEmbedUnimplementedDatabaseModuleServer()
+}
+
+func RegisterDatabaseModuleServer(s grpc.ServiceRegistrar, srv DatabaseModuleServer) {
+	s.RegisterService(&DatabaseModule_ServiceDesc, srv)
+}
+
+func _DatabaseModule_OpenKeyValueDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenKeyValueDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseModuleServer).OpenKeyValueDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseModule_OpenKeyValueDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseModuleServer).OpenKeyValueDB(ctx, req.(*OpenKeyValueDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseModule_OpenSQLDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSQLDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseModuleServer).OpenSQLDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseModule_OpenSQLDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseModuleServer).OpenSQLDB(ctx, req.(*OpenSQLDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseModule_ExportDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DBBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatabaseModuleServer).ExportDB(m, &databaseModuleExportDBServer{stream})
+}
+
+type DatabaseModule_ExportDBServer interface {
+	Send(*DBBackupChunk) error
+	grpc.ServerStream
+}
+
+type databaseModuleExportDBServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseModuleExportDBServer) Send(m *DBBackupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DatabaseModule_ImportDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DatabaseModuleServer).ImportDB(&databaseModuleImportDBServer{stream})
+}
+
+type DatabaseModule_ImportDBServer interface {
+	SendAndClose(*DBBackupInfo) error
+	Recv() (*DBBackupChunk, error)
+	grpc.ServerStream
+}
+
+type databaseModuleImportDBServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseModuleImportDBServer) SendAndClose(m *DBBackupInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *databaseModuleImportDBServer) Recv() (*DBBackupChunk, error) {
+	m := new(DBBackupChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DatabaseModule_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseModuleServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseModule_Backup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseModuleServer).Backup(ctx, req.(*DBBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseModule_Backups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseModuleServer).Backups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseModule_Backups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseModuleServer).Backups(ctx, req.(*DBBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseModule_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseModuleServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseModule_RestoreBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseModuleServer).RestoreBackup(ctx, req.(*DBBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DatabaseModule_ServiceDesc is the grpc.ServiceDesc for DatabaseModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DatabaseModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.DatabaseModule",
+	HandlerType: (*DatabaseModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenKeyValueDB",
+			Handler:    _DatabaseModule_OpenKeyValueDB_Handler,
+		},
+		{
+			MethodName: "OpenSQLDB",
+			Handler:    _DatabaseModule_OpenSQLDB_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _DatabaseModule_Backup_Handler,
+		},
+		{
+			MethodName: "Backups",
+			Handler:    _DatabaseModule_Backups_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _DatabaseModule_RestoreBackup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportDB",
+			Handler:       _DatabaseModule_ExportDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportDB",
+			Handler:       _DatabaseModule_ImportDB_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/database.proto",
+}
+
+const (
+	KeyValueDB_Get_FullMethodName        = "/tempest.dynamic.v1.KeyValueDB/Get"
+	KeyValueDB_Set_FullMethodName        = "/tempest.dynamic.v1.KeyValueDB/Set"
+	KeyValueDB_SetWithTTL_FullMethodName = "/tempest.dynamic.v1.KeyValueDB/SetWithTTL"
+	KeyValueDB_TTL_FullMethodName        = "/tempest.dynamic.v1.KeyValueDB/TTL"
+	KeyValueDB_Delete_FullMethodName     = "/tempest.dynamic.v1.KeyValueDB/Delete"
+	KeyValueDB_Iterate_FullMethodName    = "/tempest.dynamic.v1.KeyValueDB/Iterate"
+	KeyValueDB_Batch_FullMethodName      = "/tempest.dynamic.v1.KeyValueDB/Batch"
+	KeyValueDB_Scan_FullMethodName       = "/tempest.dynamic.v1.KeyValueDB/Scan"
+	KeyValueDB_Watch_FullMethodName      = "/tempest.dynamic.v1.KeyValueDB/Watch"
+	KeyValueDB_Close_FullMethodName      = "/tempest.dynamic.v1.KeyValueDB/Close"
+)
+
+// KeyValueDBClient is the client API for KeyValueDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KeyValueDBClient interface {
+	Get(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVGetResponse, error)
+	Set(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetWithTTL sets a key expiring after ttl_ms.
+	SetWithTTL(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TTL(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVTTLResponse, error)
+	Delete(ctx context.Context, in *KVDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Iterate(ctx context.Context, in *KeyValueDBHandle, opts ...grpc.CallOption) (KeyValueDB_IterateClient, error)
+	// Batch commits its writes atomically if every condition holds.
+	Batch(ctx context.Context, in *KVBatchRequest, opts ...grpc.CallOption) (*KVBatchResponse, error)
+	// Scan returns one page of a key range.
+	Scan(ctx context.Context, in *KVScanRequest, opts ...grpc.CallOption) (*KVPage, error)
+	// Watch sends an empty acknowledgement once watching, then one message per change.
+	Watch(ctx context.Context, in *KVWatchRequest, opts ...grpc.CallOption) (KeyValueDB_WatchClient, error)
+	Close(ctx context.Context, in *KeyValueDBHandle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type keyValueDBClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeyValueDBClient(cc grpc.ClientConnInterface) KeyValueDBClient {
+	return &keyValueDBClient{cc}
+}
+
+func (c *keyValueDBClient) Get(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVGetResponse, error) {
+	out := new(KVGetResponse)
+	err := c.cc.Invoke(ctx, KeyValueDB_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) Set(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KeyValueDB_Set_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) SetWithTTL(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KeyValueDB_SetWithTTL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) TTL(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVTTLResponse, error) {
+	out := new(KVTTLResponse)
+	err := c.cc.Invoke(ctx, KeyValueDB_TTL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) Delete(ctx context.Context, in *KVDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KeyValueDB_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) Iterate(ctx context.Context, in *KeyValueDBHandle, opts ...grpc.CallOption) (KeyValueDB_IterateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyValueDB_ServiceDesc.Streams[0], KeyValueDB_Iterate_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyValueDBIterateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KeyValueDB_IterateClient interface {
+	Recv() (*KVEntry, error)
+	grpc.ClientStream
+}
+
+type keyValueDBIterateClient struct {
+	grpc.ClientStream
+}
+
+func (x *keyValueDBIterateClient) Recv() (*KVEntry, error) {
+	m := new(KVEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keyValueDBClient) Batch(ctx context.Context, in *KVBatchRequest, opts ...grpc.CallOption) (*KVBatchResponse, error) {
+	out := new(KVBatchResponse)
+	err := c.cc.Invoke(ctx, KeyValueDB_Batch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) Scan(ctx context.Context, in *KVScanRequest, opts ...grpc.CallOption) (*KVPage, error) {
+	out := new(KVPage)
+	err := c.cc.Invoke(ctx, KeyValueDB_Scan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueDBClient) Watch(ctx context.Context, in *KVWatchRequest, opts ...grpc.CallOption) (KeyValueDB_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyValueDB_ServiceDesc.Streams[1], KeyValueDB_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyValueDBWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KeyValueDB_WatchClient interface {
+	Recv() (*KVChange, error)
+	grpc.ClientStream
+}
+
+type keyValueDBWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *keyValueDBWatchClient) Recv() (*KVChange, error) {
+	m := new(KVChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keyValueDBClient) Close(ctx context.Context, in *KeyValueDBHandle, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KeyValueDB_Close_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyValueDBServer is the server API for KeyValueDB service.
+// All implementations must embed UnimplementedKeyValueDBServer
+// for forward compatibility
+type KeyValueDBServer interface {
+	Get(context.Context, *KVGetRequest) (*KVGetResponse, error)
+	Set(context.Context, *KVSetRequest) (*emptypb.Empty, error)
+	// SetWithTTL sets a key expiring after ttl_ms.
+	SetWithTTL(context.Context, *KVSetRequest) (*emptypb.Empty, error)
+	TTL(context.Context, *KVGetRequest) (*KVTTLResponse, error)
+	Delete(context.Context, *KVDeleteRequest) (*emptypb.Empty, error)
+	Iterate(*KeyValueDBHandle, KeyValueDB_IterateServer) error
+	// Batch commits its writes atomically if every condition holds.
+	Batch(context.Context, *KVBatchRequest) (*KVBatchResponse, error)
+	// Scan returns one page of a key range.
+	Scan(context.Context, *KVScanRequest) (*KVPage, error)
+	// Watch sends an empty acknowledgement once watching, then one message per change.
+	Watch(*KVWatchRequest, KeyValueDB_WatchServer) error
+	Close(context.Context, *KeyValueDBHandle) (*emptypb.Empty, error)
+	mustEmbedUnimplementedKeyValueDBServer()
+}
+
+// UnimplementedKeyValueDBServer must be embedded to have forward compatible implementations.
+type UnimplementedKeyValueDBServer struct {
+}
+
+func (UnimplementedKeyValueDBServer) Get(context.Context, *KVGetRequest) (*KVGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedKeyValueDBServer) Set(context.Context, *KVSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedKeyValueDBServer) SetWithTTL(context.Context, *KVSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithTTL not implemented")
+}
+func (UnimplementedKeyValueDBServer) TTL(context.Context, *KVGetRequest) (*KVTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (UnimplementedKeyValueDBServer) Delete(context.Context, *KVDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedKeyValueDBServer) Iterate(*KeyValueDBHandle, KeyValueDB_IterateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Iterate not implemented")
+}
+func (UnimplementedKeyValueDBServer) Batch(context.Context, *KVBatchRequest) (*KVBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedKeyValueDBServer) Scan(context.Context, *KVScanRequest) (*KVPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (UnimplementedKeyValueDBServer) Watch(*KVWatchRequest, KeyValueDB_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedKeyValueDBServer) Close(context.Context, *KeyValueDBHandle) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedKeyValueDBServer) mustEmbedUnimplementedKeyValueDBServer() {}
+
+// UnsafeKeyValueDBServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeyValueDBServer will
+// result in compilation errors.
+type UnsafeKeyValueDBServer interface {
+	mustEmbedUnimplementedKeyValueDBServer()
+}
+
+func RegisterKeyValueDBServer(s grpc.ServiceRegistrar, srv KeyValueDBServer) {
+	s.RegisterService(&KeyValueDB_ServiceDesc, srv)
+}
+
+func _KeyValueDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).Get(ctx, req.(*KVGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).Set(ctx, req.(*KVSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_SetWithTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).SetWithTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_SetWithTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).SetWithTTL(ctx, req.(*KVSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_TTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).TTL(ctx, req.(*KVGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).Delete(ctx, req.(*KVDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_Iterate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KeyValueDBHandle)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyValueDBServer).Iterate(m, &keyValueDBIterateServer{stream})
+}
+
+type KeyValueDB_IterateServer interface {
+	Send(*KVEntry) error
+	grpc.ServerStream
+}
+
+type keyValueDBIterateServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyValueDBIterateServer) Send(m *KVEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KeyValueDB_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).Batch(ctx, req.(*KVBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).Scan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_Scan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).Scan(ctx, req.(*KVScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueDB_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KVWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyValueDBServer).Watch(m, &keyValueDBWatchServer{stream})
+}
+
+type KeyValueDB_WatchServer interface {
+	Send(*KVChange) error
+	grpc.ServerStream
+}
+
+type keyValueDBWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyValueDBWatchServer) Send(m *KVChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KeyValueDB_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyValueDBHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueDBServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyValueDB_Close_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueDBServer).Close(ctx, req.(*KeyValueDBHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KeyValueDB_ServiceDesc is the grpc.ServiceDesc for KeyValueDB service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KeyValueDB_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.KeyValueDB",
+	HandlerType: (*KeyValueDBServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _KeyValueDB_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _KeyValueDB_Set_Handler,
+		},
+		{
+			MethodName: "SetWithTTL",
+			Handler:    _KeyValueDB_SetWithTTL_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _KeyValueDB_TTL_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _KeyValueDB_Delete_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _KeyValueDB_Batch_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _KeyValueDB_Scan_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _KeyValueDB_Close_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Iterate",
+			Handler:       _KeyValueDB_Iterate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _KeyValueDB_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/database.proto",
+}
+
+const (
+	SQLDB_Exec_FullMethodName     = "/tempest.dynamic.v1.SQLDB/Exec"
+	SQLDB_Query_FullMethodName    = "/tempest.dynamic.v1.SQLDB/Query"
+	SQLDB_Begin_FullMethodName    = "/tempest.dynamic.v1.SQLDB/Begin"
+	SQLDB_Commit_FullMethodName   = "/tempest.dynamic.v1.SQLDB/Commit"
+	SQLDB_Rollback_FullMethodName = "/tempest.dynamic.v1.SQLDB/Rollback"
+	SQLDB_Close_FullMethodName    = "/tempest.dynamic.v1.SQLDB/Close"
+)
+
+// SQLDBClient is the client API for SQLDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SQLDBClient interface {
+	Exec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*SQLExecResponse, error)
+	// Query streams the rows of a query; the first message carries the columns.
+	Query(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (SQLDB_QueryClient, error)
+	Begin(ctx context.Context, in *SQLDBHandle, opts ...grpc.CallOption) (*SQLTxHandle, error)
+	Commit(ctx context.Context, in *SQLTxHandle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Rollback(ctx context.Context, in *SQLTxHandle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Close(ctx context.Context, in *SQLDBHandle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type sQLDBClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSQLDBClient(cc grpc.ClientConnInterface) SQLDBClient {
+	return &sQLDBClient{cc}
+}
+
+func (c *sQLDBClient) Exec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*SQLExecResponse, error) {
+	out := new(SQLExecResponse)
+	err := c.cc.Invoke(ctx, SQLDB_Exec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLDBClient) Query(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (SQLDB_QueryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SQLDB_ServiceDesc.Streams[0], SQLDB_Query_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sQLDBQueryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SQLDB_QueryClient interface {
+	Recv() (*SQLRowsChunk, error)
+	grpc.ClientStream
+}
+
+type sQLDBQueryClient struct {
+	grpc.ClientStream
+}
+
+func (x *sQLDBQueryClient) Recv() (*SQLRowsChunk, error) {
+	m := new(SQLRowsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sQLDBClient) Begin(ctx context.Context, in *SQLDBHandle, opts ...grpc.CallOption) (*SQLTxHandle, error) {
+	out := new(SQLTxHandle)
+	err := c.cc.Invoke(ctx, SQLDB_Begin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLDBClient) Commit(ctx context.Context, in *SQLTxHandle, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLDB_Commit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLDBClient) Rollback(ctx context.Context, in *SQLTxHandle, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLDB_Rollback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLDBClient) Close(ctx context.Context, in *SQLDBHandle, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLDB_Close_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SQLDBServer is the server API for SQLDB service.
+// All implementations must embed UnimplementedSQLDBServer
+// for forward compatibility
+type SQLDBServer interface {
+	Exec(context.Context, *SQLExecRequest) (*SQLExecResponse, error)
+	// Query streams the rows of a query; the first message carries the columns.
+	Query(*SQLExecRequest, SQLDB_QueryServer) error
+	Begin(context.Context, *SQLDBHandle) (*SQLTxHandle, error)
+	Commit(context.Context, *SQLTxHandle) (*emptypb.Empty, error)
+	Rollback(context.Context, *SQLTxHandle) (*emptypb.Empty, error)
+	Close(context.Context, *SQLDBHandle) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSQLDBServer()
+}
+
+// UnimplementedSQLDBServer must be embedded to have forward compatible implementations.
+type UnimplementedSQLDBServer struct {
+}
+
+func (UnimplementedSQLDBServer) Exec(context.Context, *SQLExecRequest) (*SQLExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedSQLDBServer) Query(*SQLExecRequest, SQLDB_QueryServer) error {
+	return status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedSQLDBServer) Begin(context.Context, *SQLDBHandle) (*SQLTxHandle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Begin not implemented")
+}
+func (UnimplementedSQLDBServer) Commit(context.Context, *SQLTxHandle) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (UnimplementedSQLDBServer) Rollback(context.Context, *SQLTxHandle) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedSQLDBServer) Close(context.Context, *SQLDBHandle) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedSQLDBServer) mustEmbedUnimplementedSQLDBServer() {}
+
+// UnsafeSQLDBServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SQLDBServer will
+// result in compilation errors.
+type UnsafeSQLDBServer interface {
+	mustEmbedUnimplementedSQLDBServer()
+}
+
+func RegisterSQLDBServer(s grpc.ServiceRegistrar, srv SQLDBServer) {
+	s.RegisterService(&SQLDB_ServiceDesc, srv)
+}
+
+func _SQLDB_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLDBServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLDB_Exec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLDBServer).Exec(ctx, req.(*SQLExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLDB_Query_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SQLExecRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLDBServer).Query(m, &sQLDBQueryServer{stream})
+}
+
+type SQLDB_QueryServer interface {
+	Send(*SQLRowsChunk) error
+	grpc.ServerStream
+}
+
+type sQLDBQueryServer struct {
+	grpc.ServerStream
+}
+
+func (x *sQLDBQueryServer) Send(m *SQLRowsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SQLDB_Begin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLDBHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLDBServer).Begin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLDB_Begin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLDBServer).Begin(ctx, req.(*SQLDBHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLDB_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLTxHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLDBServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLDB_Commit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLDBServer).Commit(ctx, req.(*SQLTxHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLDB_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLTxHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLDBServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLDB_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLDBServer).Rollback(ctx, req.(*SQLTxHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLDB_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLDBHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLDBServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLDB_Close_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLDBServer).Close(ctx, req.(*SQLDBHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SQLDB_ServiceDesc is the grpc.ServiceDesc for SQLDB service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SQLDB_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.SQLDB",
+	HandlerType: (*SQLDBServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Exec",
+			Handler:    _SQLDB_Exec_Handler,
+		},
+		{
+			MethodName: "Begin",
+			Handler:    _SQLDB_Begin_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _SQLDB_Commit_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _SQLDB_Rollback_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _SQLDB_Close_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Query",
+			Handler:       _SQLDB_Query_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/database.proto",
+}
@@ -0,0 +1,851 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/flex.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FlexSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexSetRequest) Reset() {
+	*x = FlexSetRequest{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexSetRequest) ProtoMessage() {}
+
+func (x *FlexSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexSetRequest.ProtoReflect.Descriptor instead.
+func (*FlexSetRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FlexSetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FlexSetRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type FlexGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexGetRequest) Reset() {
+	*x = FlexGetRequest{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexGetRequest) ProtoMessage() {}
+
+func (x *FlexGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexGetRequest.ProtoReflect.Descriptor instead.
+func (*FlexGetRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FlexGetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type FlexGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexGetResponse) Reset() {
+	*x = FlexGetResponse{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexGetResponse) ProtoMessage() {}
+
+func (x *FlexGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexGetResponse.ProtoReflect.Descriptor instead.
+func (*FlexGetResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlexGetResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FlexGetResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type FlexPublishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	PayloadJson   []byte                 `protobuf:"bytes,2,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexPublishRequest) Reset() {
+	*x = FlexPublishRequest{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexPublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexPublishRequest) ProtoMessage() {}
+
+func (x *FlexPublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexPublishRequest.ProtoReflect.Descriptor instead.
+func (*FlexPublishRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FlexPublishRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *FlexPublishRequest) GetPayloadJson() []byte {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return nil
+}
+
+type FlexSubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexSubscribeRequest) Reset() {
+	*x = FlexSubscribeRequest{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexSubscribeRequest) ProtoMessage() {}
+
+func (x *FlexSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*FlexSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlexSubscribeRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type FlexPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayloadJson   []byte                 `protobuf:"bytes,1,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexPayload) Reset() {
+	*x = FlexPayload{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexPayload) ProtoMessage() {}
+
+func (x *FlexPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexPayload.ProtoReflect.Descriptor instead.
+func (*FlexPayload) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FlexPayload) GetPayloadJson() []byte {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return nil
+}
+
+type FlexExposeMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// api_name and version are set on the first message only.
+	ApiName       string `protobuf:"bytes,1,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	CallId        uint64 `protobuf:"varint,2,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	ResultJson    []byte `protobuf:"bytes,3,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Version       string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexExposeMessage) Reset() {
+	*x = FlexExposeMessage{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexExposeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexExposeMessage) ProtoMessage() {}
+
+func (x *FlexExposeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexExposeMessage.ProtoReflect.Descriptor instead.
+func (*FlexExposeMessage) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FlexExposeMessage) GetApiName() string {
+	if x != nil {
+		return x.ApiName
+	}
+	return ""
+}
+
+func (x *FlexExposeMessage) GetCallId() uint64 {
+	if x != nil {
+		return x.CallId
+	}
+	return 0
+}
+
+func (x *FlexExposeMessage) GetResultJson() []byte {
+	if x != nil {
+		return x.ResultJson
+	}
+	return nil
+}
+
+func (x *FlexExposeMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FlexExposeMessage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type FlexExposeCall struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// call_id 0 acknowledges a successful registration.
+	CallId        uint64               `protobuf:"varint,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	ArgsJson      []byte               `protobuf:"bytes,2,opt,name=args_json,json=argsJson,proto3" json:"args_json,omitempty"`
+	Timeout       *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexExposeCall) Reset() {
+	*x = FlexExposeCall{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexExposeCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexExposeCall) ProtoMessage() {}
+
+func (x *FlexExposeCall) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexExposeCall.ProtoReflect.Descriptor instead.
+func (*FlexExposeCall) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FlexExposeCall) GetCallId() uint64 {
+	if x != nil {
+		return x.CallId
+	}
+	return 0
+}
+
+func (x *FlexExposeCall) GetArgsJson() []byte {
+	if x != nil {
+		return x.ArgsJson
+	}
+	return nil
+}
+
+func (x *FlexExposeCall) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type FlexCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiName       string                 `protobuf:"bytes,1,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	ArgsJson      []byte                 `protobuf:"bytes,2,opt,name=args_json,json=argsJson,proto3" json:"args_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexCallRequest) Reset() {
+	*x = FlexCallRequest{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexCallRequest) ProtoMessage() {}
+
+func (x *FlexCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexCallRequest.ProtoReflect.Descriptor instead.
+func (*FlexCallRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FlexCallRequest) GetApiName() string {
+	if x != nil {
+		return x.ApiName
+	}
+	return ""
+}
+
+func (x *FlexCallRequest) GetArgsJson() []byte {
+	if x != nil {
+		return x.ArgsJson
+	}
+	return nil
+}
+
+type FlexCallResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResultJson []byte                 `protobuf:"bytes,1,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	// error is the handler's error string; transport failures are gRPC errors.
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexCallResponse) Reset() {
+	*x = FlexCallResponse{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexCallResponse) ProtoMessage() {}
+
+func (x *FlexCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexCallResponse.ProtoReflect.Descriptor instead.
+func (*FlexCallResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FlexCallResponse) GetResultJson() []byte {
+	if x != nil {
+		return x.ResultJson
+	}
+	return nil
+}
+
+func (x *FlexCallResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FlexAPIInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// owner is the id of the exposing plugin, empty for host APIs.
+	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexAPIInfo) Reset() {
+	*x = FlexAPIInfo{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexAPIInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexAPIInfo) ProtoMessage() {}
+
+func (x *FlexAPIInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexAPIInfo.ProtoReflect.Descriptor instead.
+func (*FlexAPIInfo) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FlexAPIInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlexAPIInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *FlexAPIInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type FlexListAPIsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apis          []*FlexAPIInfo         `protobuf:"bytes,1,rep,name=apis,proto3" json:"apis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexListAPIsResponse) Reset() {
+	*x = FlexListAPIsResponse{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexListAPIsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexListAPIsResponse) ProtoMessage() {}
+
+func (x *FlexListAPIsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexListAPIsResponse.ProtoReflect.Descriptor instead.
+func (*FlexListAPIsResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FlexListAPIsResponse) GetApis() []*FlexAPIInfo {
+	if x != nil {
+		return x.Apis
+	}
+	return nil
+}
+
+type FlexAPIEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Api           *FlexAPIInfo           `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexAPIEvent) Reset() {
+	*x = FlexAPIEvent{}
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexAPIEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexAPIEvent) ProtoMessage() {}
+
+func (x *FlexAPIEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_flex_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexAPIEvent.ProtoReflect.Descriptor instead.
+func (*FlexAPIEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_flex_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FlexAPIEvent) GetApi() *FlexAPIInfo {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+func (x *FlexAPIEvent) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+var File_tempest_dynamic_v1_flex_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_flex_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtempest/dynamic/v1/flex.proto\x12\x12tempest.dynamic.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"8\n" +
+	"\x0eFlexSetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\"\n" +
+	"\x0eFlexGetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"7\n" +
+	"\x0fFlexGetResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\"M\n" +
+	"\x12FlexPublishRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12!\n" +
+	"\fpayload_json\x18\x02 \x01(\fR\vpayloadJson\",\n" +
+	"\x14FlexSubscribeRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\"0\n" +
+	"\vFlexPayload\x12!\n" +
+	"\fpayload_json\x18\x01 \x01(\fR\vpayloadJson\"\x98\x01\n" +
+	"\x11FlexExposeMessage\x12\x19\n" +
+	"\bapi_name\x18\x01 \x01(\tR\aapiName\x12\x17\n" +
+	"\acall_id\x18\x02 \x01(\x04R\x06callId\x12\x1f\n" +
+	"\vresult_json\x18\x03 \x01(\fR\n" +
+	"resultJson\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\"{\n" +
+	"\x0eFlexExposeCall\x12\x17\n" +
+	"\acall_id\x18\x01 \x01(\x04R\x06callId\x12\x1b\n" +
+	"\targs_json\x18\x02 \x01(\fR\bargsJson\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"I\n" +
+	"\x0fFlexCallRequest\x12\x19\n" +
+	"\bapi_name\x18\x01 \x01(\tR\aapiName\x12\x1b\n" +
+	"\targs_json\x18\x02 \x01(\fR\bargsJson\"I\n" +
+	"\x10FlexCallResponse\x12\x1f\n" +
+	"\vresult_json\x18\x01 \x01(\fR\n" +
+	"resultJson\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"Q\n" +
+	"\vFlexAPIInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"K\n" +
+	"\x14FlexListAPIsResponse\x123\n" +
+	"\x04apis\x18\x01 \x03(\v2\x1f.tempest.dynamic.v1.FlexAPIInfoR\x04apis\"_\n" +
+	"\fFlexAPIEvent\x121\n" +
+	"\x03api\x18\x01 \x01(\v2\x1f.tempest.dynamic.v1.FlexAPIInfoR\x03api\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable2\x87\x05\n" +
+	"\n" +
+	"FlexModule\x12A\n" +
+	"\x03Set\x12\".tempest.dynamic.v1.FlexSetRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x03Get\x12\".tempest.dynamic.v1.FlexGetRequest\x1a#.tempest.dynamic.v1.FlexGetResponse\x12I\n" +
+	"\aPublish\x12&.tempest.dynamic.v1.FlexPublishRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\tSubscribe\x12(.tempest.dynamic.v1.FlexSubscribeRequest\x1a\x1f.tempest.dynamic.v1.FlexPayload0\x01\x12W\n" +
+	"\x06Expose\x12%.tempest.dynamic.v1.FlexExposeMessage\x1a\".tempest.dynamic.v1.FlexExposeCall(\x010\x01\x12Q\n" +
+	"\x04Call\x12#.tempest.dynamic.v1.FlexCallRequest\x1a$.tempest.dynamic.v1.FlexCallResponse\x12L\n" +
+	"\bListAPIs\x12\x16.google.protobuf.Empty\x1a(.tempest.dynamic.v1.FlexListAPIsResponse\x12G\n" +
+	"\tWatchAPIs\x12\x16.google.protobuf.Empty\x1a .tempest.dynamic.v1.FlexAPIEvent0\x01B7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_flex_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_flex_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_flex_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_flex_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_flex_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_flex_proto_rawDesc), len(file_tempest_dynamic_v1_flex_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_flex_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_flex_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_tempest_dynamic_v1_flex_proto_goTypes = []any{
+	(*FlexSetRequest)(nil),       // 0: tempest.dynamic.v1.FlexSetRequest
+	(*FlexGetRequest)(nil),       // 1: tempest.dynamic.v1.FlexGetRequest
+	(*FlexGetResponse)(nil),      // 2: tempest.dynamic.v1.FlexGetResponse
+	(*FlexPublishRequest)(nil),   // 3: tempest.dynamic.v1.FlexPublishRequest
+	(*FlexSubscribeRequest)(nil), // 4: tempest.dynamic.v1.FlexSubscribeRequest
+	(*FlexPayload)(nil),          // 5: tempest.dynamic.v1.FlexPayload
+	(*FlexExposeMessage)(nil),    // 6: tempest.dynamic.v1.FlexExposeMessage
+	(*FlexExposeCall)(nil),       // 7: tempest.dynamic.v1.FlexExposeCall
+	(*FlexCallRequest)(nil),      // 8: tempest.dynamic.v1.FlexCallRequest
+	(*FlexCallResponse)(nil),     // 9: tempest.dynamic.v1.FlexCallResponse
+	(*FlexAPIInfo)(nil),          // 10: tempest.dynamic.v1.FlexAPIInfo
+	(*FlexListAPIsResponse)(nil), // 11: tempest.dynamic.v1.FlexListAPIsResponse
+	(*FlexAPIEvent)(nil),         // 12: tempest.dynamic.v1.FlexAPIEvent
+	(*durationpb.Duration)(nil),  // 13: google.protobuf.Duration
+	(*emptypb.Empty)(nil),        // 14: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_flex_proto_depIdxs = []int32{
+	13, // 0: tempest.dynamic.v1.FlexExposeCall.timeout:type_name -> google.protobuf.Duration
+	10, // 1: tempest.dynamic.v1.FlexListAPIsResponse.apis:type_name -> tempest.dynamic.v1.FlexAPIInfo
+	10, // 2: tempest.dynamic.v1.FlexAPIEvent.api:type_name -> tempest.dynamic.v1.FlexAPIInfo
+	0,  // 3: tempest.dynamic.v1.FlexModule.Set:input_type -> tempest.dynamic.v1.FlexSetRequest
+	1,  // 4: tempest.dynamic.v1.FlexModule.Get:input_type -> tempest.dynamic.v1.FlexGetRequest
+	3,  // 5: tempest.dynamic.v1.FlexModule.Publish:input_type -> tempest.dynamic.v1.FlexPublishRequest
+	4,  // 6: tempest.dynamic.v1.FlexModule.Subscribe:input_type -> tempest.dynamic.v1.FlexSubscribeRequest
+	6,  // 7: tempest.dynamic.v1.FlexModule.Expose:input_type -> tempest.dynamic.v1.FlexExposeMessage
+	8,  // 8: tempest.dynamic.v1.FlexModule.Call:input_type -> tempest.dynamic.v1.FlexCallRequest
+	14, // 9: tempest.dynamic.v1.FlexModule.ListAPIs:input_type -> google.protobuf.Empty
+	14, // 10: tempest.dynamic.v1.FlexModule.WatchAPIs:input_type -> google.protobuf.Empty
+	14, // 11: tempest.dynamic.v1.FlexModule.Set:output_type -> google.protobuf.Empty
+	2,  // 12: tempest.dynamic.v1.FlexModule.Get:output_type -> tempest.dynamic.v1.FlexGetResponse
+	14, // 13: tempest.dynamic.v1.FlexModule.Publish:output_type -> google.protobuf.Empty
+	5,  // 14: tempest.dynamic.v1.FlexModule.Subscribe:output_type -> tempest.dynamic.v1.FlexPayload
+	7,  // 15: tempest.dynamic.v1.FlexModule.Expose:output_type -> tempest.dynamic.v1.FlexExposeCall
+	9,  // 16: tempest.dynamic.v1.FlexModule.Call:output_type -> tempest.dynamic.v1.FlexCallResponse
+	11, // 17: tempest.dynamic.v1.FlexModule.ListAPIs:output_type -> tempest.dynamic.v1.FlexListAPIsResponse
+	12, // 18: tempest.dynamic.v1.FlexModule.WatchAPIs:output_type -> tempest.dynamic.v1.FlexAPIEvent
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_flex_proto_init() }
+func file_tempest_dynamic_v1_flex_proto_init() {
+	if File_tempest_dynamic_v1_flex_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_flex_proto_rawDesc), len(file_tempest_dynamic_v1_flex_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_flex_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_flex_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_flex_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_flex_proto = out.File
+	file_tempest_dynamic_v1_flex_proto_goTypes = nil
+	file_tempest_dynamic_v1_flex_proto_depIdxs = nil
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/flex.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FlexModule_Set_FullMethodName       = "/tempest.dynamic.v1.FlexModule/Set"
+	FlexModule_Get_FullMethodName       = "/tempest.dynamic.v1.FlexModule/Get"
+	FlexModule_Publish_FullMethodName   = "/tempest.dynamic.v1.FlexModule/Publish"
+	FlexModule_Subscribe_FullMethodName = "/tempest.dynamic.v1.FlexModule/Subscribe"
+	FlexModule_Expose_FullMethodName    = "/tempest.dynamic.v1.FlexModule/Expose"
+	FlexModule_Call_FullMethodName      = "/tempest.dynamic.v1.FlexModule/Call"
+	FlexModule_ListAPIs_FullMethodName  = "/tempest.dynamic.v1.FlexModule/ListAPIs"
+	FlexModule_WatchAPIs_FullMethodName = "/tempest.dynamic.v1.FlexModule/WatchAPIs"
+)
+
+// FlexModuleClient is the client API for FlexModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FlexModuleClient interface {
+	Set(ctx context.Context, in *FlexSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Get(ctx context.Context, in *FlexGetRequest, opts ...grpc.CallOption) (*FlexGetResponse, error)
+	Publish(ctx context.Context, in *FlexPublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Subscribe sends an empty acknowledgement once subscribed, then one message per payload.
+	Subscribe(ctx context.Context, in *FlexSubscribeRequest, opts ...grpc.CallOption) (FlexModule_SubscribeClient, error)
+	// Expose: the first client message names the API; the server then streams calls and
+	// the client answers each with the same call_id. Closing the stream removes the API.
+	Expose(ctx context.Context, opts ...grpc.CallOption) (FlexModule_ExposeClient, error)
+	Call(ctx context.Context, in *FlexCallRequest, opts ...grpc.CallOption) (*FlexCallResponse, error)
+	ListAPIs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlexListAPIsResponse, error)
+	// WatchAPIs sends an empty acknowledgement once watching, then one message per change.
+	WatchAPIs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (FlexModule_WatchAPIsClient, error)
+}
+
+type flexModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlexModuleClient(cc grpc.ClientConnInterface) FlexModuleClient {
+	return &flexModuleClient{cc}
+}
+
+func (c *flexModuleClient) Set(ctx context.Context, in *FlexSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FlexModule_Set_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flexModuleClient) Get(ctx context.Context, in *FlexGetRequest, opts ...grpc.CallOption) (*FlexGetResponse, error) {
+	out := new(FlexGetResponse)
+	err := c.cc.Invoke(ctx, FlexModule_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flexModuleClient) Publish(ctx context.Context, in *FlexPublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FlexModule_Publish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flexModuleClient) Subscribe(ctx context.Context, in *FlexSubscribeRequest, opts ...grpc.CallOption) (FlexModule_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlexModule_ServiceDesc.Streams[0], FlexModule_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flexModuleSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlexModule_SubscribeClient interface {
+	Recv() (*FlexPayload, error)
+	grpc.ClientStream
+}
+
+type flexModuleSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *flexModuleSubscribeClient) Recv() (*FlexPayload, error) {
+	m := new(FlexPayload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flexModuleClient) Expose(ctx context.Context, opts ...grpc.CallOption) (FlexModule_ExposeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlexModule_ServiceDesc.Streams[1], FlexModule_Expose_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flexModuleExposeClient{stream}
+	return x, nil
+}
+
+type FlexModule_ExposeClient interface {
+	Send(*FlexExposeMessage) error
+	Recv() (*FlexExposeCall, error)
+	grpc.ClientStream
+}
+
+type flexModuleExposeClient struct {
+	grpc.ClientStream
+}
+
+func (x *flexModuleExposeClient) Send(m *FlexExposeMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *flexModuleExposeClient) Recv() (*FlexExposeCall, error) {
+	m := new(FlexExposeCall)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flexModuleClient) Call(ctx context.Context, in *FlexCallRequest, opts ...grpc.CallOption) (*FlexCallResponse, error) {
+	out := new(FlexCallResponse)
+	err := c.cc.Invoke(ctx, FlexModule_Call_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flexModuleClient) ListAPIs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlexListAPIsResponse, error) {
+	out := new(FlexListAPIsResponse)
+	err := c.cc.Invoke(ctx, FlexModule_ListAPIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flexModuleClient) WatchAPIs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (FlexModule_WatchAPIsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlexModule_ServiceDesc.Streams[2], FlexModule_WatchAPIs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flexModuleWatchAPIsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlexModule_WatchAPIsClient interface {
+	Recv() (*FlexAPIEvent, error)
+	grpc.ClientStream
+}
+
+type flexModuleWatchAPIsClient struct {
+	grpc.ClientStream
+}
+
+func (x *flexModuleWatchAPIsClient) Recv() (*FlexAPIEvent, error) {
+	m := new(FlexAPIEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FlexModuleServer is the server API for FlexModule service.
+// All implementations must embed UnimplementedFlexModuleServer
+// for forward compatibility
+type FlexModuleServer interface {
+	Set(context.Context, *FlexSetRequest) (*emptypb.Empty, error)
+	Get(context.Context, *FlexGetRequest) (*FlexGetResponse, error)
+	Publish(context.Context, *FlexPublishRequest) (*emptypb.Empty, error)
+	// Subscribe sends an empty acknowledgement once subscribed, then one message per payload.
+	Subscribe(*FlexSubscribeRequest, FlexModule_SubscribeServer) error
+	// Expose: the first client message names the API; the server then streams calls and
+	// the client answers each with the same call_id. Closing the stream removes the API.
+	Expose(FlexModule_ExposeServer) error
+	Call(context.Context, *FlexCallRequest) (*FlexCallResponse, error)
+	ListAPIs(context.Context, *emptypb.Empty) (*FlexListAPIsResponse, error)
+	// WatchAPIs sends an empty acknowledgement once watching, then one message per change.
+	WatchAPIs(*emptypb.Empty, FlexModule_WatchAPIsServer) error
+	mustEmbedUnimplementedFlexModuleServer()
+}
+
+// UnimplementedFlexModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedFlexModuleServer struct {
+}
+
+func (UnimplementedFlexModuleServer) Set(context.Context, *FlexSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedFlexModuleServer) Get(context.Context, *FlexGetRequest) (*FlexGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedFlexModuleServer) Publish(context.Context, *FlexPublishRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedFlexModuleServer) Subscribe(*FlexSubscribeRequest, FlexModule_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedFlexModuleServer) Expose(FlexModule_ExposeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Expose not implemented")
+}
+func (UnimplementedFlexModuleServer) Call(context.Context, *FlexCallRequest) (*FlexCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (UnimplementedFlexModuleServer) ListAPIs(context.Context, *emptypb.Empty) (*FlexListAPIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIs not implemented")
+}
+func (UnimplementedFlexModuleServer) WatchAPIs(*emptypb.Empty, FlexModule_WatchAPIsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAPIs not implemented")
+}
+func (UnimplementedFlexModuleServer) mustEmbedUnimplementedFlexModuleServer() {}
+
+// UnsafeFlexModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlexModuleServer will
+// result in compilation errors.
+type UnsafeFlexModuleServer interface {
+	mustEmbedUnimplementedFlexModuleServer()
+}
+
+func RegisterFlexModuleServer(s grpc.ServiceRegistrar, srv FlexModuleServer) {
+	s.RegisterService(&FlexModule_ServiceDesc, srv)
+}
+
+func _FlexModule_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlexSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlexModuleServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlexModule_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlexModuleServer).Set(ctx, req.(*FlexSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlexModule_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlexGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlexModuleServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlexModule_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlexModuleServer).Get(ctx, req.(*FlexGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlexModule_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlexPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlexModuleServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlexModule_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlexModuleServer).Publish(ctx, req.(*FlexPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlexModule_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlexSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlexModuleServer).Subscribe(m, &flexModuleSubscribeServer{stream})
+}
+
+type FlexModule_SubscribeServer interface {
+	Send(*FlexPayload) error
+	grpc.ServerStream
+}
+
+type flexModuleSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *flexModuleSubscribeServer) Send(m *FlexPayload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlexModule_Expose_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FlexModuleServer).Expose(&flexModuleExposeServer{stream})
+}
+
+type FlexModule_ExposeServer interface {
+	Send(*FlexExposeCall) error
+	Recv() (*FlexExposeMessage, error)
+	grpc.ServerStream
+}
+
+type flexModuleExposeServer struct {
+	grpc.ServerStream
+}
+
+func (x *flexModuleExposeServer) Send(m *FlexExposeCall) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *flexModuleExposeServer) Recv() (*FlexExposeMessage, error) {
+	m := new(FlexExposeMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FlexModule_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlexCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlexModuleServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlexModule_Call_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlexModuleServer).Call(ctx, req.(*FlexCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlexModule_ListAPIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlexModuleServer).ListAPIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlexModule_ListAPIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlexModuleServer).ListAPIs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlexModule_WatchAPIs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlexModuleServer).WatchAPIs(m, &flexModuleWatchAPIsServer{stream})
+}
+
+type FlexModule_WatchAPIsServer interface {
+	Send(*FlexAPIEvent) error
+	grpc.ServerStream
+}
+
+type flexModuleWatchAPIsServer struct {
+	grpc.ServerStream
+}
+
+func (x *flexModuleWatchAPIsServer) Send(m *FlexAPIEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// FlexModule_ServiceDesc is the grpc.ServiceDesc for FlexModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FlexModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.FlexModule",
+	HandlerType: (*FlexModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Set",
+			Handler:    _FlexModule_Set_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _FlexModule_Get_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _FlexModule_Publish_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _FlexModule_Call_Handler,
+		},
+		{
+			MethodName: "ListAPIs",
+			Handler:    _FlexModule_ListAPIs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _FlexModule_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Expose",
+			Handler:       _FlexModule_Expose_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchAPIs",
+			Handler:       _FlexModule_WatchAPIs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/flex.proto",
+}
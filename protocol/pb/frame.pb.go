@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/frame.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListModulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModulesResponse) Reset() {
+	*x = ListModulesResponse{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModulesResponse) ProtoMessage() {}
+
+func (x *ListModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModulesResponse.ProtoReflect.Descriptor instead.
+func (*ListModulesResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListModulesResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetModuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleRequest) Reset() {
+	*x = GetModuleRequest{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleRequest) ProtoMessage() {}
+
+func (x *GetModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleRequest.ProtoReflect.Descriptor instead.
+func (*GetModuleRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetModuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetModuleResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Exists bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// kind selects the module service to use ("chat", "commands", ...); empty if the module
+	// has no RPC bridge.
+	Kind          string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleResponse) Reset() {
+	*x = GetModuleResponse{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleResponse) ProtoMessage() {}
+
+func (x *GetModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleResponse.ProtoReflect.Descriptor instead.
+func (*GetModuleResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetModuleResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *GetModuleResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetModuleResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type PluginConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Author          string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Source          string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Disable         bool                   `protobuf:"varint,5,opt,name=disable,proto3" json:"disable,omitempty"`
+	Version         string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	Config          *structpb.Struct       `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	Depends         []*PluginDependency    `protobuf:"bytes,8,rep,name=depends,proto3" json:"depends,omitempty"`
+	RequiredModules []string               `protobuf:"bytes,9,rep,name=required_modules,json=requiredModules,proto3" json:"required_modules,omitempty"`
+	RequiredDaemons []string               `protobuf:"bytes,10,rep,name=required_daemons,json=requiredDaemons,proto3" json:"required_daemons,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PluginConfig) Reset() {
+	*x = PluginConfig{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginConfig) ProtoMessage() {}
+
+func (x *PluginConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginConfig.ProtoReflect.Descriptor instead.
+func (*PluginConfig) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PluginConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PluginConfig) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *PluginConfig) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PluginConfig) GetDisable() bool {
+	if x != nil {
+		return x.Disable
+	}
+	return false
+}
+
+func (x *PluginConfig) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PluginConfig) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PluginConfig) GetDepends() []*PluginDependency {
+	if x != nil {
+		return x.Depends
+	}
+	return nil
+}
+
+func (x *PluginConfig) GetRequiredModules() []string {
+	if x != nil {
+		return x.RequiredModules
+	}
+	return nil
+}
+
+func (x *PluginConfig) GetRequiredDaemons() []string {
+	if x != nil {
+		return x.RequiredDaemons
+	}
+	return nil
+}
+
+type PluginDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginDependency) Reset() {
+	*x = PluginDependency{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginDependency) ProtoMessage() {}
+
+func (x *PluginDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginDependency.ProtoReflect.Descriptor instead.
+func (*PluginDependency) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PluginDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginDependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PluginDependency) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+type GetPluginConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPluginConfigRequest) Reset() {
+	*x = GetPluginConfigRequest{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPluginConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginConfigRequest) ProtoMessage() {}
+
+func (x *GetPluginConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetPluginConfigRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPluginConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPluginConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Config        *PluginConfig          `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPluginConfigResponse) Reset() {
+	*x = GetPluginConfigResponse{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPluginConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginConfigResponse) ProtoMessage() {}
+
+func (x *GetPluginConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetPluginConfigResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPluginConfigResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *GetPluginConfigResponse) GetConfig() *PluginConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpgradePluginConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Config        *structpb.Struct       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradePluginConfigRequest) Reset() {
+	*x = UpgradePluginConfigRequest{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradePluginConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePluginConfigRequest) ProtoMessage() {}
+
+func (x *UpgradePluginConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePluginConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpgradePluginConfigRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpgradePluginConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpgradePluginConfigRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpgradePluginFullConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Config        *PluginConfig          `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradePluginFullConfigRequest) Reset() {
+	*x = UpgradePluginFullConfigRequest{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradePluginFullConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePluginFullConfigRequest) ProtoMessage() {}
+
+func (x *UpgradePluginFullConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePluginFullConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpgradePluginFullConfigRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpgradePluginFullConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpgradePluginFullConfigRequest) GetConfig() *PluginConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ActivateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateEvent) Reset() {
+	*x = ActivateEvent{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEvent) ProtoMessage() {}
+
+func (x *ActivateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEvent.ProtoReflect.Descriptor instead.
+func (*ActivateEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ActivateEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+type WatchConfigChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchConfigChangeRequest) Reset() {
+	*x = WatchConfigChangeRequest{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchConfigChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConfigChangeRequest) ProtoMessage() {}
+
+func (x *WatchConfigChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConfigChangeRequest.ProtoReflect.Descriptor instead.
+func (*WatchConfigChangeRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchConfigChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ConfigChangeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	OldConfig     *PluginConfig          `protobuf:"bytes,2,opt,name=old_config,json=oldConfig,proto3" json:"old_config,omitempty"`
+	NewConfig     *PluginConfig          `protobuf:"bytes,3,opt,name=new_config,json=newConfig,proto3" json:"new_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigChangeEvent) Reset() {
+	*x = ConfigChangeEvent{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChangeEvent) ProtoMessage() {}
+
+func (x *ConfigChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChangeEvent.ProtoReflect.Descriptor instead.
+func (*ConfigChangeEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfigChangeEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *ConfigChangeEvent) GetOldConfig() *PluginConfig {
+	if x != nil {
+		return x.OldConfig
+	}
+	return nil
+}
+
+func (x *ConfigChangeEvent) GetNewConfig() *PluginConfig {
+	if x != nil {
+		return x.NewConfig
+	}
+	return nil
+}
+
+type ModuleCapability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Methods       []string               `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleCapability) Reset() {
+	*x = ModuleCapability{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleCapability) ProtoMessage() {}
+
+func (x *ModuleCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleCapability.ProtoReflect.Descriptor instead.
+func (*ModuleCapability) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModuleCapability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleCapability) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ModuleCapability) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type Capabilities struct {
+	state           protoimpl.MessageState       `protogen:"open.v1"`
+	ProtocolVersion int32                        `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	FrameMethods    []string                     `protobuf:"bytes,2,rep,name=frame_methods,json=frameMethods,proto3" json:"frame_methods,omitempty"`
+	Modules         map[string]*ModuleCapability `protobuf:"bytes,3,rep,name=modules,proto3" json:"modules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_frame_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_frame_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Capabilities) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Capabilities) GetFrameMethods() []string {
+	if x != nil {
+		return x.FrameMethods
+	}
+	return nil
+}
+
+func (x *Capabilities) GetModules() map[string]*ModuleCapability {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+var File_tempest_dynamic_v1_frame_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_frame_proto_rawDesc = "" +
+	"\n" +
+	"\x1etempest/dynamic/v1/frame.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"+\n" +
+	"\x13ListModulesResponse\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"&\n" +
+	"\x10GetModuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"S\n" +
+	"\x11GetModuleResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\"\xef\x02\n" +
+	"\fPluginConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\adisable\x18\x05 \x01(\bR\adisable\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12/\n" +
+	"\x06config\x18\a \x01(\v2\x17.google.protobuf.StructR\x06config\x12>\n" +
+	"\adepends\x18\b \x03(\v2$.tempest.dynamic.v1.PluginDependencyR\adepends\x12)\n" +
+	"\x10required_modules\x18\t \x03(\tR\x0frequiredModules\x12)\n" +
+	"\x10required_daemons\x18\n" +
+	" \x03(\tR\x0frequiredDaemons\"\\\n" +
+	"\x10PluginDependency\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
+	"\boptional\x18\x03 \x01(\bR\boptional\"(\n" +
+	"\x16GetPluginConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"k\n" +
+	"\x17GetPluginConfigResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\x128\n" +
+	"\x06config\x18\x02 \x01(\v2 .tempest.dynamic.v1.PluginConfigR\x06config\"]\n" +
+	"\x1aUpgradePluginConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x06config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06config\"j\n" +
+	"\x1eUpgradePluginFullConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\x06config\x18\x02 \x01(\v2 .tempest.dynamic.v1.PluginConfigR\x06config\"0\n" +
+	"\rActivateEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\"*\n" +
+	"\x18WatchConfigChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb6\x01\n" +
+	"\x11ConfigChangeEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12?\n" +
+	"\n" +
+	"old_config\x18\x02 \x01(\v2 .tempest.dynamic.v1.PluginConfigR\toldConfig\x12?\n" +
+	"\n" +
+	"new_config\x18\x03 \x01(\v2 .tempest.dynamic.v1.PluginConfigR\tnewConfig\"T\n" +
+	"\x10ModuleCapability\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\amethods\x18\x03 \x03(\tR\amethods\"\x89\x02\n" +
+	"\fCapabilities\x12)\n" +
+	"\x10protocol_version\x18\x01 \x01(\x05R\x0fprotocolVersion\x12#\n" +
+	"\rframe_methods\x18\x02 \x03(\tR\fframeMethods\x12G\n" +
+	"\amodules\x18\x03 \x03(\v2-.tempest.dynamic.v1.Capabilities.ModulesEntryR\amodules\x1a`\n" +
+	"\fModulesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
+	"\x05value\x18\x02 \x01(\v2$.tempest.dynamic.v1.ModuleCapabilityR\x05value:\x028\x012\xeb\x05\n" +
+	"\x05Frame\x12N\n" +
+	"\vListModules\x12\x16.google.protobuf.Empty\x1a'.tempest.dynamic.v1.ListModulesResponse\x12X\n" +
+	"\tGetModule\x12$.tempest.dynamic.v1.GetModuleRequest\x1a%.tempest.dynamic.v1.GetModuleResponse\x12j\n" +
+	"\x0fGetPluginConfig\x12*.tempest.dynamic.v1.GetPluginConfigRequest\x1a+.tempest.dynamic.v1.GetPluginConfigResponse\x12]\n" +
+	"\x13UpgradePluginConfig\x12..tempest.dynamic.v1.UpgradePluginConfigRequest\x1a\x16.google.protobuf.Empty\x12e\n" +
+	"\x17UpgradePluginFullConfig\x122.tempest.dynamic.v1.UpgradePluginFullConfigRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\rWatchActivate\x12\x16.google.protobuf.Empty\x1a!.tempest.dynamic.v1.ActivateEvent0\x01\x12j\n" +
+	"\x11WatchConfigChange\x12,.tempest.dynamic.v1.WatchConfigChangeRequest\x1a%.tempest.dynamic.v1.ConfigChangeEvent0\x01\x12L\n" +
+	"\x10ListCapabilities\x12\x16.google.protobuf.Empty\x1a .tempest.dynamic.v1.CapabilitiesB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_frame_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_frame_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_frame_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_frame_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_frame_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_frame_proto_rawDesc), len(file_tempest_dynamic_v1_frame_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_frame_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_frame_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_tempest_dynamic_v1_frame_proto_goTypes = []any{
+	(*ListModulesResponse)(nil),            // 0: tempest.dynamic.v1.ListModulesResponse
+	(*GetModuleRequest)(nil),               // 1: tempest.dynamic.v1.GetModuleRequest
+	(*GetModuleResponse)(nil),              // 2: tempest.dynamic.v1.GetModuleResponse
+	(*PluginConfig)(nil),                   // 3: tempest.dynamic.v1.PluginConfig
+	(*PluginDependency)(nil),               // 4: tempest.dynamic.v1.PluginDependency
+	(*GetPluginConfigRequest)(nil),         // 5: tempest.dynamic.v1.GetPluginConfigRequest
+	(*GetPluginConfigResponse)(nil),        // 6: tempest.dynamic.v1.GetPluginConfigResponse
+	(*UpgradePluginConfigRequest)(nil),     // 7: tempest.dynamic.v1.UpgradePluginConfigRequest
+	(*UpgradePluginFullConfigRequest)(nil), // 8: tempest.dynamic.v1.UpgradePluginFullConfigRequest
+	(*ActivateEvent)(nil),                  // 9: tempest.dynamic.v1.ActivateEvent
+	(*WatchConfigChangeRequest)(nil),       // 10: tempest.dynamic.v1.WatchConfigChangeRequest
+	(*ConfigChangeEvent)(nil),              // 11: tempest.dynamic.v1.ConfigChangeEvent
+	(*ModuleCapability)(nil),               // 12: tempest.dynamic.v1.ModuleCapability
+	(*Capabilities)(nil),                   // 13: tempest.dynamic.v1.Capabilities
+	nil,                                    // 14: tempest.dynamic.v1.Capabilities.ModulesEntry
+	(*structpb.Struct)(nil),                // 15: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                  // 16: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_frame_proto_depIdxs = []int32{
+	15, // 0: tempest.dynamic.v1.PluginConfig.config:type_name -> google.protobuf.Struct
+	4,  // 1: tempest.dynamic.v1.PluginConfig.depends:type_name -> tempest.dynamic.v1.PluginDependency
+	3,  // 2: tempest.dynamic.v1.GetPluginConfigResponse.config:type_name -> tempest.dynamic.v1.PluginConfig
+	15, // 3: tempest.dynamic.v1.UpgradePluginConfigRequest.config:type_name -> google.protobuf.Struct
+	3,  // 4: tempest.dynamic.v1.UpgradePluginFullConfigRequest.config:type_name -> tempest.dynamic.v1.PluginConfig
+	3,  // 5: tempest.dynamic.v1.ConfigChangeEvent.old_config:type_name -> tempest.dynamic.v1.PluginConfig
+	3,  // 6: tempest.dynamic.v1.ConfigChangeEvent.new_config:type_name -> tempest.dynamic.v1.PluginConfig
+	14, // 7: tempest.dynamic.v1.Capabilities.modules:type_name -> tempest.dynamic.v1.Capabilities.ModulesEntry
+	12, // 8: tempest.dynamic.v1.Capabilities.ModulesEntry.value:type_name -> tempest.dynamic.v1.ModuleCapability
+	16, // 9: tempest.dynamic.v1.Frame.ListModules:input_type -> google.protobuf.Empty
+	1,  // 10: tempest.dynamic.v1.Frame.GetModule:input_type -> tempest.dynamic.v1.GetModuleRequest
+	5,  // 11: tempest.dynamic.v1.Frame.GetPluginConfig:input_type -> tempest.dynamic.v1.GetPluginConfigRequest
+	7,  // 12: tempest.dynamic.v1.Frame.UpgradePluginConfig:input_type -> tempest.dynamic.v1.UpgradePluginConfigRequest
+	8,  // 13: tempest.dynamic.v1.Frame.UpgradePluginFullConfig:input_type -> tempest.dynamic.v1.UpgradePluginFullConfigRequest
+	16, // 14: tempest.dynamic.v1.Frame.WatchActivate:input_type -> google.protobuf.Empty
+	10, // 15: tempest.dynamic.v1.Frame.WatchConfigChange:input_type -> tempest.dynamic.v1.WatchConfigChangeRequest
+	16, // 16: tempest.dynamic.v1.Frame.ListCapabilities:input_type -> google.protobuf.Empty
+	0,  // 17: tempest.dynamic.v1.Frame.ListModules:output_type -> tempest.dynamic.v1.ListModulesResponse
+	2,  // 18: tempest.dynamic.v1.Frame.GetModule:output_type -> tempest.dynamic.v1.GetModuleResponse
+	6,  // 19: tempest.dynamic.v1.Frame.GetPluginConfig:output_type -> tempest.dynamic.v1.GetPluginConfigResponse
+	16, // 20: tempest.dynamic.v1.Frame.UpgradePluginConfig:output_type -> google.protobuf.Empty
+	16, // 21: tempest.dynamic.v1.Frame.UpgradePluginFullConfig:output_type -> google.protobuf.Empty
+	9,  // 22: tempest.dynamic.v1.Frame.WatchActivate:output_type -> tempest.dynamic.v1.ActivateEvent
+	11, // 23: tempest.dynamic.v1.Frame.WatchConfigChange:output_type -> tempest.dynamic.v1.ConfigChangeEvent
+	13, // 24: tempest.dynamic.v1.Frame.ListCapabilities:output_type -> tempest.dynamic.v1.Capabilities
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_frame_proto_init() }
+func file_tempest_dynamic_v1_frame_proto_init() {
+	if File_tempest_dynamic_v1_frame_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_frame_proto_rawDesc), len(file_tempest_dynamic_v1_frame_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_frame_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_frame_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_frame_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_frame_proto = out.File
+	file_tempest_dynamic_v1_frame_proto_goTypes = nil
+	file_tempest_dynamic_v1_frame_proto_depIdxs = nil
+}
@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/frame.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Frame_ListModules_FullMethodName             = "/tempest.dynamic.v1.Frame/ListModules"
+	Frame_GetModule_FullMethodName               = "/tempest.dynamic.v1.Frame/GetModule"
+	Frame_GetPluginConfig_FullMethodName         = "/tempest.dynamic.v1.Frame/GetPluginConfig"
+	Frame_UpgradePluginConfig_FullMethodName     = "/tempest.dynamic.v1.Frame/UpgradePluginConfig"
+	Frame_UpgradePluginFullConfig_FullMethodName = "/tempest.dynamic.v1.Frame/UpgradePluginFullConfig"
+	Frame_WatchActivate_FullMethodName           = "/tempest.dynamic.v1.Frame/WatchActivate"
+	Frame_WatchConfigChange_FullMethodName       = "/tempest.dynamic.v1.Frame/WatchConfigChange"
+	Frame_ListCapabilities_FullMethodName        = "/tempest.dynamic.v1.Frame/ListCapabilities"
+)
+
+// FrameClient is the client API for Frame service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FrameClient interface {
+	ListModules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListModulesResponse, error)
+	GetModule(ctx context.Context, in *GetModuleRequest, opts ...grpc.CallOption) (*GetModuleResponse, error)
+	GetPluginConfig(ctx context.Context, in *GetPluginConfigRequest, opts ...grpc.CallOption) (*GetPluginConfigResponse, error)
+	UpgradePluginConfig(ctx context.Context, in *UpgradePluginConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpgradePluginFullConfig(ctx context.Context, in *UpgradePluginFullConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	WatchActivate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Frame_WatchActivateClient, error)
+	WatchConfigChange(ctx context.Context, in *WatchConfigChangeRequest, opts ...grpc.CallOption) (Frame_WatchConfigChangeClient, error)
+	ListCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Capabilities, error)
+}
+
+type frameClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFrameClient(cc grpc.ClientConnInterface) FrameClient {
+	return &frameClient{cc}
+}
+
+func (c *frameClient) ListModules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListModulesResponse, error) {
+	out := new(ListModulesResponse)
+	err := c.cc.Invoke(ctx, Frame_ListModules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameClient) GetModule(ctx context.Context, in *GetModuleRequest, opts ...grpc.CallOption) (*GetModuleResponse, error) {
+	out := new(GetModuleResponse)
+	err := c.cc.Invoke(ctx, Frame_GetModule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameClient) GetPluginConfig(ctx context.Context, in *GetPluginConfigRequest, opts ...grpc.CallOption) (*GetPluginConfigResponse, error) {
+	out := new(GetPluginConfigResponse)
+	err := c.cc.Invoke(ctx, Frame_GetPluginConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameClient) UpgradePluginConfig(ctx context.Context, in *UpgradePluginConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Frame_UpgradePluginConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameClient) UpgradePluginFullConfig(ctx context.Context, in *UpgradePluginFullConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Frame_UpgradePluginFullConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameClient) WatchActivate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Frame_WatchActivateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Frame_ServiceDesc.Streams[0], Frame_WatchActivate_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &frameWatchActivateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Frame_WatchActivateClient interface {
+	Recv() (*ActivateEvent, error)
+	grpc.ClientStream
+}
+
+type frameWatchActivateClient struct {
+	grpc.ClientStream
+}
+
+func (x *frameWatchActivateClient) Recv() (*ActivateEvent, error) {
+	m := new(ActivateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *frameClient) WatchConfigChange(ctx context.Context, in *WatchConfigChangeRequest, opts ...grpc.CallOption) (Frame_WatchConfigChangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Frame_ServiceDesc.Streams[1], Frame_WatchConfigChange_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &frameWatchConfigChangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Frame_WatchConfigChangeClient interface {
+	Recv() (*ConfigChangeEvent, error)
+	grpc.ClientStream
+}
+
+type frameWatchConfigChangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *frameWatchConfigChangeClient) Recv() (*ConfigChangeEvent, error) {
+	m := new(ConfigChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *frameClient) ListCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Capabilities, error) {
+	out := new(Capabilities)
+	err := c.cc.Invoke(ctx, Frame_ListCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FrameServer is the server API for Frame service.
+// All implementations must embed UnimplementedFrameServer
+// for forward compatibility
+type FrameServer interface {
+	ListModules(context.Context, *emptypb.Empty) (*ListModulesResponse, error)
+	GetModule(context.Context, *GetModuleRequest) (*GetModuleResponse, error)
+	GetPluginConfig(context.Context, *GetPluginConfigRequest) (*GetPluginConfigResponse, error)
+	UpgradePluginConfig(context.Context, *UpgradePluginConfigRequest) (*emptypb.Empty, error)
+	UpgradePluginFullConfig(context.Context, *UpgradePluginFullConfigRequest) (*emptypb.Empty, error)
+	WatchActivate(*emptypb.Empty, Frame_WatchActivateServer) error
+	WatchConfigChange(*WatchConfigChangeRequest, Frame_WatchConfigChangeServer) error
+	ListCapabilities(context.Context, *emptypb.Empty) (*Capabilities, error)
+	mustEmbedUnimplementedFrameServer()
+}
+
+// UnimplementedFrameServer must be embedded to have forward compatible implementations.
+type UnimplementedFrameServer struct {
+}
+
+func (UnimplementedFrameServer) ListModules(context.Context, *emptypb.Empty) (*ListModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModules not implemented")
+}
+func (UnimplementedFrameServer) GetModule(context.Context, *GetModuleRequest) (*GetModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModule not implemented")
+}
+func (UnimplementedFrameServer) GetPluginConfig(context.Context, *GetPluginConfigRequest) (*GetPluginConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginConfig not implemented")
+}
+func (UnimplementedFrameServer) UpgradePluginConfig(context.Context, *UpgradePluginConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePluginConfig not implemented")
+}
+func (UnimplementedFrameServer) UpgradePluginFullConfig(context.Context, *UpgradePluginFullConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePluginFullConfig not implemented")
+}
+func (UnimplementedFrameServer) WatchActivate(*emptypb.Empty, Frame_WatchActivateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchActivate not implemented")
+}
+func (UnimplementedFrameServer) WatchConfigChange(*WatchConfigChangeRequest, Frame_WatchConfigChangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfigChange not implemented")
+}
+func (UnimplementedFrameServer) ListCapabilities(context.Context, *emptypb.Empty) (*Capabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCapabilities not implemented")
+}
+func (UnimplementedFrameServer) mustEmbedUnimplementedFrameServer() {}
+
+// UnsafeFrameServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FrameServer will
+// result in compilation errors.
+type UnsafeFrameServer interface {
+	mustEmbedUnimplementedFrameServer()
+}
+
+func RegisterFrameServer(s grpc.ServiceRegistrar, srv FrameServer) {
+	s.RegisterService(&Frame_ServiceDesc, srv)
+}
+
+func _Frame_ListModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServer).ListModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Frame_ListModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServer).ListModules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Frame_GetModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServer).GetModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Frame_GetModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServer).GetModule(ctx, req.(*GetModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Frame_GetPluginConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServer).GetPluginConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Frame_GetPluginConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServer).GetPluginConfig(ctx, req.(*GetPluginConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Frame_UpgradePluginConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradePluginConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServer).UpgradePluginConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Frame_UpgradePluginConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServer).UpgradePluginConfig(ctx, req.(*UpgradePluginConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Frame_UpgradePluginFullConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradePluginFullConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServer).UpgradePluginFullConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Frame_UpgradePluginFullConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServer).UpgradePluginFullConfig(ctx, req.(*UpgradePluginFullConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Frame_WatchActivate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrameServer).WatchActivate(m, &frameWatchActivateServer{stream})
+}
+
+type Frame_WatchActivateServer interface {
+	Send(*ActivateEvent) error
+	grpc.ServerStream
+}
+
+type frameWatchActivateServer struct {
+	grpc.ServerStream
+}
+
+func (x *frameWatchActivateServer) Send(m *ActivateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Frame_WatchConfigChange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigChangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrameServer).WatchConfigChange(m, &frameWatchConfigChangeServer{stream})
+}
+
+type Frame_WatchConfigChangeServer interface {
+	Send(*ConfigChangeEvent) error
+	grpc.ServerStream
+}
+
+type frameWatchConfigChangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *frameWatchConfigChangeServer) Send(m *ConfigChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Frame_ListCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServer).ListCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Frame_ListCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServer).ListCapabilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Frame_ServiceDesc is the grpc.ServiceDesc for Frame service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Frame_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.Frame",
+	HandlerType: (*FrameServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListModules",
+			Handler:    _Frame_ListModules_Handler,
+		},
+		{
+			MethodName: "GetModule",
+			Handler:    _Frame_GetModule_Handler,
+		},
+		{
+			MethodName: "GetPluginConfig",
+			Handler:    _Frame_GetPluginConfig_Handler,
+		},
+		{
+			MethodName: "UpgradePluginConfig",
+			Handler:    _Frame_UpgradePluginConfig_Handler,
+		},
+		{
+			MethodName: "UpgradePluginFullConfig",
+			Handler:    _Frame_UpgradePluginFullConfig_Handler,
+		},
+		{
+			MethodName: "ListCapabilities",
+			Handler:    _Frame_ListCapabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchActivate",
+			Handler:       _Frame_WatchActivate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchConfigChange",
+			Handler:       _Frame_WatchConfigChange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/frame.proto",
+}
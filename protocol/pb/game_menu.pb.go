@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/game_menu.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameMenuEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Triggers      []string               `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	ArgumentHint  string                 `protobuf:"bytes,2,opt,name=argument_hint,json=argumentHint,proto3" json:"argument_hint,omitempty"`
+	Usage         string                 `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMenuEntry) Reset() {
+	*x = GameMenuEntry{}
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMenuEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMenuEntry) ProtoMessage() {}
+
+func (x *GameMenuEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMenuEntry.ProtoReflect.Descriptor instead.
+func (*GameMenuEntry) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_game_menu_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GameMenuEntry) GetTriggers() []string {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *GameMenuEntry) GetArgumentHint() string {
+	if x != nil {
+		return x.ArgumentHint
+	}
+	return ""
+}
+
+func (x *GameMenuEntry) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+type GameMenuTrigger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Chat          *ChatMsg               `protobuf:"bytes,2,opt,name=chat,proto3" json:"chat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMenuTrigger) Reset() {
+	*x = GameMenuTrigger{}
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMenuTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMenuTrigger) ProtoMessage() {}
+
+func (x *GameMenuTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMenuTrigger.ProtoReflect.Descriptor instead.
+func (*GameMenuTrigger) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_game_menu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameMenuTrigger) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *GameMenuTrigger) GetChat() *ChatMsg {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type GameMenuEntryRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMenuEntryRef) Reset() {
+	*x = GameMenuEntryRef{}
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMenuEntryRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMenuEntryRef) ProtoMessage() {}
+
+func (x *GameMenuEntryRef) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMenuEntryRef.ProtoReflect.Descriptor instead.
+func (*GameMenuEntryRef) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_game_menu_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameMenuEntryRef) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type GameMenuEntryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Triggers      []string               `protobuf:"bytes,2,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	ArgumentHint  string                 `protobuf:"bytes,3,opt,name=argument_hint,json=argumentHint,proto3" json:"argument_hint,omitempty"`
+	Usage         string                 `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMenuEntryInfo) Reset() {
+	*x = GameMenuEntryInfo{}
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMenuEntryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMenuEntryInfo) ProtoMessage() {}
+
+func (x *GameMenuEntryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMenuEntryInfo.ProtoReflect.Descriptor instead.
+func (*GameMenuEntryInfo) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_game_menu_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameMenuEntryInfo) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *GameMenuEntryInfo) GetTriggers() []string {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *GameMenuEntryInfo) GetArgumentHint() string {
+	if x != nil {
+		return x.ArgumentHint
+	}
+	return ""
+}
+
+func (x *GameMenuEntryInfo) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+type TriggerGameMenuEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Chat          *ChatMsg               `protobuf:"bytes,2,opt,name=chat,proto3" json:"chat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerGameMenuEntryRequest) Reset() {
+	*x = TriggerGameMenuEntryRequest{}
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerGameMenuEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerGameMenuEntryRequest) ProtoMessage() {}
+
+func (x *TriggerGameMenuEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_game_menu_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerGameMenuEntryRequest.ProtoReflect.Descriptor instead.
+func (*TriggerGameMenuEntryRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_game_menu_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TriggerGameMenuEntryRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *TriggerGameMenuEntryRequest) GetChat() *ChatMsg {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+var File_tempest_dynamic_v1_game_menu_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_game_menu_proto_rawDesc = "" +
+	"\n" +
+	"\"tempest/dynamic/v1/game_menu.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dtempest/dynamic/v1/chat.proto\"f\n" +
+	"\rGameMenuEntry\x12\x1a\n" +
+	"\btriggers\x18\x01 \x03(\tR\btriggers\x12#\n" +
+	"\rargument_hint\x18\x02 \x01(\tR\fargumentHint\x12\x14\n" +
+	"\x05usage\x18\x03 \x01(\tR\x05usage\"]\n" +
+	"\x0fGameMenuTrigger\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12/\n" +
+	"\x04chat\x18\x02 \x01(\v2\x1b.tempest.dynamic.v1.ChatMsgR\x04chat\"-\n" +
+	"\x10GameMenuEntryRef\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\"\x85\x01\n" +
+	"\x11GameMenuEntryInfo\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x1a\n" +
+	"\btriggers\x18\x02 \x03(\tR\btriggers\x12#\n" +
+	"\rargument_hint\x18\x03 \x01(\tR\fargumentHint\x12\x14\n" +
+	"\x05usage\x18\x04 \x01(\tR\x05usage\"i\n" +
+	"\x1bTriggerGameMenuEntryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12/\n" +
+	"\x04chat\x18\x02 \x01(\v2\x1b.tempest.dynamic.v1.ChatMsgR\x04chat2\xea\x02\n" +
+	"\x0eGameMenuModule\x12Y\n" +
+	"\rRegisterEntry\x12!.tempest.dynamic.v1.GameMenuEntry\x1a#.tempest.dynamic.v1.GameMenuTrigger0\x01\x12O\n" +
+	"\x0fRemoveMenuEntry\x12$.tempest.dynamic.v1.GameMenuEntryRef\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x10SubscribeEntries\x12\x16.google.protobuf.Empty\x1a%.tempest.dynamic.v1.GameMenuEntryInfo0\x01\x12W\n" +
+	"\fTriggerEntry\x12/.tempest.dynamic.v1.TriggerGameMenuEntryRequest\x1a\x16.google.protobuf.EmptyB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_game_menu_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_game_menu_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_game_menu_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_game_menu_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_game_menu_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_game_menu_proto_rawDesc), len(file_tempest_dynamic_v1_game_menu_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_game_menu_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_game_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tempest_dynamic_v1_game_menu_proto_goTypes = []any{
+	(*GameMenuEntry)(nil),               // 0: tempest.dynamic.v1.GameMenuEntry
+	(*GameMenuTrigger)(nil),             // 1: tempest.dynamic.v1.GameMenuTrigger
+	(*GameMenuEntryRef)(nil),            // 2: tempest.dynamic.v1.GameMenuEntryRef
+	(*GameMenuEntryInfo)(nil),           // 3: tempest.dynamic.v1.GameMenuEntryInfo
+	(*TriggerGameMenuEntryRequest)(nil), // 4: tempest.dynamic.v1.TriggerGameMenuEntryRequest
+	(*ChatMsg)(nil),                     // 5: tempest.dynamic.v1.ChatMsg
+	(*emptypb.Empty)(nil),               // 6: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_game_menu_proto_depIdxs = []int32{
+	5, // 0: tempest.dynamic.v1.GameMenuTrigger.chat:type_name -> tempest.dynamic.v1.ChatMsg
+	5, // 1: tempest.dynamic.v1.TriggerGameMenuEntryRequest.chat:type_name -> tempest.dynamic.v1.ChatMsg
+	0, // 2: tempest.dynamic.v1.GameMenuModule.RegisterEntry:input_type -> tempest.dynamic.v1.GameMenuEntry
+	2, // 3: tempest.dynamic.v1.GameMenuModule.RemoveMenuEntry:input_type -> tempest.dynamic.v1.GameMenuEntryRef
+	6, // 4: tempest.dynamic.v1.GameMenuModule.SubscribeEntries:input_type -> google.protobuf.Empty
+	4, // 5: tempest.dynamic.v1.GameMenuModule.TriggerEntry:input_type -> tempest.dynamic.v1.TriggerGameMenuEntryRequest
+	1, // 6: tempest.dynamic.v1.GameMenuModule.RegisterEntry:output_type -> tempest.dynamic.v1.GameMenuTrigger
+	6, // 7: tempest.dynamic.v1.GameMenuModule.RemoveMenuEntry:output_type -> google.protobuf.Empty
+	3, // 8: tempest.dynamic.v1.GameMenuModule.SubscribeEntries:output_type -> tempest.dynamic.v1.GameMenuEntryInfo
+	6, // 9: tempest.dynamic.v1.GameMenuModule.TriggerEntry:output_type -> google.protobuf.Empty
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_game_menu_proto_init() }
+func file_tempest_dynamic_v1_game_menu_proto_init() {
+	if File_tempest_dynamic_v1_game_menu_proto != nil {
+		return
+	}
+	file_tempest_dynamic_v1_chat_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_game_menu_proto_rawDesc), len(file_tempest_dynamic_v1_game_menu_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_game_menu_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_game_menu_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_game_menu_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_game_menu_proto = out.File
+	file_tempest_dynamic_v1_game_menu_proto_goTypes = nil
+	file_tempest_dynamic_v1_game_menu_proto_depIdxs = nil
+}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/game_menu.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GameMenuModule_RegisterEntry_FullMethodName    = "/tempest.dynamic.v1.GameMenuModule/RegisterEntry"
+	GameMenuModule_RemoveMenuEntry_FullMethodName  = "/tempest.dynamic.v1.GameMenuModule/RemoveMenuEntry"
+	GameMenuModule_SubscribeEntries_FullMethodName = "/tempest.dynamic.v1.GameMenuModule/SubscribeEntries"
+	GameMenuModule_TriggerEntry_FullMethodName     = "/tempest.dynamic.v1.GameMenuModule/TriggerEntry"
+)
+
+// GameMenuModuleClient is the client API for GameMenuModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameMenuModuleClient interface {
+	// RegisterEntry sends the assigned entry id first, then one message per trigger.
+	// Cancelling the stream removes the entry.
+	RegisterEntry(ctx context.Context, in *GameMenuEntry, opts ...grpc.CallOption) (GameMenuModule_RegisterEntryClient, error)
+	RemoveMenuEntry(ctx context.Context, in *GameMenuEntryRef, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SubscribeEntries sends an empty acknowledgement, replays the existing entries, then streams updates.
+	SubscribeEntries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (GameMenuModule_SubscribeEntriesClient, error)
+	TriggerEntry(ctx context.Context, in *TriggerGameMenuEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gameMenuModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameMenuModuleClient(cc grpc.ClientConnInterface) GameMenuModuleClient {
+	return &gameMenuModuleClient{cc}
+}
+
+func (c *gameMenuModuleClient) RegisterEntry(ctx context.Context, in *GameMenuEntry, opts ...grpc.CallOption) (GameMenuModule_RegisterEntryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GameMenuModule_ServiceDesc.Streams[0], GameMenuModule_RegisterEntry_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameMenuModuleRegisterEntryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GameMenuModule_RegisterEntryClient interface {
+	Recv() (*GameMenuTrigger, error)
+	grpc.ClientStream
+}
+
+type gameMenuModuleRegisterEntryClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameMenuModuleRegisterEntryClient) Recv() (*GameMenuTrigger, error) {
+	m := new(GameMenuTrigger)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameMenuModuleClient) RemoveMenuEntry(ctx context.Context, in *GameMenuEntryRef, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GameMenuModule_RemoveMenuEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMenuModuleClient) SubscribeEntries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (GameMenuModule_SubscribeEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GameMenuModule_ServiceDesc.Streams[1], GameMenuModule_SubscribeEntries_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameMenuModuleSubscribeEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GameMenuModule_SubscribeEntriesClient interface {
+	Recv() (*GameMenuEntryInfo, error)
+	grpc.ClientStream
+}
+
+type gameMenuModuleSubscribeEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameMenuModuleSubscribeEntriesClient) Recv() (*GameMenuEntryInfo, error) {
+	m := new(GameMenuEntryInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameMenuModuleClient) TriggerEntry(ctx context.Context, in *TriggerGameMenuEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GameMenuModule_TriggerEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameMenuModuleServer is the server API for GameMenuModule service.
+// All implementations must embed UnimplementedGameMenuModuleServer
+// for forward compatibility
+type GameMenuModuleServer interface {
+	// RegisterEntry sends the assigned entry id first, then one message per trigger.
+	// Cancelling the stream removes the entry.
+	RegisterEntry(*GameMenuEntry, GameMenuModule_RegisterEntryServer) error
+	RemoveMenuEntry(context.Context, *GameMenuEntryRef) (*emptypb.Empty, error)
+	// SubscribeEntries sends an empty acknowledgement, replays the existing entries, then streams updates.
+	SubscribeEntries(*emptypb.Empty, GameMenuModule_SubscribeEntriesServer) error
+	TriggerEntry(context.Context, *TriggerGameMenuEntryRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGameMenuModuleServer()
+}
+
+// UnimplementedGameMenuModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedGameMenuModuleServer struct {
+}
+
+func (UnimplementedGameMenuModuleServer) RegisterEntry(*GameMenuEntry, GameMenuModule_RegisterEntryServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterEntry not implemented")
+}
+func (UnimplementedGameMenuModuleServer) RemoveMenuEntry(context.Context, *GameMenuEntryRef) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMenuEntry not implemented")
+}
+func (UnimplementedGameMenuModuleServer) SubscribeEntries(*emptypb.Empty, GameMenuModule_SubscribeEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEntries not implemented")
+}
+func (UnimplementedGameMenuModuleServer) TriggerEntry(context.Context, *TriggerGameMenuEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerEntry not implemented")
+}
+func (UnimplementedGameMenuModuleServer) mustEmbedUnimplementedGameMenuModuleServer() {}
+
+// UnsafeGameMenuModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameMenuModuleServer will
+// result in compilation errors.
+type UnsafeGameMenuModuleServer interface {
+	mustEmbedUnimplementedGameMenuModuleServer()
+}
+
+func RegisterGameMenuModuleServer(s grpc.ServiceRegistrar, srv GameMenuModuleServer) {
+	s.RegisterService(&GameMenuModule_ServiceDesc, srv)
+}
+
+func _GameMenuModule_RegisterEntry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GameMenuEntry)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameMenuModuleServer).RegisterEntry(m, &gameMenuModuleRegisterEntryServer{stream})
+}
+
+type GameMenuModule_RegisterEntryServer interface {
+	Send(*GameMenuTrigger) error
+	grpc.ServerStream
+}
+
+type gameMenuModuleRegisterEntryServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameMenuModuleRegisterEntryServer) Send(m *GameMenuTrigger) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GameMenuModule_RemoveMenuEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameMenuEntryRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMenuModuleServer).RemoveMenuEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMenuModule_RemoveMenuEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMenuModuleServer).RemoveMenuEntry(ctx, req.(*GameMenuEntryRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMenuModule_SubscribeEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameMenuModuleServer).SubscribeEntries(m, &gameMenuModuleSubscribeEntriesServer{stream})
+}
+
+type GameMenuModule_SubscribeEntriesServer interface {
+	Send(*GameMenuEntryInfo) error
+	grpc.ServerStream
+}
+
+type gameMenuModuleSubscribeEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameMenuModuleSubscribeEntriesServer) Send(m *GameMenuEntryInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GameMenuModule_TriggerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerGameMenuEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMenuModuleServer).TriggerEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMenuModule_TriggerEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMenuModuleServer).TriggerEntry(ctx, req.(*TriggerGameMenuEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameMenuModule_ServiceDesc is the grpc.ServiceDesc for GameMenuModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameMenuModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.GameMenuModule",
+	HandlerType: (*GameMenuModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveMenuEntry",
+			Handler:    _GameMenuModule_RemoveMenuEntry_Handler,
+		},
+		{
+			MethodName: "TriggerEntry",
+			Handler:    _GameMenuModule_TriggerEntry_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterEntry",
+			Handler:       _GameMenuModule_RegisterEntry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEntries",
+			Handler:       _GameMenuModule_SubscribeEntries_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/game_menu.proto",
+}
@@ -0,0 +1,9 @@
+// Package pb contains the Go code generated from proto/tempest/dynamic/v1.
+//
+// The .proto files describe the gRPC transport (ProtocolVersion3) so plugins can be written in
+// any language with gRPC support. Regenerate with protoc, protoc-gen-go and protoc-gen-go-grpc:
+//
+//	go generate ./protocol/pb
+package pb
+
+//go:generate sh -c "protoc -I ../../proto --go_out=. --go_opt=module=github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb --go-grpc_out=. --go-grpc_opt=module=github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb ../../proto/tempest/dynamic/v1/*.proto"
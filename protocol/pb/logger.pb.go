@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/logger.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Scope string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// level is one of "SUCC", "INFO", "WARN", "ERRO", "DBUG", "TRAC".
+	Level         string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Msg           string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_logger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LogRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LogRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type LogField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is unset for nil.
+	//
+	// Types that are valid to be assigned to Value:
+	//
+	//	*LogField_StringValue
+	//	*LogField_IntValue
+	//	*LogField_UintValue
+	//	*LogField_FloatValue
+	//	*LogField_BoolValue
+	//	*LogField_TimeValue
+	Value         isLogField_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogField) Reset() {
+	*x = LogField{}
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogField) ProtoMessage() {}
+
+func (x *LogField) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogField.ProtoReflect.Descriptor instead.
+func (*LogField) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_logger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LogField) GetValue() isLogField_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *LogField) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*LogField_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *LogField) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*LogField_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *LogField) GetUintValue() uint64 {
+	if x != nil {
+		if x, ok := x.Value.(*LogField_UintValue); ok {
+			return x.UintValue
+		}
+	}
+	return 0
+}
+
+func (x *LogField) GetFloatValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*LogField_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *LogField) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*LogField_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *LogField) GetTimeValue() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Value.(*LogField_TimeValue); ok {
+			return x.TimeValue
+		}
+	}
+	return nil
+}
+
+type isLogField_Value interface {
+	isLogField_Value()
+}
+
+type LogField_StringValue struct {
+	StringValue string `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type LogField_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type LogField_UintValue struct {
+	UintValue uint64 `protobuf:"varint,4,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type LogField_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,5,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type LogField_BoolValue struct {
+	BoolValue bool `protobuf:"varint,6,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type LogField_TimeValue struct {
+	TimeValue *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time_value,json=timeValue,proto3,oneof"`
+}
+
+func (*LogField_StringValue) isLogField_Value() {}
+
+func (*LogField_IntValue) isLogField_Value() {}
+
+func (*LogField_UintValue) isLogField_Value() {}
+
+func (*LogField_FloatValue) isLogField_Value() {}
+
+func (*LogField_BoolValue) isLogField_Value() {}
+
+func (*LogField_TimeValue) isLogField_Value() {}
+
+type LogRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Msg           string                 `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Err           string                 `protobuf:"bytes,5,opt,name=err,proto3" json:"err,omitempty"`
+	Fields        []*LogField            `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRecordRequest) Reset() {
+	*x = LogRecordRequest{}
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRecordRequest) ProtoMessage() {}
+
+func (x *LogRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRecordRequest.ProtoReflect.Descriptor instead.
+func (*LogRecordRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_logger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogRecordRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LogRecordRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LogRecordRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogRecordRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LogRecordRequest) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *LogRecordRequest) GetFields() []*LogField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type LogQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scopes        []string               `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Levels        []string               `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Ascending     bool                   `protobuf:"varint,8,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogQuery) Reset() {
+	*x = LogQuery{}
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogQuery) ProtoMessage() {}
+
+func (x *LogQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogQuery.ProtoReflect.Descriptor instead.
+func (*LogQuery) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_logger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogQuery) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *LogQuery) GetLevels() []string {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *LogQuery) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *LogQuery) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *LogQuery) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LogQuery) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LogQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *LogQuery) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+type LogPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*LogRecordRequest    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogPage) Reset() {
+	*x = LogPage{}
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPage) ProtoMessage() {}
+
+func (x *LogPage) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_logger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPage.ProtoReflect.Descriptor instead.
+func (*LogPage) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_logger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LogPage) GetRecords() []*LogRecordRequest {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *LogPage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_tempest_dynamic_v1_logger_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_logger_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftempest/dynamic/v1/logger.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"J\n" +
+	"\n" +
+	"LogRequest\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"\x8b\x02\n" +
+	"\bLogField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12#\n" +
+	"\fstring_value\x18\x02 \x01(\tH\x00R\vstringValue\x12\x1d\n" +
+	"\tint_value\x18\x03 \x01(\x03H\x00R\bintValue\x12\x1f\n" +
+	"\n" +
+	"uint_value\x18\x04 \x01(\x04H\x00R\tuintValue\x12!\n" +
+	"\vfloat_value\x18\x05 \x01(\x01H\x00R\n" +
+	"floatValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x06 \x01(\bH\x00R\tboolValue\x12;\n" +
+	"\n" +
+	"time_value\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\ttimeValueB\a\n" +
+	"\x05value\"\xc8\x01\n" +
+	"\x10LogRecordRequest\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x10\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\x12\x10\n" +
+	"\x03err\x18\x05 \x01(\tR\x03err\x124\n" +
+	"\x06fields\x18\x06 \x03(\v2\x1c.tempest.dynamic.v1.LogFieldR\x06fields\"\xfe\x01\n" +
+	"\bLogQuery\x12\x16\n" +
+	"\x06scopes\x18\x01 \x03(\tR\x06scopes\x12\x16\n" +
+	"\x06levels\x18\x02 \x03(\tR\x06levels\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x1c\n" +
+	"\tascending\x18\b \x01(\bR\tascending\"j\n" +
+	"\aLogPage\x12>\n" +
+	"\arecords\x18\x01 \x03(\v2$.tempest.dynamic.v1.LogRecordRequestR\arecords\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor2\xb2\x02\n" +
+	"\fLoggerModule\x12=\n" +
+	"\x03Log\x12\x1e.tempest.dynamic.v1.LogRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\tLogRecord\x12$.tempest.dynamic.v1.LogRecordRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\tQueryLogs\x12\x1c.tempest.dynamic.v1.LogQuery\x1a\x1b.tempest.dynamic.v1.LogPage\x12P\n" +
+	"\bTailLogs\x12\x1c.tempest.dynamic.v1.LogQuery\x1a$.tempest.dynamic.v1.LogRecordRequest0\x01B7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_logger_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_logger_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_logger_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_logger_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_logger_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_logger_proto_rawDesc), len(file_tempest_dynamic_v1_logger_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_logger_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_logger_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tempest_dynamic_v1_logger_proto_goTypes = []any{
+	(*LogRequest)(nil),            // 0: tempest.dynamic.v1.LogRequest
+	(*LogField)(nil),              // 1: tempest.dynamic.v1.LogField
+	(*LogRecordRequest)(nil),      // 2: tempest.dynamic.v1.LogRecordRequest
+	(*LogQuery)(nil),              // 3: tempest.dynamic.v1.LogQuery
+	(*LogPage)(nil),               // 4: tempest.dynamic.v1.LogPage
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 6: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_logger_proto_depIdxs = []int32{
+	5,  // 0: tempest.dynamic.v1.LogField.time_value:type_name -> google.protobuf.Timestamp
+	5,  // 1: tempest.dynamic.v1.LogRecordRequest.time:type_name -> google.protobuf.Timestamp
+	1,  // 2: tempest.dynamic.v1.LogRecordRequest.fields:type_name -> tempest.dynamic.v1.LogField
+	5,  // 3: tempest.dynamic.v1.LogQuery.since:type_name -> google.protobuf.Timestamp
+	5,  // 4: tempest.dynamic.v1.LogQuery.until:type_name -> google.protobuf.Timestamp
+	2,  // 5: tempest.dynamic.v1.LogPage.records:type_name -> tempest.dynamic.v1.LogRecordRequest
+	0,  // 6: tempest.dynamic.v1.LoggerModule.Log:input_type -> tempest.dynamic.v1.LogRequest
+	2,  // 7: tempest.dynamic.v1.LoggerModule.LogRecord:input_type -> tempest.dynamic.v1.LogRecordRequest
+	3,  // 8: tempest.dynamic.v1.LoggerModule.QueryLogs:input_type -> tempest.dynamic.v1.LogQuery
+	3,  // 9: tempest.dynamic.v1.LoggerModule.TailLogs:input_type -> tempest.dynamic.v1.LogQuery
+	6,  // 10: tempest.dynamic.v1.LoggerModule.Log:output_type -> google.protobuf.Empty
+	6,  // 11: tempest.dynamic.v1.LoggerModule.LogRecord:output_type -> google.protobuf.Empty
+	4,  // 12: tempest.dynamic.v1.LoggerModule.QueryLogs:output_type -> tempest.dynamic.v1.LogPage
+	2,  // 13: tempest.dynamic.v1.LoggerModule.TailLogs:output_type -> tempest.dynamic.v1.LogRecordRequest
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_logger_proto_init() }
+func file_tempest_dynamic_v1_logger_proto_init() {
+	if File_tempest_dynamic_v1_logger_proto != nil {
+		return
+	}
+	file_tempest_dynamic_v1_logger_proto_msgTypes[1].OneofWrappers = []any{
+		(*LogField_StringValue)(nil),
+		(*LogField_IntValue)(nil),
+		(*LogField_UintValue)(nil),
+		(*LogField_FloatValue)(nil),
+		(*LogField_BoolValue)(nil),
+		(*LogField_TimeValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_logger_proto_rawDesc), len(file_tempest_dynamic_v1_logger_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_logger_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_logger_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_logger_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_logger_proto = out.File
+	file_tempest_dynamic_v1_logger_proto_goTypes = nil
+	file_tempest_dynamic_v1_logger_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/logger.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LoggerModule_Log_FullMethodName       = "/tempest.dynamic.v1.LoggerModule/Log"
+	LoggerModule_LogRecord_FullMethodName = "/tempest.dynamic.v1.LoggerModule/LogRecord"
+	LoggerModule_QueryLogs_FullMethodName = "/tempest.dynamic.v1.LoggerModule/QueryLogs"
+	LoggerModule_TailLogs_FullMethodName  = "/tempest.dynamic.v1.LoggerModule/TailLogs"
+)
+
+// LoggerModuleClient is the client API for LoggerModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LoggerModuleClient interface {
+	Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogRecord(ctx context.Context, in *LogRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	QueryLogs(ctx context.Context, in *LogQuery, opts ...grpc.CallOption) (*LogPage, error)
+	// TailLogs sends an empty acknowledgement once subscribed, then one message per record.
+	TailLogs(ctx context.Context, in *LogQuery, opts ...grpc.CallOption) (LoggerModule_TailLogsClient, error)
+}
+
+type loggerModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoggerModuleClient(cc grpc.ClientConnInterface) LoggerModuleClient {
+	return &loggerModuleClient{cc}
+}
+
+func (c *loggerModuleClient) Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LoggerModule_Log_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loggerModuleClient) LogRecord(ctx context.Context, in *LogRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LoggerModule_LogRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loggerModuleClient) QueryLogs(ctx context.Context, in *LogQuery, opts ...grpc.CallOption) (*LogPage, error) {
+	out := new(LogPage)
+	err := c.cc.Invoke(ctx, LoggerModule_QueryLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loggerModuleClient) TailLogs(ctx context.Context, in *LogQuery, opts ...grpc.CallOption) (LoggerModule_TailLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoggerModule_ServiceDesc.Streams[0], LoggerModule_TailLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loggerModuleTailLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LoggerModule_TailLogsClient interface {
+	Recv() (*LogRecordRequest, error)
+	grpc.ClientStream
+}
+
+type loggerModuleTailLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *loggerModuleTailLogsClient) Recv() (*LogRecordRequest, error) {
+	m := new(LogRecordRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LoggerModuleServer is the server API for LoggerModule service.
+// All implementations must embed UnimplementedLoggerModuleServer
+// for forward compatibility
+type LoggerModuleServer interface {
+	Log(context.Context, *LogRequest) (*emptypb.Empty, error)
+	LogRecord(context.Context, *LogRecordRequest) (*emptypb.Empty, error)
+	QueryLogs(context.Context, *LogQuery) (*LogPage, error)
+	// TailLogs sends an empty acknowledgement once subscribed, then one message per record.
+	TailLogs(*LogQuery, LoggerModule_TailLogsServer) error
+	mustEmbedUnimplementedLoggerModuleServer()
+}
+
+// UnimplementedLoggerModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedLoggerModuleServer struct {
+}
+
+func (UnimplementedLoggerModuleServer) Log(context.Context, *LogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Log not implemented")
+}
+func (UnimplementedLoggerModuleServer) LogRecord(context.Context, *LogRecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogRecord not implemented")
+}
+func (UnimplementedLoggerModuleServer) QueryLogs(context.Context, *LogQuery) (*LogPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLogs not implemented")
+}
+func (UnimplementedLoggerModuleServer) TailLogs(*LogQuery, LoggerModule_TailLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
+}
+func (UnimplementedLoggerModuleServer) mustEmbedUnimplementedLoggerModuleServer() {}
+
+// UnsafeLoggerModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoggerModuleServer will
+// result in compilation errors.
+type UnsafeLoggerModuleServer interface {
+	mustEmbedUnimplementedLoggerModuleServer()
+}
+
+func RegisterLoggerModuleServer(s grpc.ServiceRegistrar, srv LoggerModuleServer) {
+	s.RegisterService(&LoggerModule_ServiceDesc, srv)
+}
+
+func _LoggerModule_Log_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoggerModuleServer).Log(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoggerModule_Log_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoggerModuleServer).Log(ctx, req.(*LogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoggerModule_LogRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoggerModuleServer).LogRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoggerModule_LogRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoggerModuleServer).LogRecord(ctx, req.(*LogRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoggerModule_QueryLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoggerModuleServer).QueryLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoggerModule_QueryLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoggerModuleServer).QueryLogs(ctx, req.(*LogQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoggerModule_TailLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LoggerModuleServer).TailLogs(m, &loggerModuleTailLogsServer{stream})
+}
+
+type LoggerModule_TailLogsServer interface {
+	Send(*LogRecordRequest) error
+	grpc.ServerStream
+}
+
+type loggerModuleTailLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *loggerModuleTailLogsServer) Send(m *LogRecordRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LoggerModule_ServiceDesc is the grpc.ServiceDesc for LoggerModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoggerModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.LoggerModule",
+	HandlerType: (*LoggerModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Log",
+			Handler:    _LoggerModule_Log_Handler,
+		},
+		{
+			MethodName: "LogRecord",
+			Handler:    _LoggerModule_LogRecord_Handler,
+		},
+		{
+			MethodName: "QueryLogs",
+			Handler:    _LoggerModule_QueryLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailLogs",
+			Handler:       _LoggerModule_TailLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/logger.proto",
+}
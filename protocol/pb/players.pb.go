@@ -0,0 +1,1187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/players.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlayerMessageKind int32
+
+const (
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_UNSPECIFIED  PlayerMessageKind = 0
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SAY      PlayerMessageKind = 1
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_SAY          PlayerMessageKind = 2
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_TITLE    PlayerMessageKind = 3
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_TITLE        PlayerMessageKind = 4
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_RAW_SUBTITLE PlayerMessageKind = 5
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_SUBTITLE     PlayerMessageKind = 6
+	PlayerMessageKind_PLAYER_MESSAGE_KIND_ACTION_BAR   PlayerMessageKind = 7
+)
+
+// Enum value maps for PlayerMessageKind.
+var (
+	PlayerMessageKind_name = map[int32]string{
+		0: "PLAYER_MESSAGE_KIND_UNSPECIFIED",
+		1: "PLAYER_MESSAGE_KIND_RAW_SAY",
+		2: "PLAYER_MESSAGE_KIND_SAY",
+		3: "PLAYER_MESSAGE_KIND_RAW_TITLE",
+		4: "PLAYER_MESSAGE_KIND_TITLE",
+		5: "PLAYER_MESSAGE_KIND_RAW_SUBTITLE",
+		6: "PLAYER_MESSAGE_KIND_SUBTITLE",
+		7: "PLAYER_MESSAGE_KIND_ACTION_BAR",
+	}
+	PlayerMessageKind_value = map[string]int32{
+		"PLAYER_MESSAGE_KIND_UNSPECIFIED":  0,
+		"PLAYER_MESSAGE_KIND_RAW_SAY":      1,
+		"PLAYER_MESSAGE_KIND_SAY":          2,
+		"PLAYER_MESSAGE_KIND_RAW_TITLE":    3,
+		"PLAYER_MESSAGE_KIND_TITLE":        4,
+		"PLAYER_MESSAGE_KIND_RAW_SUBTITLE": 5,
+		"PLAYER_MESSAGE_KIND_SUBTITLE":     6,
+		"PLAYER_MESSAGE_KIND_ACTION_BAR":   7,
+	}
+)
+
+func (x PlayerMessageKind) Enum() *PlayerMessageKind {
+	p := new(PlayerMessageKind)
+	*p = x
+	return p
+}
+
+func (x PlayerMessageKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerMessageKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tempest_dynamic_v1_players_proto_enumTypes[0].Descriptor()
+}
+
+func (PlayerMessageKind) Type() protoreflect.EnumType {
+	return &file_tempest_dynamic_v1_players_proto_enumTypes[0]
+}
+
+func (x PlayerMessageKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerMessageKind.Descriptor instead.
+func (PlayerMessageKind) EnumDescriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{0}
+}
+
+type PlayerProperty int32
+
+const (
+	PlayerProperty_PLAYER_PROPERTY_UNSPECIFIED                PlayerProperty = 0
+	PlayerProperty_PLAYER_PROPERTY_NAME                       PlayerProperty = 1
+	PlayerProperty_PLAYER_PROPERTY_ENTITY_UNIQUE_ID           PlayerProperty = 2
+	PlayerProperty_PLAYER_PROPERTY_LOGIN_TIME                 PlayerProperty = 3
+	PlayerProperty_PLAYER_PROPERTY_PLATFORM_CHAT_ID           PlayerProperty = 4
+	PlayerProperty_PLAYER_PROPERTY_BUILD_PLATFORM             PlayerProperty = 5
+	PlayerProperty_PLAYER_PROPERTY_SKIN_ID                    PlayerProperty = 6
+	PlayerProperty_PLAYER_PROPERTY_STATUS_INVULNERABLE        PlayerProperty = 7
+	PlayerProperty_PLAYER_PROPERTY_STATUS_FLYING              PlayerProperty = 8
+	PlayerProperty_PLAYER_PROPERTY_STATUS_MAY_FLY             PlayerProperty = 9
+	PlayerProperty_PLAYER_PROPERTY_DEVICE_ID                  PlayerProperty = 10
+	PlayerProperty_PLAYER_PROPERTY_ENTITY_RUNTIME_ID          PlayerProperty = 11
+	PlayerProperty_PLAYER_PROPERTY_ENTITY_METADATA            PlayerProperty = 12
+	PlayerProperty_PLAYER_PROPERTY_IS_OP                      PlayerProperty = 13
+	PlayerProperty_PLAYER_PROPERTY_ONLINE                     PlayerProperty = 14
+	PlayerProperty_PLAYER_PROPERTY_CAN_BUILD                  PlayerProperty = 15
+	PlayerProperty_PLAYER_PROPERTY_CAN_DIG                    PlayerProperty = 16
+	PlayerProperty_PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES PlayerProperty = 17
+	PlayerProperty_PLAYER_PROPERTY_CAN_OPEN_CONTAINERS        PlayerProperty = 18
+	PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_PLAYERS         PlayerProperty = 19
+	PlayerProperty_PLAYER_PROPERTY_CAN_ATTACK_MOBS            PlayerProperty = 20
+	PlayerProperty_PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS  PlayerProperty = 21
+	PlayerProperty_PLAYER_PROPERTY_CAN_TELEPORT               PlayerProperty = 22
+)
+
+// Enum value maps for PlayerProperty.
+var (
+	PlayerProperty_name = map[int32]string{
+		0:  "PLAYER_PROPERTY_UNSPECIFIED",
+		1:  "PLAYER_PROPERTY_NAME",
+		2:  "PLAYER_PROPERTY_ENTITY_UNIQUE_ID",
+		3:  "PLAYER_PROPERTY_LOGIN_TIME",
+		4:  "PLAYER_PROPERTY_PLATFORM_CHAT_ID",
+		5:  "PLAYER_PROPERTY_BUILD_PLATFORM",
+		6:  "PLAYER_PROPERTY_SKIN_ID",
+		7:  "PLAYER_PROPERTY_STATUS_INVULNERABLE",
+		8:  "PLAYER_PROPERTY_STATUS_FLYING",
+		9:  "PLAYER_PROPERTY_STATUS_MAY_FLY",
+		10: "PLAYER_PROPERTY_DEVICE_ID",
+		11: "PLAYER_PROPERTY_ENTITY_RUNTIME_ID",
+		12: "PLAYER_PROPERTY_ENTITY_METADATA",
+		13: "PLAYER_PROPERTY_IS_OP",
+		14: "PLAYER_PROPERTY_ONLINE",
+		15: "PLAYER_PROPERTY_CAN_BUILD",
+		16: "PLAYER_PROPERTY_CAN_DIG",
+		17: "PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES",
+		18: "PLAYER_PROPERTY_CAN_OPEN_CONTAINERS",
+		19: "PLAYER_PROPERTY_CAN_ATTACK_PLAYERS",
+		20: "PLAYER_PROPERTY_CAN_ATTACK_MOBS",
+		21: "PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS",
+		22: "PLAYER_PROPERTY_CAN_TELEPORT",
+	}
+	PlayerProperty_value = map[string]int32{
+		"PLAYER_PROPERTY_UNSPECIFIED":                0,
+		"PLAYER_PROPERTY_NAME":                       1,
+		"PLAYER_PROPERTY_ENTITY_UNIQUE_ID":           2,
+		"PLAYER_PROPERTY_LOGIN_TIME":                 3,
+		"PLAYER_PROPERTY_PLATFORM_CHAT_ID":           4,
+		"PLAYER_PROPERTY_BUILD_PLATFORM":             5,
+		"PLAYER_PROPERTY_SKIN_ID":                    6,
+		"PLAYER_PROPERTY_STATUS_INVULNERABLE":        7,
+		"PLAYER_PROPERTY_STATUS_FLYING":              8,
+		"PLAYER_PROPERTY_STATUS_MAY_FLY":             9,
+		"PLAYER_PROPERTY_DEVICE_ID":                  10,
+		"PLAYER_PROPERTY_ENTITY_RUNTIME_ID":          11,
+		"PLAYER_PROPERTY_ENTITY_METADATA":            12,
+		"PLAYER_PROPERTY_IS_OP":                      13,
+		"PLAYER_PROPERTY_ONLINE":                     14,
+		"PLAYER_PROPERTY_CAN_BUILD":                  15,
+		"PLAYER_PROPERTY_CAN_DIG":                    16,
+		"PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES": 17,
+		"PLAYER_PROPERTY_CAN_OPEN_CONTAINERS":        18,
+		"PLAYER_PROPERTY_CAN_ATTACK_PLAYERS":         19,
+		"PLAYER_PROPERTY_CAN_ATTACK_MOBS":            20,
+		"PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS":  21,
+		"PLAYER_PROPERTY_CAN_TELEPORT":               22,
+	}
+)
+
+func (x PlayerProperty) Enum() *PlayerProperty {
+	p := new(PlayerProperty)
+	*p = x
+	return p
+}
+
+func (x PlayerProperty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerProperty) Descriptor() protoreflect.EnumDescriptor {
+	return file_tempest_dynamic_v1_players_proto_enumTypes[1].Descriptor()
+}
+
+func (PlayerProperty) Type() protoreflect.EnumType {
+	return &file_tempest_dynamic_v1_players_proto_enumTypes[1]
+}
+
+func (x PlayerProperty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerProperty.Descriptor instead.
+func (PlayerProperty) EnumDescriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{1}
+}
+
+type PlayerRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRef) Reset() {
+	*x = PlayerRef{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRef) ProtoMessage() {}
+
+func (x *PlayerRef) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRef.ProtoReflect.Descriptor instead.
+func (*PlayerRef) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayerRef) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PlayerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerRef           `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerList) Reset() {
+	*x = PlayerList{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerList) ProtoMessage() {}
+
+func (x *PlayerList) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerList.ProtoReflect.Descriptor instead.
+func (*PlayerList) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlayerList) GetPlayers() []*PlayerRef {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type PlayerLookupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// player is unset when the host returned no player.
+	Player        *PlayerRef `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLookupResponse) Reset() {
+	*x = PlayerLookupResponse{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLookupResponse) ProtoMessage() {}
+
+func (x *PlayerLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLookupResponse.ProtoReflect.Descriptor instead.
+func (*PlayerLookupResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayerLookupResponse) GetPlayer() *PlayerRef {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type GetPlayerByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerByNameRequest) Reset() {
+	*x = GetPlayerByNameRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerByNameRequest) ProtoMessage() {}
+
+func (x *GetPlayerByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerByNameRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPlayerByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPlayerByUUIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerByUUIDRequest) Reset() {
+	*x = GetPlayerByUUIDRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerByUUIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerByUUIDRequest) ProtoMessage() {}
+
+func (x *GetPlayerByUUIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerByUUIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerByUUIDRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPlayerByUUIDRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetPlayerByEntityRuntimeIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeId     uint64                 `protobuf:"varint,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerByEntityRuntimeIDRequest) Reset() {
+	*x = GetPlayerByEntityRuntimeIDRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerByEntityRuntimeIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerByEntityRuntimeIDRequest) ProtoMessage() {}
+
+func (x *GetPlayerByEntityRuntimeIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerByEntityRuntimeIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerByEntityRuntimeIDRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPlayerByEntityRuntimeIDRequest) GetRuntimeId() uint64 {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return 0
+}
+
+type PlayerChangeEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Uuid       string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// event_type is one of "exist", "online", "offline".
+	EventType     string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerChangeEvent) Reset() {
+	*x = PlayerChangeEvent{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerChangeEvent) ProtoMessage() {}
+
+func (x *PlayerChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerChangeEvent.ProtoReflect.Descriptor instead.
+func (*PlayerChangeEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlayerChangeEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *PlayerChangeEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerChangeEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerChangeEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type PlayerMessageToRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Kind   PlayerMessageKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=tempest.dynamic.v1.PlayerMessageKind" json:"kind,omitempty"`
+	Text   string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// title is only used by the subtitle kinds.
+	Title         string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerMessageToRequest) Reset() {
+	*x = PlayerMessageToRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerMessageToRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerMessageToRequest) ProtoMessage() {}
+
+func (x *PlayerMessageToRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerMessageToRequest.ProtoReflect.Descriptor instead.
+func (*PlayerMessageToRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlayerMessageToRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *PlayerMessageToRequest) GetKind() PlayerMessageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PlayerMessageKind_PLAYER_MESSAGE_KIND_UNSPECIFIED
+}
+
+func (x *PlayerMessageToRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PlayerMessageToRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type PlayerMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Kind          PlayerMessageKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=tempest.dynamic.v1.PlayerMessageKind" json:"kind,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerMessageRequest) Reset() {
+	*x = PlayerMessageRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerMessageRequest) ProtoMessage() {}
+
+func (x *PlayerMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerMessageRequest.ProtoReflect.Descriptor instead.
+func (*PlayerMessageRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerMessageRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerMessageRequest) GetKind() PlayerMessageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PlayerMessageKind_PLAYER_MESSAGE_KIND_UNSPECIFIED
+}
+
+func (x *PlayerMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PlayerMessageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type PlayerPropertyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Property      PlayerProperty         `protobuf:"varint,2,opt,name=property,proto3,enum=tempest.dynamic.v1.PlayerProperty" json:"property,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerPropertyRequest) Reset() {
+	*x = PlayerPropertyRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerPropertyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPropertyRequest) ProtoMessage() {}
+
+func (x *PlayerPropertyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPropertyRequest.ProtoReflect.Descriptor instead.
+func (*PlayerPropertyRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlayerPropertyRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerPropertyRequest) GetProperty() PlayerProperty {
+	if x != nil {
+		return x.Property
+	}
+	return PlayerProperty_PLAYER_PROPERTY_UNSPECIFIED
+}
+
+type PlayerPropertyValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*PlayerPropertyValue_BoolValue
+	//	*PlayerPropertyValue_IntValue
+	//	*PlayerPropertyValue_UintValue
+	//	*PlayerPropertyValue_StringValue
+	//	*PlayerPropertyValue_TimeValue
+	//	*PlayerPropertyValue_MetadataValue
+	Value         isPlayerPropertyValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerPropertyValue) Reset() {
+	*x = PlayerPropertyValue{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerPropertyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPropertyValue) ProtoMessage() {}
+
+func (x *PlayerPropertyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPropertyValue.ProtoReflect.Descriptor instead.
+func (*PlayerPropertyValue) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlayerPropertyValue) GetValue() isPlayerPropertyValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PlayerPropertyValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*PlayerPropertyValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *PlayerPropertyValue) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*PlayerPropertyValue_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *PlayerPropertyValue) GetUintValue() uint64 {
+	if x != nil {
+		if x, ok := x.Value.(*PlayerPropertyValue_UintValue); ok {
+			return x.UintValue
+		}
+	}
+	return 0
+}
+
+func (x *PlayerPropertyValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*PlayerPropertyValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *PlayerPropertyValue) GetTimeValue() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Value.(*PlayerPropertyValue_TimeValue); ok {
+			return x.TimeValue
+		}
+	}
+	return nil
+}
+
+func (x *PlayerPropertyValue) GetMetadataValue() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.Value.(*PlayerPropertyValue_MetadataValue); ok {
+			return x.MetadataValue
+		}
+	}
+	return nil
+}
+
+type isPlayerPropertyValue_Value interface {
+	isPlayerPropertyValue_Value()
+}
+
+type PlayerPropertyValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,1,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type PlayerPropertyValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type PlayerPropertyValue_UintValue struct {
+	UintValue uint64 `protobuf:"varint,3,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type PlayerPropertyValue_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type PlayerPropertyValue_TimeValue struct {
+	TimeValue *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_value,json=timeValue,proto3,oneof"`
+}
+
+type PlayerPropertyValue_MetadataValue struct {
+	// metadata_value keys are the decimal metadata keys.
+	MetadataValue *structpb.Struct `protobuf:"bytes,6,opt,name=metadata_value,json=metadataValue,proto3,oneof"`
+}
+
+func (*PlayerPropertyValue_BoolValue) isPlayerPropertyValue_Value() {}
+
+func (*PlayerPropertyValue_IntValue) isPlayerPropertyValue_Value() {}
+
+func (*PlayerPropertyValue_UintValue) isPlayerPropertyValue_Value() {}
+
+func (*PlayerPropertyValue_StringValue) isPlayerPropertyValue_Value() {}
+
+func (*PlayerPropertyValue_TimeValue) isPlayerPropertyValue_Value() {}
+
+func (*PlayerPropertyValue_MetadataValue) isPlayerPropertyValue_Value() {}
+
+type SetPlayerAbilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// ability is one of the PLAYER_PROPERTY_CAN_* values.
+	Ability       PlayerProperty `protobuf:"varint,2,opt,name=ability,proto3,enum=tempest.dynamic.v1.PlayerProperty" json:"ability,omitempty"`
+	Allow         bool           `protobuf:"varint,3,opt,name=allow,proto3" json:"allow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerAbilityRequest) Reset() {
+	*x = SetPlayerAbilityRequest{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerAbilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerAbilityRequest) ProtoMessage() {}
+
+func (x *SetPlayerAbilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerAbilityRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerAbilityRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetPlayerAbilityRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SetPlayerAbilityRequest) GetAbility() PlayerProperty {
+	if x != nil {
+		return x.Ability
+	}
+	return PlayerProperty_PLAYER_PROPERTY_UNSPECIFIED
+}
+
+func (x *SetPlayerAbilityRequest) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+type SetPlayerAbilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is only meaningful for PLAYER_PROPERTY_CAN_TELEPORT.
+	Value         bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerAbilityResponse) Reset() {
+	*x = SetPlayerAbilityResponse{}
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerAbilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerAbilityResponse) ProtoMessage() {}
+
+func (x *SetPlayerAbilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_players_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerAbilityResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerAbilityResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_players_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetPlayerAbilityResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+var File_tempest_dynamic_v1_players_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_players_proto_rawDesc = "" +
+	"\n" +
+	" tempest/dynamic/v1/players.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"3\n" +
+	"\tPlayerRef\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"E\n" +
+	"\n" +
+	"PlayerList\x127\n" +
+	"\aplayers\x18\x01 \x03(\v2\x1d.tempest.dynamic.v1.PlayerRefR\aplayers\"M\n" +
+	"\x14PlayerLookupResponse\x125\n" +
+	"\x06player\x18\x01 \x01(\v2\x1d.tempest.dynamic.v1.PlayerRefR\x06player\",\n" +
+	"\x16GetPlayerByNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\",\n" +
+	"\x16GetPlayerByUUIDRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"B\n" +
+	"!GetPlayerByEntityRuntimeIDRequest\x12\x1d\n" +
+	"\n" +
+	"runtime_id\x18\x01 \x01(\x04R\truntimeId\"{\n" +
+	"\x11PlayerChangeEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\"\x95\x01\n" +
+	"\x16PlayerMessageToRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x129\n" +
+	"\x04kind\x18\x02 \x01(\x0e2%.tempest.dynamic.v1.PlayerMessageKindR\x04kind\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"\x8f\x01\n" +
+	"\x14PlayerMessageRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x129\n" +
+	"\x04kind\x18\x02 \x01(\x0e2%.tempest.dynamic.v1.PlayerMessageKindR\x04kind\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"k\n" +
+	"\x15PlayerPropertyRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12>\n" +
+	"\bproperty\x18\x02 \x01(\x0e2\".tempest.dynamic.v1.PlayerPropertyR\bproperty\"\xa3\x02\n" +
+	"\x13PlayerPropertyValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x01 \x01(\bH\x00R\tboolValue\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x03H\x00R\bintValue\x12\x1f\n" +
+	"\n" +
+	"uint_value\x18\x03 \x01(\x04H\x00R\tuintValue\x12#\n" +
+	"\fstring_value\x18\x04 \x01(\tH\x00R\vstringValue\x12;\n" +
+	"\n" +
+	"time_value\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\ttimeValue\x12@\n" +
+	"\x0emetadata_value\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x00R\rmetadataValueB\a\n" +
+	"\x05value\"\x81\x01\n" +
+	"\x17SetPlayerAbilityRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12<\n" +
+	"\aability\x18\x02 \x01(\x0e2\".tempest.dynamic.v1.PlayerPropertyR\aability\x12\x14\n" +
+	"\x05allow\x18\x03 \x01(\bR\x05allow\"0\n" +
+	"\x18SetPlayerAbilityResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value*\xa4\x02\n" +
+	"\x11PlayerMessageKind\x12#\n" +
+	"\x1fPLAYER_MESSAGE_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bPLAYER_MESSAGE_KIND_RAW_SAY\x10\x01\x12\x1b\n" +
+	"\x17PLAYER_MESSAGE_KIND_SAY\x10\x02\x12!\n" +
+	"\x1dPLAYER_MESSAGE_KIND_RAW_TITLE\x10\x03\x12\x1d\n" +
+	"\x19PLAYER_MESSAGE_KIND_TITLE\x10\x04\x12$\n" +
+	" PLAYER_MESSAGE_KIND_RAW_SUBTITLE\x10\x05\x12 \n" +
+	"\x1cPLAYER_MESSAGE_KIND_SUBTITLE\x10\x06\x12\"\n" +
+	"\x1ePLAYER_MESSAGE_KIND_ACTION_BAR\x10\a*\xbd\x06\n" +
+	"\x0ePlayerProperty\x12\x1f\n" +
+	"\x1bPLAYER_PROPERTY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PLAYER_PROPERTY_NAME\x10\x01\x12$\n" +
+	" PLAYER_PROPERTY_ENTITY_UNIQUE_ID\x10\x02\x12\x1e\n" +
+	"\x1aPLAYER_PROPERTY_LOGIN_TIME\x10\x03\x12$\n" +
+	" PLAYER_PROPERTY_PLATFORM_CHAT_ID\x10\x04\x12\"\n" +
+	"\x1ePLAYER_PROPERTY_BUILD_PLATFORM\x10\x05\x12\x1b\n" +
+	"\x17PLAYER_PROPERTY_SKIN_ID\x10\x06\x12'\n" +
+	"#PLAYER_PROPERTY_STATUS_INVULNERABLE\x10\a\x12!\n" +
+	"\x1dPLAYER_PROPERTY_STATUS_FLYING\x10\b\x12\"\n" +
+	"\x1ePLAYER_PROPERTY_STATUS_MAY_FLY\x10\t\x12\x1d\n" +
+	"\x19PLAYER_PROPERTY_DEVICE_ID\x10\n" +
+	"\x12%\n" +
+	"!PLAYER_PROPERTY_ENTITY_RUNTIME_ID\x10\v\x12#\n" +
+	"\x1fPLAYER_PROPERTY_ENTITY_METADATA\x10\f\x12\x19\n" +
+	"\x15PLAYER_PROPERTY_IS_OP\x10\r\x12\x1a\n" +
+	"\x16PLAYER_PROPERTY_ONLINE\x10\x0e\x12\x1d\n" +
+	"\x19PLAYER_PROPERTY_CAN_BUILD\x10\x0f\x12\x1b\n" +
+	"\x17PLAYER_PROPERTY_CAN_DIG\x10\x10\x12.\n" +
+	"*PLAYER_PROPERTY_CAN_USE_DOORS_AND_SWITCHES\x10\x11\x12'\n" +
+	"#PLAYER_PROPERTY_CAN_OPEN_CONTAINERS\x10\x12\x12&\n" +
+	"\"PLAYER_PROPERTY_CAN_ATTACK_PLAYERS\x10\x13\x12#\n" +
+	"\x1fPLAYER_PROPERTY_CAN_ATTACK_MOBS\x10\x14\x12-\n" +
+	")PLAYER_PROPERTY_CAN_USE_OPERATOR_COMMANDS\x10\x15\x12 \n" +
+	"\x1cPLAYER_PROPERTY_CAN_TELEPORT\x10\x162\xda\x04\n" +
+	"\rPlayersModule\x12M\n" +
+	"\x13GetAllOnlinePlayers\x12\x16.google.protobuf.Empty\x1a\x1e.tempest.dynamic.v1.PlayerList\x12g\n" +
+	"\x0fGetPlayerByName\x12*.tempest.dynamic.v1.GetPlayerByNameRequest\x1a(.tempest.dynamic.v1.PlayerLookupResponse\x12g\n" +
+	"\x0fGetPlayerByUUID\x12*.tempest.dynamic.v1.GetPlayerByUUIDRequest\x1a(.tempest.dynamic.v1.PlayerLookupResponse\x12}\n" +
+	"\x1aGetPlayerByEntityRuntimeID\x125.tempest.dynamic.v1.GetPlayerByEntityRuntimeIDRequest\x1a(.tempest.dynamic.v1.PlayerLookupResponse\x12T\n" +
+	"\x11WatchPlayerChange\x12\x16.google.protobuf.Empty\x1a%.tempest.dynamic.v1.PlayerChangeEvent0\x01\x12S\n" +
+	"\rSendMessageTo\x12*.tempest.dynamic.v1.PlayerMessageToRequest\x1a\x16.google.protobuf.Empty2\xa8\x02\n" +
+	"\tPlayerKit\x12a\n" +
+	"\vGetProperty\x12).tempest.dynamic.v1.PlayerPropertyRequest\x1a'.tempest.dynamic.v1.PlayerPropertyValue\x12g\n" +
+	"\n" +
+	"SetAbility\x12+.tempest.dynamic.v1.SetPlayerAbilityRequest\x1a,.tempest.dynamic.v1.SetPlayerAbilityResponse\x12O\n" +
+	"\vSendMessage\x12(.tempest.dynamic.v1.PlayerMessageRequest\x1a\x16.google.protobuf.EmptyB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_players_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_players_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_players_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_players_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_players_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_players_proto_rawDesc), len(file_tempest_dynamic_v1_players_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_players_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_players_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tempest_dynamic_v1_players_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_tempest_dynamic_v1_players_proto_goTypes = []any{
+	(PlayerMessageKind)(0),                    // 0: tempest.dynamic.v1.PlayerMessageKind
+	(PlayerProperty)(0),                       // 1: tempest.dynamic.v1.PlayerProperty
+	(*PlayerRef)(nil),                         // 2: tempest.dynamic.v1.PlayerRef
+	(*PlayerList)(nil),                        // 3: tempest.dynamic.v1.PlayerList
+	(*PlayerLookupResponse)(nil),              // 4: tempest.dynamic.v1.PlayerLookupResponse
+	(*GetPlayerByNameRequest)(nil),            // 5: tempest.dynamic.v1.GetPlayerByNameRequest
+	(*GetPlayerByUUIDRequest)(nil),            // 6: tempest.dynamic.v1.GetPlayerByUUIDRequest
+	(*GetPlayerByEntityRuntimeIDRequest)(nil), // 7: tempest.dynamic.v1.GetPlayerByEntityRuntimeIDRequest
+	(*PlayerChangeEvent)(nil),                 // 8: tempest.dynamic.v1.PlayerChangeEvent
+	(*PlayerMessageToRequest)(nil),            // 9: tempest.dynamic.v1.PlayerMessageToRequest
+	(*PlayerMessageRequest)(nil),              // 10: tempest.dynamic.v1.PlayerMessageRequest
+	(*PlayerPropertyRequest)(nil),             // 11: tempest.dynamic.v1.PlayerPropertyRequest
+	(*PlayerPropertyValue)(nil),               // 12: tempest.dynamic.v1.PlayerPropertyValue
+	(*SetPlayerAbilityRequest)(nil),           // 13: tempest.dynamic.v1.SetPlayerAbilityRequest
+	(*SetPlayerAbilityResponse)(nil),          // 14: tempest.dynamic.v1.SetPlayerAbilityResponse
+	(*timestamppb.Timestamp)(nil),             // 15: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                   // 16: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                     // 17: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_players_proto_depIdxs = []int32{
+	2,  // 0: tempest.dynamic.v1.PlayerList.players:type_name -> tempest.dynamic.v1.PlayerRef
+	2,  // 1: tempest.dynamic.v1.PlayerLookupResponse.player:type_name -> tempest.dynamic.v1.PlayerRef
+	0,  // 2: tempest.dynamic.v1.PlayerMessageToRequest.kind:type_name -> tempest.dynamic.v1.PlayerMessageKind
+	0,  // 3: tempest.dynamic.v1.PlayerMessageRequest.kind:type_name -> tempest.dynamic.v1.PlayerMessageKind
+	1,  // 4: tempest.dynamic.v1.PlayerPropertyRequest.property:type_name -> tempest.dynamic.v1.PlayerProperty
+	15, // 5: tempest.dynamic.v1.PlayerPropertyValue.time_value:type_name -> google.protobuf.Timestamp
+	16, // 6: tempest.dynamic.v1.PlayerPropertyValue.metadata_value:type_name -> google.protobuf.Struct
+	1,  // 7: tempest.dynamic.v1.SetPlayerAbilityRequest.ability:type_name -> tempest.dynamic.v1.PlayerProperty
+	17, // 8: tempest.dynamic.v1.PlayersModule.GetAllOnlinePlayers:input_type -> google.protobuf.Empty
+	5,  // 9: tempest.dynamic.v1.PlayersModule.GetPlayerByName:input_type -> tempest.dynamic.v1.GetPlayerByNameRequest
+	6,  // 10: tempest.dynamic.v1.PlayersModule.GetPlayerByUUID:input_type -> tempest.dynamic.v1.GetPlayerByUUIDRequest
+	7,  // 11: tempest.dynamic.v1.PlayersModule.GetPlayerByEntityRuntimeID:input_type -> tempest.dynamic.v1.GetPlayerByEntityRuntimeIDRequest
+	17, // 12: tempest.dynamic.v1.PlayersModule.WatchPlayerChange:input_type -> google.protobuf.Empty
+	9,  // 13: tempest.dynamic.v1.PlayersModule.SendMessageTo:input_type -> tempest.dynamic.v1.PlayerMessageToRequest
+	11, // 14: tempest.dynamic.v1.PlayerKit.GetProperty:input_type -> tempest.dynamic.v1.PlayerPropertyRequest
+	13, // 15: tempest.dynamic.v1.PlayerKit.SetAbility:input_type -> tempest.dynamic.v1.SetPlayerAbilityRequest
+	10, // 16: tempest.dynamic.v1.PlayerKit.SendMessage:input_type -> tempest.dynamic.v1.PlayerMessageRequest
+	3,  // 17: tempest.dynamic.v1.PlayersModule.GetAllOnlinePlayers:output_type -> tempest.dynamic.v1.PlayerList
+	4,  // 18: tempest.dynamic.v1.PlayersModule.GetPlayerByName:output_type -> tempest.dynamic.v1.PlayerLookupResponse
+	4,  // 19: tempest.dynamic.v1.PlayersModule.GetPlayerByUUID:output_type -> tempest.dynamic.v1.PlayerLookupResponse
+	4,  // 20: tempest.dynamic.v1.PlayersModule.GetPlayerByEntityRuntimeID:output_type -> tempest.dynamic.v1.PlayerLookupResponse
+	8,  // 21: tempest.dynamic.v1.PlayersModule.WatchPlayerChange:output_type -> tempest.dynamic.v1.PlayerChangeEvent
+	17, // 22: tempest.dynamic.v1.PlayersModule.SendMessageTo:output_type -> google.protobuf.Empty
+	12, // 23: tempest.dynamic.v1.PlayerKit.GetProperty:output_type -> tempest.dynamic.v1.PlayerPropertyValue
+	14, // 24: tempest.dynamic.v1.PlayerKit.SetAbility:output_type -> tempest.dynamic.v1.SetPlayerAbilityResponse
+	17, // 25: tempest.dynamic.v1.PlayerKit.SendMessage:output_type -> google.protobuf.Empty
+	17, // [17:26] is the sub-list for method output_type
+	8,  // [8:17] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_players_proto_init() }
+func file_tempest_dynamic_v1_players_proto_init() {
+	if File_tempest_dynamic_v1_players_proto != nil {
+		return
+	}
+	file_tempest_dynamic_v1_players_proto_msgTypes[10].OneofWrappers = []any{
+		(*PlayerPropertyValue_BoolValue)(nil),
+		(*PlayerPropertyValue_IntValue)(nil),
+		(*PlayerPropertyValue_UintValue)(nil),
+		(*PlayerPropertyValue_StringValue)(nil),
+		(*PlayerPropertyValue_TimeValue)(nil),
+		(*PlayerPropertyValue_MetadataValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_players_proto_rawDesc), len(file_tempest_dynamic_v1_players_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_tempest_dynamic_v1_players_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_players_proto_depIdxs,
+		EnumInfos:         file_tempest_dynamic_v1_players_proto_enumTypes,
+		MessageInfos:      file_tempest_dynamic_v1_players_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_players_proto = out.File
+	file_tempest_dynamic_v1_players_proto_goTypes = nil
+	file_tempest_dynamic_v1_players_proto_depIdxs = nil
+}
@@ -0,0 +1,487 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/players.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PlayersModule_GetAllOnlinePlayers_FullMethodName        = "/tempest.dynamic.v1.PlayersModule/GetAllOnlinePlayers"
+	PlayersModule_GetPlayerByName_FullMethodName            = "/tempest.dynamic.v1.PlayersModule/GetPlayerByName"
+	PlayersModule_GetPlayerByUUID_FullMethodName            = "/tempest.dynamic.v1.PlayersModule/GetPlayerByUUID"
+	PlayersModule_GetPlayerByEntityRuntimeID_FullMethodName = "/tempest.dynamic.v1.PlayersModule/GetPlayerByEntityRuntimeID"
+	PlayersModule_WatchPlayerChange_FullMethodName          = "/tempest.dynamic.v1.PlayersModule/WatchPlayerChange"
+	PlayersModule_SendMessageTo_FullMethodName              = "/tempest.dynamic.v1.PlayersModule/SendMessageTo"
+)
+
+// PlayersModuleClient is the client API for PlayersModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlayersModuleClient interface {
+	GetAllOnlinePlayers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PlayerList, error)
+	GetPlayerByName(ctx context.Context, in *GetPlayerByNameRequest, opts ...grpc.CallOption) (*PlayerLookupResponse, error)
+	GetPlayerByUUID(ctx context.Context, in *GetPlayerByUUIDRequest, opts ...grpc.CallOption) (*PlayerLookupResponse, error)
+	GetPlayerByEntityRuntimeID(ctx context.Context, in *GetPlayerByEntityRuntimeIDRequest, opts ...grpc.CallOption) (*PlayerLookupResponse, error)
+	WatchPlayerChange(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (PlayersModule_WatchPlayerChangeClient, error)
+	SendMessageTo(ctx context.Context, in *PlayerMessageToRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type playersModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayersModuleClient(cc grpc.ClientConnInterface) PlayersModuleClient {
+	return &playersModuleClient{cc}
+}
+
+func (c *playersModuleClient) GetAllOnlinePlayers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PlayerList, error) {
+	out := new(PlayerList)
+	err := c.cc.Invoke(ctx, PlayersModule_GetAllOnlinePlayers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playersModuleClient) GetPlayerByName(ctx context.Context, in *GetPlayerByNameRequest, opts ...grpc.CallOption) (*PlayerLookupResponse, error) {
+	out := new(PlayerLookupResponse)
+	err := c.cc.Invoke(ctx, PlayersModule_GetPlayerByName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playersModuleClient) GetPlayerByUUID(ctx context.Context, in *GetPlayerByUUIDRequest, opts ...grpc.CallOption) (*PlayerLookupResponse, error) {
+	out := new(PlayerLookupResponse)
+	err := c.cc.Invoke(ctx, PlayersModule_GetPlayerByUUID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playersModuleClient) GetPlayerByEntityRuntimeID(ctx context.Context, in *GetPlayerByEntityRuntimeIDRequest, opts ...grpc.CallOption) (*PlayerLookupResponse, error) {
+	out := new(PlayerLookupResponse)
+	err := c.cc.Invoke(ctx, PlayersModule_GetPlayerByEntityRuntimeID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playersModuleClient) WatchPlayerChange(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (PlayersModule_WatchPlayerChangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlayersModule_ServiceDesc.Streams[0], PlayersModule_WatchPlayerChange_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playersModuleWatchPlayerChangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlayersModule_WatchPlayerChangeClient interface {
+	Recv() (*PlayerChangeEvent, error)
+	grpc.ClientStream
+}
+
+type playersModuleWatchPlayerChangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *playersModuleWatchPlayerChangeClient) Recv() (*PlayerChangeEvent, error) {
+	m := new(PlayerChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playersModuleClient) SendMessageTo(ctx context.Context, in *PlayerMessageToRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlayersModule_SendMessageTo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayersModuleServer is the server API for PlayersModule service.
+// All implementations must embed UnimplementedPlayersModuleServer
+// for forward compatibility
+type PlayersModuleServer interface {
+	GetAllOnlinePlayers(context.Context, *emptypb.Empty) (*PlayerList, error)
+	GetPlayerByName(context.Context, *GetPlayerByNameRequest) (*PlayerLookupResponse, error)
+	GetPlayerByUUID(context.Context, *GetPlayerByUUIDRequest) (*PlayerLookupResponse, error)
+	GetPlayerByEntityRuntimeID(context.Context, *GetPlayerByEntityRuntimeIDRequest) (*PlayerLookupResponse, error)
+	WatchPlayerChange(*emptypb.Empty, PlayersModule_WatchPlayerChangeServer) error
+	SendMessageTo(context.Context, *PlayerMessageToRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedPlayersModuleServer()
+}
+
+// UnimplementedPlayersModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedPlayersModuleServer struct {
+}
+
+func (UnimplementedPlayersModuleServer) GetAllOnlinePlayers(context.Context, *emptypb.Empty) (*PlayerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllOnlinePlayers not implemented")
+}
+func (UnimplementedPlayersModuleServer) GetPlayerByName(context.Context, *GetPlayerByNameRequest) (*PlayerLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerByName not implemented")
+}
+func (UnimplementedPlayersModuleServer) GetPlayerByUUID(context.Context, *GetPlayerByUUIDRequest) (*PlayerLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerByUUID not implemented")
+}
+func (UnimplementedPlayersModuleServer) GetPlayerByEntityRuntimeID(context.Context, *GetPlayerByEntityRuntimeIDRequest) (*PlayerLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerByEntityRuntimeID not implemented")
+}
+func (UnimplementedPlayersModuleServer) WatchPlayerChange(*emptypb.Empty, PlayersModule_WatchPlayerChangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPlayerChange not implemented")
+}
+func (UnimplementedPlayersModuleServer) SendMessageTo(context.Context, *PlayerMessageToRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessageTo not implemented")
+}
+func (UnimplementedPlayersModuleServer) mustEmbedUnimplementedPlayersModuleServer() {}
+
+// UnsafePlayersModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayersModuleServer will
+// result in compilation errors.
+type UnsafePlayersModuleServer interface {
+	mustEmbedUnimplementedPlayersModuleServer()
+}
+
+func RegisterPlayersModuleServer(s grpc.ServiceRegistrar, srv PlayersModuleServer) {
+	s.RegisterService(&PlayersModule_ServiceDesc, srv)
+}
+
+func _PlayersModule_GetAllOnlinePlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayersModuleServer).GetAllOnlinePlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayersModule_GetAllOnlinePlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayersModuleServer).GetAllOnlinePlayers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayersModule_GetPlayerByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayersModuleServer).GetPlayerByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayersModule_GetPlayerByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayersModuleServer).GetPlayerByName(ctx, req.(*GetPlayerByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayersModule_GetPlayerByUUID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerByUUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayersModuleServer).GetPlayerByUUID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayersModule_GetPlayerByUUID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayersModuleServer).GetPlayerByUUID(ctx, req.(*GetPlayerByUUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayersModule_GetPlayerByEntityRuntimeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerByEntityRuntimeIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayersModuleServer).GetPlayerByEntityRuntimeID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayersModule_GetPlayerByEntityRuntimeID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayersModuleServer).GetPlayerByEntityRuntimeID(ctx, req.(*GetPlayerByEntityRuntimeIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayersModule_WatchPlayerChange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlayersModuleServer).WatchPlayerChange(m, &playersModuleWatchPlayerChangeServer{stream})
+}
+
+type PlayersModule_WatchPlayerChangeServer interface {
+	Send(*PlayerChangeEvent) error
+	grpc.ServerStream
+}
+
+type playersModuleWatchPlayerChangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *playersModuleWatchPlayerChangeServer) Send(m *PlayerChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlayersModule_SendMessageTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerMessageToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayersModuleServer).SendMessageTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayersModule_SendMessageTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayersModuleServer).SendMessageTo(ctx, req.(*PlayerMessageToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayersModule_ServiceDesc is the grpc.ServiceDesc for PlayersModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayersModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.PlayersModule",
+	HandlerType: (*PlayersModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllOnlinePlayers",
+			Handler:    _PlayersModule_GetAllOnlinePlayers_Handler,
+		},
+		{
+			MethodName: "GetPlayerByName",
+			Handler:    _PlayersModule_GetPlayerByName_Handler,
+		},
+		{
+			MethodName: "GetPlayerByUUID",
+			Handler:    _PlayersModule_GetPlayerByUUID_Handler,
+		},
+		{
+			MethodName: "GetPlayerByEntityRuntimeID",
+			Handler:    _PlayersModule_GetPlayerByEntityRuntimeID_Handler,
+		},
+		{
+			MethodName: "SendMessageTo",
+			Handler:    _PlayersModule_SendMessageTo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPlayerChange",
+			Handler:       _PlayersModule_WatchPlayerChange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/players.proto",
+}
+
+const (
+	PlayerKit_GetProperty_FullMethodName = "/tempest.dynamic.v1.PlayerKit/GetProperty"
+	PlayerKit_SetAbility_FullMethodName  = "/tempest.dynamic.v1.PlayerKit/SetAbility"
+	PlayerKit_SendMessage_FullMethodName = "/tempest.dynamic.v1.PlayerKit/SendMessage"
+)
+
+// PlayerKitClient is the client API for PlayerKit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlayerKitClient interface {
+	GetProperty(ctx context.Context, in *PlayerPropertyRequest, opts ...grpc.CallOption) (*PlayerPropertyValue, error)
+	SetAbility(ctx context.Context, in *SetPlayerAbilityRequest, opts ...grpc.CallOption) (*SetPlayerAbilityResponse, error)
+	SendMessage(ctx context.Context, in *PlayerMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type playerKitClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerKitClient(cc grpc.ClientConnInterface) PlayerKitClient {
+	return &playerKitClient{cc}
+}
+
+func (c *playerKitClient) GetProperty(ctx context.Context, in *PlayerPropertyRequest, opts ...grpc.CallOption) (*PlayerPropertyValue, error) {
+	out := new(PlayerPropertyValue)
+	err := c.cc.Invoke(ctx, PlayerKit_GetProperty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitClient) SetAbility(ctx context.Context, in *SetPlayerAbilityRequest, opts ...grpc.CallOption) (*SetPlayerAbilityResponse, error) {
+	out := new(SetPlayerAbilityResponse)
+	err := c.cc.Invoke(ctx, PlayerKit_SetAbility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitClient) SendMessage(ctx context.Context, in *PlayerMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlayerKit_SendMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerKitServer is the server API for PlayerKit service.
+// All implementations must embed UnimplementedPlayerKitServer
+// for forward compatibility
+type PlayerKitServer interface {
+	GetProperty(context.Context, *PlayerPropertyRequest) (*PlayerPropertyValue, error)
+	SetAbility(context.Context, *SetPlayerAbilityRequest) (*SetPlayerAbilityResponse, error)
+	SendMessage(context.Context, *PlayerMessageRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedPlayerKitServer()
+}
+
+// UnimplementedPlayerKitServer must be embedded to have forward compatible implementations.
+type UnimplementedPlayerKitServer struct {
+}
+
+func (UnimplementedPlayerKitServer) GetProperty(context.Context, *PlayerPropertyRequest) (*PlayerPropertyValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProperty not implemented")
+}
+func (UnimplementedPlayerKitServer) SetAbility(context.Context, *SetPlayerAbilityRequest) (*SetPlayerAbilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAbility not implemented")
+}
+func (UnimplementedPlayerKitServer) SendMessage(context.Context, *PlayerMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedPlayerKitServer) mustEmbedUnimplementedPlayerKitServer() {}
+
+// UnsafePlayerKitServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerKitServer will
+// result in compilation errors.
+type UnsafePlayerKitServer interface {
+	mustEmbedUnimplementedPlayerKitServer()
+}
+
+func RegisterPlayerKitServer(s grpc.ServiceRegistrar, srv PlayerKitServer) {
+	s.RegisterService(&PlayerKit_ServiceDesc, srv)
+}
+
+func _PlayerKit_GetProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerPropertyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServer).GetProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKit_GetProperty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServer).GetProperty(ctx, req.(*PlayerPropertyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKit_SetAbility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerAbilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServer).SetAbility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKit_SetAbility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServer).SetAbility(ctx, req.(*SetPlayerAbilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKit_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKit_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServer).SendMessage(ctx, req.(*PlayerMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerKit_ServiceDesc is the grpc.ServiceDesc for PlayerKit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerKit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.PlayerKit",
+	HandlerType: (*PlayerKitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProperty",
+			Handler:    _PlayerKit_GetProperty_Handler,
+		},
+		{
+			MethodName: "SetAbility",
+			Handler:    _PlayerKit_SetAbility_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _PlayerKit_SendMessage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tempest/dynamic/v1/players.proto",
+}
@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/plugin.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Config          *structpb.Struct       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	FrameBrokerId   uint32                 `protobuf:"varint,3,opt,name=frame_broker_id,json=frameBrokerId,proto3" json:"frame_broker_id,omitempty"`
+	ProtocolVersion int32                  `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// host_version and sdk_version describe the host.
+	HostVersion   string `protobuf:"bytes,5,opt,name=host_version,json=hostVersion,proto3" json:"host_version,omitempty"`
+	SdkVersion    string `protobuf:"bytes,6,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitRequest) Reset() {
+	*x = InitRequest{}
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitRequest) ProtoMessage() {}
+
+func (x *InitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InitRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *InitRequest) GetFrameBrokerId() uint32 {
+	if x != nil {
+		return x.FrameBrokerId
+	}
+	return 0
+}
+
+func (x *InitRequest) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *InitRequest) GetHostVersion() string {
+	if x != nil {
+		return x.HostVersion
+	}
+	return ""
+}
+
+func (x *InitRequest) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+// InitResponse reports the plugin build to the host.
+type InitResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SdkVersion string                 `protobuf:"bytes,1,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	// min_host_version and max_host_version bound the supported host versions (inclusive).
+	MinHostVersion string `protobuf:"bytes,2,opt,name=min_host_version,json=minHostVersion,proto3" json:"min_host_version,omitempty"`
+	MaxHostVersion string `protobuf:"bytes,3,opt,name=max_host_version,json=maxHostVersion,proto3" json:"max_host_version,omitempty"`
+	// config_schema is the JSON Schema of the plugin config, see api.ConfigSchema; unset when the
+	// plugin does not declare one.
+	ConfigSchema  *structpb.Struct `protobuf:"bytes,4,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitResponse) Reset() {
+	*x = InitResponse{}
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitResponse) ProtoMessage() {}
+
+func (x *InitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
+func (*InitResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InitResponse) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+func (x *InitResponse) GetMinHostVersion() string {
+	if x != nil {
+		return x.MinHostVersion
+	}
+	return ""
+}
+
+func (x *InitResponse) GetMaxHostVersion() string {
+	if x != nil {
+		return x.MaxHostVersion
+	}
+	return ""
+}
+
+func (x *InitResponse) GetConfigSchema() *structpb.Struct {
+	if x != nil {
+		return x.ConfigSchema
+	}
+	return nil
+}
+
+// LifecycleRequest carries the host deadline of Load and Unload. Older hosts send
+// google.protobuf.Empty, which decodes to the zero value.
+type LifecycleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// call_id identifies the call for Cancel.
+	CallId string `protobuf:"bytes,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	// timeout_ms is the remaining host deadline; 0 means no deadline.
+	TimeoutMs     int64 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifecycleRequest) Reset() {
+	*x = LifecycleRequest{}
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleRequest) ProtoMessage() {}
+
+func (x *LifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleRequest.ProtoReflect.Descriptor instead.
+func (*LifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LifecycleRequest) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *LifecycleRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallId        string                 `protobuf:"bytes,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelRequest) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ok reports whether the call was still in flight.
+	Ok            bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+var File_tempest_dynamic_v1_plugin_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_plugin_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftempest/dynamic/v1/plugin.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xe5\x01\n" +
+	"\vInitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x06config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06config\x12&\n" +
+	"\x0fframe_broker_id\x18\x03 \x01(\rR\rframeBrokerId\x12)\n" +
+	"\x10protocol_version\x18\x04 \x01(\x05R\x0fprotocolVersion\x12!\n" +
+	"\fhost_version\x18\x05 \x01(\tR\vhostVersion\x12\x1f\n" +
+	"\vsdk_version\x18\x06 \x01(\tR\n" +
+	"sdkVersion\"\xc1\x01\n" +
+	"\fInitResponse\x12\x1f\n" +
+	"\vsdk_version\x18\x01 \x01(\tR\n" +
+	"sdkVersion\x12(\n" +
+	"\x10min_host_version\x18\x02 \x01(\tR\x0eminHostVersion\x12(\n" +
+	"\x10max_host_version\x18\x03 \x01(\tR\x0emaxHostVersion\x12<\n" +
+	"\rconfig_schema\x18\x04 \x01(\v2\x17.google.protobuf.StructR\fconfigSchema\"J\n" +
+	"\x10LifecycleRequest\x12\x17\n" +
+	"\acall_id\x18\x01 \x01(\tR\x06callId\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\x03R\ttimeoutMs\"(\n" +
+	"\rCancelRequest\x12\x17\n" +
+	"\acall_id\x18\x01 \x01(\tR\x06callId\" \n" +
+	"\x0eCancelResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok2\xb2\x02\n" +
+	"\x06Plugin\x12I\n" +
+	"\x04Init\x12\x1f.tempest.dynamic.v1.InitRequest\x1a .tempest.dynamic.v1.InitResponse\x12D\n" +
+	"\x04Load\x12$.tempest.dynamic.v1.LifecycleRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x06Unload\x12$.tempest.dynamic.v1.LifecycleRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\x06Cancel\x12!.tempest.dynamic.v1.CancelRequest\x1a\".tempest.dynamic.v1.CancelResponseB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_plugin_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_plugin_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_plugin_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_plugin_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_plugin_proto_rawDesc), len(file_tempest_dynamic_v1_plugin_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_plugin_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tempest_dynamic_v1_plugin_proto_goTypes = []any{
+	(*InitRequest)(nil),      // 0: tempest.dynamic.v1.InitRequest
+	(*InitResponse)(nil),     // 1: tempest.dynamic.v1.InitResponse
+	(*LifecycleRequest)(nil), // 2: tempest.dynamic.v1.LifecycleRequest
+	(*CancelRequest)(nil),    // 3: tempest.dynamic.v1.CancelRequest
+	(*CancelResponse)(nil),   // 4: tempest.dynamic.v1.CancelResponse
+	(*structpb.Struct)(nil),  // 5: google.protobuf.Struct
+	(*emptypb.Empty)(nil),    // 6: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_plugin_proto_depIdxs = []int32{
+	5, // 0: tempest.dynamic.v1.InitRequest.config:type_name -> google.protobuf.Struct
+	5, // 1: tempest.dynamic.v1.InitResponse.config_schema:type_name -> google.protobuf.Struct
+	0, // 2: tempest.dynamic.v1.Plugin.Init:input_type -> tempest.dynamic.v1.InitRequest
+	2, // 3: tempest.dynamic.v1.Plugin.Load:input_type -> tempest.dynamic.v1.LifecycleRequest
+	2, // 4: tempest.dynamic.v1.Plugin.Unload:input_type -> tempest.dynamic.v1.LifecycleRequest
+	3, // 5: tempest.dynamic.v1.Plugin.Cancel:input_type -> tempest.dynamic.v1.CancelRequest
+	1, // 6: tempest.dynamic.v1.Plugin.Init:output_type -> tempest.dynamic.v1.InitResponse
+	6, // 7: tempest.dynamic.v1.Plugin.Load:output_type -> google.protobuf.Empty
+	6, // 8: tempest.dynamic.v1.Plugin.Unload:output_type -> google.protobuf.Empty
+	4, // 9: tempest.dynamic.v1.Plugin.Cancel:output_type -> tempest.dynamic.v1.CancelResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_plugin_proto_init() }
+func file_tempest_dynamic_v1_plugin_proto_init() {
+	if File_tempest_dynamic_v1_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_plugin_proto_rawDesc), len(file_tempest_dynamic_v1_plugin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_plugin_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_plugin_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_plugin_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_plugin_proto = out.File
+	file_tempest_dynamic_v1_plugin_proto_goTypes = nil
+	file_tempest_dynamic_v1_plugin_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/storage_path.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StoragePathKind int32
+
+const (
+	StoragePathKind_STORAGE_PATH_KIND_UNSPECIFIED StoragePathKind = 0
+	StoragePathKind_STORAGE_PATH_KIND_CONFIG      StoragePathKind = 1
+	StoragePathKind_STORAGE_PATH_KIND_CODE        StoragePathKind = 2
+	StoragePathKind_STORAGE_PATH_KIND_DATA_FILE   StoragePathKind = 3
+	StoragePathKind_STORAGE_PATH_KIND_CACHE       StoragePathKind = 4
+)
+
+// Enum value maps for StoragePathKind.
+var (
+	StoragePathKind_name = map[int32]string{
+		0: "STORAGE_PATH_KIND_UNSPECIFIED",
+		1: "STORAGE_PATH_KIND_CONFIG",
+		2: "STORAGE_PATH_KIND_CODE",
+		3: "STORAGE_PATH_KIND_DATA_FILE",
+		4: "STORAGE_PATH_KIND_CACHE",
+	}
+	StoragePathKind_value = map[string]int32{
+		"STORAGE_PATH_KIND_UNSPECIFIED": 0,
+		"STORAGE_PATH_KIND_CONFIG":      1,
+		"STORAGE_PATH_KIND_CODE":        2,
+		"STORAGE_PATH_KIND_DATA_FILE":   3,
+		"STORAGE_PATH_KIND_CACHE":       4,
+	}
+)
+
+func (x StoragePathKind) Enum() *StoragePathKind {
+	p := new(StoragePathKind)
+	*p = x
+	return p
+}
+
+func (x StoragePathKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StoragePathKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tempest_dynamic_v1_storage_path_proto_enumTypes[0].Descriptor()
+}
+
+func (StoragePathKind) Type() protoreflect.EnumType {
+	return &file_tempest_dynamic_v1_storage_path_proto_enumTypes[0]
+}
+
+func (x StoragePathKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StoragePathKind.Descriptor instead.
+func (StoragePathKind) EnumDescriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_storage_path_proto_rawDescGZIP(), []int{0}
+}
+
+type StoragePathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          StoragePathKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=tempest.dynamic.v1.StoragePathKind" json:"kind,omitempty"`
+	Parts         []string               `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoragePathRequest) Reset() {
+	*x = StoragePathRequest{}
+	mi := &file_tempest_dynamic_v1_storage_path_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePathRequest) ProtoMessage() {}
+
+func (x *StoragePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_storage_path_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePathRequest.ProtoReflect.Descriptor instead.
+func (*StoragePathRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_storage_path_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StoragePathRequest) GetKind() StoragePathKind {
+	if x != nil {
+		return x.Kind
+	}
+	return StoragePathKind_STORAGE_PATH_KIND_UNSPECIFIED
+}
+
+func (x *StoragePathRequest) GetParts() []string {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type StoragePathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoragePathResponse) Reset() {
+	*x = StoragePathResponse{}
+	mi := &file_tempest_dynamic_v1_storage_path_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePathResponse) ProtoMessage() {}
+
+func (x *StoragePathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_storage_path_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePathResponse.ProtoReflect.Descriptor instead.
+func (*StoragePathResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_storage_path_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoragePathResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+var File_tempest_dynamic_v1_storage_path_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_storage_path_proto_rawDesc = "" +
+	"\n" +
+	"%tempest/dynamic/v1/storage_path.proto\x12\x12tempest.dynamic.v1\"c\n" +
+	"\x12StoragePathRequest\x127\n" +
+	"\x04kind\x18\x01 \x01(\x0e2#.tempest.dynamic.v1.StoragePathKindR\x04kind\x12\x14\n" +
+	"\x05parts\x18\x02 \x03(\tR\x05parts\")\n" +
+	"\x13StoragePathResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path*\xac\x01\n" +
+	"\x0fStoragePathKind\x12!\n" +
+	"\x1dSTORAGE_PATH_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18STORAGE_PATH_KIND_CONFIG\x10\x01\x12\x1a\n" +
+	"\x16STORAGE_PATH_KIND_CODE\x10\x02\x12\x1f\n" +
+	"\x1bSTORAGE_PATH_KIND_DATA_FILE\x10\x03\x12\x1b\n" +
+	"\x17STORAGE_PATH_KIND_CACHE\x10\x042l\n" +
+	"\x11StoragePathModule\x12W\n" +
+	"\x04Path\x12&.tempest.dynamic.v1.StoragePathRequest\x1a'.tempest.dynamic.v1.StoragePathResponseB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_storage_path_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_storage_path_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_storage_path_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_storage_path_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_storage_path_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_storage_path_proto_rawDesc), len(file_tempest_dynamic_v1_storage_path_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_storage_path_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_storage_path_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tempest_dynamic_v1_storage_path_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tempest_dynamic_v1_storage_path_proto_goTypes = []any{
+	(StoragePathKind)(0),        // 0: tempest.dynamic.v1.StoragePathKind
+	(*StoragePathRequest)(nil),  // 1: tempest.dynamic.v1.StoragePathRequest
+	(*StoragePathResponse)(nil), // 2: tempest.dynamic.v1.StoragePathResponse
+}
+var file_tempest_dynamic_v1_storage_path_proto_depIdxs = []int32{
+	0, // 0: tempest.dynamic.v1.StoragePathRequest.kind:type_name -> tempest.dynamic.v1.StoragePathKind
+	1, // 1: tempest.dynamic.v1.StoragePathModule.Path:input_type -> tempest.dynamic.v1.StoragePathRequest
+	2, // 2: tempest.dynamic.v1.StoragePathModule.Path:output_type -> tempest.dynamic.v1.StoragePathResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_storage_path_proto_init() }
+func file_tempest_dynamic_v1_storage_path_proto_init() {
+	if File_tempest_dynamic_v1_storage_path_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_storage_path_proto_rawDesc), len(file_tempest_dynamic_v1_storage_path_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_storage_path_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_storage_path_proto_depIdxs,
+		EnumInfos:         file_tempest_dynamic_v1_storage_path_proto_enumTypes,
+		MessageInfos:      file_tempest_dynamic_v1_storage_path_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_storage_path_proto = out.File
+	file_tempest_dynamic_v1_storage_path_proto_goTypes = nil
+	file_tempest_dynamic_v1_storage_path_proto_depIdxs = nil
+}
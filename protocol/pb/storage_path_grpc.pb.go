@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/storage_path.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	StoragePathModule_Path_FullMethodName = "/tempest.dynamic.v1.StoragePathModule/Path"
+)
+
+// StoragePathModuleClient is the client API for StoragePathModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StoragePathModuleClient interface {
+	Path(ctx context.Context, in *StoragePathRequest, opts ...grpc.CallOption) (*StoragePathResponse, error)
+}
+
+type storagePathModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStoragePathModuleClient(cc grpc.ClientConnInterface) StoragePathModuleClient {
+	return &storagePathModuleClient{cc}
+}
+
+func (c *storagePathModuleClient) Path(ctx context.Context, in *StoragePathRequest, opts ...grpc.CallOption) (*StoragePathResponse, error) {
+	out := new(StoragePathResponse)
+	err := c.cc.Invoke(ctx, StoragePathModule_Path_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StoragePathModuleServer is the server API for StoragePathModule service.
+// All implementations must embed UnimplementedStoragePathModuleServer
+// for forward compatibility
+type StoragePathModuleServer interface {
+	Path(context.Context, *StoragePathRequest) (*StoragePathResponse, error)
+	mustEmbedUnimplementedStoragePathModuleServer()
+}
+
+// UnimplementedStoragePathModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedStoragePathModuleServer struct {
+}
+
+func (UnimplementedStoragePathModuleServer) Path(context.Context, *StoragePathRequest) (*StoragePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Path not implemented")
+}
+func (UnimplementedStoragePathModuleServer) mustEmbedUnimplementedStoragePathModuleServer() {}
+
+// UnsafeStoragePathModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StoragePathModuleServer will
+// result in compilation errors.
+type UnsafeStoragePathModuleServer interface {
+	mustEmbedUnimplementedStoragePathModuleServer()
+}
+
+func RegisterStoragePathModuleServer(s grpc.ServiceRegistrar, srv StoragePathModuleServer) {
+	s.RegisterService(&StoragePathModule_ServiceDesc, srv)
+}
+
+func _StoragePathModule_Path_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoragePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragePathModuleServer).Path(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoragePathModule_Path_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragePathModuleServer).Path(ctx, req.(*StoragePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StoragePathModule_ServiceDesc is the grpc.ServiceDesc for StoragePathModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StoragePathModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.StoragePathModule",
+	HandlerType: (*StoragePathModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Path",
+			Handler:    _StoragePathModule_Path_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tempest/dynamic/v1/storage_path.proto",
+}
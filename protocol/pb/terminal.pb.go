@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/terminal.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TerminalPrintRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// level is one of "SUCC", "INFO", "WARN", "ERRO".
+	Level         string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Scope         string `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Msg           string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalPrintRequest) Reset() {
+	*x = TerminalPrintRequest{}
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalPrintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalPrintRequest) ProtoMessage() {}
+
+func (x *TerminalPrintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalPrintRequest.ProtoReflect.Descriptor instead.
+func (*TerminalPrintRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TerminalPrintRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *TerminalPrintRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *TerminalPrintRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type TerminalRawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalRawRequest) Reset() {
+	*x = TerminalRawRequest{}
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalRawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalRawRequest) ProtoMessage() {}
+
+func (x *TerminalRawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalRawRequest.ProtoReflect.Descriptor instead.
+func (*TerminalRawRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TerminalRawRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type TerminalColorTransRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalColorTransRequest) Reset() {
+	*x = TerminalColorTransRequest{}
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalColorTransRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalColorTransRequest) ProtoMessage() {}
+
+func (x *TerminalColorTransRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalColorTransRequest.ProtoReflect.Descriptor instead.
+func (*TerminalColorTransRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TerminalColorTransRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type TerminalColorTransResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalColorTransResponse) Reset() {
+	*x = TerminalColorTransResponse{}
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalColorTransResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalColorTransResponse) ProtoMessage() {}
+
+func (x *TerminalColorTransResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalColorTransResponse.ProtoReflect.Descriptor instead.
+func (*TerminalColorTransResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TerminalColorTransResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type TerminalLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalLine) Reset() {
+	*x = TerminalLine{}
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalLine) ProtoMessage() {}
+
+func (x *TerminalLine) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalLine.ProtoReflect.Descriptor instead.
+func (*TerminalLine) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TerminalLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+type InterceptNextLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterceptNextLineRequest) Reset() {
+	*x = InterceptNextLineRequest{}
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterceptNextLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterceptNextLineRequest) ProtoMessage() {}
+
+func (x *InterceptNextLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterceptNextLineRequest.ProtoReflect.Descriptor instead.
+func (*InterceptNextLineRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InterceptNextLineRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+var File_tempest_dynamic_v1_terminal_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_terminal_proto_rawDesc = "" +
+	"\n" +
+	"!tempest/dynamic/v1/terminal.proto\x12\x12tempest.dynamic.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"T\n" +
+	"\x14TerminalPrintRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"&\n" +
+	"\x12TerminalRawRequest\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"-\n" +
+	"\x19TerminalColorTransRequest\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\".\n" +
+	"\x1aTerminalColorTransResponse\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"\"\n" +
+	"\fTerminalLine\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\tR\x04line\"O\n" +
+	"\x18InterceptNextLineRequest\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout2\xc8\x03\n" +
+	"\x0eTerminalModule\x12I\n" +
+	"\x05Print\x12(.tempest.dynamic.v1.TerminalPrintRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x03Raw\x12&.tempest.dynamic.v1.TerminalRawRequest\x1a\x16.google.protobuf.Empty\x12o\n" +
+	"\x0eColorTransANSI\x12-.tempest.dynamic.v1.TerminalColorTransRequest\x1a..tempest.dynamic.v1.TerminalColorTransResponse\x12L\n" +
+	"\x0eSubscribeLines\x12\x16.google.protobuf.Empty\x1a .tempest.dynamic.v1.TerminalLine0\x01\x12e\n" +
+	"\x11InterceptNextLine\x12,.tempest.dynamic.v1.InterceptNextLineRequest\x1a .tempest.dynamic.v1.TerminalLine0\x01B7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_terminal_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_terminal_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_terminal_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_terminal_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_terminal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_terminal_proto_rawDesc), len(file_tempest_dynamic_v1_terminal_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_terminal_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_terminal_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_tempest_dynamic_v1_terminal_proto_goTypes = []any{
+	(*TerminalPrintRequest)(nil),       // 0: tempest.dynamic.v1.TerminalPrintRequest
+	(*TerminalRawRequest)(nil),         // 1: tempest.dynamic.v1.TerminalRawRequest
+	(*TerminalColorTransRequest)(nil),  // 2: tempest.dynamic.v1.TerminalColorTransRequest
+	(*TerminalColorTransResponse)(nil), // 3: tempest.dynamic.v1.TerminalColorTransResponse
+	(*TerminalLine)(nil),               // 4: tempest.dynamic.v1.TerminalLine
+	(*InterceptNextLineRequest)(nil),   // 5: tempest.dynamic.v1.InterceptNextLineRequest
+	(*durationpb.Duration)(nil),        // 6: google.protobuf.Duration
+	(*emptypb.Empty)(nil),              // 7: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_terminal_proto_depIdxs = []int32{
+	6, // 0: tempest.dynamic.v1.InterceptNextLineRequest.timeout:type_name -> google.protobuf.Duration
+	0, // 1: tempest.dynamic.v1.TerminalModule.Print:input_type -> tempest.dynamic.v1.TerminalPrintRequest
+	1, // 2: tempest.dynamic.v1.TerminalModule.Raw:input_type -> tempest.dynamic.v1.TerminalRawRequest
+	2, // 3: tempest.dynamic.v1.TerminalModule.ColorTransANSI:input_type -> tempest.dynamic.v1.TerminalColorTransRequest
+	7, // 4: tempest.dynamic.v1.TerminalModule.SubscribeLines:input_type -> google.protobuf.Empty
+	5, // 5: tempest.dynamic.v1.TerminalModule.InterceptNextLine:input_type -> tempest.dynamic.v1.InterceptNextLineRequest
+	7, // 6: tempest.dynamic.v1.TerminalModule.Print:output_type -> google.protobuf.Empty
+	7, // 7: tempest.dynamic.v1.TerminalModule.Raw:output_type -> google.protobuf.Empty
+	3, // 8: tempest.dynamic.v1.TerminalModule.ColorTransANSI:output_type -> tempest.dynamic.v1.TerminalColorTransResponse
+	4, // 9: tempest.dynamic.v1.TerminalModule.SubscribeLines:output_type -> tempest.dynamic.v1.TerminalLine
+	4, // 10: tempest.dynamic.v1.TerminalModule.InterceptNextLine:output_type -> tempest.dynamic.v1.TerminalLine
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_terminal_proto_init() }
+func file_tempest_dynamic_v1_terminal_proto_init() {
+	if File_tempest_dynamic_v1_terminal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_terminal_proto_rawDesc), len(file_tempest_dynamic_v1_terminal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_terminal_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_terminal_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_terminal_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_terminal_proto = out.File
+	file_tempest_dynamic_v1_terminal_proto_goTypes = nil
+	file_tempest_dynamic_v1_terminal_proto_depIdxs = nil
+}
@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/terminal.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TerminalModule_Print_FullMethodName             = "/tempest.dynamic.v1.TerminalModule/Print"
+	TerminalModule_Raw_FullMethodName               = "/tempest.dynamic.v1.TerminalModule/Raw"
+	TerminalModule_ColorTransANSI_FullMethodName    = "/tempest.dynamic.v1.TerminalModule/ColorTransANSI"
+	TerminalModule_SubscribeLines_FullMethodName    = "/tempest.dynamic.v1.TerminalModule/SubscribeLines"
+	TerminalModule_InterceptNextLine_FullMethodName = "/tempest.dynamic.v1.TerminalModule/InterceptNextLine"
+)
+
+// TerminalModuleClient is the client API for TerminalModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TerminalModuleClient interface {
+	Print(ctx context.Context, in *TerminalPrintRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Raw(ctx context.Context, in *TerminalRawRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ColorTransANSI(ctx context.Context, in *TerminalColorTransRequest, opts ...grpc.CallOption) (*TerminalColorTransResponse, error)
+	// SubscribeLines sends an empty acknowledgement once subscribed, then one message per line.
+	SubscribeLines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalModule_SubscribeLinesClient, error)
+	// InterceptNextLine sends an acknowledgement, then at most one intercepted line.
+	InterceptNextLine(ctx context.Context, in *InterceptNextLineRequest, opts ...grpc.CallOption) (TerminalModule_InterceptNextLineClient, error)
+}
+
+type terminalModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTerminalModuleClient(cc grpc.ClientConnInterface) TerminalModuleClient {
+	return &terminalModuleClient{cc}
+}
+
+func (c *terminalModuleClient) Print(ctx context.Context, in *TerminalPrintRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TerminalModule_Print_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalModuleClient) Raw(ctx context.Context, in *TerminalRawRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TerminalModule_Raw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalModuleClient) ColorTransANSI(ctx context.Context, in *TerminalColorTransRequest, opts ...grpc.CallOption) (*TerminalColorTransResponse, error) {
+	out := new(TerminalColorTransResponse)
+	err := c.cc.Invoke(ctx, TerminalModule_ColorTransANSI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalModuleClient) SubscribeLines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalModule_SubscribeLinesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalModule_ServiceDesc.Streams[0], TerminalModule_SubscribeLines_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalModuleSubscribeLinesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalModule_SubscribeLinesClient interface {
+	Recv() (*TerminalLine, error)
+	grpc.ClientStream
+}
+
+type terminalModuleSubscribeLinesClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalModuleSubscribeLinesClient) Recv() (*TerminalLine, error) {
+	m := new(TerminalLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *terminalModuleClient) InterceptNextLine(ctx context.Context, in *InterceptNextLineRequest, opts ...grpc.CallOption) (TerminalModule_InterceptNextLineClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalModule_ServiceDesc.Streams[1], TerminalModule_InterceptNextLine_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalModuleInterceptNextLineClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalModule_InterceptNextLineClient interface {
+	Recv() (*TerminalLine, error)
+	grpc.ClientStream
+}
+
+type terminalModuleInterceptNextLineClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalModuleInterceptNextLineClient) Recv() (*TerminalLine, error) {
+	m := new(TerminalLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TerminalModuleServer is the server API for TerminalModule service.
+// All implementations must embed UnimplementedTerminalModuleServer
+// for forward compatibility
+type TerminalModuleServer interface {
+	Print(context.Context, *TerminalPrintRequest) (*emptypb.Empty, error)
+	Raw(context.Context, *TerminalRawRequest) (*emptypb.Empty, error)
+	ColorTransANSI(context.Context, *TerminalColorTransRequest) (*TerminalColorTransResponse, error)
+	// SubscribeLines sends an empty acknowledgement once subscribed, then one message per line.
+	SubscribeLines(*emptypb.Empty, TerminalModule_SubscribeLinesServer) error
+	// InterceptNextLine sends an acknowledgement, then at most one intercepted line.
+	InterceptNextLine(*InterceptNextLineRequest, TerminalModule_InterceptNextLineServer) error
+	mustEmbedUnimplementedTerminalModuleServer()
+}
+
+// UnimplementedTerminalModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedTerminalModuleServer struct {
+}
+
+func (UnimplementedTerminalModuleServer) Print(context.Context, *TerminalPrintRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Print not implemented")
+}
+func (UnimplementedTerminalModuleServer) Raw(context.Context, *TerminalRawRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Raw not implemented")
+}
+func (UnimplementedTerminalModuleServer) ColorTransANSI(context.Context, *TerminalColorTransRequest) (*TerminalColorTransResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ColorTransANSI not implemented")
+}
+func (UnimplementedTerminalModuleServer) SubscribeLines(*emptypb.Empty, TerminalModule_SubscribeLinesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeLines not implemented")
+}
+func (UnimplementedTerminalModuleServer) InterceptNextLine(*InterceptNextLineRequest, TerminalModule_InterceptNextLineServer) error {
+	return status.Errorf(codes.Unimplemented, "method InterceptNextLine not implemented")
+}
+func (UnimplementedTerminalModuleServer) mustEmbedUnimplementedTerminalModuleServer() {}
+
+// UnsafeTerminalModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TerminalModuleServer will
+// result in compilation errors.
+type UnsafeTerminalModuleServer interface {
+	mustEmbedUnimplementedTerminalModuleServer()
+}
+
+func RegisterTerminalModuleServer(s grpc.ServiceRegistrar, srv TerminalModuleServer) {
+	s.RegisterService(&TerminalModule_ServiceDesc, srv)
+}
+
+func _TerminalModule_Print_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminalPrintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalModuleServer).Print(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalModule_Print_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalModuleServer).Print(ctx, req.(*TerminalPrintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalModule_Raw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminalRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalModuleServer).Raw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalModule_Raw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalModuleServer).Raw(ctx, req.(*TerminalRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalModule_ColorTransANSI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminalColorTransRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalModuleServer).ColorTransANSI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalModule_ColorTransANSI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalModuleServer).ColorTransANSI(ctx, req.(*TerminalColorTransRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalModule_SubscribeLines_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalModuleServer).SubscribeLines(m, &terminalModuleSubscribeLinesServer{stream})
+}
+
+type TerminalModule_SubscribeLinesServer interface {
+	Send(*TerminalLine) error
+	grpc.ServerStream
+}
+
+type terminalModuleSubscribeLinesServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalModuleSubscribeLinesServer) Send(m *TerminalLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TerminalModule_InterceptNextLine_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InterceptNextLineRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalModuleServer).InterceptNextLine(m, &terminalModuleInterceptNextLineServer{stream})
+}
+
+type TerminalModule_InterceptNextLineServer interface {
+	Send(*TerminalLine) error
+	grpc.ServerStream
+}
+
+type terminalModuleInterceptNextLineServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalModuleInterceptNextLineServer) Send(m *TerminalLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TerminalModule_ServiceDesc is the grpc.ServiceDesc for TerminalModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TerminalModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.TerminalModule",
+	HandlerType: (*TerminalModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Print",
+			Handler:    _TerminalModule_Print_Handler,
+		},
+		{
+			MethodName: "Raw",
+			Handler:    _TerminalModule_Raw_Handler,
+		},
+		{
+			MethodName: "ColorTransANSI",
+			Handler:    _TerminalModule_ColorTransANSI_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLines",
+			Handler:       _TerminalModule_SubscribeLines_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InterceptNextLine",
+			Handler:       _TerminalModule_InterceptNextLine_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/terminal.proto",
+}
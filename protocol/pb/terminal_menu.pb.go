@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/terminal_menu.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TerminalMenuEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Triggers      []string               `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	ArgumentHint  string                 `protobuf:"bytes,2,opt,name=argument_hint,json=argumentHint,proto3" json:"argument_hint,omitempty"`
+	Usage         string                 `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalMenuEntry) Reset() {
+	*x = TerminalMenuEntry{}
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalMenuEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalMenuEntry) ProtoMessage() {}
+
+func (x *TerminalMenuEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalMenuEntry.ProtoReflect.Descriptor instead.
+func (*TerminalMenuEntry) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TerminalMenuEntry) GetTriggers() []string {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *TerminalMenuEntry) GetArgumentHint() string {
+	if x != nil {
+		return x.ArgumentHint
+	}
+	return ""
+}
+
+func (x *TerminalMenuEntry) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+type TerminalMenuTrigger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Args          []string               `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalMenuTrigger) Reset() {
+	*x = TerminalMenuTrigger{}
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalMenuTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalMenuTrigger) ProtoMessage() {}
+
+func (x *TerminalMenuTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalMenuTrigger.ProtoReflect.Descriptor instead.
+func (*TerminalMenuTrigger) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TerminalMenuTrigger) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type TerminalCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalCallRequest) Reset() {
+	*x = TerminalCallRequest{}
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalCallRequest) ProtoMessage() {}
+
+func (x *TerminalCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalCallRequest.ProtoReflect.Descriptor instead.
+func (*TerminalCallRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TerminalCallRequest) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+type TerminalMenuEntryEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Entry         *TerminalMenuEntry     `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalMenuEntryEvent) Reset() {
+	*x = TerminalMenuEntryEvent{}
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalMenuEntryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalMenuEntryEvent) ProtoMessage() {}
+
+func (x *TerminalMenuEntryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalMenuEntryEvent.ProtoReflect.Descriptor instead.
+func (*TerminalMenuEntryEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TerminalMenuEntryEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *TerminalMenuEntryEvent) GetEntry() *TerminalMenuEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type TerminalCallEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Line          string                 `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalCallEvent) Reset() {
+	*x = TerminalCallEvent{}
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalCallEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalCallEvent) ProtoMessage() {}
+
+func (x *TerminalCallEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalCallEvent.ProtoReflect.Descriptor instead.
+func (*TerminalCallEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TerminalCallEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *TerminalCallEvent) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+type PopBackendMenuEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenerId    string                 `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopBackendMenuEvent) Reset() {
+	*x = PopBackendMenuEvent{}
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopBackendMenuEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopBackendMenuEvent) ProtoMessage() {}
+
+func (x *PopBackendMenuEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_terminal_menu_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopBackendMenuEvent.ProtoReflect.Descriptor instead.
+func (*PopBackendMenuEvent) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PopBackendMenuEvent) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+var File_tempest_dynamic_v1_terminal_menu_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_terminal_menu_proto_rawDesc = "" +
+	"\n" +
+	"&tempest/dynamic/v1/terminal_menu.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\"j\n" +
+	"\x11TerminalMenuEntry\x12\x1a\n" +
+	"\btriggers\x18\x01 \x03(\tR\btriggers\x12#\n" +
+	"\rargument_hint\x18\x02 \x01(\tR\fargumentHint\x12\x14\n" +
+	"\x05usage\x18\x03 \x01(\tR\x05usage\")\n" +
+	"\x13TerminalMenuTrigger\x12\x12\n" +
+	"\x04args\x18\x01 \x03(\tR\x04args\")\n" +
+	"\x13TerminalCallRequest\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\tR\x04line\"v\n" +
+	"\x16TerminalMenuEntryEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12;\n" +
+	"\x05entry\x18\x02 \x01(\v2%.tempest.dynamic.v1.TerminalMenuEntryR\x05entry\"H\n" +
+	"\x11TerminalCallEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\"6\n" +
+	"\x13PopBackendMenuEvent\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId2\xa3\x04\n" +
+	"\x12TerminalMenuModule\x12a\n" +
+	"\rRegisterEntry\x12%.tempest.dynamic.v1.TerminalMenuEntry\x1a'.tempest.dynamic.v1.TerminalMenuTrigger0\x01\x12V\n" +
+	"\x13PublishTerminalCall\x12'.tempest.dynamic.v1.TerminalCallRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x15PublishPopBackendMenu\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x11WatchAddMenuEntry\x12\x16.google.protobuf.Empty\x1a*.tempest.dynamic.v1.TerminalMenuEntryEvent0\x01\x12T\n" +
+	"\x11WatchTerminalCall\x12\x16.google.protobuf.Empty\x1a%.tempest.dynamic.v1.TerminalCallEvent0\x01\x12X\n" +
+	"\x13WatchPopBackendMenu\x12\x16.google.protobuf.Empty\x1a'.tempest.dynamic.v1.PopBackendMenuEvent0\x01B7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_terminal_menu_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_terminal_menu_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_terminal_menu_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_terminal_menu_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_terminal_menu_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_terminal_menu_proto_rawDesc), len(file_tempest_dynamic_v1_terminal_menu_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_terminal_menu_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_terminal_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_tempest_dynamic_v1_terminal_menu_proto_goTypes = []any{
+	(*TerminalMenuEntry)(nil),      // 0: tempest.dynamic.v1.TerminalMenuEntry
+	(*TerminalMenuTrigger)(nil),    // 1: tempest.dynamic.v1.TerminalMenuTrigger
+	(*TerminalCallRequest)(nil),    // 2: tempest.dynamic.v1.TerminalCallRequest
+	(*TerminalMenuEntryEvent)(nil), // 3: tempest.dynamic.v1.TerminalMenuEntryEvent
+	(*TerminalCallEvent)(nil),      // 4: tempest.dynamic.v1.TerminalCallEvent
+	(*PopBackendMenuEvent)(nil),    // 5: tempest.dynamic.v1.PopBackendMenuEvent
+	(*emptypb.Empty)(nil),          // 6: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_terminal_menu_proto_depIdxs = []int32{
+	0, // 0: tempest.dynamic.v1.TerminalMenuEntryEvent.entry:type_name -> tempest.dynamic.v1.TerminalMenuEntry
+	0, // 1: tempest.dynamic.v1.TerminalMenuModule.RegisterEntry:input_type -> tempest.dynamic.v1.TerminalMenuEntry
+	2, // 2: tempest.dynamic.v1.TerminalMenuModule.PublishTerminalCall:input_type -> tempest.dynamic.v1.TerminalCallRequest
+	6, // 3: tempest.dynamic.v1.TerminalMenuModule.PublishPopBackendMenu:input_type -> google.protobuf.Empty
+	6, // 4: tempest.dynamic.v1.TerminalMenuModule.WatchAddMenuEntry:input_type -> google.protobuf.Empty
+	6, // 5: tempest.dynamic.v1.TerminalMenuModule.WatchTerminalCall:input_type -> google.protobuf.Empty
+	6, // 6: tempest.dynamic.v1.TerminalMenuModule.WatchPopBackendMenu:input_type -> google.protobuf.Empty
+	1, // 7: tempest.dynamic.v1.TerminalMenuModule.RegisterEntry:output_type -> tempest.dynamic.v1.TerminalMenuTrigger
+	6, // 8: tempest.dynamic.v1.TerminalMenuModule.PublishTerminalCall:output_type -> google.protobuf.Empty
+	6, // 9: tempest.dynamic.v1.TerminalMenuModule.PublishPopBackendMenu:output_type -> google.protobuf.Empty
+	3, // 10: tempest.dynamic.v1.TerminalMenuModule.WatchAddMenuEntry:output_type -> tempest.dynamic.v1.TerminalMenuEntryEvent
+	4, // 11: tempest.dynamic.v1.TerminalMenuModule.WatchTerminalCall:output_type -> tempest.dynamic.v1.TerminalCallEvent
+	5, // 12: tempest.dynamic.v1.TerminalMenuModule.WatchPopBackendMenu:output_type -> tempest.dynamic.v1.PopBackendMenuEvent
+	7, // [7:13] is the sub-list for method output_type
+	1, // [1:7] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_terminal_menu_proto_init() }
+func file_tempest_dynamic_v1_terminal_menu_proto_init() {
+	if File_tempest_dynamic_v1_terminal_menu_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_terminal_menu_proto_rawDesc), len(file_tempest_dynamic_v1_terminal_menu_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_terminal_menu_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_terminal_menu_proto_depIdxs,
+		MessageInfos:      file_tempest_dynamic_v1_terminal_menu_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_terminal_menu_proto = out.File
+	file_tempest_dynamic_v1_terminal_menu_proto_goTypes = nil
+	file_tempest_dynamic_v1_terminal_menu_proto_depIdxs = nil
+}
@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/terminal_menu.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TerminalMenuModule_RegisterEntry_FullMethodName         = "/tempest.dynamic.v1.TerminalMenuModule/RegisterEntry"
+	TerminalMenuModule_PublishTerminalCall_FullMethodName   = "/tempest.dynamic.v1.TerminalMenuModule/PublishTerminalCall"
+	TerminalMenuModule_PublishPopBackendMenu_FullMethodName = "/tempest.dynamic.v1.TerminalMenuModule/PublishPopBackendMenu"
+	TerminalMenuModule_WatchAddMenuEntry_FullMethodName     = "/tempest.dynamic.v1.TerminalMenuModule/WatchAddMenuEntry"
+	TerminalMenuModule_WatchTerminalCall_FullMethodName     = "/tempest.dynamic.v1.TerminalMenuModule/WatchTerminalCall"
+	TerminalMenuModule_WatchPopBackendMenu_FullMethodName   = "/tempest.dynamic.v1.TerminalMenuModule/WatchPopBackendMenu"
+)
+
+// TerminalMenuModuleClient is the client API for TerminalMenuModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TerminalMenuModuleClient interface {
+	// RegisterEntry sends an acknowledgement, then one message per trigger.
+	// Cancelling the stream removes the entry.
+	RegisterEntry(ctx context.Context, in *TerminalMenuEntry, opts ...grpc.CallOption) (TerminalMenuModule_RegisterEntryClient, error)
+	PublishTerminalCall(ctx context.Context, in *TerminalCallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PublishPopBackendMenu(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	WatchAddMenuEntry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalMenuModule_WatchAddMenuEntryClient, error)
+	WatchTerminalCall(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalMenuModule_WatchTerminalCallClient, error)
+	WatchPopBackendMenu(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalMenuModule_WatchPopBackendMenuClient, error)
+}
+
+type terminalMenuModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTerminalMenuModuleClient(cc grpc.ClientConnInterface) TerminalMenuModuleClient {
+	return &terminalMenuModuleClient{cc}
+}
+
+func (c *terminalMenuModuleClient) RegisterEntry(ctx context.Context, in *TerminalMenuEntry, opts ...grpc.CallOption) (TerminalMenuModule_RegisterEntryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalMenuModule_ServiceDesc.Streams[0], TerminalMenuModule_RegisterEntry_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalMenuModuleRegisterEntryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalMenuModule_RegisterEntryClient interface {
+	Recv() (*TerminalMenuTrigger, error)
+	grpc.ClientStream
+}
+
+type terminalMenuModuleRegisterEntryClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalMenuModuleRegisterEntryClient) Recv() (*TerminalMenuTrigger, error) {
+	m := new(TerminalMenuTrigger)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *terminalMenuModuleClient) PublishTerminalCall(ctx context.Context, in *TerminalCallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TerminalMenuModule_PublishTerminalCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalMenuModuleClient) PublishPopBackendMenu(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TerminalMenuModule_PublishPopBackendMenu_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalMenuModuleClient) WatchAddMenuEntry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalMenuModule_WatchAddMenuEntryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalMenuModule_ServiceDesc.Streams[1], TerminalMenuModule_WatchAddMenuEntry_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalMenuModuleWatchAddMenuEntryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalMenuModule_WatchAddMenuEntryClient interface {
+	Recv() (*TerminalMenuEntryEvent, error)
+	grpc.ClientStream
+}
+
+type terminalMenuModuleWatchAddMenuEntryClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalMenuModuleWatchAddMenuEntryClient) Recv() (*TerminalMenuEntryEvent, error) {
+	m := new(TerminalMenuEntryEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *terminalMenuModuleClient) WatchTerminalCall(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalMenuModule_WatchTerminalCallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalMenuModule_ServiceDesc.Streams[2], TerminalMenuModule_WatchTerminalCall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalMenuModuleWatchTerminalCallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalMenuModule_WatchTerminalCallClient interface {
+	Recv() (*TerminalCallEvent, error)
+	grpc.ClientStream
+}
+
+type terminalMenuModuleWatchTerminalCallClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalMenuModuleWatchTerminalCallClient) Recv() (*TerminalCallEvent, error) {
+	m := new(TerminalCallEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *terminalMenuModuleClient) WatchPopBackendMenu(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TerminalMenuModule_WatchPopBackendMenuClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalMenuModule_ServiceDesc.Streams[3], TerminalMenuModule_WatchPopBackendMenu_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalMenuModuleWatchPopBackendMenuClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalMenuModule_WatchPopBackendMenuClient interface {
+	Recv() (*PopBackendMenuEvent, error)
+	grpc.ClientStream
+}
+
+type terminalMenuModuleWatchPopBackendMenuClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalMenuModuleWatchPopBackendMenuClient) Recv() (*PopBackendMenuEvent, error) {
+	m := new(PopBackendMenuEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TerminalMenuModuleServer is the server API for TerminalMenuModule service.
+// All implementations must embed UnimplementedTerminalMenuModuleServer
+// for forward compatibility
+type TerminalMenuModuleServer interface {
+	// RegisterEntry sends an acknowledgement, then one message per trigger.
+	// Cancelling the stream removes the entry.
+	RegisterEntry(*TerminalMenuEntry, TerminalMenuModule_RegisterEntryServer) error
+	PublishTerminalCall(context.Context, *TerminalCallRequest) (*emptypb.Empty, error)
+	PublishPopBackendMenu(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	WatchAddMenuEntry(*emptypb.Empty, TerminalMenuModule_WatchAddMenuEntryServer) error
+	WatchTerminalCall(*emptypb.Empty, TerminalMenuModule_WatchTerminalCallServer) error
+	WatchPopBackendMenu(*emptypb.Empty, TerminalMenuModule_WatchPopBackendMenuServer) error
+	mustEmbedUnimplementedTerminalMenuModuleServer()
+}
+
+// UnimplementedTerminalMenuModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedTerminalMenuModuleServer struct {
+}
+
+func (UnimplementedTerminalMenuModuleServer) RegisterEntry(*TerminalMenuEntry, TerminalMenuModule_RegisterEntryServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterEntry not implemented")
+}
+func (UnimplementedTerminalMenuModuleServer) PublishTerminalCall(context.Context, *TerminalCallRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTerminalCall not implemented")
+}
+func (UnimplementedTerminalMenuModuleServer) PublishPopBackendMenu(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPopBackendMenu not implemented")
+}
+func (UnimplementedTerminalMenuModuleServer) WatchAddMenuEntry(*emptypb.Empty, TerminalMenuModule_WatchAddMenuEntryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAddMenuEntry not implemented")
+}
+func (UnimplementedTerminalMenuModuleServer) WatchTerminalCall(*emptypb.Empty, TerminalMenuModule_WatchTerminalCallServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTerminalCall not implemented")
+}
+func (UnimplementedTerminalMenuModuleServer) WatchPopBackendMenu(*emptypb.Empty, TerminalMenuModule_WatchPopBackendMenuServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPopBackendMenu not implemented")
+}
+func (UnimplementedTerminalMenuModuleServer) mustEmbedUnimplementedTerminalMenuModuleServer() {}
+
+// UnsafeTerminalMenuModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TerminalMenuModuleServer will
+// result in compilation errors.
+type UnsafeTerminalMenuModuleServer interface {
+	mustEmbedUnimplementedTerminalMenuModuleServer()
+}
+
+func RegisterTerminalMenuModuleServer(s grpc.ServiceRegistrar, srv TerminalMenuModuleServer) {
+	s.RegisterService(&TerminalMenuModule_ServiceDesc, srv)
+}
+
+func _TerminalMenuModule_RegisterEntry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TerminalMenuEntry)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalMenuModuleServer).RegisterEntry(m, &terminalMenuModuleRegisterEntryServer{stream})
+}
+
+type TerminalMenuModule_RegisterEntryServer interface {
+	Send(*TerminalMenuTrigger) error
+	grpc.ServerStream
+}
+
+type terminalMenuModuleRegisterEntryServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalMenuModuleRegisterEntryServer) Send(m *TerminalMenuTrigger) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TerminalMenuModule_PublishTerminalCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminalCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalMenuModuleServer).PublishTerminalCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalMenuModule_PublishTerminalCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalMenuModuleServer).PublishTerminalCall(ctx, req.(*TerminalCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalMenuModule_PublishPopBackendMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalMenuModuleServer).PublishPopBackendMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalMenuModule_PublishPopBackendMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalMenuModuleServer).PublishPopBackendMenu(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalMenuModule_WatchAddMenuEntry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalMenuModuleServer).WatchAddMenuEntry(m, &terminalMenuModuleWatchAddMenuEntryServer{stream})
+}
+
+type TerminalMenuModule_WatchAddMenuEntryServer interface {
+	Send(*TerminalMenuEntryEvent) error
+	grpc.ServerStream
+}
+
+type terminalMenuModuleWatchAddMenuEntryServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalMenuModuleWatchAddMenuEntryServer) Send(m *TerminalMenuEntryEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TerminalMenuModule_WatchTerminalCall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalMenuModuleServer).WatchTerminalCall(m, &terminalMenuModuleWatchTerminalCallServer{stream})
+}
+
+type TerminalMenuModule_WatchTerminalCallServer interface {
+	Send(*TerminalCallEvent) error
+	grpc.ServerStream
+}
+
+type terminalMenuModuleWatchTerminalCallServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalMenuModuleWatchTerminalCallServer) Send(m *TerminalCallEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TerminalMenuModule_WatchPopBackendMenu_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalMenuModuleServer).WatchPopBackendMenu(m, &terminalMenuModuleWatchPopBackendMenuServer{stream})
+}
+
+type TerminalMenuModule_WatchPopBackendMenuServer interface {
+	Send(*PopBackendMenuEvent) error
+	grpc.ServerStream
+}
+
+type terminalMenuModuleWatchPopBackendMenuServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalMenuModuleWatchPopBackendMenuServer) Send(m *PopBackendMenuEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TerminalMenuModule_ServiceDesc is the grpc.ServiceDesc for TerminalMenuModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TerminalMenuModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.TerminalMenuModule",
+	HandlerType: (*TerminalMenuModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishTerminalCall",
+			Handler:    _TerminalMenuModule_PublishTerminalCall_Handler,
+		},
+		{
+			MethodName: "PublishPopBackendMenu",
+			Handler:    _TerminalMenuModule_PublishPopBackendMenu_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterEntry",
+			Handler:       _TerminalMenuModule_RegisterEntry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchAddMenuEntry",
+			Handler:       _TerminalMenuModule_WatchAddMenuEntry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTerminalCall",
+			Handler:       _TerminalMenuModule_WatchTerminalCall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPopBackendMenu",
+			Handler:       _TerminalMenuModule_WatchPopBackendMenu_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tempest/dynamic/v1/terminal_menu.proto",
+}
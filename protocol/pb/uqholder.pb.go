@@ -0,0 +1,780 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tempest/dynamic/v1/uqholder.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UQHolderRaw int32
+
+const (
+	UQHolderRaw_UQHOLDER_RAW_UNSPECIFIED UQHolderRaw = 0
+	UQHolderRaw_UQHOLDER_RAW_BASIC       UQHolderRaw = 1
+	UQHolderRaw_UQHOLDER_RAW_EXTEND      UQHolderRaw = 2
+)
+
+// Enum value maps for UQHolderRaw.
+var (
+	UQHolderRaw_name = map[int32]string{
+		0: "UQHOLDER_RAW_UNSPECIFIED",
+		1: "UQHOLDER_RAW_BASIC",
+		2: "UQHOLDER_RAW_EXTEND",
+	}
+	UQHolderRaw_value = map[string]int32{
+		"UQHOLDER_RAW_UNSPECIFIED": 0,
+		"UQHOLDER_RAW_BASIC":       1,
+		"UQHOLDER_RAW_EXTEND":      2,
+	}
+)
+
+func (x UQHolderRaw) Enum() *UQHolderRaw {
+	p := new(UQHolderRaw)
+	*p = x
+	return p
+}
+
+func (x UQHolderRaw) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UQHolderRaw) Descriptor() protoreflect.EnumDescriptor {
+	return file_tempest_dynamic_v1_uqholder_proto_enumTypes[0].Descriptor()
+}
+
+func (UQHolderRaw) Type() protoreflect.EnumType {
+	return &file_tempest_dynamic_v1_uqholder_proto_enumTypes[0]
+}
+
+func (x UQHolderRaw) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UQHolderRaw.Descriptor instead.
+func (UQHolderRaw) EnumDescriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{0}
+}
+
+type UQHolderState int32
+
+const (
+	UQHolderState_UQHOLDER_STATE_UNSPECIFIED                   UQHolderState = 0
+	UQHolderState_UQHOLDER_STATE_COMPRESS_THRESHOLD            UQHolderState = 1
+	UQHolderState_UQHOLDER_STATE_WORLD_GAME_MODE               UQHolderState = 2
+	UQHolderState_UQHOLDER_STATE_GAME_MODE                     UQHolderState = 3
+	UQHolderState_UQHOLDER_STATE_WORLD_DIFFICULTY              UQHolderState = 4
+	UQHolderState_UQHOLDER_STATE_TIME                          UQHolderState = 5
+	UQHolderState_UQHOLDER_STATE_DAY_TIME                      UQHolderState = 6
+	UQHolderState_UQHOLDER_STATE_DAY_TIME_PERCENT              UQHolderState = 7
+	UQHolderState_UQHOLDER_STATE_CURRENT_TICK                  UQHolderState = 8
+	UQHolderState_UQHOLDER_STATE_SYNC_RATIO                    UQHolderState = 9
+	UQHolderState_UQHOLDER_STATE_BOT_DIMENSION                 UQHolderState = 10
+	UQHolderState_UQHOLDER_STATE_BOT_POSITION                  UQHolderState = 11
+	UQHolderState_UQHOLDER_STATE_BOT_POSITION_OUT_OF_SYNC_TICK UQHolderState = 12
+	UQHolderState_UQHOLDER_STATE_CLIENT_DIMENSION              UQHolderState = 13
+	UQHolderState_UQHOLDER_STATE_CLIENT_HOT_BAR_SLOT           UQHolderState = 14
+	UQHolderState_UQHOLDER_STATE_CLIENT_HOLDING_ITEM           UQHolderState = 15
+)
+
+// Enum value maps for UQHolderState.
+var (
+	UQHolderState_name = map[int32]string{
+		0:  "UQHOLDER_STATE_UNSPECIFIED",
+		1:  "UQHOLDER_STATE_COMPRESS_THRESHOLD",
+		2:  "UQHOLDER_STATE_WORLD_GAME_MODE",
+		3:  "UQHOLDER_STATE_GAME_MODE",
+		4:  "UQHOLDER_STATE_WORLD_DIFFICULTY",
+		5:  "UQHOLDER_STATE_TIME",
+		6:  "UQHOLDER_STATE_DAY_TIME",
+		7:  "UQHOLDER_STATE_DAY_TIME_PERCENT",
+		8:  "UQHOLDER_STATE_CURRENT_TICK",
+		9:  "UQHOLDER_STATE_SYNC_RATIO",
+		10: "UQHOLDER_STATE_BOT_DIMENSION",
+		11: "UQHOLDER_STATE_BOT_POSITION",
+		12: "UQHOLDER_STATE_BOT_POSITION_OUT_OF_SYNC_TICK",
+		13: "UQHOLDER_STATE_CLIENT_DIMENSION",
+		14: "UQHOLDER_STATE_CLIENT_HOT_BAR_SLOT",
+		15: "UQHOLDER_STATE_CLIENT_HOLDING_ITEM",
+	}
+	UQHolderState_value = map[string]int32{
+		"UQHOLDER_STATE_UNSPECIFIED":                   0,
+		"UQHOLDER_STATE_COMPRESS_THRESHOLD":            1,
+		"UQHOLDER_STATE_WORLD_GAME_MODE":               2,
+		"UQHOLDER_STATE_GAME_MODE":                     3,
+		"UQHOLDER_STATE_WORLD_DIFFICULTY":              4,
+		"UQHOLDER_STATE_TIME":                          5,
+		"UQHOLDER_STATE_DAY_TIME":                      6,
+		"UQHOLDER_STATE_DAY_TIME_PERCENT":              7,
+		"UQHOLDER_STATE_CURRENT_TICK":                  8,
+		"UQHOLDER_STATE_SYNC_RATIO":                    9,
+		"UQHOLDER_STATE_BOT_DIMENSION":                 10,
+		"UQHOLDER_STATE_BOT_POSITION":                  11,
+		"UQHOLDER_STATE_BOT_POSITION_OUT_OF_SYNC_TICK": 12,
+		"UQHOLDER_STATE_CLIENT_DIMENSION":              13,
+		"UQHOLDER_STATE_CLIENT_HOT_BAR_SLOT":           14,
+		"UQHOLDER_STATE_CLIENT_HOLDING_ITEM":           15,
+	}
+)
+
+func (x UQHolderState) Enum() *UQHolderState {
+	p := new(UQHolderState)
+	*p = x
+	return p
+}
+
+func (x UQHolderState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UQHolderState) Descriptor() protoreflect.EnumDescriptor {
+	return file_tempest_dynamic_v1_uqholder_proto_enumTypes[1].Descriptor()
+}
+
+func (UQHolderState) Type() protoreflect.EnumType {
+	return &file_tempest_dynamic_v1_uqholder_proto_enumTypes[1]
+}
+
+func (x UQHolderState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UQHolderState.Descriptor instead.
+func (UQHolderState) EnumDescriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{1}
+}
+
+type BotInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RuntimeId     uint64                 `protobuf:"varint,2,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	UniqueId      int64                  `protobuf:"varint,3,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	UuidStr       string                 `protobuf:"bytes,5,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Xuid          string                 `protobuf:"bytes,6,opt,name=xuid,proto3" json:"xuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BotInfo) Reset() {
+	*x = BotInfo{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotInfo) ProtoMessage() {}
+
+func (x *BotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotInfo.ProtoReflect.Descriptor instead.
+func (*BotInfo) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BotInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BotInfo) GetRuntimeId() uint64 {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return 0
+}
+
+func (x *BotInfo) GetUniqueId() int64 {
+	if x != nil {
+		return x.UniqueId
+	}
+	return 0
+}
+
+func (x *BotInfo) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *BotInfo) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *BotInfo) GetXuid() string {
+	if x != nil {
+		return x.Xuid
+	}
+	return ""
+}
+
+type UQHolderRawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          UQHolderRaw            `protobuf:"varint,1,opt,name=kind,proto3,enum=tempest.dynamic.v1.UQHolderRaw" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UQHolderRawRequest) Reset() {
+	*x = UQHolderRawRequest{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UQHolderRawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UQHolderRawRequest) ProtoMessage() {}
+
+func (x *UQHolderRawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UQHolderRawRequest.ProtoReflect.Descriptor instead.
+func (*UQHolderRawRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UQHolderRawRequest) GetKind() UQHolderRaw {
+	if x != nil {
+		return x.Kind
+	}
+	return UQHolderRaw_UQHOLDER_RAW_UNSPECIFIED
+}
+
+type UQHolderStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         UQHolderState          `protobuf:"varint,1,opt,name=state,proto3,enum=tempest.dynamic.v1.UQHolderState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UQHolderStateRequest) Reset() {
+	*x = UQHolderStateRequest{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UQHolderStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UQHolderStateRequest) ProtoMessage() {}
+
+func (x *UQHolderStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UQHolderStateRequest.ProtoReflect.Descriptor instead.
+func (*UQHolderStateRequest) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UQHolderStateRequest) GetState() UQHolderState {
+	if x != nil {
+		return x.State
+	}
+	return UQHolderState_UQHOLDER_STATE_UNSPECIFIED
+}
+
+type Vec3 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vec3) Reset() {
+	*x = Vec3{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vec3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vec3) ProtoMessage() {}
+
+func (x *Vec3) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vec3.ProtoReflect.Descriptor instead.
+func (*Vec3) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Vec3) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vec3) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vec3) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type UQHolderStateValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ok is false when the host has not received the value yet.
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*UQHolderStateValue_IntValue
+	//	*UQHolderStateValue_UintValue
+	//	*UQHolderStateValue_FloatValue
+	//	*UQHolderStateValue_Vec3Value
+	//	*UQHolderStateValue_StructValue
+	Value         isUQHolderStateValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UQHolderStateValue) Reset() {
+	*x = UQHolderStateValue{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UQHolderStateValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UQHolderStateValue) ProtoMessage() {}
+
+func (x *UQHolderStateValue) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UQHolderStateValue.ProtoReflect.Descriptor instead.
+func (*UQHolderStateValue) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UQHolderStateValue) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *UQHolderStateValue) GetValue() isUQHolderStateValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *UQHolderStateValue) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*UQHolderStateValue_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *UQHolderStateValue) GetUintValue() uint64 {
+	if x != nil {
+		if x, ok := x.Value.(*UQHolderStateValue_UintValue); ok {
+			return x.UintValue
+		}
+	}
+	return 0
+}
+
+func (x *UQHolderStateValue) GetFloatValue() float32 {
+	if x != nil {
+		if x, ok := x.Value.(*UQHolderStateValue_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *UQHolderStateValue) GetVec3Value() *Vec3 {
+	if x != nil {
+		if x, ok := x.Value.(*UQHolderStateValue_Vec3Value); ok {
+			return x.Vec3Value
+		}
+	}
+	return nil
+}
+
+func (x *UQHolderStateValue) GetStructValue() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.Value.(*UQHolderStateValue_StructValue); ok {
+			return x.StructValue
+		}
+	}
+	return nil
+}
+
+type isUQHolderStateValue_Value interface {
+	isUQHolderStateValue_Value()
+}
+
+type UQHolderStateValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type UQHolderStateValue_UintValue struct {
+	UintValue uint64 `protobuf:"varint,3,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type UQHolderStateValue_FloatValue struct {
+	FloatValue float32 `protobuf:"fixed32,4,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type UQHolderStateValue_Vec3Value struct {
+	Vec3Value *Vec3 `protobuf:"bytes,5,opt,name=vec3_value,json=vec3Value,proto3,oneof"`
+}
+
+type UQHolderStateValue_StructValue struct {
+	StructValue *structpb.Struct `protobuf:"bytes,6,opt,name=struct_value,json=structValue,proto3,oneof"`
+}
+
+func (*UQHolderStateValue_IntValue) isUQHolderStateValue_Value() {}
+
+func (*UQHolderStateValue_UintValue) isUQHolderStateValue_Value() {}
+
+func (*UQHolderStateValue_FloatValue) isUQHolderStateValue_Value() {}
+
+func (*UQHolderStateValue_Vec3Value) isUQHolderStateValue_Value() {}
+
+func (*UQHolderStateValue_StructValue) isUQHolderStateValue_Value() {}
+
+type GameRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanBeModified bool                   `protobuf:"varint,1,opt,name=can_be_modified,json=canBeModified,proto3" json:"can_be_modified,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRule) Reset() {
+	*x = GameRule{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRule) ProtoMessage() {}
+
+func (x *GameRule) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRule.ProtoReflect.Descriptor instead.
+func (*GameRule) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GameRule) GetCanBeModified() bool {
+	if x != nil {
+		return x.CanBeModified
+	}
+	return false
+}
+
+func (x *GameRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GameRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         map[string]*GameRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRulesResponse) Reset() {
+	*x = GameRulesResponse{}
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRulesResponse) ProtoMessage() {}
+
+func (x *GameRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempest_dynamic_v1_uqholder_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRulesResponse.ProtoReflect.Descriptor instead.
+func (*GameRulesResponse) Descriptor() ([]byte, []int) {
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GameRulesResponse) GetRules() map[string]*GameRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+var File_tempest_dynamic_v1_uqholder_proto protoreflect.FileDescriptor
+
+const file_tempest_dynamic_v1_uqholder_proto_rawDesc = "" +
+	"\n" +
+	"!tempest/dynamic/v1/uqholder.proto\x12\x12tempest.dynamic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xa4\x01\n" +
+	"\aBotInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"runtime_id\x18\x02 \x01(\x04R\truntimeId\x12\x1b\n" +
+	"\tunique_id\x18\x03 \x01(\x03R\buniqueId\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x19\n" +
+	"\buuid_str\x18\x05 \x01(\tR\auuidStr\x12\x12\n" +
+	"\x04xuid\x18\x06 \x01(\tR\x04xuid\"I\n" +
+	"\x12UQHolderRawRequest\x123\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1f.tempest.dynamic.v1.UQHolderRawR\x04kind\"O\n" +
+	"\x14UQHolderStateRequest\x127\n" +
+	"\x05state\x18\x01 \x01(\x0e2!.tempest.dynamic.v1.UQHolderStateR\x05state\"0\n" +
+	"\x04Vec3\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x02R\x01y\x12\f\n" +
+	"\x01z\x18\x03 \x01(\x02R\x01z\"\x89\x02\n" +
+	"\x12UQHolderStateValue\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x03H\x00R\bintValue\x12\x1f\n" +
+	"\n" +
+	"uint_value\x18\x03 \x01(\x04H\x00R\tuintValue\x12!\n" +
+	"\vfloat_value\x18\x04 \x01(\x02H\x00R\n" +
+	"floatValue\x129\n" +
+	"\n" +
+	"vec3_value\x18\x05 \x01(\v2\x18.tempest.dynamic.v1.Vec3H\x00R\tvec3Value\x12<\n" +
+	"\fstruct_value\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x00R\vstructValueB\a\n" +
+	"\x05value\"H\n" +
+	"\bGameRule\x12&\n" +
+	"\x0fcan_be_modified\x18\x01 \x01(\bR\rcanBeModified\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xb3\x01\n" +
+	"\x11GameRulesResponse\x12F\n" +
+	"\x05rules\x18\x01 \x03(\v20.tempest.dynamic.v1.GameRulesResponse.RulesEntryR\x05rules\x1aV\n" +
+	"\n" +
+	"RulesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.tempest.dynamic.v1.GameRuleR\x05value:\x028\x01*\\\n" +
+	"\vUQHolderRaw\x12\x1c\n" +
+	"\x18UQHOLDER_RAW_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12UQHOLDER_RAW_BASIC\x10\x01\x12\x17\n" +
+	"\x13UQHOLDER_RAW_EXTEND\x10\x02*\xc2\x04\n" +
+	"\rUQHolderState\x12\x1e\n" +
+	"\x1aUQHOLDER_STATE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!UQHOLDER_STATE_COMPRESS_THRESHOLD\x10\x01\x12\"\n" +
+	"\x1eUQHOLDER_STATE_WORLD_GAME_MODE\x10\x02\x12\x1c\n" +
+	"\x18UQHOLDER_STATE_GAME_MODE\x10\x03\x12#\n" +
+	"\x1fUQHOLDER_STATE_WORLD_DIFFICULTY\x10\x04\x12\x17\n" +
+	"\x13UQHOLDER_STATE_TIME\x10\x05\x12\x1b\n" +
+	"\x17UQHOLDER_STATE_DAY_TIME\x10\x06\x12#\n" +
+	"\x1fUQHOLDER_STATE_DAY_TIME_PERCENT\x10\a\x12\x1f\n" +
+	"\x1bUQHOLDER_STATE_CURRENT_TICK\x10\b\x12\x1d\n" +
+	"\x19UQHOLDER_STATE_SYNC_RATIO\x10\t\x12 \n" +
+	"\x1cUQHOLDER_STATE_BOT_DIMENSION\x10\n" +
+	"\x12\x1f\n" +
+	"\x1bUQHOLDER_STATE_BOT_POSITION\x10\v\x120\n" +
+	",UQHOLDER_STATE_BOT_POSITION_OUT_OF_SYNC_TICK\x10\f\x12#\n" +
+	"\x1fUQHOLDER_STATE_CLIENT_DIMENSION\x10\r\x12&\n" +
+	"\"UQHOLDER_STATE_CLIENT_HOT_BAR_SLOT\x10\x0e\x12&\n" +
+	"\"UQHOLDER_STATE_CLIENT_HOLDING_ITEM\x10\x0f2\xc8\x02\n" +
+	"\x0eUQHolderModule\x12A\n" +
+	"\n" +
+	"GetBotInfo\x12\x16.google.protobuf.Empty\x1a\x1b.tempest.dynamic.v1.BotInfo\x12I\n" +
+	"\x06GetRaw\x12&.tempest.dynamic.v1.UQHolderRawRequest\x1a\x17.google.protobuf.Struct\x12\\\n" +
+	"\bGetState\x12(.tempest.dynamic.v1.UQHolderStateRequest\x1a&.tempest.dynamic.v1.UQHolderStateValue\x12J\n" +
+	"\tGameRules\x12\x16.google.protobuf.Empty\x1a%.tempest.dynamic.v1.GameRulesResponseB7Z5github.com/Yeah114/EmptyDea-plugin-sdk/protocol/pb;pbb\x06proto3"
+
+var (
+	file_tempest_dynamic_v1_uqholder_proto_rawDescOnce sync.Once
+	file_tempest_dynamic_v1_uqholder_proto_rawDescData []byte
+)
+
+func file_tempest_dynamic_v1_uqholder_proto_rawDescGZIP() []byte {
+	file_tempest_dynamic_v1_uqholder_proto_rawDescOnce.Do(func() {
+		file_tempest_dynamic_v1_uqholder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_uqholder_proto_rawDesc), len(file_tempest_dynamic_v1_uqholder_proto_rawDesc)))
+	})
+	return file_tempest_dynamic_v1_uqholder_proto_rawDescData
+}
+
+var file_tempest_dynamic_v1_uqholder_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tempest_dynamic_v1_uqholder_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_tempest_dynamic_v1_uqholder_proto_goTypes = []any{
+	(UQHolderRaw)(0),             // 0: tempest.dynamic.v1.UQHolderRaw
+	(UQHolderState)(0),           // 1: tempest.dynamic.v1.UQHolderState
+	(*BotInfo)(nil),              // 2: tempest.dynamic.v1.BotInfo
+	(*UQHolderRawRequest)(nil),   // 3: tempest.dynamic.v1.UQHolderRawRequest
+	(*UQHolderStateRequest)(nil), // 4: tempest.dynamic.v1.UQHolderStateRequest
+	(*Vec3)(nil),                 // 5: tempest.dynamic.v1.Vec3
+	(*UQHolderStateValue)(nil),   // 6: tempest.dynamic.v1.UQHolderStateValue
+	(*GameRule)(nil),             // 7: tempest.dynamic.v1.GameRule
+	(*GameRulesResponse)(nil),    // 8: tempest.dynamic.v1.GameRulesResponse
+	nil,                          // 9: tempest.dynamic.v1.GameRulesResponse.RulesEntry
+	(*structpb.Struct)(nil),      // 10: google.protobuf.Struct
+	(*emptypb.Empty)(nil),        // 11: google.protobuf.Empty
+}
+var file_tempest_dynamic_v1_uqholder_proto_depIdxs = []int32{
+	0,  // 0: tempest.dynamic.v1.UQHolderRawRequest.kind:type_name -> tempest.dynamic.v1.UQHolderRaw
+	1,  // 1: tempest.dynamic.v1.UQHolderStateRequest.state:type_name -> tempest.dynamic.v1.UQHolderState
+	5,  // 2: tempest.dynamic.v1.UQHolderStateValue.vec3_value:type_name -> tempest.dynamic.v1.Vec3
+	10, // 3: tempest.dynamic.v1.UQHolderStateValue.struct_value:type_name -> google.protobuf.Struct
+	9,  // 4: tempest.dynamic.v1.GameRulesResponse.rules:type_name -> tempest.dynamic.v1.GameRulesResponse.RulesEntry
+	7,  // 5: tempest.dynamic.v1.GameRulesResponse.RulesEntry.value:type_name -> tempest.dynamic.v1.GameRule
+	11, // 6: tempest.dynamic.v1.UQHolderModule.GetBotInfo:input_type -> google.protobuf.Empty
+	3,  // 7: tempest.dynamic.v1.UQHolderModule.GetRaw:input_type -> tempest.dynamic.v1.UQHolderRawRequest
+	4,  // 8: tempest.dynamic.v1.UQHolderModule.GetState:input_type -> tempest.dynamic.v1.UQHolderStateRequest
+	11, // 9: tempest.dynamic.v1.UQHolderModule.GameRules:input_type -> google.protobuf.Empty
+	2,  // 10: tempest.dynamic.v1.UQHolderModule.GetBotInfo:output_type -> tempest.dynamic.v1.BotInfo
+	10, // 11: tempest.dynamic.v1.UQHolderModule.GetRaw:output_type -> google.protobuf.Struct
+	6,  // 12: tempest.dynamic.v1.UQHolderModule.GetState:output_type -> tempest.dynamic.v1.UQHolderStateValue
+	8,  // 13: tempest.dynamic.v1.UQHolderModule.GameRules:output_type -> tempest.dynamic.v1.GameRulesResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_tempest_dynamic_v1_uqholder_proto_init() }
+func file_tempest_dynamic_v1_uqholder_proto_init() {
+	if File_tempest_dynamic_v1_uqholder_proto != nil {
+		return
+	}
+	file_tempest_dynamic_v1_uqholder_proto_msgTypes[4].OneofWrappers = []any{
+		(*UQHolderStateValue_IntValue)(nil),
+		(*UQHolderStateValue_UintValue)(nil),
+		(*UQHolderStateValue_FloatValue)(nil),
+		(*UQHolderStateValue_Vec3Value)(nil),
+		(*UQHolderStateValue_StructValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempest_dynamic_v1_uqholder_proto_rawDesc), len(file_tempest_dynamic_v1_uqholder_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempest_dynamic_v1_uqholder_proto_goTypes,
+		DependencyIndexes: file_tempest_dynamic_v1_uqholder_proto_depIdxs,
+		EnumInfos:         file_tempest_dynamic_v1_uqholder_proto_enumTypes,
+		MessageInfos:      file_tempest_dynamic_v1_uqholder_proto_msgTypes,
+	}.Build()
+	File_tempest_dynamic_v1_uqholder_proto = out.File
+	file_tempest_dynamic_v1_uqholder_proto_goTypes = nil
+	file_tempest_dynamic_v1_uqholder_proto_depIdxs = nil
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: tempest/dynamic/v1/uqholder.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	UQHolderModule_GetBotInfo_FullMethodName = "/tempest.dynamic.v1.UQHolderModule/GetBotInfo"
+	UQHolderModule_GetRaw_FullMethodName     = "/tempest.dynamic.v1.UQHolderModule/GetRaw"
+	UQHolderModule_GetState_FullMethodName   = "/tempest.dynamic.v1.UQHolderModule/GetState"
+	UQHolderModule_GameRules_FullMethodName  = "/tempest.dynamic.v1.UQHolderModule/GameRules"
+)
+
+// UQHolderModuleClient is the client API for UQHolderModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UQHolderModuleClient interface {
+	GetBotInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BotInfo, error)
+	GetRaw(ctx context.Context, in *UQHolderRawRequest, opts ...grpc.CallOption) (*structpb.Struct, error)
+	GetState(ctx context.Context, in *UQHolderStateRequest, opts ...grpc.CallOption) (*UQHolderStateValue, error)
+	GameRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GameRulesResponse, error)
+}
+
+type uQHolderModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUQHolderModuleClient(cc grpc.ClientConnInterface) UQHolderModuleClient {
+	return &uQHolderModuleClient{cc}
+}
+
+func (c *uQHolderModuleClient) GetBotInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BotInfo, error) {
+	out := new(BotInfo)
+	err := c.cc.Invoke(ctx, UQHolderModule_GetBotInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uQHolderModuleClient) GetRaw(ctx context.Context, in *UQHolderRawRequest, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, UQHolderModule_GetRaw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uQHolderModuleClient) GetState(ctx context.Context, in *UQHolderStateRequest, opts ...grpc.CallOption) (*UQHolderStateValue, error) {
+	out := new(UQHolderStateValue)
+	err := c.cc.Invoke(ctx, UQHolderModule_GetState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uQHolderModuleClient) GameRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GameRulesResponse, error) {
+	out := new(GameRulesResponse)
+	err := c.cc.Invoke(ctx, UQHolderModule_GameRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UQHolderModuleServer is the server API for UQHolderModule service.
+// All implementations must embed UnimplementedUQHolderModuleServer
+// for forward compatibility
+type UQHolderModuleServer interface {
+	GetBotInfo(context.Context, *emptypb.Empty) (*BotInfo, error)
+	GetRaw(context.Context, *UQHolderRawRequest) (*structpb.Struct, error)
+	GetState(context.Context, *UQHolderStateRequest) (*UQHolderStateValue, error)
+	GameRules(context.Context, *emptypb.Empty) (*GameRulesResponse, error)
+	mustEmbedUnimplementedUQHolderModuleServer()
+}
+
+// UnimplementedUQHolderModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedUQHolderModuleServer struct {
+}
+
+func (UnimplementedUQHolderModuleServer) GetBotInfo(context.Context, *emptypb.Empty) (*BotInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBotInfo not implemented")
+}
+func (UnimplementedUQHolderModuleServer) GetRaw(context.Context, *UQHolderRawRequest) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRaw not implemented")
+}
+func (UnimplementedUQHolderModuleServer) GetState(context.Context, *UQHolderStateRequest) (*UQHolderStateValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
+}
+func (UnimplementedUQHolderModuleServer) GameRules(context.Context, *emptypb.Empty) (*GameRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GameRules not implemented")
+}
+func (UnimplementedUQHolderModuleServer) mustEmbedUnimplementedUQHolderModuleServer() {}
+
+// UnsafeUQHolderModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UQHolderModuleServer will
+// result in compilation errors.
+type UnsafeUQHolderModuleServer interface {
+	mustEmbedUnimplementedUQHolderModuleServer()
+}
+
+func RegisterUQHolderModuleServer(s grpc.ServiceRegistrar, srv UQHolderModuleServer) {
+	s.RegisterService(&UQHolderModule_ServiceDesc, srv)
+}
+
+func _UQHolderModule_GetBotInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UQHolderModuleServer).GetBotInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UQHolderModule_GetBotInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UQHolderModuleServer).GetBotInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UQHolderModule_GetRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UQHolderRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UQHolderModuleServer).GetRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UQHolderModule_GetRaw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UQHolderModuleServer).GetRaw(ctx, req.(*UQHolderRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UQHolderModule_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UQHolderStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UQHolderModuleServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UQHolderModule_GetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UQHolderModuleServer).GetState(ctx, req.(*UQHolderStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UQHolderModule_GameRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UQHolderModuleServer).GameRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UQHolderModule_GameRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UQHolderModuleServer).GameRules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UQHolderModule_ServiceDesc is the grpc.ServiceDesc for UQHolderModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UQHolderModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tempest.dynamic.v1.UQHolderModule",
+	HandlerType: (*UQHolderModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBotInfo",
+			Handler:    _UQHolderModule_GetBotInfo_Handler,
+		},
+		{
+			MethodName: "GetRaw",
+			Handler:    _UQHolderModule_GetRaw_Handler,
+		},
+		{
+			MethodName: "GetState",
+			Handler:    _UQHolderModule_GetState_Handler,
+		},
+		{
+			MethodName: "GameRules",
+			Handler:    _UQHolderModule_GameRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tempest/dynamic/v1/uqholder.proto",
+}
@@ -3,10 +3,11 @@ package protocol
 import (
 	"context"
 	"net"
-	"net/rpc"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-plugin"
+
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
 
@@ -431,15 +432,15 @@ func (s *PlayerKitRPCServer) setBool(args *PlayerKitSetBoolArgs, fn func(context
 }
 
 type playerKitRPCClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
-func newPlayerKitRPCClient(conn net.Conn) api.PlayerKit {
+func newPlayerKitRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.PlayerKit {
 	if conn == nil {
 		return nil
 	}
-	return &playerKitRPCClient{c: rpc.NewClient(conn)}
+	return &playerKitRPCClient{c: newRPCConn(conn, broker, ServicePlayerKit)}
 }
 
 func timeoutMsFromContext(ctx context.Context) int64 {
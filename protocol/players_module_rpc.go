@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 
 	"github.com/hashicorp/go-plugin"
@@ -66,7 +65,7 @@ func (s *playersChangeCallbackServer) OnEvent(args *PlayersChangeEventArgs, _ *E
 }
 
 type playersChangeCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -127,7 +126,7 @@ func (s *PlayersModuleRPCServer) NewPlayerKit(args *PlayersGetPlayerArgs, resp *
 		return nil
 	}
 	id := s.broker.NextId()
-	go acceptAndServeMuxBroker(s.broker, id, &PlayerKitRPCServer{Impl: kit})
+	go acceptAndServeMuxBroker(s.broker, id, ServicePlayerKit, &PlayerKitRPCServer{Impl: kit})
 	resp.Exists = true
 	resp.PlayerBrokerID = id
 	return nil
@@ -152,7 +151,7 @@ func (s *PlayersModuleRPCServer) GetPlayerByUUID(args *PlayersGetPlayerArgs, res
 		return nil
 	}
 	id := s.broker.NextId()
-	go acceptAndServeMuxBroker(s.broker, id, &PlayerKitRPCServer{Impl: kit})
+	go acceptAndServeMuxBroker(s.broker, id, ServicePlayerKit, &PlayerKitRPCServer{Impl: kit})
 	resp.Exists = true
 	resp.PlayerBrokerID = id
 	return nil
@@ -177,7 +176,7 @@ func (s *PlayersModuleRPCServer) GetPlayerByName(args *PlayersGetPlayerArgs, res
 		return nil
 	}
 	id := s.broker.NextId()
-	go acceptAndServeMuxBroker(s.broker, id, &PlayerKitRPCServer{Impl: kit})
+	go acceptAndServeMuxBroker(s.broker, id, ServicePlayerKit, &PlayerKitRPCServer{Impl: kit})
 	resp.Exists = true
 	resp.PlayerBrokerID = id
 	return nil
@@ -202,7 +201,7 @@ func (s *PlayersModuleRPCServer) GetPlayerByEntityRuntimeID(args *PlayersGetPlay
 		return nil
 	}
 	id := s.broker.NextId()
-	go acceptAndServeMuxBroker(s.broker, id, &PlayerKitRPCServer{Impl: kit})
+	go acceptAndServeMuxBroker(s.broker, id, ServicePlayerKit, &PlayerKitRPCServer{Impl: kit})
 	resp.Exists = true
 	resp.PlayerBrokerID = id
 	return nil
@@ -237,7 +236,7 @@ func (s *PlayersModuleRPCServer) GetAllOnlinePlayers(args *PlayersGetAllOnlineAr
 			continue
 		}
 		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &PlayerKitRPCServer{Impl: kit})
+		go acceptAndServeMuxBroker(s.broker, id, ServicePlayerKit, &PlayerKitRPCServer{Impl: kit})
 		resp.PlayerBrokerIDs = append(resp.PlayerBrokerIDs, id)
 	}
 	return nil
@@ -258,7 +257,7 @@ func (s *PlayersModuleRPCServer) RegisterWhenPlayerChange(args *PlayersRegisterW
 	if err != nil {
 		return err
 	}
-	cb := &playersChangeCallbackClient{c: rpc.NewClient(conn)}
+	cb := &playersChangeCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NamePlayersModule)}
 	listenerID, err := s.Impl.RegisterWhenPlayerChange(func(event *api.PlayerChangeEvent) {
 		_ = cb.OnEvent(event)
 	})
@@ -332,7 +331,7 @@ func (s *PlayersModuleRPCServer) ActionBarTo(args *PlayersSayToArgs, _ *Empty) e
 }
 
 type playersModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -341,7 +340,7 @@ func newPlayersModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.Play
 	if conn == nil {
 		return nil
 	}
-	return &playersModuleRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &playersModuleRPCClient{c: newRPCConn(conn, broker, api.NamePlayersModule), broker: broker}
 }
 
 func (c *playersModuleRPCClient) Name() string { return api.NamePlayersModule }
@@ -361,7 +360,7 @@ func (c *playersModuleRPCClient) NewPlayerKit(uuid string) api.PlayerKit {
 	if err != nil {
 		return nil
 	}
-	return newPlayerKitRPCClient(conn)
+	return newPlayerKitRPCClient(conn, c.broker)
 }
 
 func (c *playersModuleRPCClient) GetAllOnlinePlayers(ctx context.Context) ([]api.PlayerKit, error) {
@@ -385,7 +384,7 @@ func (c *playersModuleRPCClient) GetAllOnlinePlayers(ctx context.Context) ([]api
 		if dialErr != nil {
 			continue
 		}
-		out = append(out, newPlayerKitRPCClient(conn))
+		out = append(out, newPlayerKitRPCClient(conn, c.broker))
 	}
 	return out, nil
 }
@@ -409,7 +408,7 @@ func (c *playersModuleRPCClient) GetPlayerByName(ctx context.Context, name strin
 	if err != nil {
 		return nil, err
 	}
-	return newPlayerKitRPCClient(conn), nil
+	return newPlayerKitRPCClient(conn, c.broker), nil
 }
 
 func (c *playersModuleRPCClient) GetPlayerByUUID(ctx context.Context, uuid string) (api.PlayerKit, error) {
@@ -431,7 +430,7 @@ func (c *playersModuleRPCClient) GetPlayerByUUID(ctx context.Context, uuid strin
 	if err != nil {
 		return nil, err
 	}
-	return newPlayerKitRPCClient(conn), nil
+	return newPlayerKitRPCClient(conn, c.broker), nil
 }
 
 func (c *playersModuleRPCClient) GetPlayerByEntityRuntimeID(ctx context.Context, runtimeID uint64) (api.PlayerKit, error) {
@@ -453,7 +452,7 @@ func (c *playersModuleRPCClient) GetPlayerByEntityRuntimeID(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return newPlayerKitRPCClient(conn), nil
+	return newPlayerKitRPCClient(conn, c.broker), nil
 }
 
 func (c *playersModuleRPCClient) RegisterWhenPlayerChange(handler func(event *api.PlayerChangeEvent)) (string, error) {
@@ -464,7 +463,7 @@ func (c *playersModuleRPCClient) RegisterWhenPlayerChange(handler func(event *ap
 		return "", errors.New("playersModuleRPCClient.RegisterWhenPlayerChange: handler is nil")
 	}
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &playersChangeCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NamePlayersModule, &playersChangeCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
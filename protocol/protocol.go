@@ -2,12 +2,54 @@ package protocol
 
 import (
 	"github.com/hashicorp/go-plugin"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
 
 const PluginKey = "tempest_dynamic_v1"
 
+// Protocol versions negotiated through go-plugin VersionedPlugins.
+// Hosts and plugins built against different SDKs agree on the highest version both speak.
+const (
+	// ProtocolVersion1 is the original Init/Load/Unload + frame/module RPC protocol.
+	ProtocolVersion1 = 1
+	// ProtocolVersion2 adds Frame.ListCapabilities and sends the negotiated version in InitArgs.
+	ProtocolVersion2 = 2
+	// ProtocolVersion3 carries the ProtocolVersion2 feature set over gRPC, using the services
+	// in proto/tempest/dynamic/v1. Go plugins opt in with WithGRPC; hosts always accept it.
+	ProtocolVersion3 = 3
+
+	// ProtocolVersion is the newest version spoken by this SDK.
+	ProtocolVersion = ProtocolVersion3
+)
+
+// Handshake.ProtocolVersion stays at ProtocolVersion1: go-plugin falls back to it when the
+// other side does not announce its supported versions.
 var Handshake = plugin.HandshakeConfig{
-	ProtocolVersion:  1,
+	ProtocolVersion:  ProtocolVersion1,
 	MagicCookieKey:   "TEMPEST_DYNAMIC_PLUGIN",
 	MagicCookieValue: "1",
 }
+
+// IsGRPCVersion reports whether protocol version v uses the gRPC transport.
+func IsGRPCVersion(v int) bool {
+	return v == ProtocolVersion3
+}
+
+// VersionedPlugins returns the plugin sets for every protocol version this SDK speaks.
+// Plugins pass their implementation (see Serve); hosts pass nil.
+func VersionedPlugins(impl api.Plugin) map[int]plugin.PluginSet {
+	out := make(map[int]plugin.PluginSet, ProtocolVersion)
+	for v := ProtocolVersion1; v <= ProtocolVersion; v++ {
+		if IsGRPCVersion(v) {
+			out[v] = plugin.PluginSet{
+				PluginKey: &DynamicGRPCPlugin{Impl: impl, ProtocolVersion: v},
+			}
+			continue
+		}
+		out[v] = plugin.PluginSet{
+			PluginKey: &DynamicRPCPlugin{Impl: impl, ProtocolVersion: v},
+		}
+	}
+	return out
+}
@@ -2,9 +2,14 @@ package protocol
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/rpc"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-plugin"
 
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
@@ -13,31 +18,101 @@ import (
 
 // RPCPlugin is the minimal surface exposed over go-plugin for api.Plugin.
 // It intentionally only supports Init/Load/Unload to keep the protocol small.
+//
+// The deadline of ctx is passed to the plugin, and when ctx ends the plugin is asked to cancel
+// the call. If it does not return within CancelGracePeriod, Load/Unload return ErrCallAbandoned
+// and the host should kill the plugin process.
 type RPCPlugin interface {
 	Init(frame sdkdefine.Frame, id string, config map[string]interface{}) error
-	Load() error
-	Unload() error
+	Load(ctx context.Context) error
+	Unload(ctx context.Context) error
+	// VersionInfo returns what the plugin reported during Init; it is zero before Init and for
+	// plugins built with an SDK that did not report it.
+	VersionInfo() sdkdefine.PluginVersionInfo
+	// ConfigSchema returns the JSON Schema of the plugin config reported during Init, see
+	// WithConfigSchema; nil when the plugin did not declare one.
+	ConfigSchema() map[string]interface{}
 }
 
+// CancelGracePeriod bounds how long Load/Unload wait for the plugin after ctx ended.
+var CancelGracePeriod = 3 * time.Second
+
+// ErrCallAbandoned is returned by RPCPlugin.Load/Unload when the plugin ignored the cancellation.
+var ErrCallAbandoned = errors.New("protocol: plugin did not return after cancellation")
+
 // DynamicRPCPlugin is the go-plugin wrapper.
 // - On the plugin (server) side, set Impl.
 // - On the host (client) side, Impl is unused.
 type DynamicRPCPlugin struct {
 	plugin.NetRPCUnsupportedPlugin
 	Impl api.Plugin
+	// ProtocolVersion is the protocol version of the plugin set this instance belongs to.
+	// Zero means ProtocolVersion1.
+	ProtocolVersion int
+	// MinHostVersion and MaxHostVersion are reported to the host during Init, see WithHostVersion.
+	MinHostVersion, MaxHostVersion string
+	// ConfigSchema is reported to the host during Init, see WithConfigSchema.
+	ConfigSchema map[string]interface{}
 }
 
 type InitArgs struct {
 	ID            string
 	Config        map[string]interface{}
 	FrameBrokerID uint32
+	// ProtocolVersion is the version negotiated by the host; zero when sent by a v1 host.
+	ProtocolVersion int
+	// HostVersion and SDKVersion describe the host; empty when sent by older hosts.
+	HostVersion string
+	SDKVersion  string
+	// Metadata is the CallMetadata of the call, see AppendCallMetadata.
+	Metadata map[string]string
+}
+
+func (a *InitArgs) setCallMetadata(md CallMetadata) { a.Metadata = md }
+
+// InitResp reports the plugin build to the host. Older plugins reply Empty, which decodes to
+// the zero value.
+type InitResp struct {
+	SDKVersion     string
+	MinHostVersion string
+	MaxHostVersion string
+	// ConfigSchema is the JSON encoded schema of the plugin config; empty when undeclared.
+	ConfigSchema string
+}
+
+// LifecycleArgs carries the host deadline of Plugin.Load and Plugin.Unload.
+// Older hosts send Empty, which decodes to the zero value (no deadline, not cancellable).
+type LifecycleArgs struct {
+	// CallID identifies the call for Plugin.Cancel.
+	CallID string
+	// TimeoutMs is the remaining host deadline; 0 means no deadline.
+	TimeoutMs int64
+	// Metadata is the CallMetadata of the call, see AppendCallMetadata.
+	Metadata map[string]string
+}
+
+func (a *LifecycleArgs) setCallMetadata(md CallMetadata) { a.Metadata = md }
+
+type CancelArgs struct {
+	CallID string
+}
+
+type CancelResp struct {
+	OK bool
 }
 
 type Empty struct{}
 
 type rpcServer struct {
-	Impl   api.Plugin
-	broker *plugin.MuxBroker
+	Impl    api.Plugin
+	broker  *plugin.MuxBroker
+	version int
+	// minHost and maxHost are the host version bounds reported in Init.
+	minHost, maxHost string
+	configSchema     map[string]interface{}
+
+	mu    sync.Mutex
+	calls map[string]context.CancelFunc
 }
 
 type frameModuleStub struct {
@@ -100,9 +175,34 @@ type UnregisterWhenActivateResp struct {
 	OK bool
 }
 
+type RegisterWhenConfigChangeArgs struct {
+	ID               string
+	CallbackBrokerID uint32
+}
+
+type RegisterWhenConfigChangeResp struct {
+	ListenerID string
+}
+
+type UnregisterWhenConfigChangeArgs struct {
+	ListenerID string
+}
+
+type UnregisterWhenConfigChangeResp struct {
+	OK bool
+}
+
+type ConfigChangeArgs struct {
+	OldConfig sdkdefine.PluginConfig
+	NewConfig sdkdefine.PluginConfig
+}
+
 type frameRPCServer struct {
-	Frame  sdkdefine.Frame
-	broker *plugin.MuxBroker
+	Frame   sdkdefine.Frame
+	broker  *plugin.MuxBroker
+	version int
+	// pluginID is the plugin the frame is served to.
+	pluginID string
 }
 
 func (s *frameRPCServer) ListModules(_ *Empty, resp *ListModulesResp) error {
@@ -145,90 +245,65 @@ func (s *frameRPCServer) GetModule(args *GetModuleArgs, resp *GetModuleResp) err
 	if s.broker == nil {
 		return nil
 	}
-	if chatMod, ok := any(mod).(api.ChatModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &ChatModuleRPCServer{Impl: chatMod, broker: s.broker})
-		resp.ModuleKind = api.NameChatModule
-		resp.ModuleBrokerID = id
+	kind, srv := moduleRPCServer(mod, s.broker, s.pluginID)
+	if srv == nil {
 		return nil
 	}
+	id := s.broker.NextId()
+	go func() {
+		acceptAndServeMuxBroker(s.broker, id, kind, srv)
+		switch srv := srv.(type) {
+		case *DatabaseModuleRPCServer:
+			srv.closeStreams()
+		case *FlexModuleRPCServer:
+			srv.release()
+		}
+	}()
+	resp.ModuleKind = kind
+	resp.ModuleBrokerID = id
+	return nil
+}
+
+// moduleRPCServer picks the RPC server bridging mod for the plugin pluginID.
+// It returns an empty kind and nil server for modules without an RPC bridge.
+func moduleRPCServer(mod sdkdefine.Module, broker *plugin.MuxBroker, pluginID string) (string, interface{}) {
+	if chatMod, ok := any(mod).(api.ChatModule); ok {
+		return api.NameChatModule, &ChatModuleRPCServer{Impl: chatMod, broker: broker}
+	}
 	if cmdsMod, ok := any(mod).(api.CommandsModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &CommandsModuleRPCServer{Impl: cmdsMod})
-		resp.ModuleKind = api.NameCommandsModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameCommandsModule, &CommandsModuleRPCServer{Impl: cmdsMod}
 	}
 	if flexMod, ok := any(mod).(api.FlexModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &FlexModuleRPCServer{Impl: flexMod, broker: s.broker})
-		resp.ModuleKind = api.NameFlexModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameFlexModule, &FlexModuleRPCServer{Impl: flexMod, broker: broker, pluginID: pluginID}
 	}
 	if uqMod, ok := any(mod).(api.UQHolderModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &UQHolderModuleRPCServer{Impl: uqMod})
-		resp.ModuleKind = api.NameUQHolderModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameUQHolderModule, &UQHolderModuleRPCServer{Impl: uqMod}
 	}
 	if gmMod, ok := any(mod).(api.GameMenuModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &GameMenuModuleRPCServer{Impl: gmMod, broker: s.broker})
-		resp.ModuleKind = api.NameGameMenuModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameGameMenuModule, &GameMenuModuleRPCServer{Impl: gmMod, broker: broker}
 	}
 	if tmMod, ok := any(mod).(api.TerminalMenuModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &TerminalMenuModuleRPCServer{Impl: tmMod, broker: s.broker})
-		resp.ModuleKind = api.NameTerminalMenuModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameTerminalMenuModule, &TerminalMenuModuleRPCServer{Impl: tmMod, broker: broker}
 	}
 	if terminalMod, ok := any(mod).(api.TerminalModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &TerminalModuleRPCServer{Impl: terminalMod, broker: s.broker})
-		resp.ModuleKind = api.NameTerminalModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameTerminalModule, &TerminalModuleRPCServer{Impl: terminalMod, broker: broker}
 	}
 	if playersMod, ok := any(mod).(api.PlayersModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &PlayersModuleRPCServer{Impl: playersMod, broker: s.broker})
-		resp.ModuleKind = api.NamePlayersModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NamePlayersModule, &PlayersModuleRPCServer{Impl: playersMod, broker: broker}
 	}
 	if loggerMod, ok := any(mod).(api.LoggerModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &LoggerModuleRPCServer{Impl: loggerMod})
-		resp.ModuleKind = api.NameLoggerModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameLoggerModule, &LoggerModuleRPCServer{Impl: loggerMod, broker: broker}
 	}
 	if dbMod, ok := any(mod).(api.DatabaseModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &DatabaseModuleRPCServer{Impl: dbMod, broker: s.broker})
-		resp.ModuleKind = api.NameDatabaseModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameDatabaseModule, &DatabaseModuleRPCServer{Impl: dbMod, broker: broker, pluginID: pluginID}
 	}
 	if spMod, ok := any(mod).(api.StoragePathModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &StoragePathModuleRPCServer{Impl: spMod})
-		resp.ModuleKind = api.NameStoragePathModule
-		resp.ModuleBrokerID = id
-		return nil
+		return api.NameStoragePathModule, &StoragePathModuleRPCServer{Impl: spMod}
 	}
 	if brainMod, ok := any(mod).(api.BrainModule); ok {
-		id := s.broker.NextId()
-		go acceptAndServeMuxBroker(s.broker, id, &BrainModuleRPCServer{Impl: brainMod, broker: s.broker})
-		resp.ModuleKind = api.NameBrainModule
-		resp.ModuleBrokerID = id
+		return api.NameBrainModule, &BrainModuleRPCServer{Impl: brainMod, broker: broker}
 	}
-	return nil
+	return "", nil
 }
 
 func (s *frameRPCServer) GetPluginConfig(args *GetPluginConfigArgs, resp *GetPluginConfigResp) error {
@@ -282,7 +357,7 @@ func (s *frameRPCServer) RegisterWhenActivate(args *RegisterWhenActivateArgs, re
 		if dialErr != nil || conn == nil {
 			return
 		}
-		client := rpc.NewClient(conn)
+		client := newCallbackRPCConn(conn, s.broker, ServiceFrame)
 		_ = client.Call("Plugin.Activate", &Empty{}, &Empty{})
 		_ = client.Close()
 	})
@@ -305,10 +380,51 @@ func (s *frameRPCServer) UnregisterWhenActivate(args *UnregisterWhenActivateArgs
 	return nil
 }
 
+func (s *frameRPCServer) RegisterWhenConfigChange(args *RegisterWhenConfigChangeArgs, resp *RegisterWhenConfigChangeResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.ListenerID = ""
+	if s == nil || s.Frame == nil || args == nil || args.ID == "" {
+		return nil
+	}
+	if s.broker == nil || args.CallbackBrokerID == 0 {
+		return nil
+	}
+
+	id, err := s.Frame.RegisterWhenConfigChange(args.ID, func(oldConfig, newConfig sdkdefine.PluginConfig) {
+		conn, dialErr := s.broker.Dial(args.CallbackBrokerID)
+		if dialErr != nil || conn == nil {
+			return
+		}
+		client := newCallbackRPCConn(conn, s.broker, ServiceFrame)
+		_ = client.Call("Plugin.ConfigChange", &ConfigChangeArgs{OldConfig: oldConfig, NewConfig: newConfig}, &Empty{})
+		_ = client.Close()
+	})
+	if err != nil {
+		return err
+	}
+	resp.ListenerID = id
+	return nil
+}
+
+func (s *frameRPCServer) UnregisterWhenConfigChange(args *UnregisterWhenConfigChangeArgs, resp *UnregisterWhenConfigChangeResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.OK = false
+	if s == nil || s.Frame == nil || args == nil || args.ListenerID == "" {
+		return nil
+	}
+	resp.OK = s.Frame.UnregisterWhenConfigChange(args.ListenerID)
+	return nil
+}
+
 type frameRPCClient struct {
-	c      *rpc.Client
-	broker *plugin.MuxBroker
-	mu     sync.Mutex
+	c       *rpcConn
+	broker  *plugin.MuxBroker
+	version int
+	mu      sync.Mutex
 }
 
 type activateCallbackRPCServer struct {
@@ -323,6 +439,18 @@ func (s *activateCallbackRPCServer) Activate(_ *Empty, _ *Empty) error {
 	return nil
 }
 
+type configChangeCallbackRPCServer struct {
+	Handler func(oldConfig, newConfig sdkdefine.PluginConfig)
+}
+
+func (s *configChangeCallbackRPCServer) ConfigChange(args *ConfigChangeArgs, _ *Empty) error {
+	if s == nil || s.Handler == nil || args == nil {
+		return nil
+	}
+	s.Handler(args.OldConfig, args.NewConfig)
+	return nil
+}
+
 func (c *frameRPCClient) ListModules() map[string]sdkdefine.Module {
 	if c == nil || c.c == nil {
 		return nil
@@ -367,7 +495,7 @@ func (c *frameRPCClient) GetModule(name string) (sdkdefine.Module, bool) {
 					return m, true
 				}
 			case api.NameCommandsModule:
-				if m := newCommandsModuleRPCClient(conn); m != nil {
+				if m := newCommandsModuleRPCClient(conn, c.broker); m != nil {
 					return m, true
 				}
 			case api.NameFlexModule:
@@ -375,7 +503,7 @@ func (c *frameRPCClient) GetModule(name string) (sdkdefine.Module, bool) {
 					return m, true
 				}
 			case api.NameUQHolderModule:
-				if m := newUQHolderModuleRPCClient(conn); m != nil {
+				if m := newUQHolderModuleRPCClient(conn, c.broker); m != nil {
 					return m, true
 				}
 			case api.NameGameMenuModule:
@@ -395,7 +523,7 @@ func (c *frameRPCClient) GetModule(name string) (sdkdefine.Module, bool) {
 					return m, true
 				}
 			case api.NameLoggerModule:
-				if m := newLoggerModuleRPCClient(conn); m != nil {
+				if m := newLoggerModuleRPCClient(conn, c.broker); m != nil {
 					return m, true
 				}
 			case api.NameDatabaseModule:
@@ -403,7 +531,7 @@ func (c *frameRPCClient) GetModule(name string) (sdkdefine.Module, bool) {
 					return m, true
 				}
 			case api.NameStoragePathModule:
-				if m := newStoragePathModuleRPCClient(conn); m != nil {
+				if m := newStoragePathModuleRPCClient(conn, c.broker); m != nil {
 					return m, true
 				}
 			case api.NameBrainModule:
@@ -466,7 +594,7 @@ func (c *frameRPCClient) RegisterWhenActivate(handler func()) (string, error) {
 	}
 
 	brokerID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, brokerID, &activateCallbackRPCServer{Handler: handler})
+	go acceptAndServeCallback(c.broker, brokerID, ServiceFrame, &activateCallbackRPCServer{Handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -493,10 +621,71 @@ func (c *frameRPCClient) UnregisterWhenActivate(listenerID string) bool {
 	return resp.OK
 }
 
-func (s *rpcServer) Init(args *InitArgs, _ *Empty) error {
+func (c *frameRPCClient) RegisterWhenConfigChange(id string, handler func(oldConfig, newConfig sdkdefine.PluginConfig)) (string, error) {
+	if c == nil || c.c == nil || handler == nil || id == "" {
+		return "", nil
+	}
+	if c.broker == nil {
+		return "", nil
+	}
+
+	brokerID := c.broker.NextId()
+	go acceptAndServeCallback(c.broker, brokerID, ServiceFrame, &configChangeCallbackRPCServer{Handler: handler})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var resp RegisterWhenConfigChangeResp
+	if err := c.c.Call("Plugin.RegisterWhenConfigChange", &RegisterWhenConfigChangeArgs{ID: id, CallbackBrokerID: brokerID}, &resp); err != nil {
+		return "", err
+	}
+	return resp.ListenerID, nil
+}
+
+func (c *frameRPCClient) UnregisterWhenConfigChange(listenerID string) bool {
+	if c == nil || c.c == nil || listenerID == "" {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var resp UnregisterWhenConfigChangeResp
+	if err := c.c.Call("Plugin.UnregisterWhenConfigChange", &UnregisterWhenConfigChangeArgs{ListenerID: listenerID}, &resp); err != nil {
+		return false
+	}
+	return resp.OK
+}
+
+func (s *rpcServer) Init(args *InitArgs, resp *InitResp) error {
+	if s != nil && args != nil {
+		setBrokerPluginID(s.broker, args.ID)
+	}
+	ctx := context.Background()
+	if args != nil {
+		ctx = withIncomingCallMetadata(ctx, args.Metadata)
+	}
+	return intercepted(ctx, s.callInfo("Init"), args, resp, func(context.Context) error {
+		return s.init(args, resp)
+	})
+}
+
+func (s *rpcServer) init(args *InitArgs, resp *InitResp) error {
 	if s == nil || s.Impl == nil {
 		return nil
 	}
+	if resp != nil {
+		resp.SDKVersion = sdkdefine.SDKVersion
+		resp.MinHostVersion = s.minHost
+		resp.MaxHostVersion = s.maxHost
+		if s.configSchema != nil {
+			raw, err := json.Marshal(s.configSchema)
+			if err != nil {
+				return fmt.Errorf("rpcServer.Init: encode config schema: %w", err)
+			}
+			resp.ConfigSchema = string(raw)
+		}
+	}
 	id := ""
 	cfg := map[string]interface{}{}
 	if args != nil {
@@ -505,59 +694,222 @@ func (s *rpcServer) Init(args *InitArgs, _ *Empty) error {
 			cfg = args.Config
 		}
 	}
+	version := s.version
+	if args != nil && args.ProtocolVersion > 0 && args.ProtocolVersion < version {
+		version = args.ProtocolVersion
+	}
 	var frame sdkdefine.Frame
 	if args != nil && args.FrameBrokerID != 0 && s.broker != nil {
 		if conn, err := s.broker.Dial(args.FrameBrokerID); err == nil && conn != nil {
-			frame = &frameRPCClient{c: rpc.NewClient(conn), broker: s.broker}
+			frame = &frameRPCClient{c: newRPCConn(conn, s.broker, ServiceFrame), broker: s.broker, version: version}
 		}
 	}
 	s.Impl.Init(frame, id, cfg)
 	return nil
 }
 
-func (s *rpcServer) Load(_ *Empty, _ *Empty) error {
+func (s *rpcServer) Load(args *LifecycleArgs, resp *Empty) error {
 	if s == nil || s.Impl == nil {
 		return nil
 	}
-	return s.Impl.Load(context.Background())
+	return intercepted(lifecycleContext(args), s.callInfo("Load"), args, resp, func(parent context.Context) error {
+		ctx, done := s.beginCall(parent, args)
+		defer done()
+		return s.Impl.Load(ctx)
+	})
 }
 
-func (s *rpcServer) Unload(_ *Empty, _ *Empty) error {
+func (s *rpcServer) Unload(args *LifecycleArgs, resp *Empty) error {
 	if s == nil || s.Impl == nil {
 		return nil
 	}
-	return s.Impl.Unload(context.Background())
+	return intercepted(lifecycleContext(args), s.callInfo("Unload"), args, resp, func(parent context.Context) error {
+		ctx, done := s.beginCall(parent, args)
+		defer done()
+		return s.Impl.Unload(ctx)
+	})
+}
+
+// Cancel cancels the context of an in-flight Load/Unload.
+func (s *rpcServer) Cancel(args *CancelArgs, resp *CancelResp) error {
+	return intercepted(context.Background(), s.callInfo("Cancel"), args, resp, func(context.Context) error {
+		return s.cancel(args, resp)
+	})
+}
+
+func (s *rpcServer) callInfo(method string) *CallInfo {
+	info := &CallInfo{Service: ServicePlugin, Method: method}
+	if s != nil {
+		info.PluginID = brokerPluginID(s.broker)
+	}
+	return info
+}
+
+func lifecycleContext(args *LifecycleArgs) context.Context {
+	if args == nil {
+		return context.Background()
+	}
+	return withIncomingCallMetadata(context.Background(), args.Metadata)
+}
+
+func (s *rpcServer) cancel(args *CancelArgs, resp *CancelResp) error {
+	if resp == nil {
+		return nil
+	}
+	resp.OK = false
+	if s == nil || args == nil || args.CallID == "" {
+		return nil
+	}
+	s.mu.Lock()
+	cancel, ok := s.calls[args.CallID]
+	s.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	resp.OK = ok
+	return nil
+}
+
+// beginCall derives the context of a lifecycle call from the host deadline and registers it
+// for Cancel. The returned func must be called once the call returns.
+func (s *rpcServer) beginCall(parent context.Context, args *LifecycleArgs) (context.Context, func()) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if args != nil && args.TimeoutMs > 0 {
+		ctx, cancel = context.WithTimeout(parent, time.Duration(args.TimeoutMs)*time.Millisecond)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+	if args == nil || args.CallID == "" {
+		return ctx, cancel
+	}
+	s.mu.Lock()
+	if s.calls == nil {
+		s.calls = map[string]context.CancelFunc{}
+	}
+	s.calls[args.CallID] = cancel
+	s.mu.Unlock()
+	return ctx, func() {
+		s.mu.Lock()
+		delete(s.calls, args.CallID)
+		s.mu.Unlock()
+		cancel()
+	}
 }
 
 type rpcClient struct {
-	c      *rpc.Client
-	broker *plugin.MuxBroker
+	c       *rpcConn
+	broker  *plugin.MuxBroker
+	version int
+	info    sdkdefine.PluginVersionInfo
+	schema  map[string]interface{}
 }
 
 func (c *rpcClient) Init(frame sdkdefine.Frame, id string, config map[string]interface{}) error {
 	if c == nil || c.c == nil {
 		return nil
 	}
+	setBrokerPluginID(c.broker, id)
 	var brokerID uint32
 	if c.broker != nil {
 		brokerID = c.broker.NextId()
-		go acceptAndServeMuxBroker(c.broker, brokerID, &frameRPCServer{Frame: frame, broker: c.broker})
+		go acceptAndServeMuxBroker(c.broker, brokerID, ServiceFrame, &frameRPCServer{Frame: frame, broker: c.broker, version: c.version, pluginID: id})
+	}
+	args := &InitArgs{
+		ID:              id,
+		Config:          config,
+		FrameBrokerID:   brokerID,
+		ProtocolVersion: c.version,
+		HostVersion:     hostVersion(frame),
+		SDKVersion:      sdkdefine.SDKVersion,
+	}
+	var resp InitResp
+	if err := c.c.Call("Plugin.Init", args, &resp); err != nil {
+		return err
+	}
+	c.info = sdkdefine.PluginVersionInfo{SDKVersion: resp.SDKVersion, MinHostVersion: resp.MinHostVersion, MaxHostVersion: resp.MaxHostVersion}
+	c.schema = nil
+	if resp.ConfigSchema != "" {
+		if err := json.Unmarshal([]byte(resp.ConfigSchema), &c.schema); err != nil {
+			return fmt.Errorf("rpcClient.Init: decode config schema: %w", err)
+		}
 	}
-	return c.c.Call("Plugin.Init", &InitArgs{ID: id, Config: config, FrameBrokerID: brokerID}, &Empty{})
+	return nil
+}
+
+func (c *rpcClient) VersionInfo() sdkdefine.PluginVersionInfo {
+	if c == nil {
+		return sdkdefine.PluginVersionInfo{}
+	}
+	return c.info
+}
+
+func (c *rpcClient) ConfigSchema() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return c.schema
+}
+
+// hostVersion returns the host application version of frame, if it knows it.
+func hostVersion(frame sdkdefine.Frame) string {
+	if hv, ok := frame.(sdkdefine.HostVersionFrame); ok {
+		return hv.HostVersion()
+	}
+	return ""
 }
 
-func (c *rpcClient) Load() error {
-	return c.c.Call("Plugin.Load", &Empty{}, &Empty{})
+func (c *rpcClient) Load(ctx context.Context) error {
+	return c.lifecycleCall(ctx, "Plugin.Load")
 }
 
-func (c *rpcClient) Unload() error {
-	return c.c.Call("Plugin.Unload", &Empty{}, &Empty{})
+func (c *rpcClient) Unload(ctx context.Context) error {
+	return c.lifecycleCall(ctx, "Plugin.Unload")
+}
+
+// lifecycleCall runs Load/Unload with the deadline of ctx. When ctx ends first it sends
+// Plugin.Cancel and waits up to CancelGracePeriod for the plugin to return.
+func (c *rpcClient) lifecycleCall(ctx context.Context, method string) error {
+	if c == nil || c.c == nil {
+		return fmt.Errorf("rpcClient.%s: client is not initialised", method)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	args := &LifecycleArgs{CallID: uuid.NewString(), TimeoutMs: timeoutMsFromCtx(ctx)}
+	call := c.c.GoContext(ctx, method, args, &Empty{}, nil)
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-ctx.Done():
+	}
+
+	// Older plugins do not know Plugin.Cancel; they only see the deadline.
+	c.c.GoContext(context.WithoutCancel(ctx), "Plugin.Cancel", &CancelArgs{CallID: args.CallID}, &CancelResp{}, nil)
+	timer := time.NewTimer(CancelGracePeriod)
+	defer timer.Stop()
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-timer.C:
+		return fmt.Errorf("rpcClient.%s: %w: %w", method, ErrCallAbandoned, ctx.Err())
+	}
+}
+
+func (p *DynamicRPCPlugin) version() int {
+	if p == nil || p.ProtocolVersion <= 0 {
+		return ProtocolVersion1
+	}
+	return p.ProtocolVersion
 }
 
 func (p *DynamicRPCPlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
-	return &rpcServer{Impl: p.Impl, broker: b}, nil
+	return &rpcServer{Impl: p.Impl, broker: b, version: p.version(), minHost: p.MinHostVersion, maxHost: p.MaxHostVersion, configSchema: p.ConfigSchema}, nil
 }
 
 func (p *DynamicRPCPlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &rpcClient{c: c, broker: b}, nil
+	conn := &rpcConn{Client: c, broker: b, service: ServicePlugin}
+	// go-plugin serves the lifecycle calls with net/rpc; Load/Unload carry metadata in their args.
+	conn.noMetadata.Store(true)
+	return &rpcClient{c: conn, broker: b, version: p.version()}, nil
 }
@@ -3,7 +3,6 @@ package protocol
 import (
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 
 	"github.com/hashicorp/go-plugin"
@@ -45,7 +44,7 @@ func (s *scoreboardUpdateCallbackServer) OnEvent(args *ScoreboardUpdateEventArgs
 }
 
 type scoreboardUpdateCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -145,7 +144,7 @@ func (s *ScoreboardDaemonRPCServer) RegisterWhenScoreUpdate(args *ScoreboardRegi
 	if err != nil {
 		return err
 	}
-	cb := &scoreboardUpdateCallbackClient{c: rpc.NewClient(conn)}
+	cb := &scoreboardUpdateCallbackClient{c: newCallbackRPCConn(conn, s.broker, ServiceScoreboardDaemon)}
 	listenerID, err := s.Impl.RegisterWhenScoreUpdate(func(event *api.ScoreUpdateEvent) {
 		_ = cb.OnEvent(event)
 	})
@@ -204,7 +203,7 @@ func (s *ScoreboardDaemonRPCServer) QueryRankByScoreboard(args *ScoreboardQueryR
 }
 
 type scoreboardDaemonRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -213,7 +212,7 @@ func newScoreboardDaemonRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.S
 	if conn == nil {
 		return nil
 	}
-	return &scoreboardDaemonRPCClient{c: rpc.NewClient(conn), broker: broker}
+	return &scoreboardDaemonRPCClient{c: newRPCConn(conn, broker, ServiceScoreboardDaemon), broker: broker}
 }
 
 func (c *scoreboardDaemonRPCClient) Name() string {
@@ -265,7 +264,7 @@ func (c *scoreboardDaemonRPCClient) RegisterWhenScoreUpdate(handler func(event *
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &scoreboardUpdateCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, ServiceScoreboardDaemon, &scoreboardUpdateCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
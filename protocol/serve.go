@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
 
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
@@ -37,14 +38,78 @@ func currentServeTestConfig() *plugin.ServeTestConfig {
 	return cfg
 }
 
+// ServeOption configures Serve.
+type ServeOption func(*serveOptions)
+
+type serveOptions struct {
+	grpc               bool
+	minHost, maxHost   string
+	configSchema       map[string]interface{}
+	clientInterceptors []ClientInterceptor
+	serverInterceptors []ServerInterceptor
+}
+
+// WithGRPC also offers the gRPC transport (ProtocolVersion3). Hosts that speak it prefer it
+// over net/rpc; older hosts keep negotiating a net/rpc version.
+func WithGRPC() ServeOption {
+	return func(o *serveOptions) { o.grpc = true }
+}
+
+// WithHostVersion declares the host versions the plugin supports (inclusive, empty means
+// unbounded). They are reported with define.SDKVersion during Init, and hosts refuse to load the
+// plugin when their version is out of range, see define.PluginVersionInfo.CheckCompatibility.
+func WithHostVersion(min, max string) ServeOption {
+	return func(o *serveOptions) { o.minHost, o.maxHost = min, max }
+}
+
+// WithConfigSchema reports the JSON Schema of the plugin config to the host during Init, so the
+// host can show and check the valid keys, e.g. WithConfigSchema(api.ConfigSchema[Config]()).
+func WithConfigSchema(schema map[string]interface{}) ServeOption {
+	return func(o *serveOptions) { o.configSchema = schema }
+}
+
+// WithClientInterceptors wraps every call the plugin makes to the host (frame, modules and
+// callbacks), see SetClientInterceptors. The first interceptor is the outermost one.
+func WithClientInterceptors(interceptors ...ClientInterceptor) ServeOption {
+	return func(o *serveOptions) { o.clientInterceptors = append(o.clientInterceptors, interceptors...) }
+}
+
+// WithServerInterceptors wraps every call the plugin serves (Init/Load/Unload and callbacks
+// from the host), see SetServerInterceptors. The first interceptor is the outermost one.
+func WithServerInterceptors(interceptors ...ServerInterceptor) ServeOption {
+	return func(o *serveOptions) { o.serverInterceptors = append(o.serverInterceptors, interceptors...) }
+}
+
 // Serve starts a go-plugin server for the provided plugin implementation.
 // This is intended to be called from a standalone plugin binary.
-func Serve(p api.Plugin) {
+func Serve(p api.Plugin, opts ...ServeOption) {
+	var o serveOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	ident := &pluginIdentity{}
+	plugins := VersionedPlugins(p)
+	for v, set := range plugins {
+		if IsGRPCVersion(v) && !o.grpc {
+			delete(plugins, v)
+			continue
+		}
+		switch dp := set[PluginKey].(type) {
+		case *DynamicRPCPlugin:
+			dp.MinHostVersion, dp.MaxHostVersion = o.minHost, o.maxHost
+			dp.ConfigSchema = o.configSchema
+		case *DynamicGRPCPlugin:
+			dp.MinHostVersion, dp.MaxHostVersion = o.minHost, o.maxHost
+			dp.ConfigSchema = o.configSchema
+			dp.ident = ident
+		}
+	}
 	cfg := &plugin.ServeConfig{
-		HandshakeConfig: Handshake,
-		Plugins: map[string]plugin.Plugin{
-			PluginKey: &DynamicRPCPlugin{Impl: p},
-		},
+		HandshakeConfig:  Handshake,
+		VersionedPlugins: plugins,
 		// Silence go-plugin internal debug logs (e.g. "plugin address"), while keeping errors.
 		Logger: hclog.New(&hclog.LoggerOptions{
 			Name:   "plugin",
@@ -52,6 +117,18 @@ func Serve(p api.Plugin) {
 			Output: os.Stderr,
 		}),
 	}
+	if o.grpc {
+		cfg.GRPCServer = func(opts []grpc.ServerOption) *grpc.Server {
+			return plugin.DefaultGRPCServer(append(opts, grpcServerOptions(ident.get)...))
+		}
+	}
+	// Interceptors are process-wide; with a test config the plugin shares them with the host.
+	if len(o.clientInterceptors) > 0 {
+		defer SetClientInterceptors(o.clientInterceptors...)()
+	}
+	if len(o.serverInterceptors) > 0 {
+		defer SetServerInterceptors(o.serverInterceptors...)()
+	}
 	if tc := currentServeTestConfig(); tc != nil {
 		cfg.Test = tc
 	}
@@ -0,0 +1,556 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// sqlRowsChunk is the number of rows sent per net/rpc call while iterating query results.
+const sqlRowsChunk = 256
+
+// SQLValueArg carries a value normalised by api.NormalizeSQLValue. Kind is empty for NULL.
+type SQLValueArg struct {
+	Kind   string
+	Int    int64
+	Float  float64
+	Bool   bool
+	String string
+	Bytes  []byte
+	Time   time.Time
+}
+
+func sqlValueArg(v any) SQLValueArg {
+	switch val := v.(type) {
+	case int64:
+		return SQLValueArg{Kind: "int", Int: val}
+	case float64:
+		return SQLValueArg{Kind: "float", Float: val}
+	case bool:
+		return SQLValueArg{Kind: "bool", Bool: val}
+	case string:
+		return SQLValueArg{Kind: "string", String: val}
+	case []byte:
+		return SQLValueArg{Kind: "bytes", Bytes: val}
+	case time.Time:
+		return SQLValueArg{Kind: "time", Time: val}
+	}
+	return SQLValueArg{}
+}
+
+func (a SQLValueArg) value() any {
+	switch a.Kind {
+	case "int":
+		return a.Int
+	case "float":
+		return a.Float
+	case "bool":
+		return a.Bool
+	case "string":
+		return a.String
+	case "bytes":
+		if a.Bytes == nil {
+			return []byte{}
+		}
+		return a.Bytes
+	case "time":
+		return a.Time
+	}
+	return nil
+}
+
+func sqlValueArgs(args []any) ([]SQLValueArg, error) {
+	norm, err := api.NormalizeSQLArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SQLValueArg, len(norm))
+	for i, v := range norm {
+		out[i] = sqlValueArg(v)
+	}
+	return out, nil
+}
+
+func sqlValues(args []SQLValueArg) []any {
+	out := make([]any, len(args))
+	for i, a := range args {
+		out[i] = a.value()
+	}
+	return out
+}
+
+type DatabaseModuleSQLDBArgs struct {
+	Name string
+}
+
+type DatabaseModuleSQLDBResp struct {
+	DBBrokerID uint32
+}
+
+type SQLDBExecArgs struct {
+	// TxID runs the statement in a transaction started with Begin; 0 runs it on the database.
+	TxID      uint64
+	Query     string
+	Args      []SQLValueArg
+	TimeoutMs int64
+}
+
+type SQLDBExecResp struct {
+	RowsAffected int64
+	LastInsertID int64
+}
+
+type SQLDBRowsResp struct {
+	// RowsID continues the rows with NextRows; it is 0 once Done.
+	RowsID  uint64
+	Columns []string
+	Rows    [][]SQLValueArg
+	Done    bool
+}
+
+type SQLDBRowsArgs struct {
+	RowsID uint64
+}
+
+type SQLDBTxArgs struct {
+	TxID uint64
+}
+
+// SQLDBRPCServer serves an api.SQLDB. Transactions and unfinished rows are kept by ID until
+// they are finished or the connection ends.
+type SQLDBRPCServer struct {
+	Impl api.SQLDB
+
+	mu     sync.Mutex
+	seq    uint64
+	txs    map[uint64]*sqlTxHandle
+	rows   map[uint64]*sqlRowsHandle
+	closed bool
+}
+
+type sqlTxHandle struct {
+	tx     api.SQLTx
+	cancel context.CancelFunc
+}
+
+type sqlRowsHandle struct {
+	rows   api.SQLRows
+	cancel context.CancelFunc
+}
+
+func (s *SQLDBRPCServer) queryer(txID uint64) (api.SQLQueryer, error) {
+	if txID == 0 {
+		return s.Impl, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h, ok := s.txs[txID]
+	if !ok {
+		return nil, errors.New("SQLDBRPCServer: transaction not found")
+	}
+	return h.tx, nil
+}
+
+func (s *SQLDBRPCServer) Exec(ctx context.Context, args *SQLDBExecArgs, resp *SQLDBExecResp) error {
+	if resp == nil {
+		return nil
+	}
+	*resp = SQLDBExecResp{}
+	if s == nil || s.Impl == nil || args == nil {
+		return errors.New("SQLDBRPCServer.Exec: database unavailable")
+	}
+	q, err := s.queryer(args.TxID)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := ctxWithTimeoutMs(ctx, args.TimeoutMs)
+	defer cancel()
+	res, err := q.Exec(ctx, args.Query, sqlValues(args.Args)...)
+	if err != nil {
+		return err
+	}
+	resp.RowsAffected = res.RowsAffected
+	resp.LastInsertID = res.LastInsertID
+	return nil
+}
+
+// Query runs a query and returns its first rows. The rows outlive the call, so they run on a
+// context of their own bounded by the caller's deadline.
+func (s *SQLDBRPCServer) Query(args *SQLDBExecArgs, resp *SQLDBRowsResp) error {
+	if resp == nil {
+		return nil
+	}
+	*resp = SQLDBRowsResp{}
+	if s == nil || s.Impl == nil || args == nil {
+		return errors.New("SQLDBRPCServer.Query: database unavailable")
+	}
+	q, err := s.queryer(args.TxID)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := ctxWithTimeoutMs(context.Background(), args.TimeoutMs)
+	rows, err := q.Query(ctx, args.Query, sqlValues(args.Args)...)
+	if err != nil {
+		cancel()
+		return err
+	}
+	resp.Columns = rows.Columns()
+	h := &sqlRowsHandle{rows: rows, cancel: cancel}
+	if err := s.fill(h, resp); err != nil || resp.Done {
+		return err
+	}
+	s.mu.Lock()
+	if s.rows == nil {
+		s.rows = map[uint64]*sqlRowsHandle{}
+	}
+	s.seq++
+	resp.RowsID = s.seq
+	s.rows[resp.RowsID] = h
+	s.mu.Unlock()
+	return nil
+}
+
+// fill reads the next chunk of h into resp, closing h at the end of the rows.
+func (s *SQLDBRPCServer) fill(h *sqlRowsHandle, resp *SQLDBRowsResp) error {
+	for len(resp.Rows) < sqlRowsChunk && h.rows.Next() {
+		vals := h.rows.Values()
+		row := make([]SQLValueArg, len(vals))
+		for i, v := range vals {
+			row[i] = sqlValueArg(v)
+		}
+		resp.Rows = append(resp.Rows, row)
+	}
+	if len(resp.Rows) == sqlRowsChunk {
+		return nil
+	}
+	resp.Done = true
+	err := h.rows.Err()
+	_ = h.rows.Close()
+	h.cancel()
+	return err
+}
+
+func (s *SQLDBRPCServer) NextRows(args *SQLDBRowsArgs, resp *SQLDBRowsResp) error {
+	if resp == nil {
+		return nil
+	}
+	*resp = SQLDBRowsResp{}
+	if s == nil || args == nil {
+		return nil
+	}
+	s.mu.Lock()
+	h, ok := s.rows[args.RowsID]
+	s.mu.Unlock()
+	if !ok {
+		return errors.New("SQLDBRPCServer.NextRows: rows not found")
+	}
+	err := s.fill(h, resp)
+	if resp.Done {
+		s.mu.Lock()
+		delete(s.rows, args.RowsID)
+		s.mu.Unlock()
+	} else {
+		resp.RowsID = args.RowsID
+	}
+	return err
+}
+
+func (s *SQLDBRPCServer) CloseRows(args *SQLDBRowsArgs, _ *Empty) error {
+	if s == nil || args == nil {
+		return nil
+	}
+	s.mu.Lock()
+	h, ok := s.rows[args.RowsID]
+	delete(s.rows, args.RowsID)
+	s.mu.Unlock()
+	if ok {
+		_ = h.rows.Close()
+		h.cancel()
+	}
+	return nil
+}
+
+// Begin starts a transaction that lives until Commit or Rollback; the client rolls it back
+// when its context ends.
+func (s *SQLDBRPCServer) Begin(_ *Empty, resp *SQLDBTxArgs) error {
+	if resp == nil {
+		return nil
+	}
+	resp.TxID = 0
+	if s == nil || s.Impl == nil {
+		return errors.New("SQLDBRPCServer.Begin: database unavailable")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	tx, err := s.Impl.Begin(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+	s.mu.Lock()
+	if s.txs == nil {
+		s.txs = map[uint64]*sqlTxHandle{}
+	}
+	s.seq++
+	resp.TxID = s.seq
+	s.txs[resp.TxID] = &sqlTxHandle{tx: tx, cancel: cancel}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *SQLDBRPCServer) takeTx(txID uint64) (*sqlTxHandle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h, ok := s.txs[txID]
+	if !ok {
+		return nil, errors.New("SQLDBRPCServer: transaction not found")
+	}
+	delete(s.txs, txID)
+	return h, nil
+}
+
+func (s *SQLDBRPCServer) Commit(args *SQLDBTxArgs, _ *Empty) error {
+	if s == nil || args == nil {
+		return nil
+	}
+	h, err := s.takeTx(args.TxID)
+	if err != nil {
+		return err
+	}
+	defer h.cancel()
+	return h.tx.Commit()
+}
+
+func (s *SQLDBRPCServer) Rollback(args *SQLDBTxArgs, _ *Empty) error {
+	if s == nil || args == nil {
+		return nil
+	}
+	h, err := s.takeTx(args.TxID)
+	if err != nil {
+		return err
+	}
+	defer h.cancel()
+	return h.tx.Rollback()
+}
+
+func (s *SQLDBRPCServer) Close(_ *Empty, _ *Empty) error {
+	if s == nil || s.Impl == nil {
+		return nil
+	}
+	s.release()
+	s.mu.Lock()
+	closed := s.closed
+	s.closed = true
+	s.mu.Unlock()
+	if closed {
+		return nil
+	}
+	return s.Impl.Close()
+}
+
+// release rolls back the open transactions and closes the open rows.
+func (s *SQLDBRPCServer) release() {
+	s.mu.Lock()
+	txs, rows := s.txs, s.rows
+	s.txs, s.rows = nil, nil
+	s.mu.Unlock()
+	for _, h := range rows {
+		_ = h.rows.Close()
+		h.cancel()
+	}
+	for _, h := range txs {
+		_ = h.tx.Rollback()
+		h.cancel()
+	}
+}
+
+// serveSQLDB serves db on the broker connection id and closes it when the connection ends.
+func serveSQLDB(broker *plugin.MuxBroker, id uint32, db api.SQLDB) {
+	srv := &SQLDBRPCServer{Impl: db}
+	acceptAndServeMuxBroker(broker, id, ServiceSQLDB, srv)
+	_ = srv.Close(nil, nil)
+}
+
+type sqlDBRPCClient struct {
+	c *rpcConn
+}
+
+func newSQLDBRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.SQLDB {
+	if conn == nil {
+		return nil
+	}
+	return &sqlDBRPCClient{c: newRPCConn(conn, broker, ServiceSQLDB)}
+}
+
+func (c *sqlDBRPCClient) Exec(ctx context.Context, query string, args ...any) (api.SQLResult, error) {
+	if c == nil || c.c == nil {
+		return api.SQLResult{}, errors.New("sqlDBRPCClient.Exec: client is not initialised")
+	}
+	return sqlRPCExec(ctx, c.c, 0, query, args)
+}
+
+func (c *sqlDBRPCClient) Query(ctx context.Context, query string, args ...any) (api.SQLRows, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("sqlDBRPCClient.Query: client is not initialised")
+	}
+	return sqlRPCQuery(ctx, c.c, 0, query, args)
+}
+
+func (c *sqlDBRPCClient) Begin(ctx context.Context) (api.SQLTx, error) {
+	if c == nil || c.c == nil {
+		return nil, errors.New("sqlDBRPCClient.Begin: client is not initialised")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var resp SQLDBTxArgs
+	if err := c.c.CallContext(ctx, "Plugin.Begin", &Empty{}, &resp); err != nil {
+		return nil, err
+	}
+	tx := &sqlTxRPCClient{c: c.c, id: resp.TxID}
+	tx.stop = context.AfterFunc(ctx, func() { _ = tx.Rollback() })
+	return tx, nil
+}
+
+func (c *sqlDBRPCClient) Close() error {
+	if c == nil || c.c == nil {
+		return nil
+	}
+	err := c.c.Call("Plugin.Close", &Empty{}, &Empty{})
+	if cerr := c.c.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+type sqlTxRPCClient struct {
+	c    *rpcConn
+	id   uint64
+	stop func() bool
+
+	mu   sync.Mutex
+	done bool
+}
+
+func (t *sqlTxRPCClient) Exec(ctx context.Context, query string, args ...any) (api.SQLResult, error) {
+	return sqlRPCExec(ctx, t.c, t.id, query, args)
+}
+
+func (t *sqlTxRPCClient) Query(ctx context.Context, query string, args ...any) (api.SQLRows, error) {
+	return sqlRPCQuery(ctx, t.c, t.id, query, args)
+}
+
+func (t *sqlTxRPCClient) Commit() error { return t.finish("Plugin.Commit") }
+
+func (t *sqlTxRPCClient) Rollback() error { return t.finish("Plugin.Rollback") }
+
+func (t *sqlTxRPCClient) finish(method string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return errors.New("sqlTxRPCClient: transaction has already been committed or rolled back")
+	}
+	t.done = true
+	if t.stop != nil {
+		t.stop()
+	}
+	return t.c.Call(method, &SQLDBTxArgs{TxID: t.id}, &Empty{})
+}
+
+func sqlRPCExec(ctx context.Context, c *rpcConn, txID uint64, query string, args []any) (api.SQLResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	wire, err := sqlValueArgs(args)
+	if err != nil {
+		return api.SQLResult{}, err
+	}
+	var resp SQLDBExecResp
+	if err := c.CallContext(ctx, "Plugin.Exec", &SQLDBExecArgs{TxID: txID, Query: query, Args: wire, TimeoutMs: timeoutMsFromCtx(ctx)}, &resp); err != nil {
+		return api.SQLResult{}, err
+	}
+	return api.SQLResult{RowsAffected: resp.RowsAffected, LastInsertID: resp.LastInsertID}, nil
+}
+
+func sqlRPCQuery(ctx context.Context, c *rpcConn, txID uint64, query string, args []any) (api.SQLRows, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	wire, err := sqlValueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	var resp SQLDBRowsResp
+	if err := c.CallContext(ctx, "Plugin.Query", &SQLDBExecArgs{TxID: txID, Query: query, Args: wire, TimeoutMs: timeoutMsFromCtx(ctx)}, &resp); err != nil {
+		return nil, err
+	}
+	return &sqlRowsRPCClient{c: c, ctx: ctx, id: resp.RowsID, cols: resp.Columns, buf: resp.Rows}, nil
+}
+
+// sqlRowsRPCClient iterates rows fetched from the server one chunk at a time.
+type sqlRowsRPCClient struct {
+	c    *rpcConn
+	ctx  context.Context
+	id   uint64
+	cols []string
+	buf  [][]SQLValueArg
+	cur  []any
+	err  error
+}
+
+func (r *sqlRowsRPCClient) Columns() []string { return r.cols }
+
+func (r *sqlRowsRPCClient) Next() bool {
+	r.cur = nil
+	if r.err != nil {
+		return false
+	}
+	if len(r.buf) == 0 && r.id != 0 {
+		var resp SQLDBRowsResp
+		if err := r.c.CallContext(r.ctx, "Plugin.NextRows", &SQLDBRowsArgs{RowsID: r.id}, &resp); err != nil {
+			r.err = err
+			r.id = 0
+			return false
+		}
+		r.id = resp.RowsID
+		r.buf = resp.Rows
+	}
+	if len(r.buf) == 0 {
+		return false
+	}
+	r.cur = sqlValues(r.buf[0])
+	r.buf = r.buf[1:]
+	return true
+}
+
+func (r *sqlRowsRPCClient) Values() []any { return r.cur }
+
+func (r *sqlRowsRPCClient) Scan(dest ...any) error {
+	if r.cur == nil {
+		return errors.New("sqlRowsRPCClient.Scan: Scan called without calling Next")
+	}
+	return api.ScanSQLValues(r.cur, dest...)
+}
+
+func (r *sqlRowsRPCClient) Err() error { return r.err }
+
+func (r *sqlRowsRPCClient) Close() error {
+	r.buf, r.cur = nil, nil
+	if r.id == 0 {
+		return nil
+	}
+	id := r.id
+	r.id = 0
+	return r.c.Call("Plugin.CloseRows", &SQLDBRowsArgs{RowsID: id}, &Empty{})
+}
+
+var (
+	_ api.SQLDB   = (*sqlDBRPCClient)(nil)
+	_ api.SQLTx   = (*sqlTxRPCClient)(nil)
+	_ api.SQLRows = (*sqlRowsRPCClient)(nil)
+)
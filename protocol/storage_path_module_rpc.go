@@ -3,9 +3,10 @@ package protocol
 import (
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 
+	"github.com/hashicorp/go-plugin"
+
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
 
@@ -102,15 +103,15 @@ func (s *StoragePathModuleRPCServer) CachePath(args *StoragePathArgs, resp *Stor
 }
 
 type storagePathModuleRPCClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
-func newStoragePathModuleRPCClient(conn net.Conn) api.StoragePathModule {
+func newStoragePathModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.StoragePathModule {
 	if conn == nil {
 		return nil
 	}
-	return &storagePathModuleRPCClient{c: rpc.NewClient(conn)}
+	return &storagePathModuleRPCClient{c: newRPCConn(conn, broker, api.NameStoragePathModule)}
 }
 
 func (c *storagePathModuleRPCClient) Name() string { return api.NameStoragePathModule }
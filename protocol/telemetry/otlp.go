@@ -0,0 +1,276 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultOTLPEndpoint is the OTLP/HTTP endpoint of a collector running on the local machine.
+const DefaultOTLPEndpoint = "http://localhost:4318"
+
+// OTLPOptions configures OTLP.
+type OTLPOptions struct {
+	// Endpoint is the base URL of the collector; "/v1/metrics" and "/v1/traces" are appended.
+	// Defaults to DefaultOTLPEndpoint.
+	Endpoint string
+	// Headers are added to every request, e.g. for authentication.
+	Headers map[string]string
+	// Client sends the requests. Defaults to a client with a 10 second timeout.
+	Client *http.Client
+	// NoTraces disables exporting spans.
+	NoTraces bool
+}
+
+// OTLP returns an Exporter sending metrics and spans to an OpenTelemetry collector with
+// OTLP/HTTP in the JSON encoding.
+func OTLP(opts OTLPOptions) Exporter {
+	if opts.Endpoint == "" {
+		opts.Endpoint = DefaultOTLPEndpoint
+	}
+	opts.Endpoint = strings.TrimSuffix(opts.Endpoint, "/")
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return ExporterFunc(func(ctx context.Context, snap *Snapshot) error {
+		if snap == nil {
+			return nil
+		}
+		if err := opts.post(ctx, "/v1/metrics", otlpMetrics(snap)); err != nil {
+			return err
+		}
+		if opts.NoTraces || len(snap.Spans) == 0 {
+			return nil
+		}
+		return opts.post(ctx, "/v1/traces", otlpTraces(snap))
+	})
+}
+
+func (o OTLPOptions) post(ctx context.Context, path string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("telemetry.OTLP: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.Endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("telemetry.OTLP: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range o.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("telemetry.OTLP: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("telemetry.OTLP: %s: %s: %s", path, resp.Status, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// The types below are the subset of the OTLP JSON encoding used here. 64 bit integers are
+// encoded as strings and ids as hex, as the encoding requires.
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpNumberPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	AsInt             string         `json:"asInt"`
+}
+
+type otlpHistogramPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	Count             string         `json:"count"`
+	Sum               float64        `json:"sum"`
+	BucketCounts      []string       `json:"bucketCounts"`
+	ExplicitBounds    []float64      `json:"explicitBounds"`
+}
+
+type otlpMetric struct {
+	Name      string         `json:"name"`
+	Unit      string         `json:"unit,omitempty"`
+	Sum       *otlpSum       `json:"sum,omitempty"`
+	Gauge     *otlpGauge     `json:"gauge,omitempty"`
+	Histogram *otlpHistogram `json:"histogram,omitempty"`
+}
+
+// otlpCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE.
+const otlpCumulative = 2
+
+type otlpSum struct {
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+	DataPoints             []otlpNumberPoint `json:"dataPoints"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberPoint `json:"dataPoints"`
+}
+
+type otlpHistogram struct {
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+	DataPoints             []otlpHistogramPoint `json:"dataPoints"`
+}
+
+const otlpScopeName = "github.com/Yeah114/EmptyDea-plugin-sdk/protocol/telemetry"
+
+func stringAttr(k, v string) otlpKeyValue {
+	return otlpKeyValue{Key: k, Value: otlpAnyValue{StringValue: &v}}
+}
+
+func boolAttr(k string, v bool) otlpKeyValue {
+	return otlpKeyValue{Key: k, Value: otlpAnyValue{BoolValue: &v}}
+}
+
+func unixNano(t time.Time) string { return strconv.FormatInt(t.UnixNano(), 10) }
+
+func otlpResourceOf(snap *Snapshot) otlpResource {
+	return otlpResource{Attributes: []otlpKeyValue{
+		stringAttr("service.name", snap.ServiceName),
+		stringAttr("emptydea.side", snap.Side),
+	}}
+}
+
+func methodAttrs(m MethodStats) []otlpKeyValue {
+	return []otlpKeyValue{
+		stringAttr("rpc.direction", m.Direction),
+		stringAttr("plugin.id", m.PluginID),
+		stringAttr("rpc.service", m.Service),
+		stringAttr("rpc.method", m.Method),
+		boolAttr("rpc.callback", m.Callback),
+	}
+}
+
+func otlpMetrics(snap *Snapshot) any {
+	start, now := unixNano(snap.Start), unixNano(snap.Time)
+	calls := &otlpSum{AggregationTemporality: otlpCumulative, IsMonotonic: true}
+	errs := &otlpSum{AggregationTemporality: otlpCumulative, IsMonotonic: true}
+	inFlight := &otlpGauge{}
+	duration := &otlpHistogram{AggregationTemporality: otlpCumulative}
+	bounds := make([]float64, len(snap.Buckets))
+	for i, b := range snap.Buckets {
+		bounds[i] = b.Seconds()
+	}
+	for _, m := range snap.Methods {
+		attrs := methodAttrs(m)
+		calls.DataPoints = append(calls.DataPoints, otlpNumberPoint{attrs, start, now, strconv.FormatUint(m.Calls, 10)})
+		errs.DataPoints = append(errs.DataPoints, otlpNumberPoint{attrs, start, now, strconv.FormatUint(m.Errors, 10)})
+		inFlight.DataPoints = append(inFlight.DataPoints, otlpNumberPoint{attrs, start, now, strconv.FormatInt(m.InFlight, 10)})
+		counts := make([]string, len(m.BucketCounts))
+		for i, c := range m.BucketCounts {
+			counts[i] = strconv.FormatUint(c, 10)
+		}
+		duration.DataPoints = append(duration.DataPoints, otlpHistogramPoint{
+			Attributes: attrs, StartTimeUnixNano: start, TimeUnixNano: now,
+			Count: strconv.FormatUint(m.Calls, 10), Sum: m.Sum.Seconds(),
+			BucketCounts: counts, ExplicitBounds: bounds,
+		})
+	}
+	return map[string]any{
+		"resourceMetrics": []any{map[string]any{
+			"resource": otlpResourceOf(snap),
+			"scopeMetrics": []any{map[string]any{
+				"scope": otlpScope{Name: otlpScopeName},
+				"metrics": []otlpMetric{
+					{Name: "tempest.rpc.calls", Unit: "{call}", Sum: calls},
+					{Name: "tempest.rpc.errors", Unit: "{call}", Sum: errs},
+					{Name: "tempest.rpc.in_flight", Unit: "{call}", Gauge: inFlight},
+					{Name: "tempest.rpc.duration", Unit: "s", Histogram: duration},
+				},
+			}},
+		}},
+	}
+}
+
+type otlpSpan struct {
+	TraceID      string         `json:"traceId"`
+	SpanID       string         `json:"spanId"`
+	ParentSpanID string         `json:"parentSpanId,omitempty"`
+	Name         string         `json:"name"`
+	Kind         int            `json:"kind"`
+	StartTime    string         `json:"startTimeUnixNano"`
+	EndTime      string         `json:"endTimeUnixNano"`
+	Attributes   []otlpKeyValue `json:"attributes"`
+	Status       *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// SPAN_KIND_SERVER, SPAN_KIND_CLIENT and STATUS_CODE_ERROR.
+const (
+	otlpKindServer = 2
+	otlpKindClient = 3
+	otlpStatusErr  = 2
+)
+
+func otlpTraces(snap *Snapshot) any {
+	spans := make([]otlpSpan, 0, len(snap.Spans))
+	for _, s := range snap.Spans {
+		out := otlpSpan{
+			TraceID:   s.TraceID.String(),
+			SpanID:    s.SpanID.String(),
+			Name:      s.Name,
+			Kind:      otlpKindClient,
+			StartTime: unixNano(s.Start),
+			EndTime:   unixNano(s.End),
+			Attributes: []otlpKeyValue{
+				stringAttr("plugin.id", s.PluginID),
+				stringAttr("rpc.service", s.Service),
+				stringAttr("rpc.method", s.Method),
+				boolAttr("rpc.callback", s.Callback),
+			},
+		}
+		if s.ParentSpanID.IsValid() {
+			out.ParentSpanID = s.ParentSpanID.String()
+		}
+		if s.Direction == DirectionServer {
+			out.Kind = otlpKindServer
+		}
+		if s.Error != "" {
+			out.Status = &otlpStatus{Code: otlpStatusErr, Message: s.Error}
+		}
+		spans = append(spans, out)
+	}
+	return map[string]any{
+		"resourceSpans": []any{map[string]any{
+			"resource": otlpResourceOf(snap),
+			"scopeSpans": []any{map[string]any{
+				"scope": otlpScope{Name: otlpScopeName},
+				"spans": spans,
+			}},
+		}},
+	}
+}
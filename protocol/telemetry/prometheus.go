@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// WritePrometheus writes the metrics of snap in the Prometheus text exposition format:
+//
+//	tempest_rpc_calls_total, tempest_rpc_errors_total    counters
+//	tempest_rpc_in_flight                                gauge
+//	tempest_rpc_duration_seconds                         histogram
+//
+// labelled with side, direction, plugin_id, service, method and callback.
+func WritePrometheus(w io.Writer, snap *Snapshot) error {
+	bw := bufio.NewWriter(w)
+	if snap == nil {
+		return bw.Flush()
+	}
+	labels := make([]string, len(snap.Methods))
+	for i, m := range snap.Methods {
+		labels[i] = fmt.Sprintf(`side="%s",direction="%s",plugin_id="%s",service="%s",method="%s",callback="%t"`,
+			escapeLabel(snap.Side), escapeLabel(m.Direction), escapeLabel(m.PluginID), escapeLabel(m.Service), escapeLabel(m.Method), m.Callback)
+	}
+	counter := func(name, help string, value func(MethodStats) string) {
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+		for i, m := range snap.Methods {
+			fmt.Fprintf(bw, "%s{%s} %s\n", name, labels[i], value(m))
+		}
+	}
+	counter("tempest_rpc_calls_total", "Finished plugin RPC calls.", func(m MethodStats) string { return strconv.FormatUint(m.Calls, 10) })
+	counter("tempest_rpc_errors_total", "Plugin RPC calls that returned an error.", func(m MethodStats) string { return strconv.FormatUint(m.Errors, 10) })
+
+	fmt.Fprintf(bw, "# HELP tempest_rpc_in_flight Plugin RPC calls in progress.\n# TYPE tempest_rpc_in_flight gauge\n")
+	for i, m := range snap.Methods {
+		fmt.Fprintf(bw, "tempest_rpc_in_flight{%s} %d\n", labels[i], m.InFlight)
+	}
+
+	fmt.Fprintf(bw, "# HELP tempest_rpc_duration_seconds Latency of plugin RPC calls.\n# TYPE tempest_rpc_duration_seconds histogram\n")
+	for i, m := range snap.Methods {
+		var cumulative uint64
+		for b, bound := range snap.Buckets {
+			if b < len(m.BucketCounts) {
+				cumulative += m.BucketCounts[b]
+			}
+			fmt.Fprintf(bw, "tempest_rpc_duration_seconds_bucket{%s,le=\"%s\"} %d\n", labels[i], formatFloat(bound.Seconds()), cumulative)
+		}
+		fmt.Fprintf(bw, "tempest_rpc_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels[i], m.Calls)
+		fmt.Fprintf(bw, "tempest_rpc_duration_seconds_sum{%s} %s\n", labels[i], formatFloat(m.Sum.Seconds()))
+		fmt.Fprintf(bw, "tempest_rpc_duration_seconds_count{%s} %d\n", labels[i], m.Calls)
+	}
+	return bw.Flush()
+}
+
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func formatFloat(f float64) string { return strconv.FormatFloat(f, 'g', -1, 64) }
+
+// ServeHTTP serves the current metrics in the Prometheus text format, so a Recorder can be
+// mounted as the /metrics endpoint of the host.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = WritePrometheus(w, r.Snapshot())
+}
+
+// PrometheusFile returns an Exporter that atomically rewrites path with the metrics of every
+// snapshot, for the textfile collector of node_exporter.
+func PrometheusFile(path string) Exporter {
+	return ExporterFunc(func(_ context.Context, snap *Snapshot) error {
+		var buf bytes.Buffer
+		if err := WritePrometheus(&buf, snap); err != nil {
+			return err
+		}
+		tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+		if err != nil {
+			return fmt.Errorf("telemetry.PrometheusFile: %w", err)
+		}
+		defer func() { _ = os.Remove(tmp.Name()) }()
+		if _, err := tmp.Write(buf.Bytes()); err != nil {
+			_ = tmp.Close()
+			return fmt.Errorf("telemetry.PrometheusFile: %w", err)
+		}
+		if err := tmp.Close(); err != nil {
+			return fmt.Errorf("telemetry.PrometheusFile: %w", err)
+		}
+		if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+			return fmt.Errorf("telemetry.PrometheusFile: %w", err)
+		}
+		if err := os.Rename(tmp.Name(), path); err != nil {
+			return fmt.Errorf("telemetry.PrometheusFile: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,412 @@
+// Package telemetry records metrics and traces of the RPC calls between the host and its plugins.
+//
+// A Recorder provides protocol interceptors that measure per-method latency, error counts,
+// in-flight calls and callbacks for each plugin ID, and record a span per call. Span contexts
+// are propagated to the peer as call metadata (see protocol.AppendCallMetadata), so the spans of
+// the host and its plugins form one trace. Snapshots are exported by Exporters, e.g. a
+// Prometheus text file or OTLP to a local collector.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
+)
+
+// DefaultBuckets are the latency histogram bounds used when Options.Buckets is empty.
+var DefaultBuckets = []time.Duration{
+	time.Millisecond, 5 * time.Millisecond, 10 * time.Millisecond, 25 * time.Millisecond,
+	50 * time.Millisecond, 100 * time.Millisecond, 250 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2500 * time.Millisecond, 5 * time.Second, 10 * time.Second,
+}
+
+// Options configures a Recorder.
+type Options struct {
+	// Side labels the metrics of this process, "host" or "plugin". Defaults to "host".
+	Side string
+	// ServiceName is reported to OTLP collectors as service.name. Defaults to "emptydea-" + Side.
+	ServiceName string
+	// Buckets are the latency histogram bounds, ascending. Defaults to DefaultBuckets.
+	Buckets []time.Duration
+	// MaxSpans bounds the spans kept between exports; older spans are dropped. Defaults to
+	// 4096; a negative value disables tracing.
+	MaxSpans int
+	// Exporters receive a snapshot every Interval while Start runs.
+	Exporters []Exporter
+	// Interval between exports. Defaults to 15 seconds.
+	Interval time.Duration
+}
+
+// Recorder records the calls passing through its interceptors. It is safe for concurrent use.
+type Recorder struct {
+	opts  Options
+	start time.Time
+
+	mu      sync.Mutex
+	methods map[methodKey]*methodState
+	spans   spanRing
+	dropped uint64
+}
+
+// spanRing keeps the latest spans up to a fixed capacity without shifting on overflow.
+type spanRing struct {
+	buf         []Span
+	head, count int
+}
+
+// push adds span and reports whether the oldest span was overwritten to make room.
+func (q *spanRing) push(span Span, capacity int) (overwrote bool) {
+	switch {
+	case q.count < len(q.buf):
+		q.buf[(q.head+q.count)%len(q.buf)] = span
+		q.count++
+	case len(q.buf) < capacity:
+		// head is 0 until the buffer first fills up to capacity.
+		q.buf = append(q.buf, span)
+		q.count++
+	default:
+		q.buf[q.head] = span
+		q.head = (q.head + 1) % len(q.buf)
+		overwrote = true
+	}
+	return overwrote
+}
+
+// slice returns a copy of the spans, oldest first.
+func (q *spanRing) slice() []Span {
+	out := make([]Span, 0, q.count)
+	for i := 0; i < q.count; i++ {
+		out = append(out, q.buf[(q.head+i)%len(q.buf)])
+	}
+	return out
+}
+
+func (q *spanRing) reset() {
+	clear(q.buf)
+	q.head, q.count = 0, 0
+}
+
+type methodKey struct {
+	direction, pluginID, service, method string
+	callback                             bool
+}
+
+type methodState struct {
+	calls, errors uint64
+	inFlight      int64
+	buckets       []uint64
+	sum           time.Duration
+}
+
+// New returns a Recorder.
+func New(opts Options) *Recorder {
+	if opts.Side == "" {
+		opts.Side = "host"
+	}
+	if opts.ServiceName == "" {
+		opts.ServiceName = "emptydea-" + opts.Side
+	}
+	if len(opts.Buckets) == 0 {
+		opts.Buckets = DefaultBuckets
+	}
+	opts.Buckets = append([]time.Duration(nil), opts.Buckets...)
+	sort.Slice(opts.Buckets, func(i, j int) bool { return opts.Buckets[i] < opts.Buckets[j] })
+	if opts.MaxSpans == 0 {
+		opts.MaxSpans = 4096
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 15 * time.Second
+	}
+	return &Recorder{opts: opts, start: time.Now(), methods: map[methodKey]*methodState{}}
+}
+
+// Direction of a recorded call.
+const (
+	DirectionClient = "client"
+	DirectionServer = "server"
+)
+
+// ClientInterceptor records outgoing calls and sends their span context to the peer.
+func (r *Recorder) ClientInterceptor() protocol.ClientInterceptor {
+	return func(ctx context.Context, info *protocol.CallInfo, args, reply any, invoke func(ctx context.Context) error) error {
+		span := r.startSpan(ctx, DirectionClient, info)
+		ctx = protocol.AppendCallMetadata(ctx, TraceParentKey, span.Context().TraceParent())
+		err := invoke(ctx)
+		r.finish(span, err)
+		return err
+	}
+}
+
+// ServerInterceptor records incoming calls, continuing the trace of the caller.
+func (r *Recorder) ServerInterceptor() protocol.ServerInterceptor {
+	return func(ctx context.Context, info *protocol.CallInfo, args any, handler func(ctx context.Context) (any, error)) (any, error) {
+		if tp, ok := protocol.IncomingCallMetadata(ctx)[TraceParentKey]; ok {
+			if sc, err := ParseTraceParent(tp); err == nil {
+				ctx = ContextWithSpanContext(ctx, sc)
+			}
+		}
+		span := r.startSpan(ctx, DirectionServer, info)
+		reply, err := handler(ContextWithSpanContext(ctx, span.Context()))
+		r.finish(span, err)
+		return reply, err
+	}
+}
+
+// ServeOptions installs the interceptors of r in protocol.Serve.
+func (r *Recorder) ServeOptions() []protocol.ServeOption {
+	return []protocol.ServeOption{
+		protocol.WithClientInterceptors(r.ClientInterceptor()),
+		protocol.WithServerInterceptors(r.ServerInterceptor()),
+	}
+}
+
+// Install installs the interceptors of r for the whole process (e.g. a host) and returns a
+// function restoring the previous interceptors. To combine r with other interceptors, pass
+// ClientInterceptor and ServerInterceptor to protocol.SetClientInterceptors/SetServerInterceptors.
+func (r *Recorder) Install() (restore func()) {
+	restoreClient := protocol.SetClientInterceptors(r.ClientInterceptor())
+	restoreServer := protocol.SetServerInterceptors(r.ServerInterceptor())
+	return func() {
+		restoreServer()
+		restoreClient()
+	}
+}
+
+func (r *Recorder) startSpan(ctx context.Context, direction string, info *protocol.CallInfo) *Span {
+	span := &Span{
+		SpanID:    newSpanID(),
+		Name:      info.FullMethod(),
+		Direction: direction,
+		PluginID:  info.PluginID,
+		Service:   info.Service,
+		Method:    info.Method,
+		Callback:  info.Callback,
+		Start:     time.Now(),
+	}
+	if parent, ok := SpanContextFromContext(ctx); ok {
+		span.TraceID, span.ParentSpanID = parent.TraceID, parent.SpanID
+	} else {
+		span.TraceID = newTraceID()
+	}
+
+	r.mu.Lock()
+	r.method(span).inFlight++
+	r.mu.Unlock()
+	return span
+}
+
+func (r *Recorder) finish(span *Span, err error) {
+	span.End = time.Now()
+	if err != nil {
+		span.Error = err.Error()
+	}
+	d := span.End.Sub(span.Start)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := r.method(span)
+	m.inFlight--
+	m.calls++
+	if err != nil {
+		m.errors++
+	}
+	m.sum += d
+	i := sort.Search(len(r.opts.Buckets), func(i int) bool { return d <= r.opts.Buckets[i] })
+	m.buckets[i]++
+
+	if r.opts.MaxSpans < 0 {
+		return
+	}
+	if r.spans.push(*span, r.opts.MaxSpans) {
+		r.dropped++
+	}
+}
+
+// method returns the state of the span's method; r.mu must be held.
+func (r *Recorder) method(span *Span) *methodState {
+	key := methodKey{span.Direction, span.PluginID, span.Service, span.Method, span.Callback}
+	m := r.methods[key]
+	if m == nil {
+		m = &methodState{buckets: make([]uint64, len(r.opts.Buckets)+1)}
+		r.methods[key] = m
+	}
+	return m
+}
+
+// Span is a recorded call.
+type Span struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	// Name is "service.Method", see protocol.CallInfo.FullMethod.
+	Name      string
+	Direction string
+	PluginID  string
+	Service   string
+	Method    string
+	Callback  bool
+	Start     time.Time
+	End       time.Time
+	// Error is the error of the call; empty on success.
+	Error string
+}
+
+// Context returns the span context propagated to the peer.
+func (s *Span) Context() SpanContext { return SpanContext{TraceID: s.TraceID, SpanID: s.SpanID} }
+
+// MethodStats are the metrics of one method for one plugin, as seen from one direction.
+type MethodStats struct {
+	Direction string
+	PluginID  string
+	Service   string
+	Method    string
+	// Callback reports broker callbacks; their call count is the callback fan-out per plugin.
+	Callback bool
+	Calls    uint64
+	Errors   uint64
+	InFlight int64
+	// BucketCounts[i] counts the calls with latency <= Buckets[i] (and > Buckets[i-1]); the
+	// last element counts the calls slower than every bound.
+	BucketCounts []uint64
+	Sum          time.Duration
+}
+
+// Snapshot is the state of a Recorder at one point in time.
+type Snapshot struct {
+	Side        string
+	ServiceName string
+	// Start is when the Recorder was created; counters are cumulative since then.
+	Start   time.Time
+	Time    time.Time
+	Buckets []time.Duration
+	Methods []MethodStats
+	// Spans are the spans finished since the previous Flush.
+	Spans []Span
+	// DroppedSpans counts the spans dropped because of Options.MaxSpans since the previous Flush.
+	DroppedSpans uint64
+}
+
+// Snapshot returns the current metrics and pending spans without draining them.
+func (r *Recorder) Snapshot() *Snapshot {
+	return r.snapshot(false)
+}
+
+func (r *Recorder) snapshot(drain bool) *Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snap := &Snapshot{
+		Side:         r.opts.Side,
+		ServiceName:  r.opts.ServiceName,
+		Start:        r.start,
+		Time:         time.Now(),
+		Buckets:      r.opts.Buckets,
+		Methods:      make([]MethodStats, 0, len(r.methods)),
+		Spans:        r.spans.slice(),
+		DroppedSpans: r.dropped,
+	}
+	for k, m := range r.methods {
+		snap.Methods = append(snap.Methods, MethodStats{
+			Direction:    k.direction,
+			PluginID:     k.pluginID,
+			Service:      k.service,
+			Method:       k.method,
+			Callback:     k.callback,
+			Calls:        m.calls,
+			Errors:       m.errors,
+			InFlight:     m.inFlight,
+			BucketCounts: append([]uint64(nil), m.buckets...),
+			Sum:          m.sum,
+		})
+	}
+	sort.Slice(snap.Methods, func(i, j int) bool {
+		a, b := snap.Methods[i], snap.Methods[j]
+		if a.PluginID != b.PluginID {
+			return a.PluginID < b.PluginID
+		}
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		return !a.Callback && b.Callback
+	})
+	if drain {
+		r.spans.reset()
+		r.dropped = 0
+	}
+	return snap
+}
+
+// Exporter publishes snapshots.
+type Exporter interface {
+	Export(ctx context.Context, snap *Snapshot) error
+}
+
+// ExporterFunc adapts a function to Exporter.
+type ExporterFunc func(ctx context.Context, snap *Snapshot) error
+
+func (f ExporterFunc) Export(ctx context.Context, snap *Snapshot) error { return f(ctx, snap) }
+
+// Flush exports a snapshot to every exporter and drops the exported spans.
+func (r *Recorder) Flush(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	snap := r.snapshot(true)
+	var errs []error
+	for _, e := range r.opts.Exporters {
+		if e == nil {
+			continue
+		}
+		if err := e.Export(ctx, snap); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Start flushes every Options.Interval until ctx ends or stop is called; stop flushes a last
+// time. onError, if not nil, receives the errors of the exporters.
+func (r *Recorder) Start(ctx context.Context, onError func(error)) (stop func()) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	report := func(err error) {
+		if err != nil && onError != nil {
+			onError(err)
+		}
+	}
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(r.opts.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				report(r.Flush(ctx))
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cancel()
+			<-done
+			flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFlush()
+			report(r.Flush(flushCtx))
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/rpc"
 	"sync"
 	"sync/atomic"
 
@@ -72,7 +71,7 @@ func (s *terminalMenuTriggerCallbackServer) OnTrigger(args *TerminalMenuTriggerE
 }
 
 type terminalMenuTriggerCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -120,7 +119,7 @@ func (s *terminalMenuAddEntryCallbackServer) OnEntry(args *TerminalMenuAddEntryE
 }
 
 type terminalMenuAddEntryCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -163,7 +162,7 @@ func (s *terminalMenuLineCallbackServer) OnLine(args *TerminalMenuLineEvent, _ *
 }
 
 type terminalMenuLineCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -200,7 +199,7 @@ func (s *terminalMenuPopCallbackServer) OnPop(_ *TerminalMenuPopEvent, _ *Empty)
 }
 
 type terminalMenuPopCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -289,7 +288,7 @@ func (s *TerminalMenuModuleRPCServer) RegisterMenuEntry(args *TerminalMenuRegist
 	if err != nil {
 		return err
 	}
-	cb := &terminalMenuTriggerCallbackClient{c: rpc.NewClient(conn)}
+	cb := &terminalMenuTriggerCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameTerminalMenuModule)}
 
 	entry := fromTerminalMenuEntryWire(args.Entry)
 	entry.OnTrigger = func(a []string) {
@@ -339,7 +338,7 @@ func (s *TerminalMenuModuleRPCServer) RegisterWhenAddMenuEntry(args *TerminalMen
 	if err != nil {
 		return err
 	}
-	cb := &terminalMenuAddEntryCallbackClient{c: rpc.NewClient(conn)}
+	cb := &terminalMenuAddEntryCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameTerminalMenuModule)}
 
 	listenerID, err := s.Impl.RegisterWhenAddMenuEntry(func(entry *api.TerminalMenuEntry) {
 		if entry == nil {
@@ -394,7 +393,7 @@ func (s *TerminalMenuModuleRPCServer) RegisterWhenTerminalCall(args *TerminalMen
 	if err != nil {
 		return err
 	}
-	cb := &terminalMenuLineCallbackClient{c: rpc.NewClient(conn)}
+	cb := &terminalMenuLineCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameTerminalMenuModule)}
 
 	listenerID, err := s.Impl.RegisterWhenTerminalCall(func(line string) {
 		_ = cb.OnLine(line)
@@ -433,7 +432,7 @@ func (s *TerminalMenuModuleRPCServer) RegisterWhenPopBackendMenu(args *TerminalM
 	if err != nil {
 		return err
 	}
-	cb := &terminalMenuPopCallbackClient{c: rpc.NewClient(conn)}
+	cb := &terminalMenuPopCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameTerminalMenuModule)}
 
 	listenerID, err := s.Impl.RegisterWhenPopBackendMenu(func(_ struct{}) {
 		_ = cb.OnPop()
@@ -499,7 +498,7 @@ func (s *TerminalMenuModuleRPCServer) RemoveMenuEntry(args *TerminalMenuRemoveEn
 }
 
 type terminalMenuModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 
@@ -513,7 +512,7 @@ func newTerminalMenuModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api
 		return nil
 	}
 	return &terminalMenuModuleRPCClient{
-		c:      rpc.NewClient(conn),
+		c:      newRPCConn(conn, broker, api.NameTerminalMenuModule),
 		broker: broker,
 	}
 }
@@ -532,7 +531,7 @@ func (c *terminalMenuModuleRPCClient) RegisterTerminalMenuEntry(entry *api.Termi
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &terminalMenuTriggerCallbackServer{handler: entry.OnTrigger})
+	go acceptAndServeCallback(c.broker, cbID, api.NameTerminalMenuModule, &terminalMenuTriggerCallbackServer{handler: entry.OnTrigger})
 
 	entryID := fmt.Sprintf("entry:%d", atomic.AddUint64(&c.entrySeq, 1))
 	c.entryMu.Lock()
@@ -602,7 +601,7 @@ func (c *terminalMenuModuleRPCClient) RegisterWhenAddMenuEntry(handler func(*api
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &terminalMenuAddEntryCallbackServer{client: c, handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameTerminalMenuModule, &terminalMenuAddEntryCallbackServer{client: c, handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -635,7 +634,7 @@ func (c *terminalMenuModuleRPCClient) RegisterWhenTerminalCall(handler func(stri
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &terminalMenuLineCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameTerminalMenuModule, &terminalMenuLineCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -668,7 +667,7 @@ func (c *terminalMenuModuleRPCClient) RegisterWhenPopBackendMenu(handler func(st
 	}
 
 	cbID := c.broker.NextId()
-	go acceptAndServeMuxBroker(c.broker, cbID, &terminalMenuPopCallbackServer{handler: handler})
+	go acceptAndServeCallback(c.broker, cbID, api.NameTerminalMenuModule, &terminalMenuPopCallbackServer{handler: handler})
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/rpc"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -64,7 +63,7 @@ func (s *terminalLineCallbackServer) Stop(_ *Empty, _ *Empty) error {
 }
 
 type terminalLineCallbackClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
@@ -245,7 +244,7 @@ func (s *TerminalModuleRPCServer) SubscribeLines(args *TerminalSubscribeArgs, re
 	if err != nil {
 		return err
 	}
-	cb := &terminalLineCallbackClient{c: rpc.NewClient(conn)}
+	cb := &terminalLineCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameTerminalModule)}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	lines, err := s.Impl.SubscribeLines(ctx)
@@ -348,7 +347,7 @@ func (s *TerminalModuleRPCServer) InterceptNextLine(args *TerminalInterceptArgs,
 	if err != nil {
 		return err
 	}
-	cb := &terminalLineCallbackClient{c: rpc.NewClient(conn)}
+	cb := &terminalLineCallbackClient{c: newCallbackRPCConn(conn, s.broker, api.NameTerminalModule)}
 
 	timeout := time.Duration(0)
 	if args.TimeoutMillis > 0 {
@@ -436,7 +435,7 @@ func (s *TerminalModuleRPCServer) CancelIntercept(args *TerminalCancelInterceptA
 }
 
 type terminalModuleRPCClient struct {
-	c      *rpc.Client
+	c      *rpcConn
 	broker *plugin.MuxBroker
 	mu     sync.Mutex
 }
@@ -446,7 +445,7 @@ func newTerminalModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.Ter
 		return nil
 	}
 	return &terminalModuleRPCClient{
-		c:      rpc.NewClient(conn),
+		c:      newRPCConn(conn, broker, api.NameTerminalModule),
 		broker: broker,
 	}
 }
@@ -508,7 +507,7 @@ func (c *terminalModuleRPCClient) SubscribeLines(ctx context.Context) (<-chan st
 	out := make(chan string, 64)
 	cbID := c.broker.NextId()
 	cbSrv := &terminalLineCallbackServer{ch: out}
-	go acceptAndServeMuxBroker(c.broker, cbID, cbSrv)
+	go acceptAndServeCallback(c.broker, cbID, api.NameTerminalModule, cbSrv)
 
 	c.mu.Lock()
 	var resp TerminalSubscribeResp
@@ -554,7 +553,7 @@ func (c *terminalModuleRPCClient) InterceptNextLine(ctx context.Context, timeout
 	ch := make(chan string, 1)
 	cbID := c.broker.NextId()
 	cbSrv := &terminalLineCallbackServer{ch: ch}
-	go acceptAndServeMuxBroker(c.broker, cbID, cbSrv)
+	go acceptAndServeCallback(c.broker, cbID, api.NameTerminalModule, cbSrv)
 
 	timeoutMs := int64(0)
 	if timeout > 0 {
@@ -5,6 +5,14 @@ import (
 	"time"
 )
 
+// ctxWithTimeoutMs derives the context of a served call from the caller's remaining deadline.
+func ctxWithTimeoutMs(parent context.Context, timeoutMs int64) (context.Context, func()) {
+	if timeoutMs <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, time.Duration(timeoutMs)*time.Millisecond)
+}
+
 func timeoutMsFromCtx(ctx context.Context) int64 {
 	if ctx == nil {
 		return 0
@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 	"net"
-	"net/rpc"
 	"sync"
 
+	"github.com/hashicorp/go-plugin"
+
 	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
 )
 
@@ -458,15 +459,15 @@ func (s *UQHolderModuleRPCServer) callFloat32OK(args *UQHolderTimeoutArgs, resp
 }
 
 type uqholderModuleRPCClient struct {
-	c  *rpc.Client
+	c  *rpcConn
 	mu sync.Mutex
 }
 
-func newUQHolderModuleRPCClient(conn net.Conn) api.UQHolderModule {
+func newUQHolderModuleRPCClient(conn net.Conn, broker *plugin.MuxBroker) api.UQHolderModule {
 	if conn == nil {
 		return nil
 	}
-	return &uqholderModuleRPCClient{c: rpc.NewClient(conn)}
+	return &uqholderModuleRPCClient{c: newRPCConn(conn, broker, api.NameUQHolderModule)}
 }
 
 func (c *uqholderModuleRPCClient) Name() string { return api.NameUQHolderModule }
@@ -0,0 +1,182 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+)
+
+// BrainModule is a fake api.BrainModule.
+// Register daemons with SetDaemon; EnableDaemon calls ReConfig on them with the requested config.
+type BrainModule struct {
+	mu      sync.Mutex
+	daemons map[string]define.Daemon
+	enabled map[string]bool
+}
+
+func NewBrainModule() *BrainModule {
+	return &BrainModule{daemons: map[string]define.Daemon{}, enabled: map[string]bool{}}
+}
+
+func (m *BrainModule) Name() string { return api.NameBrainModule }
+
+// SetDaemon makes d available under name (e.g. a *ScoreboardDaemon or *ChunkDaemon).
+func (m *BrainModule) SetDaemon(name string, d define.Daemon) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.daemons[name] = d
+}
+
+// Enabled reports whether the plugin currently has name enabled.
+func (m *BrainModule) Enabled(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.enabled[name]
+}
+
+func (m *BrainModule) EnableDaemon(_ context.Context, name string, config map[string]interface{}) (map[string]interface{}, define.Daemon, error) {
+	m.mu.Lock()
+	d := m.daemons[name]
+	m.mu.Unlock()
+	if d == nil {
+		return nil, nil, errors.New("sdktest.BrainModule.EnableDaemon: daemon " + name + " not found")
+	}
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	if err := d.ReConfig(config); err != nil {
+		return nil, nil, err
+	}
+	m.mu.Lock()
+	m.enabled[name] = true
+	m.mu.Unlock()
+	return d.Config(), d, nil
+}
+
+func (m *BrainModule) DisableDaemon(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.daemons[name]; !ok {
+		return errors.New("sdktest.BrainModule.DisableDaemon: daemon " + name + " not found")
+	}
+	delete(m.enabled, name)
+	return nil
+}
+
+// Daemon is a minimal define.Daemon that stores its config.
+type Daemon struct {
+	mu     sync.Mutex
+	name   string
+	config map[string]interface{}
+}
+
+func NewDaemon(name string) *Daemon {
+	return &Daemon{name: name, config: map[string]interface{}{}}
+}
+
+func (d *Daemon) Name() string { return d.name }
+
+func (d *Daemon) ReConfig(config map[string]interface{}) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.config = copyAnyMap(config)
+	return nil
+}
+
+func (d *Daemon) Config() map[string]interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return copyAnyMap(d.config)
+}
+
+// ScoreboardDaemon is a fake api.ScoreboardDaemon. Use Emit to push score updates.
+type ScoreboardDaemon struct {
+	*Daemon
+	updates listeners[*api.ScoreUpdateEvent]
+
+	// Scores and Ranks back the Query methods; keys are player UUIDs and scoreboard names.
+	// Ranks must be stored in descending score order.
+	Scores map[string][]api.PlayerScoreQueryResult
+	Ranks  map[string][]api.RankQueryResult
+}
+
+func NewScoreboardDaemon() *ScoreboardDaemon {
+	return &ScoreboardDaemon{
+		Daemon: NewDaemon("scoreboard"),
+		Scores: map[string][]api.PlayerScoreQueryResult{},
+		Ranks:  map[string][]api.RankQueryResult{},
+	}
+}
+
+func (d *ScoreboardDaemon) RegisterWhenScoreUpdate(handler func(event *api.ScoreUpdateEvent)) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.ScoreboardDaemon.RegisterWhenScoreUpdate: handler is nil")
+	}
+	return d.updates.add(handler), nil
+}
+
+func (d *ScoreboardDaemon) UnregisterWhenScoreUpdate(listenerID string) bool {
+	return d.updates.remove(listenerID)
+}
+
+// Emit delivers a score update to the registered handlers.
+func (d *ScoreboardDaemon) Emit(event *api.ScoreUpdateEvent) {
+	d.updates.emit(event)
+}
+
+func (d *ScoreboardDaemon) QueryScoreByPlayerUUID(uuid string) *[]api.PlayerScoreQueryResult {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := append([]api.PlayerScoreQueryResult(nil), d.Scores[uuid]...)
+	return &out
+}
+
+func (d *ScoreboardDaemon) QueryRankByScoreboard(scoreboardName string, descending bool, maxCount int) *[]api.RankQueryResult {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := append([]api.RankQueryResult(nil), d.Ranks[scoreboardName]...)
+	if !descending {
+		for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+			out[i], out[j] = out[j], out[i]
+		}
+	}
+	if maxCount > 0 && len(out) > maxCount {
+		out = out[:maxCount]
+	}
+	return &out
+}
+
+// ChunkDaemon is a fake api.ChunkDaemon. Use Emit to push chunks.
+type ChunkDaemon struct {
+	*Daemon
+	chunks listeners[*api.ChunkNewChunkEvent]
+}
+
+func NewChunkDaemon() *ChunkDaemon {
+	return &ChunkDaemon{Daemon: NewDaemon("chunk")}
+}
+
+func (d *ChunkDaemon) RegisterWhenNewChunk(handler func(event *api.ChunkNewChunkEvent)) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.ChunkDaemon.RegisterWhenNewChunk: handler is nil")
+	}
+	return d.chunks.add(handler), nil
+}
+
+func (d *ChunkDaemon) UnregisterWhenNewChunk(listenerID string) bool {
+	return d.chunks.remove(listenerID)
+}
+
+// Emit delivers a chunk to the registered handlers.
+func (d *ChunkDaemon) Emit(event *api.ChunkNewChunkEvent) {
+	d.chunks.emit(event)
+}
+
+var (
+	_ api.BrainModule      = (*BrainModule)(nil)
+	_ api.ScoreboardDaemon = (*ScoreboardDaemon)(nil)
+	_ api.ChunkDaemon      = (*ChunkDaemon)(nil)
+)
@@ -0,0 +1,141 @@
+package sdktest
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// ChatModule is a fake api.ChatModule. Use Emit to inject chat messages.
+type ChatModule struct {
+	all   listeners[*api.ChatMsg]
+	named listeners[*api.ChatMsg]
+
+	interceptMu sync.Mutex
+	intercepts  []*chatIntercept
+}
+
+type chatIntercept struct {
+	name    string
+	handler func(*api.ChatMsg)
+}
+
+func NewChatModule() *ChatModule {
+	return &ChatModule{}
+}
+
+func (m *ChatModule) Name() string { return api.NameChatModule }
+
+func (m *ChatModule) RegisterWhenChatMsg(handler func(event *api.ChatMsg)) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.ChatModule.RegisterWhenChatMsg: handler is nil")
+	}
+	return m.all.add(handler), nil
+}
+
+func (m *ChatModule) UnregisterWhenChatMsg(listenerID string) bool {
+	return m.all.remove(listenerID)
+}
+
+func (m *ChatModule) RegisterWhenReceiveMsgFromSenderNamed(name string, handler func(event *api.ChatMsg)) (string, error) {
+	if name == "" {
+		return "", errors.New("sdktest.ChatModule.RegisterWhenReceiveMsgFromSenderNamed: sender name is empty")
+	}
+	if handler == nil {
+		return "", errors.New("sdktest.ChatModule.RegisterWhenReceiveMsgFromSenderNamed: handler is nil")
+	}
+	return m.named.add(func(event *api.ChatMsg) {
+		if event != nil && event.Name == name {
+			handler(event)
+		}
+	}), nil
+}
+
+func (m *ChatModule) UnregisterWhenReceiveMsgFromSenderNamed(listenerID string) bool {
+	return m.named.remove(listenerID)
+}
+
+// InterceptNextMessage captures the next message from name; intercepted messages are not
+// delivered to the other listeners, matching the host behaviour.
+func (m *ChatModule) InterceptNextMessage(name string, handler func(*api.ChatMsg)) (func(), error) {
+	if handler == nil {
+		return nil, errors.New("sdktest.ChatModule.InterceptNextMessage: handler is nil")
+	}
+	ic := &chatIntercept{name: name, handler: handler}
+	m.interceptMu.Lock()
+	m.intercepts = append(m.intercepts, ic)
+	m.interceptMu.Unlock()
+	return func() { m.dropIntercept(ic) }, nil
+}
+
+func (m *ChatModule) dropIntercept(ic *chatIntercept) bool {
+	m.interceptMu.Lock()
+	defer m.interceptMu.Unlock()
+	for i, it := range m.intercepts {
+		if it == ic {
+			m.intercepts = append(m.intercepts[:i], m.intercepts[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Emit delivers event to the plugin. Handlers run synchronously before Emit returns.
+func (m *ChatModule) Emit(event *api.ChatMsg) {
+	if event == nil {
+		return
+	}
+	m.interceptMu.Lock()
+	var ic *chatIntercept
+	for _, it := range m.intercepts {
+		if it.name == "" || it.name == event.Name {
+			ic = it
+			break
+		}
+	}
+	m.interceptMu.Unlock()
+	if ic != nil && m.dropIntercept(ic) {
+		ic.handler(event)
+		return
+	}
+	m.all.emit(event)
+	m.named.emit(event)
+}
+
+// Say is a shortcut for Emit(NewChatMsg(name, msg...)).
+func (m *ChatModule) Say(name string, msg ...string) {
+	m.Emit(NewChatMsg(name, msg...))
+}
+
+// ListenerCount reports how many chat listeners (including sender-named ones) are registered.
+func (m *ChatModule) ListenerCount() int {
+	return m.all.len() + m.named.len()
+}
+
+// NewChatMsg builds a ChatMsg as the host would for a plain player message.
+// msg holds the already space-split words.
+func NewChatMsg(name string, msg ...string) *api.ChatMsg {
+	raw := ""
+	for i, s := range msg {
+		if i > 0 {
+			raw += " "
+		}
+		raw += s
+	}
+	return &api.ChatMsg{
+		Name:      name,
+		Msg:       append([]string(nil), msg...),
+		RawMsg:    raw,
+		ParsedMsg: raw,
+		UD: api.ChatUD{
+			Name:      name,
+			Msg:       append([]string(nil), msg...),
+			RawMsg:    raw,
+			RawName:   name,
+			ParsedMsg: raw,
+		},
+	}
+}
+
+var _ api.ChatModule = (*ChatModule)(nil)
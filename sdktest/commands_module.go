@@ -0,0 +1,149 @@
+package sdktest
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// CommandKind tells which CommandsModule method sent a command.
+type CommandKind string
+
+const (
+	CommandKindSettings CommandKind = "settings"
+	CommandKindPlayer   CommandKind = "player"
+	CommandKindWS       CommandKind = "ws"
+	CommandKindChat     CommandKind = "chat"
+	CommandKindTitle    CommandKind = "title"
+)
+
+// SentCommand records one command sent by the plugin.
+type SentCommand struct {
+	Kind        CommandKind
+	Command     string
+	Dimensional bool
+	WithResp    bool
+	Timeout     time.Duration
+}
+
+// CommandsModule is a fake api.CommandsModule.
+// It records every command and answers *WithResp calls from canned outputs or a Responder.
+// Without either, an empty output is returned when timeout <= 0 and a timeout error otherwise.
+type CommandsModule struct {
+	mu      sync.Mutex
+	sent    []SentCommand
+	outputs map[string]*api.CommandOutput
+
+	// Responder, when set, is consulted for *WithResp calls without a canned output.
+	Responder func(cmd SentCommand) (*api.CommandOutput, error)
+	// Err, when set, is returned by every send method.
+	Err error
+}
+
+func NewCommandsModule() *CommandsModule {
+	return &CommandsModule{outputs: map[string]*api.CommandOutput{}}
+}
+
+func (m *CommandsModule) Name() string { return api.NameCommandsModule }
+
+// SetOutput registers the output returned for command by SendPlayerCommandWithResp/SendWSCommandWithResp.
+func (m *CommandsModule) SetOutput(command string, output *api.CommandOutput) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.outputs == nil {
+		m.outputs = map[string]*api.CommandOutput{}
+	}
+	m.outputs[command] = output
+}
+
+// Sent returns all recorded commands in call order.
+func (m *CommandsModule) Sent() []SentCommand {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]SentCommand(nil), m.sent...)
+}
+
+// SentCommands returns the command lines recorded for kind.
+func (m *CommandsModule) SentCommands(kind CommandKind) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []string
+	for _, c := range m.sent {
+		if c.Kind == kind {
+			out = append(out, c.Command)
+		}
+	}
+	return out
+}
+
+// Reset clears the recorded commands; canned outputs are kept.
+func (m *CommandsModule) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = nil
+}
+
+func (m *CommandsModule) record(cmd SentCommand) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, cmd)
+	return m.Err
+}
+
+func (m *CommandsModule) respond(cmd SentCommand) (*api.CommandOutput, error) {
+	if err := m.record(cmd); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	out, ok := m.outputs[cmd.Command]
+	responder := m.Responder
+	m.mu.Unlock()
+	if ok {
+		return out, nil
+	}
+	if responder != nil {
+		return responder(cmd)
+	}
+	if cmd.Timeout > 0 {
+		return nil, errors.New("sdktest.CommandsModule: no output for command " + cmd.Command)
+	}
+	return &api.CommandOutput{CommandLine: cmd.Command}, nil
+}
+
+func (m *CommandsModule) SendSettingsCommand(command string, dimensional bool) error {
+	return m.record(SentCommand{Kind: CommandKindSettings, Command: command, Dimensional: dimensional})
+}
+
+func (m *CommandsModule) SendPlayerCommand(command string) error {
+	return m.record(SentCommand{Kind: CommandKindPlayer, Command: command})
+}
+
+func (m *CommandsModule) SendWSCommand(command string) error {
+	return m.record(SentCommand{Kind: CommandKindWS, Command: command})
+}
+
+func (m *CommandsModule) SendPlayerCommandWithResp(command string, timeout time.Duration) (*api.CommandOutput, error) {
+	return m.respond(SentCommand{Kind: CommandKindPlayer, Command: command, WithResp: true, Timeout: timeout})
+}
+
+func (m *CommandsModule) SendWSCommandWithResp(command string, timeout time.Duration) (*api.CommandOutput, error) {
+	return m.respond(SentCommand{Kind: CommandKindWS, Command: command, WithResp: true, Timeout: timeout})
+}
+
+func (m *CommandsModule) AwaitChangesGeneral() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.Err
+}
+
+func (m *CommandsModule) SendChat(content string) error {
+	return m.record(SentCommand{Kind: CommandKindChat, Command: content})
+}
+
+func (m *CommandsModule) Title(message string) error {
+	return m.record(SentCommand{Kind: CommandKindTitle, Command: message})
+}
+
+var _ api.CommandsModule = (*CommandsModule)(nil)
@@ -0,0 +1,203 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/dbbackup"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/sqldb"
+)
+
+// DatabaseModule is a fake api.DatabaseModule backed by in-memory KeyValueDBs.
+// Data survives Close so a plugin can be reloaded against the same database.
+// SQL databases are real SQLite files, see SQLDir, and backups are stored in BackupDir.
+type DatabaseModule struct {
+	// SQLDir is the root of the SQL databases; a temporary directory is created on first use
+	// when it is empty. Remove it with Cleanup.
+	SQLDir string
+	// BackupDir is the dbbackup.Store of Backup, created like SQLDir.
+	BackupDir string
+	// BackupKeep is the number of backups kept per database, dbbackup.DefaultKeep when 0.
+	BackupKeep int
+
+	mu       sync.Mutex
+	dbs      map[string]*KeyValueDB
+	tempDirs []string
+}
+
+func NewDatabaseModule() *DatabaseModule {
+	return &DatabaseModule{dbs: map[string]*KeyValueDB{}}
+}
+
+func (m *DatabaseModule) Name() string { return api.NameDatabaseModule }
+
+func (m *DatabaseModule) KeyValueDB(name string, dbType string) (api.KeyValueDB, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("sdktest.DatabaseModule.KeyValueDB: name is empty")
+	}
+	switch strings.TrimSpace(dbType) {
+	case api.DBTypeDefault, api.DBTypeTextLog, api.DBTypeLevel, api.DBTypeJSON:
+	default:
+		return nil, errors.New("sdktest.DatabaseModule.KeyValueDB: unknown db type " + dbType)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	db := m.dbs[name]
+	if db == nil {
+		db = NewKeyValueDB()
+		m.dbs[name] = db
+	}
+	db.mu.Lock()
+	db.closed = false
+	db.mu.Unlock()
+	return db, nil
+}
+
+// dir returns *path, creating a temporary directory first when it is empty.
+func (m *DatabaseModule) dir(path *string, pattern string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if *path == "" {
+		dir, err := os.MkdirTemp("", pattern)
+		if err != nil {
+			return "", err
+		}
+		*path = dir
+		m.tempDirs = append(m.tempDirs, dir)
+	}
+	return *path, nil
+}
+
+func (m *DatabaseModule) sqlDir() (*sqldb.Dir, error) {
+	dir, err := m.dir(&m.SQLDir, "sdktest-sqldb-*")
+	if err != nil {
+		return nil, err
+	}
+	return sqldb.NewDir(dir), nil
+}
+
+// SQLDB opens the database ref under SQLDir, with the same per-owner layout as hosts.
+func (m *DatabaseModule) SQLDB(ref api.SQLDBRef) (api.SQLDB, error) {
+	dir, err := m.sqlDir()
+	if err != nil {
+		return nil, err
+	}
+	return dir.Open(ref)
+}
+
+// ExportDB exports a consistent copy of the database ref.
+func (m *DatabaseModule) ExportDB(ctx context.Context, ref api.DBRef, w io.Writer) (api.DBBackupInfo, error) {
+	switch ref.Kind {
+	case api.DBKindKV:
+		db := m.DB(ref.Name)
+		if db == nil {
+			return api.DBBackupInfo{}, fmt.Errorf("sdktest.DatabaseModule.ExportDB: database %s not found", ref.Name)
+		}
+		return api.ExportKeyValueDB(w, db.clone(), ref)
+	case api.DBKindSQL:
+		dir, err := m.sqlDir()
+		if err != nil {
+			return api.DBBackupInfo{}, err
+		}
+		return dir.Export(ctx, ref, w)
+	}
+	return api.DBBackupInfo{}, fmt.Errorf("sdktest.DatabaseModule.ExportDB: unknown kind %q", ref.Kind)
+}
+
+// ImportDB replaces the content of the database ref, creating it if needed.
+func (m *DatabaseModule) ImportDB(ctx context.Context, ref api.DBRef, r io.Reader) (api.DBBackupInfo, error) {
+	switch ref.Kind {
+	case api.DBKindKV:
+		db, err := m.KeyValueDB(ref.Name, ref.DBType)
+		if err != nil {
+			return api.DBBackupInfo{}, err
+		}
+		info, err := api.ImportKeyValueDB(r, db)
+		info.Ref = ref
+		return info, err
+	case api.DBKindSQL:
+		dir, err := m.sqlDir()
+		if err != nil {
+			return api.DBBackupInfo{}, err
+		}
+		return dir.Import(ctx, ref, r)
+	}
+	return api.DBBackupInfo{}, fmt.Errorf("sdktest.DatabaseModule.ImportDB: unknown kind %q", ref.Kind)
+}
+
+func (m *DatabaseModule) backups() (*dbbackup.Store, error) {
+	dir, err := m.dir(&m.BackupDir, "sdktest-backups-*")
+	if err != nil {
+		return nil, err
+	}
+	return dbbackup.NewStore(dir, m.BackupKeep), nil
+}
+
+// Backup stores a backup of ref in BackupDir.
+func (m *DatabaseModule) Backup(ctx context.Context, ref api.DBRef) (api.DBBackupInfo, error) {
+	store, err := m.backups()
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	return store.Save(ref, func(w io.Writer) (api.DBBackupInfo, error) {
+		return m.ExportDB(ctx, ref, w)
+	})
+}
+
+func (m *DatabaseModule) Backups(_ context.Context, ref api.DBRef) ([]api.DBBackupInfo, error) {
+	store, err := m.backups()
+	if err != nil {
+		return nil, err
+	}
+	return store.List(ref)
+}
+
+func (m *DatabaseModule) RestoreBackup(ctx context.Context, ref api.DBRef, id string) (api.DBBackupInfo, error) {
+	store, err := m.backups()
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	f, err := store.Open(ref, id)
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer f.Close()
+	info, err := m.ImportDB(ctx, ref, f)
+	info.ID = id
+	return info, err
+}
+
+// Cleanup removes the temporary SQLDir and BackupDir created on first use. Close the databases
+// first.
+func (m *DatabaseModule) Cleanup() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	for _, dir := range m.tempDirs {
+		switch dir {
+		case m.SQLDir:
+			m.SQLDir = ""
+		case m.BackupDir:
+			m.BackupDir = ""
+		}
+		errs = append(errs, os.RemoveAll(dir))
+	}
+	m.tempDirs = nil
+	return errors.Join(errs...)
+}
+
+// DB returns the database opened under name, or nil.
+func (m *DatabaseModule) DB(name string) *KeyValueDB {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.dbs[strings.TrimSpace(name)]
+}
+
+var _ api.DatabaseModule = (*DatabaseModule)(nil)
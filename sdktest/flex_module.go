@@ -0,0 +1,182 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// FlexModule is a fake api.FlexModule. Every plugin sharing the same Frame sees the same state,
+// so it can also be used to test several plugins talking to each other.
+type FlexModule struct {
+	mu        sync.Mutex
+	kv        map[string]string
+	subs      map[string]map[chan []byte]struct{}
+	apis      map[string]flexAPI
+	watchers  map[chan api.FlexAPIEvent]struct{}
+	published []FlexPublished
+}
+
+type flexAPI struct {
+	info    api.FlexAPIInfo
+	handler func(context.Context, []byte) ([]byte, string)
+}
+
+// FlexPublished records one Publish call.
+type FlexPublished struct {
+	Topic   string
+	Payload []byte
+}
+
+func NewFlexModule() *FlexModule {
+	return &FlexModule{
+		kv:       map[string]string{},
+		subs:     map[string]map[chan []byte]struct{}{},
+		apis:     map[string]flexAPI{},
+		watchers: map[chan api.FlexAPIEvent]struct{}{},
+	}
+}
+
+func (m *FlexModule) Name() string { return api.NameFlexModule }
+
+func (m *FlexModule) Set(key string, val string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.kv[key] = val
+}
+
+func (m *FlexModule) Get(key string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.kv[key]
+	return v, ok
+}
+
+func (m *FlexModule) Publish(topic string, payloadJSON []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.published = append(m.published, FlexPublished{Topic: topic, Payload: append([]byte(nil), payloadJSON...)})
+	for ch := range m.subs[topic] {
+		select {
+		case ch <- append([]byte(nil), payloadJSON...):
+		default:
+		}
+	}
+}
+
+// Published returns every published payload in call order.
+func (m *FlexModule) Published() []FlexPublished {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]FlexPublished(nil), m.published...)
+}
+
+func (m *FlexModule) Subscribe(ctx context.Context, topic string) <-chan []byte {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ch := make(chan []byte, 256)
+	m.mu.Lock()
+	if m.subs[topic] == nil {
+		m.subs[topic] = map[chan []byte]struct{}{}
+	}
+	m.subs[topic][ch] = struct{}{}
+	m.mu.Unlock()
+	context.AfterFunc(ctx, func() {
+		m.mu.Lock()
+		delete(m.subs[topic], ch)
+		close(ch)
+		m.mu.Unlock()
+	})
+	return ch
+}
+
+func (m *FlexModule) Expose(apiName string, handler func(context.Context, []byte) ([]byte, string)) (func(), error) {
+	return m.ExposeAPI(api.FlexAPIInfo{Name: apiName}, handler)
+}
+
+// ExposeAPI keeps info.Owner as given: the RPC bridge sets it to the ID of the exposing plugin.
+func (m *FlexModule) ExposeAPI(info api.FlexAPIInfo, handler func(context.Context, []byte) ([]byte, string)) (func(), error) {
+	if info.Name == "" {
+		return func() {}, errors.New("sdktest.FlexModule.ExposeAPI: api name is empty")
+	}
+	if handler == nil {
+		return func() {}, errors.New("sdktest.FlexModule.ExposeAPI: handler is nil")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.apis[info.Name]; ok {
+		return func() {}, errors.New("sdktest.FlexModule.ExposeAPI: api " + info.Name + " already exposed")
+	}
+	m.apis[info.Name] = flexAPI{info: info, handler: handler}
+	m.notifyLocked(api.FlexAPIEvent{API: info, Available: true})
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			delete(m.apis, info.Name)
+			m.notifyLocked(api.FlexAPIEvent{API: info, Available: false})
+			m.mu.Unlock()
+		})
+	}, nil
+}
+
+func (m *FlexModule) notifyLocked(ev api.FlexAPIEvent) {
+	for ch := range m.watchers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (m *FlexModule) ListAPIs() []api.FlexAPIInfo {
+	m.mu.Lock()
+	out := make([]api.FlexAPIInfo, 0, len(m.apis))
+	for _, a := range m.apis {
+		out = append(out, a.info)
+	}
+	m.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func (m *FlexModule) WaitForAPI(ctx context.Context, apiName string) (api.FlexAPIInfo, error) {
+	return api.WaitForFlexAPI(ctx, m, apiName)
+}
+
+func (m *FlexModule) WatchAPIs(ctx context.Context) <-chan api.FlexAPIEvent {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ch := make(chan api.FlexAPIEvent, 256)
+	m.mu.Lock()
+	m.watchers[ch] = struct{}{}
+	m.mu.Unlock()
+	context.AfterFunc(ctx, func() {
+		m.mu.Lock()
+		delete(m.watchers, ch)
+		close(ch)
+		m.mu.Unlock()
+	})
+	return ch
+}
+
+func (m *FlexModule) Call(ctx context.Context, apiName string, argsJSON []byte) ([]byte, string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	m.mu.Lock()
+	handler := m.apis[apiName].handler
+	m.mu.Unlock()
+	if handler == nil {
+		return nil, "", errors.New("sdktest.FlexModule.Call: api " + apiName + " not found")
+	}
+	res, errStr := handler(ctx, append([]byte(nil), argsJSON...))
+	return res, errStr, nil
+}
+
+var _ api.FlexModule = (*FlexModule)(nil)
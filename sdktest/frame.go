@@ -0,0 +1,282 @@
+// Package sdktest provides in-process fakes of the EmptyDea host so plugins can be unit-tested
+// without the host binary.
+//
+// A typical test builds a Frame, drives a plugin through Init/Load and then injects events
+// (chat messages, player changes, terminal input) while asserting what the plugin sent back:
+//
+//	f := sdktest.NewFrame()
+//	p := &MyPlugin{}
+//	if err := f.LoadPlugin(ctx, p, "my-plugin", define.PluginConfig{Name: "my"}); err != nil { ... }
+//	f.Chat.Emit(&api.ChatMsg{Name: "Steve", Msg: []string{"hi"}})
+//	if got := f.Commands.Sent(); ... { ... }
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
+)
+
+// Frame is a scriptable define.Frame.
+// The exported module fields are pre-registered by NewFrame; tests may replace or remove them
+// with SetModule/RemoveModule.
+type Frame struct {
+	Chat         *ChatModule
+	Commands     *CommandsModule
+	Players      *PlayersModule
+	TerminalMenu *TerminalMenuModule
+	GameMenu     *GameMenuModule
+	Terminal     *TerminalModule
+	Logger       *LoggerModule
+	Flex         *FlexModule
+	Database     *DatabaseModule
+	StoragePath  *StoragePathModule
+	UQHolder     *UQHolderModule
+	Brain        *BrainModule
+
+	// HostVersionValue is sent to plugins as the host version, see define.HostVersionFrame.
+	HostVersionValue string
+
+	mu       sync.Mutex
+	modules  map[string]define.Module
+	configs  map[string]define.PluginConfig
+	upgrades []ConfigUpgrade
+
+	activate     listeners[struct{}]
+	configChange listeners[configChange]
+}
+
+type configChange struct {
+	id                   string
+	oldConfig, newConfig define.PluginConfig
+}
+
+// ConfigUpgrade records a call to UpgradePluginConfig or UpgradePluginFullConfig.
+type ConfigUpgrade struct {
+	ID     string
+	Config define.PluginConfig
+}
+
+// NewFrame returns a Frame with every fake module registered under its api.NameXXX name.
+func NewFrame() *Frame {
+	f := &Frame{
+		Chat:         NewChatModule(),
+		Commands:     NewCommandsModule(),
+		Players:      NewPlayersModule(),
+		TerminalMenu: NewTerminalMenuModule(),
+		GameMenu:     NewGameMenuModule(),
+		Terminal:     NewTerminalModule(),
+		Logger:       NewLoggerModule(),
+		Flex:         NewFlexModule(),
+		Database:     NewDatabaseModule(),
+		StoragePath:  NewStoragePathModule(""),
+		UQHolder:     NewUQHolderModule(),
+		Brain:        NewBrainModule(),
+		modules:      map[string]define.Module{},
+		configs:      map[string]define.PluginConfig{},
+	}
+	for _, m := range []define.Module{
+		f.Chat, f.Commands, f.Players, f.TerminalMenu, f.GameMenu, f.Terminal,
+		f.Logger, f.Flex, f.Database, f.StoragePath, f.UQHolder, f.Brain,
+	} {
+		f.modules[m.Name()] = m
+	}
+	return f
+}
+
+// SetModule registers (or replaces) a module under its Name().
+func (f *Frame) SetModule(m define.Module) {
+	if f == nil || m == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.modules == nil {
+		f.modules = map[string]define.Module{}
+	}
+	f.modules[m.Name()] = m
+}
+
+// RemoveModule makes GetModule report the module as missing.
+func (f *Frame) RemoveModule(name string) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.modules, name)
+}
+
+func (f *Frame) HostVersion() string { return f.HostVersionValue }
+
+func (f *Frame) ListModules() map[string]define.Module {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make(map[string]define.Module, len(f.modules))
+	for name, m := range f.modules {
+		out[name] = m
+	}
+	return out
+}
+
+func (f *Frame) GetModule(name string) (define.Module, bool) {
+	if f == nil {
+		return nil, false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	m, ok := f.modules[name]
+	return m, ok
+}
+
+// SetPluginConfig stores the config returned by GetPluginConfig(id).
+func (f *Frame) SetPluginConfig(id string, config define.PluginConfig) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.configs == nil {
+		f.configs = map[string]define.PluginConfig{}
+	}
+	f.configs[id] = config
+}
+
+// EditPluginConfig replaces the config of id like an operator editing its JSON file would,
+// firing the ConfigChange listeners of id with the previous and new config.
+func (f *Frame) EditPluginConfig(id string, config define.PluginConfig) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	if f.configs == nil {
+		f.configs = map[string]define.PluginConfig{}
+	}
+	old := f.configs[id]
+	f.configs[id] = config
+	f.mu.Unlock()
+	f.configChange.emit(configChange{id: id, oldConfig: old, newConfig: config})
+}
+
+func (f *Frame) GetPluginConfig(id string) (define.PluginConfig, bool) {
+	if f == nil {
+		return define.PluginConfig{}, false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cfg, ok := f.configs[id]
+	return cfg, ok
+}
+
+func (f *Frame) UpgradePluginConfig(id string, config map[string]interface{}) error {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cfg, ok := f.configs[id]
+	if !ok {
+		return errors.New("sdktest.Frame.UpgradePluginConfig: plugin " + id + " not found")
+	}
+	cfg.Config = config
+	f.configs[id] = cfg
+	f.upgrades = append(f.upgrades, ConfigUpgrade{ID: id, Config: cfg})
+	return nil
+}
+
+func (f *Frame) UpgradePluginFullConfig(id string, config define.PluginConfig) error {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.configs[id]; !ok {
+		return errors.New("sdktest.Frame.UpgradePluginFullConfig: plugin " + id + " not found")
+	}
+	f.configs[id] = config
+	f.upgrades = append(f.upgrades, ConfigUpgrade{ID: id, Config: config})
+	return nil
+}
+
+// ConfigUpgrades returns every config upgrade the plugins requested, in call order.
+func (f *Frame) ConfigUpgrades() []ConfigUpgrade {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]ConfigUpgrade(nil), f.upgrades...)
+}
+
+func (f *Frame) RegisterWhenActivate(handler func()) (string, error) {
+	if f == nil || handler == nil {
+		return "", errors.New("sdktest.Frame.RegisterWhenActivate: handler is nil")
+	}
+	return f.activate.add(func(struct{}) { handler() }), nil
+}
+
+func (f *Frame) UnregisterWhenActivate(listenerID string) bool {
+	if f == nil {
+		return false
+	}
+	return f.activate.remove(listenerID)
+}
+
+// Activate fires the activate event, like the host does after all plugins are loaded.
+func (f *Frame) Activate() {
+	if f == nil {
+		return
+	}
+	f.activate.emit(struct{}{})
+}
+
+func (f *Frame) RegisterWhenConfigChange(id string, handler func(oldConfig, newConfig define.PluginConfig)) (string, error) {
+	if f == nil || handler == nil {
+		return "", errors.New("sdktest.Frame.RegisterWhenConfigChange: handler is nil")
+	}
+	return f.configChange.add(func(ev configChange) {
+		if ev.id == id {
+			handler(ev.oldConfig, ev.newConfig)
+		}
+	}), nil
+}
+
+func (f *Frame) UnregisterWhenConfigChange(listenerID string) bool {
+	if f == nil {
+		return false
+	}
+	return f.configChange.remove(listenerID)
+}
+
+// ListCapabilities reports the fake modules as a host speaking the newest protocol would.
+func (f *Frame) ListCapabilities() (define.Capabilities, error) {
+	return protocol.HostCapabilities(f, protocol.ProtocolVersion), nil
+}
+
+// LoadPlugin stores config for id, then calls p.Init and p.Load the way DynamicLoader does.
+func (f *Frame) LoadPlugin(ctx context.Context, p api.Plugin, id string, config define.PluginConfig) error {
+	if f == nil || p == nil {
+		return errors.New("sdktest.Frame.LoadPlugin: plugin is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if config.Config == nil {
+		config.Config = map[string]interface{}{}
+	}
+	f.SetPluginConfig(id, config)
+	p.Init(f, id, config.Config)
+	return p.Load(ctx)
+}
+
+var (
+	_ define.Frame           = (*Frame)(nil)
+	_ define.CapabilityFrame = (*Frame)(nil)
+)
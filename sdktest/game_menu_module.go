@@ -0,0 +1,139 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// GameMenuModule is a fake api.GameMenuModule.
+type GameMenuModule struct {
+	mu      sync.Mutex
+	entries map[string]*api.GameMenuEntry
+	order   []string
+	subs    map[chan *api.GameMenuEntryInfo]struct{}
+}
+
+func NewGameMenuModule() *GameMenuModule {
+	return &GameMenuModule{
+		entries: map[string]*api.GameMenuEntry{},
+		subs:    map[chan *api.GameMenuEntryInfo]struct{}{},
+	}
+}
+
+func (m *GameMenuModule) Name() string { return api.NameGameMenuModule }
+
+func gameMenuEntryInfo(id string, e *api.GameMenuEntry) *api.GameMenuEntryInfo {
+	return &api.GameMenuEntryInfo{
+		EntryID:      id,
+		Triggers:     append([]string(nil), e.Triggers...),
+		ArgumentHint: e.ArgumentHint,
+		Usage:        e.Usage,
+	}
+}
+
+func (m *GameMenuModule) RegisterGameMenuEntry(entry *api.GameMenuEntry) (string, error) {
+	if entry == nil {
+		return "", errors.New("sdktest.GameMenuModule.RegisterGameMenuEntry: entry is nil")
+	}
+	id := uuid.NewString()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[id] = entry
+	m.order = append(m.order, id)
+	info := gameMenuEntryInfo(id, entry)
+	for ch := range m.subs {
+		select {
+		case ch <- info:
+		default:
+		}
+	}
+	return id, nil
+}
+
+func (m *GameMenuModule) RemoveMenuEntry(entryID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, entryID)
+	for i, id := range m.order {
+		if id == entryID {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Entries returns a snapshot of the registered entries in registration order.
+func (m *GameMenuModule) Entries() []*api.GameMenuEntryInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]*api.GameMenuEntryInfo, 0, len(m.order))
+	for _, id := range m.order {
+		out = append(out, gameMenuEntryInfo(id, m.entries[id]))
+	}
+	return out
+}
+
+func (m *GameMenuModule) SubscribeEntries(ctx context.Context) (<-chan *api.GameMenuEntryInfo, func(), error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	m.mu.Lock()
+	ch := make(chan *api.GameMenuEntryInfo, len(m.order)+64)
+	for _, id := range m.order {
+		ch <- gameMenuEntryInfo(id, m.entries[id])
+	}
+	m.subs[ch] = struct{}{}
+	m.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			m.mu.Lock()
+			delete(m.subs, ch)
+			close(ch)
+			m.mu.Unlock()
+		})
+	}
+	context.AfterFunc(ctx, cancel)
+	return ch, cancel, nil
+}
+
+func (m *GameMenuModule) TriggerEntry(entryID string, chat *api.ChatMsg) {
+	m.mu.Lock()
+	entry := m.entries[entryID]
+	m.mu.Unlock()
+	if entry == nil || entry.OnTrigger == nil {
+		return
+	}
+	entry.OnTrigger(chat)
+}
+
+// Trigger selects the first entry with a matching trigger on behalf of chat.Name.
+func (m *GameMenuModule) Trigger(trigger string, chat *api.ChatMsg) bool {
+	m.mu.Lock()
+	var hit *api.GameMenuEntry
+	for _, id := range m.order {
+		for _, t := range m.entries[id].Triggers {
+			if t == trigger {
+				hit = m.entries[id]
+				break
+			}
+		}
+		if hit != nil {
+			break
+		}
+	}
+	m.mu.Unlock()
+	if hit == nil || hit.OnTrigger == nil {
+		return false
+	}
+	hit.OnTrigger(chat)
+	return true
+}
+
+var _ api.GameMenuModule = (*GameMenuModule)(nil)
@@ -0,0 +1,296 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// KeyValueDB is an in-memory api.KeyValueDB. Iterate visits keys in ascending order.
+// Expired keys are swept on every access and by Sweep.
+type KeyValueDB struct {
+	// Clock returns the current time used for expiry; time.Now when nil.
+	Clock func() time.Time
+
+	mu       sync.Mutex
+	data     map[string]string
+	expires  map[string]time.Time
+	closed   bool
+	watchers map[chan api.KVChange]string
+}
+
+func NewKeyValueDB() *KeyValueDB {
+	return &KeyValueDB{data: map[string]string{}, expires: map[string]time.Time{}, watchers: map[chan api.KVChange]string{}}
+}
+
+func (db *KeyValueDB) now() time.Time {
+	if db.Clock != nil {
+		return db.Clock()
+	}
+	return time.Now()
+}
+
+// sweep deletes the expired keys; db.mu must be held.
+func (db *KeyValueDB) sweep() int {
+	now := db.now()
+	n := 0
+	for key, deadline := range db.expires {
+		if !now.Before(deadline) {
+			db.write(api.KVWrite{Key: key, Delete: true})
+			n++
+		}
+	}
+	return n
+}
+
+// Sweep deletes the expired keys, notifying watchers, and returns how many were deleted.
+func (db *KeyValueDB) Sweep() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.sweep()
+}
+
+// write applies w and notifies the watchers; db.mu must be held.
+func (db *KeyValueDB) write(w api.KVWrite) {
+	old, existed := db.data[w.Key]
+	change := api.KVChange{Op: api.KVChangeSet, Key: w.Key, OldValue: old, OldExists: existed, NewValue: w.Value}
+	delete(db.expires, w.Key)
+	if w.Delete {
+		if !existed {
+			// Like real backends, deleting a missing key is not a change.
+			return
+		}
+		delete(db.data, w.Key)
+		change.Op, change.NewValue = api.KVChangeDelete, ""
+	} else {
+		db.data[w.Key] = w.Value
+		if w.TTL > 0 {
+			db.expires[w.Key] = db.now().Add(w.TTL)
+		}
+	}
+	for ch, prefix := range db.watchers {
+		if !strings.HasPrefix(w.Key, prefix) {
+			continue
+		}
+		select {
+		case ch <- change:
+		default:
+		}
+	}
+}
+
+var errKeyValueDBClosed = errors.New("sdktest.KeyValueDB: database is closed")
+
+func (db *KeyValueDB) Get(key string) (string, bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return "", false, errKeyValueDBClosed
+	}
+	db.sweep()
+	v, ok := db.data[key]
+	return v, ok, nil
+}
+
+func (db *KeyValueDB) Set(key, value string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return errKeyValueDBClosed
+	}
+	db.sweep()
+	db.write(api.KVWrite{Key: key, Value: value})
+	return nil
+}
+
+func (db *KeyValueDB) SetWithTTL(key, value string, ttl time.Duration) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return errKeyValueDBClosed
+	}
+	db.sweep()
+	db.write(api.KVWrite{Key: key, Value: value, TTL: ttl})
+	return nil
+}
+
+func (db *KeyValueDB) TTL(key string) (time.Duration, bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return 0, false, errKeyValueDBClosed
+	}
+	db.sweep()
+	if _, ok := db.data[key]; !ok {
+		return 0, false, nil
+	}
+	deadline, ok := db.expires[key]
+	if !ok {
+		return 0, true, nil
+	}
+	return deadline.Sub(db.now()), true, nil
+}
+
+func (db *KeyValueDB) Delete(key string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return errKeyValueDBClosed
+	}
+	db.sweep()
+	db.write(api.KVWrite{Key: key, Delete: true})
+	return nil
+}
+
+func (db *KeyValueDB) Batch(b api.KVBatch) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return errKeyValueDBClosed
+	}
+	db.sweep()
+	for _, c := range b.Conditions {
+		v, ok := db.data[c.Key]
+		if !c.Holds(v, ok) {
+			return api.ErrKVConflict
+		}
+	}
+	for _, w := range b.Writes {
+		db.write(w)
+	}
+	return nil
+}
+
+func (db *KeyValueDB) Scan(q api.KVScan) (api.KVPage, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return api.KVPage{}, errKeyValueDBClosed
+	}
+	db.sweep()
+	keys := make([]string, 0, len(db.data))
+	for k := range db.data {
+		if q.Contains(k) && q.After(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	if q.Reverse {
+		slices.Reverse(keys)
+	}
+	var page api.KVPage
+	limit := q.PageLimit()
+	if len(keys) > limit {
+		keys = keys[:limit]
+		page.NextCursor = api.KVCursor(keys[limit-1])
+	}
+	for _, k := range keys {
+		page.Entries = append(page.Entries, api.KVEntry{Key: k, Value: db.data[k]})
+	}
+	return page, nil
+}
+
+func (db *KeyValueDB) Iterate(fn func(key, value string) bool) error {
+	if fn == nil {
+		return nil
+	}
+	db.mu.Lock()
+	if db.closed {
+		db.mu.Unlock()
+		return errKeyValueDBClosed
+	}
+	db.sweep()
+	keys := make([]string, 0, len(db.data))
+	for k := range db.data {
+		keys = append(keys, k)
+	}
+	snapshot := make(map[string]string, len(db.data))
+	for k, v := range db.data {
+		snapshot[k] = v
+	}
+	db.mu.Unlock()
+
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !fn(k, snapshot[k]) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (db *KeyValueDB) Watch(ctx context.Context, prefix string) (<-chan api.KVChange, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return nil, errKeyValueDBClosed
+	}
+	ch := make(chan api.KVChange, 64)
+	db.watchers[ch] = prefix
+	context.AfterFunc(ctx, func() {
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		if _, ok := db.watchers[ch]; ok {
+			delete(db.watchers, ch)
+			close(ch)
+		}
+	})
+	return ch, nil
+}
+
+// Close closes the database and ends its watches.
+func (db *KeyValueDB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.closed = true
+	for ch := range db.watchers {
+		delete(db.watchers, ch)
+		close(ch)
+	}
+	return nil
+}
+
+// Snapshot returns a copy of the unexpired data, even after Close.
+func (db *KeyValueDB) Snapshot() map[string]string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.sweep()
+	out := make(map[string]string, len(db.data))
+	for k, v := range db.data {
+		out[k] = v
+	}
+	return out
+}
+
+// clone returns a copy of the unexpired data with its deadlines, for consistent exports.
+func (db *KeyValueDB) clone() *KeyValueDB {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.sweep()
+	out := NewKeyValueDB()
+	out.Clock = db.Clock
+	for k, v := range db.data {
+		out.data[k] = v
+	}
+	for k, deadline := range db.expires {
+		out.expires[k] = deadline
+	}
+	return out
+}
+
+var (
+	_ api.KeyValueDB = (*KeyValueDB)(nil)
+	_ api.KVBatcher  = (*KeyValueDB)(nil)
+	_ api.KVScanner  = (*KeyValueDB)(nil)
+	_ api.KVWatcher  = (*KeyValueDB)(nil)
+	_ api.KVExpirer  = (*KeyValueDB)(nil)
+)
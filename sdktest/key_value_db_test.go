@@ -0,0 +1,43 @@
+package sdktest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+func TestKeyValueDBWatchSkipsMissingDeletes(t *testing.T) {
+	db := NewKeyValueDB()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := db.Watch(ctx, "")
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	if err := db.Delete("missing"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := db.Set("steve", "1"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := db.Delete("steve"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	want := []api.KVChange{
+		{Op: api.KVChangeSet, Key: "steve", NewValue: "1"},
+		{Op: api.KVChangeDelete, Key: "steve", OldValue: "1", OldExists: true},
+	}
+	for _, w := range want {
+		if got := <-ch; got != w {
+			t.Fatalf("change = %+v, want %+v", got, w)
+		}
+	}
+	select {
+	case got := <-ch:
+		t.Fatalf("unexpected change %+v", got)
+	default:
+	}
+}
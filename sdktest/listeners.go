@@ -0,0 +1,63 @@
+package sdktest
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// listeners is a small registry used by the fakes to mimic the host's
+// RegisterWhenXXX/UnregisterWhenXXX pairs.
+type listeners[T any] struct {
+	mu       sync.Mutex
+	seq      uint64
+	handlers map[string]listener[T]
+}
+
+type listener[T any] struct {
+	seq     uint64
+	handler func(T)
+}
+
+func (l *listeners[T]) add(handler func(T)) string {
+	id := uuid.NewString()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.handlers == nil {
+		l.handlers = make(map[string]listener[T])
+	}
+	l.seq++
+	l.handlers[id] = listener[T]{seq: l.seq, handler: handler}
+	return id
+}
+
+func (l *listeners[T]) remove(id string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.handlers[id]; !ok {
+		return false
+	}
+	delete(l.handlers, id)
+	return true
+}
+
+func (l *listeners[T]) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.handlers)
+}
+
+// emit calls every handler synchronously in registration order.
+func (l *listeners[T]) emit(v T) {
+	l.mu.Lock()
+	list := make([]listener[T], 0, len(l.handlers))
+	for _, h := range l.handlers {
+		list = append(list, h)
+	}
+	l.mu.Unlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].seq < list[j].seq })
+	for _, h := range list {
+		h.handler(v)
+	}
+}
@@ -0,0 +1,172 @@
+package sdktest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// LogEntry records one LoggerModule call.
+type LogEntry struct {
+	Time  time.Time
+	Scope string
+	Level api.Level
+	Msg   string
+	// Err and Fields are set by LogRecord.
+	Err    string
+	Fields []api.LogField
+}
+
+// Field returns the value of the first field named key.
+func (e LogEntry) Field(key string) (any, bool) {
+	return api.LogRecord{Fields: e.Fields}.Field(key)
+}
+
+// LoggerModule is a fake api.LoggerModule that keeps every entry in memory.
+type LoggerModule struct {
+	mu      sync.Mutex
+	entries []LogEntry
+	tails   map[chan api.LogRecord]api.LogQuery
+}
+
+func NewLoggerModule() *LoggerModule {
+	return &LoggerModule{tails: map[chan api.LogRecord]api.LogQuery{}}
+}
+
+func (e LogEntry) record() api.LogRecord {
+	return api.LogRecord{Time: e.Time, Scope: e.Scope, Level: e.Level, Msg: e.Msg, Err: e.Err, Fields: e.Fields}
+}
+
+// add stores e and sends it to the matching tails; m.mu must be held.
+func (m *LoggerModule) add(e LogEntry) {
+	m.entries = append(m.entries, e)
+	record := e.record()
+	for ch, q := range m.tails {
+		if !q.Match(record) {
+			continue
+		}
+		select {
+		case ch <- record:
+		default:
+		}
+	}
+}
+
+func (m *LoggerModule) Name() string { return api.NameLoggerModule }
+
+func (m *LoggerModule) Log(scope string, level api.Level, msg string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.add(LogEntry{Time: time.Now(), Scope: scope, Level: level, Msg: msg})
+}
+
+func (m *LoggerModule) LogRecord(record api.LogRecord) {
+	record = record.Normalized()
+	if record.Time.IsZero() {
+		record.Time = time.Now()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.add(LogEntry{
+		Time: record.Time, Scope: record.Scope, Level: record.Level, Msg: record.Msg,
+		Err: record.Err, Fields: record.Fields,
+	})
+}
+
+// QueryLogs pages through the recorded entries. Cursors are entry positions.
+func (m *LoggerModule) QueryLogs(_ context.Context, q api.LogQuery) (api.LogPage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	step, i := -1, len(m.entries)-1
+	if q.Ascending {
+		step, i = 1, 0
+	}
+	if q.Cursor != "" {
+		n, err := strconv.Atoi(q.Cursor)
+		if err != nil || n < 0 || n > len(m.entries) {
+			return api.LogPage{}, fmt.Errorf("sdktest.LoggerModule.QueryLogs: invalid cursor %q", q.Cursor)
+		}
+		i = n
+	}
+	var page api.LogPage
+	limit := q.PageLimit()
+	for ; i >= 0 && i < len(m.entries); i += step {
+		record := m.entries[i].record()
+		if !q.Match(record) {
+			continue
+		}
+		if len(page.Records) == limit {
+			page.NextCursor = strconv.Itoa(i)
+			break
+		}
+		page.Records = append(page.Records, record)
+	}
+	return page, nil
+}
+
+func (m *LoggerModule) TailLogs(ctx context.Context, q api.LogQuery) (<-chan api.LogRecord, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ch := make(chan api.LogRecord, 64)
+	m.mu.Lock()
+	m.tails[ch] = q
+	m.mu.Unlock()
+	context.AfterFunc(ctx, func() {
+		m.mu.Lock()
+		delete(m.tails, ch)
+		close(ch)
+		m.mu.Unlock()
+	})
+	return ch, nil
+}
+
+func (m *LoggerModule) Info(scope, msg string) { m.Log(scope, api.LevelInfo, msg) }
+
+func (m *LoggerModule) Warn(scope, msg string) { m.Log(scope, api.LevelWarn, msg) }
+
+func (m *LoggerModule) Error(scope, msg string) { m.Log(scope, api.LevelError, msg) }
+
+func (m *LoggerModule) Success(scope, msg string) { m.Log(scope, api.LevelSuccess, msg) }
+
+// Entries returns every logged entry in call order.
+func (m *LoggerModule) Entries() []LogEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]LogEntry(nil), m.entries...)
+}
+
+// EntriesAt returns the entries logged with level.
+func (m *LoggerModule) EntriesAt(level api.Level) []LogEntry {
+	var out []LogEntry
+	for _, e := range m.Entries() {
+		if e.Level == level {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Contains reports whether any logged message contains substr.
+func (m *LoggerModule) Contains(substr string) bool {
+	for _, e := range m.Entries() {
+		if strings.Contains(e.Msg, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset clears the recorded entries.
+func (m *LoggerModule) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = nil
+}
+
+var _ api.LoggerModule = (*LoggerModule)(nil)
@@ -0,0 +1,224 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/define"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
+)
+
+// loopbackMu serialises protocol.SetServeTestConfig, which is process global.
+var loopbackMu sync.Mutex
+
+// envProtocolVersions is the variable go-plugin clients use to announce their protocol versions.
+const envProtocolVersions = "PLUGIN_PROTOCOL_VERSIONS"
+
+func protocolVersionsEnv() string {
+	versions := make([]string, 0, protocol.ProtocolVersion)
+	for v := protocol.ProtocolVersion1; v <= protocol.ProtocolVersion; v++ {
+		versions = append(versions, strconv.Itoa(v))
+	}
+	return strings.Join(versions, ",")
+}
+
+// LoopbackStartTimeout bounds how long StartLoopback waits for the plugin server to come up.
+var LoopbackStartTimeout = 10 * time.Second
+
+// Loopback runs a plugin through the real go-plugin RPC layer inside the current process.
+//
+// The plugin is served by protocol.Serve in go-plugin test mode and the host side talks to it
+// through protocol.DynamicRPCPlugin, exactly like DynamicLoader does with a plugin binary.
+// Every module call the plugin makes therefore goes through the *RPCClient/*RPCServer pairs
+// and the broker callbacks before reaching the host Frame.
+type Loopback struct {
+	// HostFrame is the Frame served to the plugin (usually a *Frame from NewFrame).
+	HostFrame define.Frame
+	// Plugin is the plugin implementation running on the plugin side.
+	Plugin api.Plugin
+	// RPC is the host-side client used to drive Init/Load/Unload.
+	RPC protocol.RPCPlugin
+
+	client  *plugin.Client
+	conn    plugin.ClientProtocol
+	cancel  context.CancelFunc
+	closeCh chan struct{}
+	once    sync.Once
+}
+
+// StartLoopback serves p in test mode, connects to it and calls RPC.Init with hostFrame.
+// A nil p starts an api.BasicPlugin, which is handy to obtain an RPC-backed Frame via RemoteFrame.
+// Close must be called to stop the plugin server.
+// opts are passed to protocol.Serve; protocol.WithGRPC() runs the plugin over the gRPC transport.
+func StartLoopback(p api.Plugin, hostFrame define.Frame, id string, config map[string]interface{}, opts ...protocol.ServeOption) (*Loopback, error) {
+	if p == nil {
+		p = &api.BasicPlugin{}
+	}
+	if hostFrame == nil {
+		hostFrame = NewFrame()
+	}
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reattachCh := make(chan *plugin.ReattachConfig, 1)
+	closeCh := make(chan struct{})
+
+	loopbackMu.Lock()
+	// go-plugin reads the versions offered by the host from the environment; announce ours
+	// so the loopback negotiates the newest protocol like a real host would.
+	prevVersions, hadVersions := os.LookupEnv(envProtocolVersions)
+	_ = os.Setenv(envProtocolVersions, protocolVersionsEnv())
+	restore := protocol.SetServeTestConfig(&plugin.ServeTestConfig{
+		Context:          ctx,
+		ReattachConfigCh: reattachCh,
+		CloseCh:          closeCh,
+	})
+	go protocol.Serve(p, opts...)
+
+	var reattach *plugin.ReattachConfig
+	select {
+	case reattach = <-reattachCh:
+	case <-closeCh:
+	case <-time.After(LoopbackStartTimeout):
+	}
+	restore()
+	if hadVersions {
+		_ = os.Setenv(envProtocolVersions, prevVersions)
+	} else {
+		_ = os.Unsetenv(envProtocolVersions)
+	}
+	loopbackMu.Unlock()
+
+	if reattach == nil {
+		cancel()
+		return nil, errors.New("sdktest.StartLoopback: plugin server did not start")
+	}
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig: protocol.Handshake,
+		// Reattached clients skip version negotiation and dispense from Plugins directly.
+		Plugins:          protocol.VersionedPlugins(nil)[reattach.ProtocolVersion],
+		Reattach:         reattach,
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolNetRPC, plugin.ProtocolGRPC},
+		GRPCDialOptions:  protocol.GRPCDialOptions(),
+		Logger: hclog.New(&hclog.LoggerOptions{
+			Name:   "sdktest",
+			Level:  hclog.Error,
+			Output: io.Discard,
+		}),
+	})
+	l := &Loopback{
+		HostFrame: hostFrame,
+		Plugin:    p,
+		client:    client,
+		cancel:    cancel,
+		closeCh:   closeCh,
+	}
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		_ = l.Close()
+		return nil, fmt.Errorf("sdktest.StartLoopback: connect plugin failed: %w", err)
+	}
+	l.conn = rpcClient
+	raw, err := rpcClient.Dispense(protocol.PluginKey)
+	if err != nil {
+		_ = l.Close()
+		return nil, fmt.Errorf("sdktest.StartLoopback: dispense plugin failed: %w", err)
+	}
+	rpcPlugin, ok := raw.(protocol.RPCPlugin)
+	if !ok {
+		_ = l.Close()
+		return nil, fmt.Errorf("sdktest.StartLoopback: unexpected plugin client type %T", raw)
+	}
+	l.RPC = rpcPlugin
+
+	if err := rpcPlugin.Init(hostFrame, id, config); err != nil {
+		_ = l.Close()
+		return nil, fmt.Errorf("sdktest.StartLoopback: init plugin failed: %w", err)
+	}
+	return l, nil
+}
+
+// Load calls RPCPlugin.Load over the wire; the plugin receives the deadline of ctx.
+// Like a host, it refuses plugins that do not support the version of HostFrame
+// (see define.HostVersionFrame and protocol.WithHostVersion).
+func (l *Loopback) Load(ctx context.Context) error {
+	if l == nil || l.RPC == nil {
+		return errors.New("sdktest.Loopback.Load: loopback is not started")
+	}
+	var hostVersion string
+	if hv, ok := l.HostFrame.(define.HostVersionFrame); ok {
+		hostVersion = hv.HostVersion()
+	}
+	if err := l.RPC.VersionInfo().CheckCompatibility(hostVersion, define.SDKVersion); err != nil {
+		return fmt.Errorf("sdktest.Loopback.Load: %w", err)
+	}
+	return l.RPC.Load(ctx)
+}
+
+// Unload calls RPCPlugin.Unload over the wire; the plugin receives the deadline of ctx.
+func (l *Loopback) Unload(ctx context.Context) error {
+	if l == nil || l.RPC == nil {
+		return errors.New("sdktest.Loopback.Unload: loopback is not started")
+	}
+	return l.RPC.Unload(ctx)
+}
+
+// ProtocolVersion returns the protocol version negotiated with the plugin server.
+func (l *Loopback) ProtocolVersion() int {
+	if l == nil || l.client == nil {
+		return 0
+	}
+	return l.client.NegotiatedVersion()
+}
+
+// RemoteFrame returns the Frame the plugin received in Init.
+// It is backed by the frame RPC client, so module lookups return the *RPCClient implementations.
+func (l *Loopback) RemoteFrame() define.Frame {
+	if l == nil || l.Plugin == nil {
+		return nil
+	}
+	return l.Plugin.Frame()
+}
+
+// Close disconnects the host client and stops the plugin server.
+// The connection is torn down like when a plugin process exits, so the host-side module and
+// callback servers of the plugin return and release what they hold.
+func (l *Loopback) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.once.Do(func() {
+		// In test mode Kill does not close the connection of a reattached client.
+		if l.conn != nil {
+			_ = l.conn.Close()
+		}
+		if l.client != nil {
+			l.client.Kill()
+		}
+		if l.cancel != nil {
+			l.cancel()
+		}
+		if l.closeCh != nil {
+			select {
+			case <-l.closeCh:
+			case <-time.After(LoopbackStartTimeout):
+			}
+		}
+	})
+	return nil
+}
@@ -0,0 +1,517 @@
+package sdktest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+	"github.com/Yeah114/EmptyDea-plugin-sdk/protocol"
+)
+
+type loopbackTransport struct {
+	name    string
+	opts    []protocol.ServeOption
+	version int
+}
+
+var loopbackTransports = []loopbackTransport{
+	{"netrpc", nil, protocol.ProtocolVersion2},
+	{"grpc", []protocol.ServeOption{protocol.WithGRPC()}, protocol.ProtocolVersion3},
+}
+
+func startLoopback(t *testing.T, tr loopbackTransport, p api.Plugin, host *Frame, id string) *Loopback {
+	t.Helper()
+	l, err := StartLoopback(p, host, id, map[string]interface{}{"greeting": "hi"}, tr.opts...)
+	if err != nil {
+		t.Fatalf("StartLoopback: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	return l
+}
+
+func remoteModule[T any](t *testing.T, l *Loopback, name string) T {
+	t.Helper()
+	m, ok := api.GetModule[T](l.RemoteFrame(), name)
+	if !ok {
+		t.Fatalf("remote frame has no %s module", name)
+	}
+	return m
+}
+
+func receive[T any](t *testing.T, ch <-chan T, what string) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for %s", what)
+	}
+	var zero T
+	return zero
+}
+
+type lifecyclePlugin struct {
+	api.BasicPlugin
+	loaded, unloaded, hadDeadline atomic.Bool
+}
+
+func (p *lifecyclePlugin) Load(ctx context.Context) error {
+	_, ok := ctx.Deadline()
+	p.hadDeadline.Store(ok)
+	p.loaded.Store(true)
+	return nil
+}
+
+func (p *lifecyclePlugin) Unload(context.Context) error {
+	p.unloaded.Store(true)
+	return nil
+}
+
+func TestLoopbackLifecycle(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			p := &lifecyclePlugin{}
+			l := startLoopback(t, tr, p, host, "hello")
+			if got := l.ProtocolVersion(); got != tr.version {
+				t.Fatalf("ProtocolVersion = %d, want %d", got, tr.version)
+			}
+			if p.ID() != "hello" || p.Config()["greeting"] != "hi" {
+				t.Fatalf("Init got id %q config %v", p.ID(), p.Config())
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := l.Load(ctx); err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if !p.loaded.Load() || !p.hadDeadline.Load() {
+				t.Fatalf("Load reached plugin: %v, with deadline: %v", p.loaded.Load(), p.hadDeadline.Load())
+			}
+
+			remoteModule[api.TerminalModule](t, l, api.NameTerminalModule).Info("hello", "over the wire")
+			if !host.Terminal.Contains("over the wire") {
+				t.Fatalf("terminal lines = %v", host.Terminal.Lines())
+			}
+
+			if err := l.Unload(ctx); err != nil {
+				t.Fatalf("Unload: %v", err)
+			}
+			if !p.unloaded.Load() {
+				t.Fatal("Unload did not reach the plugin")
+			}
+		})
+	}
+}
+
+// stuckPlugin blocks in Load until release is closed, ignoring ctx unless honour is set.
+type stuckPlugin struct {
+	api.BasicPlugin
+	honour  bool
+	release chan struct{}
+}
+
+func (p *stuckPlugin) Load(ctx context.Context) error {
+	if p.honour {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	<-p.release
+	return nil
+}
+
+func TestLoopbackLoadCancellation(t *testing.T) {
+	prev := protocol.CancelGracePeriod
+	protocol.CancelGracePeriod = 100 * time.Millisecond
+	t.Cleanup(func() { protocol.CancelGracePeriod = prev })
+
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			for _, honour := range []bool{true, false} {
+				p := &stuckPlugin{honour: honour, release: make(chan struct{})}
+				l := startLoopback(t, tr, p, NewFrame(), "stuck")
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				err := l.Load(ctx)
+				cancel()
+				close(p.release)
+				if err == nil {
+					t.Fatalf("honour=%v: Load returned nil", honour)
+				}
+				if abandoned := errors.Is(err, protocol.ErrCallAbandoned); abandoned == honour {
+					t.Fatalf("honour=%v: Load = %v", honour, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLoopbackChatCallback(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			l := startLoopback(t, tr, nil, host, "chat")
+			chat := remoteModule[api.ChatModule](t, l, api.NameChatModule)
+
+			all := make(chan *api.ChatMsg, 4)
+			id, err := chat.RegisterWhenChatMsg(func(event *api.ChatMsg) { all <- event })
+			if err != nil {
+				t.Fatalf("RegisterWhenChatMsg: %v", err)
+			}
+			named := make(chan *api.ChatMsg, 4)
+			if _, err := chat.RegisterWhenReceiveMsgFromSenderNamed("Alex", func(event *api.ChatMsg) { named <- event }); err != nil {
+				t.Fatalf("RegisterWhenReceiveMsgFromSenderNamed: %v", err)
+			}
+
+			host.Chat.Say("Steve", "hello", "there")
+			if got := receive(t, all, "chat message"); got.Name != "Steve" || len(got.Msg) != 2 || got.Msg[1] != "there" {
+				t.Fatalf("callback got %+v", got)
+			}
+			host.Chat.Say("Alex", "hi")
+			if got := receive(t, named, "named chat message"); got.Name != "Alex" {
+				t.Fatalf("named callback got %+v", got)
+			}
+			receive(t, all, "second chat message")
+			select {
+			case got := <-named:
+				t.Fatalf("named callback got a message from %q", got.Name)
+			default:
+			}
+
+			if !chat.UnregisterWhenChatMsg(id) {
+				t.Fatal("UnregisterWhenChatMsg returned false")
+			}
+			host.Chat.Say("Steve", "bye")
+			select {
+			case got := <-all:
+				t.Fatalf("unregistered callback got %+v", got)
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestLoopbackFlexPubSub(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			l := startLoopback(t, tr, nil, host, "flex")
+			flex := remoteModule[api.FlexModule](t, l, api.NameFlexModule)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			ch := flex.Subscribe(ctx, "scores")
+			host.Flex.Publish("scores", []byte(`{"steve":1}`))
+			if got := receive(t, ch, "host publication"); string(got) != `{"steve":1}` {
+				t.Fatalf("subscriber got %s", got)
+			}
+
+			flex.Publish("scores", []byte(`{"alex":2}`))
+			published := host.Flex.Published()
+			if last := published[len(published)-1]; last.Topic != "scores" || string(last.Payload) != `{"alex":2}` {
+				t.Fatalf("host saw %+v", published)
+			}
+			if got := receive(t, ch, "plugin publication"); string(got) != `{"alex":2}` {
+				t.Fatalf("subscriber got %s", got)
+			}
+
+			cancel()
+			for range ch {
+			}
+		})
+	}
+}
+
+// TestLoopbackFlexAPIsReleasedOnClose checks that the APIs of a plugin whose connection ends are
+// withdrawn, so other plugins waiting on WatchAPIs learn about it.
+func TestLoopbackFlexAPIsReleasedOnClose(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events := host.Flex.WatchAPIs(ctx)
+
+			l := startLoopback(t, tr, nil, host, "provider")
+			flex := remoteModule[api.FlexModule](t, l, api.NameFlexModule)
+			if _, err := flex.Expose("greet", func(context.Context, []byte) ([]byte, string) { return []byte(`"hi"`), "" }); err != nil {
+				t.Fatalf("Expose: %v", err)
+			}
+			if ev := receive(t, events, "expose event"); !ev.Available || ev.API.Name != "greet" || ev.API.Owner != "provider" {
+				t.Fatalf("expose event = %+v", ev)
+			}
+
+			_ = l.Close()
+			if ev := receive(t, events, "withdraw event"); ev.Available || ev.API.Name != "greet" {
+				t.Fatalf("withdraw event = %+v", ev)
+			}
+			if apis := host.Flex.ListAPIs(); len(apis) != 0 {
+				t.Fatalf("ListAPIs after Close = %+v", apis)
+			}
+		})
+	}
+}
+
+func TestLoopbackFlexWatchEndsWithHost(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			l := startLoopback(t, tr, nil, host, "watcher")
+			events := remoteModule[api.FlexModule](t, l, api.NameFlexModule).WatchAPIs(context.Background())
+
+			if _, err := host.Flex.Expose("greet", func(context.Context, []byte) ([]byte, string) { return nil, "" }); err != nil {
+				t.Fatalf("Expose: %v", err)
+			}
+			if ev := receive(t, events, "expose event"); !ev.Available || ev.API.Name != "greet" {
+				t.Fatalf("expose event = %+v", ev)
+			}
+
+			_ = l.Close()
+			timeout := time.After(5 * time.Second)
+			for {
+				select {
+				case _, ok := <-events:
+					if !ok {
+						return
+					}
+				case <-timeout:
+					t.Fatal("WatchAPIs channel still open after the host side ended")
+				}
+			}
+		})
+	}
+}
+
+func TestLoopbackKeyValueIterate(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			l := startLoopback(t, tr, nil, host, "kv")
+			db, err := remoteModule[api.DatabaseModule](t, l, api.NameDatabaseModule).KeyValueDB("scores", "")
+			if err != nil {
+				t.Fatalf("KeyValueDB: %v", err)
+			}
+			want := map[string]string{"alex": "2", "steve": "1", "zuri": "3"}
+			for k, v := range want {
+				if err := db.Set(k, v); err != nil {
+					t.Fatalf("Set(%q): %v", k, err)
+				}
+			}
+			if got := host.Database.DB("scores").Snapshot(); len(got) != len(want) {
+				t.Fatalf("host database = %v", got)
+			}
+
+			got := map[string]string{}
+			if err := db.Iterate(func(key, value string) bool {
+				got[key] = value
+				return true
+			}); err != nil {
+				t.Fatalf("Iterate: %v", err)
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Fatalf("Iterate = %v, want %v", got, want)
+				}
+			}
+
+			n := 0
+			if err := db.Iterate(func(key, value string) bool {
+				n++
+				return false
+			}); err != nil {
+				t.Fatalf("Iterate stopped early: %v", err)
+			}
+			if n != 1 {
+				t.Fatalf("Iterate called fn %d times after it returned false", n)
+			}
+		})
+	}
+}
+
+// TestLoopbackCloseReleasesSQLTransaction checks that Close tears the connection down like a
+// crashed plugin: the host must roll back its open transaction, or the next instance of the
+// plugin cannot write to its database.
+// plainDatabaseModule serves KeyValueDBs with only the methods of api.KeyValueDB, like a host
+// backend without the optional interfaces.
+type plainDatabaseModule struct{ *DatabaseModule }
+
+type plainKeyValueDB struct{ api.KeyValueDB }
+
+func (m plainDatabaseModule) KeyValueDB(name, dbType string) (api.KeyValueDB, error) {
+	db, err := m.DatabaseModule.KeyValueDB(name, dbType)
+	return plainKeyValueDB{db}, err
+}
+
+func TestLoopbackKeyValueOptionalInterfaces(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			host.SetModule(plainDatabaseModule{host.Database})
+			l := startLoopback(t, tr, nil, host, "kv")
+			db, err := remoteModule[api.DatabaseModule](t, l, api.NameDatabaseModule).KeyValueDB("scores", "")
+			if err != nil {
+				t.Fatalf("KeyValueDB: %v", err)
+			}
+			for _, k := range []string{"p:steve", "p:alex", "p:zuri", "q:other"} {
+				if err := db.Set(k, "1"); err != nil {
+					t.Fatalf("Set(%q): %v", k, err)
+				}
+			}
+
+			if _, err := api.SetIfAbsent(db, "p:alex", "2"); !errors.Is(err, api.ErrKVUnsupported) {
+				t.Fatalf("SetIfAbsent on a backend without batches: %v", err)
+			}
+
+			// The host emulates Scan over Iterate.
+			var keys []string
+			q := api.KVScan{Prefix: "p:", Reverse: true, Limit: 2}
+			for {
+				page, err := api.ScanPage(db, q)
+				if err != nil {
+					t.Fatalf("ScanPage: %v", err)
+				}
+				for _, e := range page.Entries {
+					keys = append(keys, e.Key)
+				}
+				if page.NextCursor == "" {
+					break
+				}
+				q.Cursor = page.NextCursor
+			}
+			if want := []string{"p:zuri", "p:steve", "p:alex"}; !slices.Equal(keys, want) {
+				t.Fatalf("scanned %v, want %v", keys, want)
+			}
+
+			if _, err := db.(api.KVWatcher).Watch(context.Background(), "p:"); !errors.Is(err, api.ErrKVUnsupported) {
+				t.Fatalf("Watch on a backend without watches: %v", err)
+			}
+			if err := db.(api.KVExpirer).SetWithTTL("p:alex", "2", time.Minute); !errors.Is(err, api.ErrKVUnsupported) {
+				t.Fatalf("SetWithTTL on a backend without expiry: %v", err)
+			}
+			if _, _, err := db.(api.KVExpirer).TTL("p:alex"); !errors.Is(err, api.ErrKVUnsupported) {
+				t.Fatalf("TTL on a backend without expiry: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoopbackCloseReleasesSQLTransaction(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			t.Cleanup(func() { _ = host.Database.Cleanup() })
+			ctx := context.Background()
+
+			first := startLoopback(t, tr, nil, host, "crashy")
+			db, err := remoteModule[api.DatabaseModule](t, first, api.NameDatabaseModule).SQLDB(api.SQLDBRef{Name: "data"})
+			if err != nil {
+				t.Fatalf("SQLDB: %v", err)
+			}
+			if _, err := db.Exec(ctx, "CREATE TABLE t (v INTEGER)"); err != nil {
+				t.Fatalf("create table: %v", err)
+			}
+			tx, err := db.Begin(ctx)
+			if err != nil {
+				t.Fatalf("Begin: %v", err)
+			}
+			if _, err := tx.Exec(ctx, "INSERT INTO t VALUES (1)"); err != nil {
+				t.Fatalf("insert in tx: %v", err)
+			}
+			// The plugin goes away without committing, rolling back or closing.
+			_ = first.Close()
+
+			second := startLoopback(t, tr, nil, host, "crashy")
+			db, err = remoteModule[api.DatabaseModule](t, second, api.NameDatabaseModule).SQLDB(api.SQLDBRef{Name: "data"})
+			if err != nil {
+				t.Fatalf("SQLDB after restart: %v", err)
+			}
+			defer db.Close()
+			if _, err := db.Exec(ctx, "INSERT INTO t VALUES (2)"); err != nil {
+				t.Fatalf("insert after restart: %v", err)
+			}
+			rows, err := db.Query(ctx, "SELECT v FROM t")
+			if err != nil {
+				t.Fatalf("query: %v", err)
+			}
+			defer rows.Close()
+			var got []int64
+			for rows.Next() {
+				var v int64
+				if err := rows.Scan(&v); err != nil {
+					t.Fatalf("scan: %v", err)
+				}
+				got = append(got, v)
+			}
+			if len(got) != 1 || got[0] != 2 {
+				t.Fatalf("rows = %v, want only the committed [2]", got)
+			}
+		})
+	}
+}
+
+func TestLoopbackBackupChecksum(t *testing.T) {
+	for _, tr := range loopbackTransports {
+		t.Run(tr.name, func(t *testing.T) {
+			host := NewFrame()
+			t.Cleanup(func() { _ = host.Database.Cleanup() })
+			ctx := context.Background()
+			l := startLoopback(t, tr, nil, host, "bank")
+			mod := remoteModule[api.DatabaseModule](t, l, api.NameDatabaseModule)
+
+			db, err := mod.KeyValueDB("accounts", "")
+			if err != nil {
+				t.Fatalf("KeyValueDB: %v", err)
+			}
+			if err := db.Set("steve", "coins=100"); err != nil {
+				t.Fatalf("Set: %v", err)
+			}
+			ref := api.DBRef{Kind: api.DBKindKV, Name: "accounts"}
+
+			var archive bytes.Buffer
+			info, err := mod.ExportDB(ctx, ref, &archive)
+			if err != nil {
+				t.Fatalf("ExportDB: %v", err)
+			}
+			var data bytes.Buffer
+			m, err := api.ReadDBBackup(bytes.NewReader(archive.Bytes()), &data)
+			if err != nil {
+				t.Fatalf("ReadDBBackup: %v", err)
+			}
+			sum := sha256.Sum256(data.Bytes())
+			if info.SHA256 != hex.EncodeToString(sum[:]) || m.Files[0].SHA256 != info.SHA256 {
+				t.Fatalf("checksum: info %s, manifest %s, data %x", info.SHA256, m.Files[0].SHA256, sum)
+			}
+			if info.Size != int64(archive.Len()) || info.Entries != 1 {
+				t.Fatalf("info = %+v, archive is %d bytes", info, archive.Len())
+			}
+
+			tampered := bytes.Replace(archive.Bytes(), []byte("coins=100"), []byte("coins=900"), 1)
+			if bytes.Equal(tampered, archive.Bytes()) {
+				t.Fatal("value not found in archive")
+			}
+			_, err = mod.ImportDB(ctx, ref, bytes.NewReader(tampered))
+			if err == nil || !strings.Contains(err.Error(), api.ErrDBBackupChecksum.Error()) {
+				t.Fatalf("ImportDB of tampered archive: %v, want checksum mismatch", err)
+			}
+			if v, _, _ := host.Database.DB("accounts").Get("steve"); v != "coins=100" {
+				t.Fatalf("value after rejected import = %q", v)
+			}
+
+			if err := db.Set("steve", "coins=0"); err != nil {
+				t.Fatalf("Set: %v", err)
+			}
+			if _, err := mod.ImportDB(ctx, ref, bytes.NewReader(archive.Bytes())); err != nil {
+				t.Fatalf("ImportDB: %v", err)
+			}
+			if v, _, _ := host.Database.DB("accounts").Get("steve"); v != "coins=100" {
+				t.Fatalf("value after import = %q", v)
+			}
+		})
+	}
+}
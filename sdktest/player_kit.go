@@ -0,0 +1,215 @@
+package sdktest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// PlayerMessageKind tells which PlayerKit/PlayersModule method produced a message.
+type PlayerMessageKind string
+
+const (
+	PlayerMessageRawSay      PlayerMessageKind = "raw_say"
+	PlayerMessageSay         PlayerMessageKind = "say"
+	PlayerMessageRawTitle    PlayerMessageKind = "raw_title"
+	PlayerMessageTitle       PlayerMessageKind = "title"
+	PlayerMessageSubtitle    PlayerMessageKind = "subtitle"
+	PlayerMessageRawSubtitle PlayerMessageKind = "raw_subtitle"
+	PlayerMessageActionBar   PlayerMessageKind = "action_bar"
+)
+
+// PlayerMessage records a message sent to a player (or target selector).
+type PlayerMessage struct {
+	Target string
+	Kind   PlayerMessageKind
+	Text   string
+	// Title is only set for subtitle messages.
+	Title string
+}
+
+// PlayerAbilities mirrors the permission getters/setters of api.PlayerKit.
+type PlayerAbilities struct {
+	CanBuild               bool
+	CanDig                 bool
+	CanUseDoorsAndSwitches bool
+	CanOpenContainers      bool
+	CanAttackPlayers       bool
+	CanAttackMobs          bool
+	CanUseOperatorCommands bool
+	CanTeleport            bool
+}
+
+// PlayerKit is a fake api.PlayerKit whose state is plain exported fields.
+// Messages sent through it are recorded on the owning PlayersModule.
+type PlayerKit struct {
+	mu sync.Mutex
+
+	UUID            string
+	PlayerName      string
+	EntityUniqueID  int64
+	EntityRuntimeID uint64
+	LoginTime       time.Time
+	PlatformChatID  string
+	BuildPlatform   int32
+	SkinID          string
+	DeviceID        string
+	Metadata        map[uint32]any
+	IsOP            bool
+	Online          bool
+	Invulnerable    bool
+	Flying          bool
+	MayFly          bool
+	Abilities       PlayerAbilities
+
+	// Err, when set, is returned by every context-taking getter/setter.
+	Err error
+
+	owner *PlayersModule
+}
+
+func (k *PlayerKit) GetUUIDString() string { return k.UUID }
+func (k *PlayerKit) GetName() string       { return k.PlayerName }
+
+func getField[T any](k *PlayerKit, v *T) (T, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.Err != nil {
+		var zero T
+		return zero, k.Err
+	}
+	return *v, nil
+}
+
+func setField[T any](k *PlayerKit, dst *T, v T) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.Err != nil {
+		return k.Err
+	}
+	*dst = v
+	return nil
+}
+
+func (k *PlayerKit) GetEntityUniqueID(context.Context) (int64, error) {
+	return getField(k, &k.EntityUniqueID)
+}
+func (k *PlayerKit) GetLoginTime(context.Context) (time.Time, error) {
+	return getField(k, &k.LoginTime)
+}
+func (k *PlayerKit) GetPlatformChatID(context.Context) (string, error) {
+	return getField(k, &k.PlatformChatID)
+}
+func (k *PlayerKit) GetBuildPlatform(context.Context) (int32, error) {
+	return getField(k, &k.BuildPlatform)
+}
+func (k *PlayerKit) GetSkinID(context.Context) (string, error) {
+	return getField(k, &k.SkinID)
+}
+
+func (k *PlayerKit) GetCanBuild(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanBuild)
+}
+func (k *PlayerKit) SetCanBuild(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanBuild, allow)
+}
+func (k *PlayerKit) GetCanDig(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanDig)
+}
+func (k *PlayerKit) SetCanDig(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanDig, allow)
+}
+func (k *PlayerKit) GetCanUseDoorsAndSwitches(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanUseDoorsAndSwitches)
+}
+func (k *PlayerKit) SetCanUseDoorsAndSwitches(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanUseDoorsAndSwitches, allow)
+}
+func (k *PlayerKit) GetCanOpenContainers(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanOpenContainers)
+}
+func (k *PlayerKit) SetCanOpenContainers(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanOpenContainers, allow)
+}
+func (k *PlayerKit) GetCanAttackPlayers(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanAttackPlayers)
+}
+func (k *PlayerKit) SetCanAttackPlayers(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanAttackPlayers, allow)
+}
+func (k *PlayerKit) GetCanAttackMobs(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanAttackMobs)
+}
+func (k *PlayerKit) SetCanAttackMobs(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanAttackMobs, allow)
+}
+func (k *PlayerKit) GetCanUseOperatorCommands(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanUseOperatorCommands)
+}
+func (k *PlayerKit) SetCanUseOperatorCommands(_ context.Context, allow bool) error {
+	return setField(k, &k.Abilities.CanUseOperatorCommands, allow)
+}
+func (k *PlayerKit) GetCanTeleport(context.Context) (bool, error) {
+	return getField(k, &k.Abilities.CanTeleport)
+}
+func (k *PlayerKit) SetCanTeleport(_ context.Context, allow bool) (bool, error) {
+	if err := setField(k, &k.Abilities.CanTeleport, allow); err != nil {
+		return false, err
+	}
+	return allow, nil
+}
+
+func (k *PlayerKit) GetStatusInvulnerable(context.Context) (bool, error) {
+	return getField(k, &k.Invulnerable)
+}
+func (k *PlayerKit) GetStatusFlying(context.Context) (bool, error) {
+	return getField(k, &k.Flying)
+}
+func (k *PlayerKit) GetStatusMayFly(context.Context) (bool, error) {
+	return getField(k, &k.MayFly)
+}
+
+func (k *PlayerKit) GetDeviceID(context.Context) (string, error) {
+	return getField(k, &k.DeviceID)
+}
+func (k *PlayerKit) GetEntityRuntimeID(context.Context) (uint64, error) {
+	return getField(k, &k.EntityRuntimeID)
+}
+func (k *PlayerKit) GetEntityMetadata(context.Context) (map[uint32]any, error) {
+	md, err := getField(k, &k.Metadata)
+	if err != nil || md == nil {
+		return md, err
+	}
+	out := make(map[uint32]any, len(md))
+	for key, v := range md {
+		out[key] = v
+	}
+	return out, nil
+}
+
+func (k *PlayerKit) GetIsOP(context.Context) (bool, error) {
+	return getField(k, &k.IsOP)
+}
+func (k *PlayerKit) GetOnline(context.Context) (bool, error) {
+	return getField(k, &k.Online)
+}
+
+func (k *PlayerKit) RawSay(jsonText string) error {
+	return k.owner.send(PlayerMessage{Target: k.PlayerName, Kind: PlayerMessageRawSay, Text: jsonText})
+}
+func (k *PlayerKit) Say(message string) error {
+	return k.owner.send(PlayerMessage{Target: k.PlayerName, Kind: PlayerMessageSay, Text: message})
+}
+func (k *PlayerKit) Title(message string) error {
+	return k.owner.send(PlayerMessage{Target: k.PlayerName, Kind: PlayerMessageTitle, Text: message})
+}
+func (k *PlayerKit) Subtitle(subtitleMessage, titleMessage string) error {
+	return k.owner.send(PlayerMessage{Target: k.PlayerName, Kind: PlayerMessageSubtitle, Text: subtitleMessage, Title: titleMessage})
+}
+func (k *PlayerKit) ActionBar(message string) error {
+	return k.owner.send(PlayerMessage{Target: k.PlayerName, Kind: PlayerMessageActionBar, Text: message})
+}
+
+var _ api.PlayerKit = (*PlayerKit)(nil)
@@ -0,0 +1,245 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// PlayersModule is a fake api.PlayersModule.
+// Use Join/Leave to drive player change events and Messages to inspect what the plugin sent.
+type PlayersModule struct {
+	mu       sync.Mutex
+	players  map[string]*PlayerKit
+	order    []string
+	messages []PlayerMessage
+	runtime  uint64
+
+	changes listeners[*api.PlayerChangeEvent]
+
+	// Err, when set, is returned by every send method.
+	Err error
+}
+
+func NewPlayersModule() *PlayersModule {
+	return &PlayersModule{players: map[string]*PlayerKit{}}
+}
+
+func (m *PlayersModule) Name() string { return api.NamePlayersModule }
+
+// AddPlayer stores a player without emitting an event (e.g. to seed state before Load).
+// Empty UUID, runtime id and unique id are filled in.
+func (m *PlayersModule) AddPlayer(kit *PlayerKit) *PlayerKit {
+	if kit == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.players == nil {
+		m.players = map[string]*PlayerKit{}
+	}
+	if kit.UUID == "" {
+		kit.UUID = uuid.NewString()
+	}
+	if kit.EntityRuntimeID == 0 {
+		m.runtime++
+		kit.EntityRuntimeID = m.runtime
+	}
+	if kit.EntityUniqueID == 0 {
+		kit.EntityUniqueID = int64(kit.EntityRuntimeID)
+	}
+	if kit.LoginTime.IsZero() {
+		kit.LoginTime = time.Now()
+	}
+	kit.owner = m
+	if _, ok := m.players[kit.UUID]; !ok {
+		m.order = append(m.order, kit.UUID)
+	}
+	m.players[kit.UUID] = kit
+	return kit
+}
+
+// Join adds an online player and emits an "online" PlayerChangeEvent.
+func (m *PlayersModule) Join(name string) *PlayerKit {
+	kit := m.AddPlayer(&PlayerKit{PlayerName: name, Online: true})
+	m.emit(kit, api.PlayerChangeEventTypeOnline)
+	return kit
+}
+
+// Leave marks the player offline and emits an "offline" PlayerChangeEvent.
+func (m *PlayersModule) Leave(name string) bool {
+	kit := m.findByName(name)
+	if kit == nil {
+		return false
+	}
+	kit.mu.Lock()
+	kit.Online = false
+	kit.mu.Unlock()
+	m.emit(kit, api.PlayerChangeEventTypeOffline)
+	return true
+}
+
+// EmitExisting emits an "exist" event for every stored player, like the host does on registration.
+func (m *PlayersModule) EmitExisting() {
+	m.mu.Lock()
+	kits := make([]*PlayerKit, 0, len(m.order))
+	for _, id := range m.order {
+		kits = append(kits, m.players[id])
+	}
+	m.mu.Unlock()
+	for _, kit := range kits {
+		m.emit(kit, api.PlayerChangeEventTypeExist)
+	}
+}
+
+func (m *PlayersModule) emit(kit *PlayerKit, eventType string) {
+	id, _ := uuid.Parse(kit.UUID)
+	m.changes.emit(&api.PlayerChangeEvent{UUID: id, Name: kit.PlayerName, EventType: eventType})
+}
+
+func (m *PlayersModule) findByName(name string) *PlayerKit {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range m.order {
+		if kit := m.players[id]; kit.PlayerName == name {
+			return kit
+		}
+	}
+	return nil
+}
+
+// Messages returns every message sent to players, in call order.
+func (m *PlayersModule) Messages() []PlayerMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]PlayerMessage(nil), m.messages...)
+}
+
+// MessagesTo returns the texts sent to target.
+func (m *PlayersModule) MessagesTo(target string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []string
+	for _, msg := range m.messages {
+		if msg.Target == target {
+			out = append(out, msg.Text)
+		}
+	}
+	return out
+}
+
+// ResetMessages clears the recorded messages.
+func (m *PlayersModule) ResetMessages() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = nil
+}
+
+func (m *PlayersModule) send(msg PlayerMessage) error {
+	if m == nil {
+		return errors.New("sdktest.PlayersModule: player kit is detached")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return m.Err
+}
+
+func (m *PlayersModule) NewPlayerKit(id string) api.PlayerKit {
+	m.mu.Lock()
+	kit, ok := m.players[id]
+	m.mu.Unlock()
+	if ok {
+		return kit
+	}
+	return m.AddPlayer(&PlayerKit{UUID: id})
+}
+
+func (m *PlayersModule) GetAllOnlinePlayers(context.Context) ([]api.PlayerKit, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]api.PlayerKit, 0, len(m.order))
+	for _, id := range m.order {
+		kit := m.players[id]
+		kit.mu.Lock()
+		online := kit.Online
+		kit.mu.Unlock()
+		if online {
+			out = append(out, kit)
+		}
+	}
+	return out, nil
+}
+
+func (m *PlayersModule) GetPlayerByName(_ context.Context, name string) (api.PlayerKit, error) {
+	if kit := m.findByName(name); kit != nil {
+		return kit, nil
+	}
+	return nil, errors.New("sdktest.PlayersModule.GetPlayerByName: player " + name + " not found")
+}
+
+func (m *PlayersModule) GetPlayerByUUID(_ context.Context, id string) (api.PlayerKit, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if kit, ok := m.players[id]; ok {
+		return kit, nil
+	}
+	return nil, errors.New("sdktest.PlayersModule.GetPlayerByUUID: player " + id + " not found")
+}
+
+func (m *PlayersModule) GetPlayerByEntityRuntimeID(_ context.Context, runtimeID uint64) (api.PlayerKit, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range m.order {
+		if kit := m.players[id]; kit.EntityRuntimeID == runtimeID {
+			return kit, nil
+		}
+	}
+	return nil, errors.New("sdktest.PlayersModule.GetPlayerByEntityRuntimeID: player not found")
+}
+
+func (m *PlayersModule) RegisterWhenPlayerChange(handler func(event *api.PlayerChangeEvent)) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.PlayersModule.RegisterWhenPlayerChange: handler is nil")
+	}
+	return m.changes.add(handler), nil
+}
+
+func (m *PlayersModule) UnregisterWhenPlayerChange(listenerID string) bool {
+	return m.changes.remove(listenerID)
+}
+
+func (m *PlayersModule) RawSayTo(target string, jsonText string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageRawSay, Text: jsonText})
+}
+
+func (m *PlayersModule) SayTo(target string, message string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageSay, Text: message})
+}
+
+func (m *PlayersModule) RawTitleTo(target string, jsonText string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageRawTitle, Text: jsonText})
+}
+
+func (m *PlayersModule) TitleTo(target string, message string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageTitle, Text: message})
+}
+
+func (m *PlayersModule) RawSubtitleTo(target string, subtitleJsonText, titleJsonText string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageRawSubtitle, Text: subtitleJsonText, Title: titleJsonText})
+}
+
+func (m *PlayersModule) SubtitleTo(target string, subtitleMessage, titleMessage string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageSubtitle, Text: subtitleMessage, Title: titleMessage})
+}
+
+func (m *PlayersModule) ActionBarTo(target string, message string) error {
+	return m.send(PlayerMessage{Target: target, Kind: PlayerMessageActionBar, Text: message})
+}
+
+var _ api.PlayersModule = (*PlayersModule)(nil)
@@ -0,0 +1,35 @@
+package sdktest
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// StoragePathModule is a fake api.StoragePathModule rooted at Root.
+// Tests usually pass t.TempDir() to NewStoragePathModule.
+type StoragePathModule struct {
+	Root string
+}
+
+// NewStoragePathModule returns a module rooted at root; an empty root uses os.TempDir().
+func NewStoragePathModule(root string) *StoragePathModule {
+	if root == "" {
+		root = filepath.Join(os.TempDir(), "sdktest")
+	}
+	return &StoragePathModule{Root: root}
+}
+
+func (m *StoragePathModule) Name() string { return api.NameStoragePathModule }
+
+func (m *StoragePathModule) join(kind string, parts []string) string {
+	return filepath.Join(append([]string{m.Root, kind}, parts...)...)
+}
+
+func (m *StoragePathModule) ConfigPath(parts ...string) string   { return m.join("config", parts) }
+func (m *StoragePathModule) CodePath(parts ...string) string     { return m.join("code", parts) }
+func (m *StoragePathModule) DataFilePath(parts ...string) string { return m.join("data", parts) }
+func (m *StoragePathModule) CachePath(parts ...string) string    { return m.join("cache", parts) }
+
+var _ api.StoragePathModule = (*StoragePathModule)(nil)
@@ -0,0 +1,131 @@
+package sdktest
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// TerminalMenuModule is a fake api.TerminalMenuModule.
+// Use Call to simulate an operator typing a line in the terminal menu.
+type TerminalMenuModule struct {
+	mu      sync.Mutex
+	entries []*api.TerminalMenuEntry
+
+	addEntry listeners[*api.TerminalMenuEntry]
+	call     listeners[string]
+	pop      listeners[struct{}]
+}
+
+func NewTerminalMenuModule() *TerminalMenuModule {
+	return &TerminalMenuModule{}
+}
+
+func (m *TerminalMenuModule) Name() string { return api.NameTerminalMenuModule }
+
+func (m *TerminalMenuModule) RegisterTerminalMenuEntry(entry *api.TerminalMenuEntry) error {
+	if entry == nil {
+		return errors.New("sdktest.TerminalMenuModule.RegisterTerminalMenuEntry: entry is nil")
+	}
+	if len(entry.Triggers) == 0 {
+		return errors.New("sdktest.TerminalMenuModule.RegisterTerminalMenuEntry: entry has no triggers")
+	}
+	m.mu.Lock()
+	m.entries = append(m.entries, entry)
+	m.mu.Unlock()
+	m.addEntry.emit(entry)
+	return nil
+}
+
+func (m *TerminalMenuModule) RemoveTerminalMenuEntry(entry *api.TerminalMenuEntry) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, e := range m.entries {
+		if e == entry {
+			m.entries = append(m.entries[:i], m.entries[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Entries returns the registered entries in registration order.
+func (m *TerminalMenuModule) Entries() []*api.TerminalMenuEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*api.TerminalMenuEntry(nil), m.entries...)
+}
+
+// Call dispatches line to the first entry whose trigger matches its first word,
+// passing the remaining words as arguments. It reports whether an entry was triggered.
+func (m *TerminalMenuModule) Call(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false
+	}
+	m.mu.Lock()
+	var hit *api.TerminalMenuEntry
+	for _, e := range m.entries {
+		for _, t := range e.Triggers {
+			if t == fields[0] {
+				hit = e
+				break
+			}
+		}
+		if hit != nil {
+			break
+		}
+	}
+	m.mu.Unlock()
+	if hit == nil || hit.OnTrigger == nil {
+		return false
+	}
+	hit.OnTrigger(fields[1:])
+	return true
+}
+
+func (m *TerminalMenuModule) PublishTerminalCall(line string) {
+	m.call.emit(line)
+	m.Call(line)
+}
+
+func (m *TerminalMenuModule) PublishPopBackendMenu() {
+	m.pop.emit(struct{}{})
+}
+
+func (m *TerminalMenuModule) RegisterWhenAddMenuEntry(handler func(*api.TerminalMenuEntry)) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.TerminalMenuModule.RegisterWhenAddMenuEntry: handler is nil")
+	}
+	return m.addEntry.add(handler), nil
+}
+
+func (m *TerminalMenuModule) UnregisterWhenAddMenuEntry(listenerID string) bool {
+	return m.addEntry.remove(listenerID)
+}
+
+func (m *TerminalMenuModule) RegisterWhenTerminalCall(handler func(string)) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.TerminalMenuModule.RegisterWhenTerminalCall: handler is nil")
+	}
+	return m.call.add(handler), nil
+}
+
+func (m *TerminalMenuModule) UnregisterWhenTerminalCall(listenerID string) bool {
+	return m.call.remove(listenerID)
+}
+
+func (m *TerminalMenuModule) RegisterWhenPopBackendMenu(handler func(struct{})) (string, error) {
+	if handler == nil {
+		return "", errors.New("sdktest.TerminalMenuModule.RegisterWhenPopBackendMenu: handler is nil")
+	}
+	return m.pop.add(handler), nil
+}
+
+func (m *TerminalMenuModule) UnregisterWhenPopBackendMenu(listenerID string) bool {
+	return m.pop.remove(listenerID)
+}
+
+var _ api.TerminalMenuModule = (*TerminalMenuModule)(nil)
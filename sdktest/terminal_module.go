@@ -0,0 +1,166 @@
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// TerminalLine records one line printed by the plugin.
+// Level and Scope are empty for Raw output.
+type TerminalLine struct {
+	Level api.Level
+	Scope string
+	Msg   string
+}
+
+func (l TerminalLine) String() string {
+	if l.Level == "" && l.Scope == "" {
+		return l.Msg
+	}
+	return fmt.Sprintf("[%s] [%s] %s", l.Level, l.Scope, l.Msg)
+}
+
+// TerminalModule is a fake api.TerminalModule.
+// Printed lines are recorded; Input simulates an operator typing a line.
+type TerminalModule struct {
+	mu         sync.Mutex
+	lines      []TerminalLine
+	subs       map[chan string]struct{}
+	intercepts []*terminalIntercept
+}
+
+type terminalIntercept struct {
+	handler func(string)
+}
+
+func NewTerminalModule() *TerminalModule {
+	return &TerminalModule{subs: map[chan string]struct{}{}}
+}
+
+func (m *TerminalModule) Name() string { return api.NameTerminalModule }
+
+func (m *TerminalModule) record(line TerminalLine) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lines = append(m.lines, line)
+}
+
+func (m *TerminalModule) Print(level api.Level, scope string, msg string) {
+	m.record(TerminalLine{Level: level, Scope: scope, Msg: msg})
+}
+
+func (m *TerminalModule) Info(scope string, msg string) { m.Print(api.LevelInfo, scope, msg) }
+
+func (m *TerminalModule) Warn(scope string, msg string) { m.Print(api.LevelWarn, scope, msg) }
+
+func (m *TerminalModule) Error(scope string, msg string) { m.Print(api.LevelError, scope, msg) }
+
+func (m *TerminalModule) Success(scope string, msg string) { m.Print(api.LevelSuccess, scope, msg) }
+
+func (m *TerminalModule) Raw(msg string) { m.record(TerminalLine{Msg: msg}) }
+
+// ColorTransANSI returns msg unchanged so colour codes stay visible to assertions.
+func (m *TerminalModule) ColorTransANSI(msg string) string { return msg }
+
+// Lines returns every printed line in call order.
+func (m *TerminalModule) Lines() []TerminalLine {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]TerminalLine(nil), m.lines...)
+}
+
+// Contains reports whether any printed message contains substr.
+func (m *TerminalModule) Contains(substr string) bool {
+	for _, l := range m.Lines() {
+		if strings.Contains(l.Msg, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset clears the recorded lines.
+func (m *TerminalModule) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lines = nil
+}
+
+// Input simulates a line typed into the terminal.
+// A pending InterceptNextLine consumes the line; otherwise it is broadcast to SubscribeLines.
+func (m *TerminalModule) Input(line string) {
+	m.mu.Lock()
+	if len(m.intercepts) > 0 {
+		ic := m.intercepts[0]
+		m.intercepts = m.intercepts[1:]
+		m.mu.Unlock()
+		ic.handler(line)
+		return
+	}
+	for ch := range m.subs {
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+	m.mu.Unlock()
+}
+
+func (m *TerminalModule) SubscribeLines(ctx context.Context) (<-chan string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ch := make(chan string, 64)
+	m.mu.Lock()
+	m.subs[ch] = struct{}{}
+	m.mu.Unlock()
+	context.AfterFunc(ctx, func() {
+		m.mu.Lock()
+		delete(m.subs, ch)
+		close(ch)
+		m.mu.Unlock()
+	})
+	return ch, nil
+}
+
+func (m *TerminalModule) InterceptNextLine(ctx context.Context, timeout time.Duration, handler func(string)) (func(), error) {
+	if handler == nil {
+		return nil, errors.New("sdktest.TerminalModule.InterceptNextLine: handler is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ic := &terminalIntercept{handler: handler}
+	m.mu.Lock()
+	m.intercepts = append(m.intercepts, ic)
+	m.mu.Unlock()
+
+	var cancelTimeout context.CancelFunc = func() {}
+	if timeout > 0 {
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+	}
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			cancelTimeout()
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			for i, it := range m.intercepts {
+				if it == ic {
+					m.intercepts = append(m.intercepts[:i], m.intercepts[i+1:]...)
+					return
+				}
+			}
+		})
+	}
+	context.AfterFunc(ctx, cancel)
+	return cancel, nil
+}
+
+var _ api.TerminalModule = (*TerminalModule)(nil)
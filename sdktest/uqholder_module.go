@@ -0,0 +1,185 @@
+package sdktest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// UQHolderState is the data returned by the fake UQHolderModule.
+// Optional values report ok=false while their pointer is nil.
+type UQHolderState struct {
+	BotName      string
+	BotRuntimeID uint64
+	BotUniqueID  int64
+	BotIdentity  string
+	BotUUIDStr   string
+	BotXUID      string
+
+	BasicRaw  map[string]any
+	ExtendRaw map[string]any
+	GameRules map[string]api.GameRule
+
+	CompressThreshold        *uint16
+	WorldGameMode            *int32
+	GameMode                 *int32
+	WorldDifficulty          *uint32
+	Time                     *int32
+	DayTime                  *int32
+	DayTimePercent           *float32
+	CurrentTick              *int64
+	SyncRatio                *float32
+	BotDimension             *int32
+	BotPosition              *[3]float32
+	BotPositionOutOfSyncTick *int64
+	ClientDimension          *int32
+	ClientHotBarSlot         *byte
+	ClientHoldingItem        map[string]any
+}
+
+// UQHolderModule is a fake api.UQHolderModule. Edit State through Update.
+type UQHolderModule struct {
+	mu    sync.Mutex
+	state UQHolderState
+
+	// Err, when set, is returned by every method.
+	Err error
+}
+
+func NewUQHolderModule() *UQHolderModule {
+	return &UQHolderModule{state: UQHolderState{BotName: "sdktest_bot"}}
+}
+
+func (m *UQHolderModule) Name() string { return api.NameUQHolderModule }
+
+// Update mutates the state under the module lock.
+func (m *UQHolderModule) Update(fn func(s *UQHolderState)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(&m.state)
+}
+
+func uqGet[T any](m *UQHolderModule, fn func(s *UQHolderState) T) (T, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Err != nil {
+		var zero T
+		return zero, m.Err
+	}
+	return fn(&m.state), nil
+}
+
+func uqOpt[T any](m *UQHolderModule, fn func(s *UQHolderState) *T) (T, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var zero T
+	if m.Err != nil {
+		return zero, false, m.Err
+	}
+	v := fn(&m.state)
+	if v == nil {
+		return zero, false, nil
+	}
+	return *v, true, nil
+}
+
+func copyAnyMap(in map[string]any) map[string]any {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func (m *UQHolderModule) BotName(context.Context) (string, error) {
+	return uqGet(m, func(s *UQHolderState) string { return s.BotName })
+}
+func (m *UQHolderModule) BotRuntimeID(context.Context) (uint64, error) {
+	return uqGet(m, func(s *UQHolderState) uint64 { return s.BotRuntimeID })
+}
+func (m *UQHolderModule) BotUniqueID(context.Context) (int64, error) {
+	return uqGet(m, func(s *UQHolderState) int64 { return s.BotUniqueID })
+}
+func (m *UQHolderModule) BotIdentity(context.Context) (string, error) {
+	return uqGet(m, func(s *UQHolderState) string { return s.BotIdentity })
+}
+func (m *UQHolderModule) BotUUIDStr(context.Context) (string, error) {
+	return uqGet(m, func(s *UQHolderState) string { return s.BotUUIDStr })
+}
+func (m *UQHolderModule) BotXUID(context.Context) (string, error) {
+	return uqGet(m, func(s *UQHolderState) string { return s.BotXUID })
+}
+func (m *UQHolderModule) BasicRaw(context.Context) (map[string]any, error) {
+	return uqGet(m, func(s *UQHolderState) map[string]any { return copyAnyMap(s.BasicRaw) })
+}
+func (m *UQHolderModule) CompressThreshold(context.Context) (uint16, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *uint16 { return s.CompressThreshold })
+}
+func (m *UQHolderModule) WorldGameMode(context.Context) (int32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int32 { return s.WorldGameMode })
+}
+func (m *UQHolderModule) GameMode(context.Context) (int32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int32 { return s.GameMode })
+}
+func (m *UQHolderModule) WorldDifficulty(context.Context) (uint32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *uint32 { return s.WorldDifficulty })
+}
+func (m *UQHolderModule) Time(context.Context) (int32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int32 { return s.Time })
+}
+func (m *UQHolderModule) DayTime(context.Context) (int32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int32 { return s.DayTime })
+}
+func (m *UQHolderModule) DayTimePercent(context.Context) (float32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *float32 { return s.DayTimePercent })
+}
+func (m *UQHolderModule) CurrentTick(context.Context) (int64, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int64 { return s.CurrentTick })
+}
+func (m *UQHolderModule) SyncRatio(context.Context) (float32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *float32 { return s.SyncRatio })
+}
+func (m *UQHolderModule) BotDimension(context.Context) (int32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int32 { return s.BotDimension })
+}
+func (m *UQHolderModule) BotPosition(context.Context) ([3]float32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *[3]float32 { return s.BotPosition })
+}
+func (m *UQHolderModule) BotPositionOutOfSyncTick(context.Context) (int64, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int64 { return s.BotPositionOutOfSyncTick })
+}
+func (m *UQHolderModule) ClientDimension(context.Context) (int32, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *int32 { return s.ClientDimension })
+}
+func (m *UQHolderModule) ClientHotBarSlot(context.Context) (byte, bool, error) {
+	return uqOpt(m, func(s *UQHolderState) *byte { return s.ClientHotBarSlot })
+}
+func (m *UQHolderModule) ClientHoldingItem(context.Context) (map[string]any, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Err != nil {
+		return nil, false, m.Err
+	}
+	if m.state.ClientHoldingItem == nil {
+		return nil, false, nil
+	}
+	return copyAnyMap(m.state.ClientHoldingItem), true, nil
+}
+func (m *UQHolderModule) GameRules(context.Context) (map[string]api.GameRule, error) {
+	return uqGet(m, func(s *UQHolderState) map[string]api.GameRule {
+		out := make(map[string]api.GameRule, len(s.GameRules))
+		for k, v := range s.GameRules {
+			out[k] = v
+		}
+		return out
+	})
+}
+func (m *UQHolderModule) ExtendRaw(context.Context) (map[string]any, error) {
+	return uqGet(m, func(s *UQHolderState) map[string]any { return copyAnyMap(s.ExtendRaw) })
+}
+
+var _ api.UQHolderModule = (*UQHolderModule)(nil)
@@ -0,0 +1,176 @@
+package sqldb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// Export writes a consistent backup of db, opened by this package, to w. The database file is
+// copied with VACUUM INTO, so writers are not blocked while the backup is written.
+func Export(ctx context.Context, db api.SQLDB, ref api.DBRef, w io.Writer) (api.DBBackupInfo, error) {
+	d, ok := db.(*sqlDB)
+	if !ok || d.db == nil {
+		return api.DBBackupInfo{}, errors.New("sqldb.Export: database was not opened by sqldb")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tmp, err := tempPath(d.path, "export")
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer os.Remove(tmp)
+	created := time.Now()
+	if _, err := d.db.ExecContext(ctx, `VACUUM INTO ?`, tmp); err != nil {
+		return api.DBBackupInfo{}, fmt.Errorf("sqldb.Export: %w", err)
+	}
+	f, err := os.Open(tmp)
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer f.Close()
+	ref.Kind = api.DBKindSQL
+	info, err := api.WriteDBBackup(w, api.DBBackupManifest{Kind: api.DBKindSQL, Name: ref.Name, Created: created}, api.DBBackupSQLFile, f)
+	info.Ref = ref
+	return info, err
+}
+
+// Import verifies the SQL backup read from r and restores it into db, opened by this package,
+// with SQLite's online backup, so other handles of the database stay usable.
+func Import(ctx context.Context, db api.SQLDB, r io.Reader) (api.DBBackupInfo, error) {
+	d, ok := db.(*sqlDB)
+	if !ok || d.db == nil {
+		return api.DBBackupInfo{}, errors.New("sqldb.Import: database was not opened by sqldb")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tmp, err := tempPath(d.path, "import")
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer os.Remove(tmp)
+	f, err := os.Create(tmp)
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	cr := &countingReader{r: r}
+	m, err := api.ReadDBBackup(cr, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	if m.Kind != api.DBKindSQL {
+		return api.DBBackupInfo{}, fmt.Errorf("%w: %s backup, want %s", api.ErrDBBackupFormat, m.Kind, api.DBKindSQL)
+	}
+
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer conn.Close()
+	err = conn.Raw(func(dc any) error {
+		rc, ok := dc.(interface {
+			NewRestore(srcURI string) (*sqlite.Backup, error)
+		})
+		if !ok {
+			return errors.New("sqldb.Import: driver does not support restores")
+		}
+		b, err := rc.NewRestore("file:" + tmp + "?mode=ro")
+		if err != nil {
+			return err
+		}
+		for {
+			more, err := b.Step(-1)
+			if err == nil && !more {
+				return b.Finish()
+			}
+			if err != nil && !busy(err) {
+				_ = b.Finish()
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				_ = b.Finish()
+				return ctx.Err()
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+	if err != nil {
+		return api.DBBackupInfo{}, fmt.Errorf("sqldb.Import: %w", err)
+	}
+	return api.DBBackupInfo{
+		Ref:     api.DBRef{Kind: m.Kind, Name: m.Name},
+		Created: m.Created,
+		Size:    cr.n,
+		SHA256:  m.Files[0].SHA256,
+	}, nil
+}
+
+// Export opens the database ref and writes a backup of it to w.
+func (d *Dir) Export(ctx context.Context, ref api.DBRef, w io.Writer) (api.DBBackupInfo, error) {
+	db, err := d.Open(ref.SQLRef())
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer db.Close()
+	return Export(ctx, db, ref, w)
+}
+
+// Import opens the database ref and restores the backup read from r into it.
+func (d *Dir) Import(ctx context.Context, ref api.DBRef, r io.Reader) (api.DBBackupInfo, error) {
+	db, err := d.Open(ref.SQLRef())
+	if err != nil {
+		return api.DBBackupInfo{}, err
+	}
+	defer db.Close()
+	info, err := Import(ctx, db, r)
+	if err == nil {
+		info.Ref = ref
+	}
+	return info, err
+}
+
+// tempPath returns an unused file name next to path.
+func tempPath(path, kind string) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+"."+kind+"-*")
+	if err != nil {
+		return "", err
+	}
+	name := f.Name()
+	_ = f.Close()
+	return name, os.Remove(name)
+}
+
+func busy(err error) bool {
+	var e *sqlite.Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	code := e.Code() & 0xff
+	return code == sqlite3.SQLITE_BUSY || code == sqlite3.SQLITE_LOCKED
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,253 @@
+// Package sqldb implements api.SQLDB on embedded SQLite databases (modernc.org/sqlite, no cgo).
+//
+// Hosts serve DatabaseModule.SQLDB with a Dir, which keeps the databases of every plugin in
+// their own directory:
+//
+//	dir := sqldb.NewDir(filepath.Join(dataDir, "sql"))
+//	func (m *databaseModule) SQLDB(ref api.SQLDBRef) (api.SQLDB, error) { return dir.Open(ref) }
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/Yeah114/EmptyDea-plugin-sdk/api"
+)
+
+// Ext is the file extension of databases opened through a Dir.
+const Ext = ".sqlite"
+
+// HostOwner is the directory of databases without an owner, opened by the host itself.
+const HostOwner = "_host"
+
+// Dir opens the databases of each plugin under Root/<owner>/<name>.sqlite.
+type Dir struct {
+	Root string
+}
+
+func NewDir(root string) *Dir {
+	return &Dir{Root: root}
+}
+
+// Path returns the file of the database ref.
+func (d *Dir) Path(ref api.SQLDBRef) (string, error) {
+	if d == nil || d.Root == "" {
+		return "", errors.New("sqldb.Dir.Path: root is empty")
+	}
+	owner := strings.TrimSpace(ref.Owner)
+	if owner == "" {
+		owner = HostOwner
+	}
+	if err := checkName(owner); err != nil {
+		return "", fmt.Errorf("sqldb.Dir.Path: owner: %w", err)
+	}
+	name := strings.TrimSpace(ref.Name)
+	if err := checkName(name); err != nil {
+		return "", fmt.Errorf("sqldb.Dir.Path: name: %w", err)
+	}
+	return filepath.Join(d.Root, owner, name+Ext), nil
+}
+
+// Open opens (or creates) the database ref.
+func (d *Dir) Open(ref api.SQLDBRef) (api.SQLDB, error) {
+	path, err := d.Path(ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	return Open(path)
+}
+
+func checkName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("empty")
+	case name == "." || name == "..":
+		return fmt.Errorf("invalid %q", name)
+	case strings.ContainsAny(name, "/\\:\x00"):
+		return fmt.Errorf("%q contains a path separator", name)
+	}
+	return nil
+}
+
+// Open opens (or creates) the SQLite database at path. It runs in WAL mode with foreign keys
+// enforced; transactions take the write lock when they begin and wait up to 5s for it.
+func Open(path string) (api.SQLDB, error) {
+	if path == "" {
+		return nil, errors.New("sqldb.Open: path is empty")
+	}
+	q := url.Values{}
+	q.Add("_pragma", "busy_timeout(5000)")
+	q.Add("_pragma", "journal_mode(WAL)")
+	q.Add("_pragma", "foreign_keys(1)")
+	q.Set("_txlock", "immediate")
+	q.Set("_time_format", "sqlite")
+	db, err := sql.Open("sqlite", "file:"+path+"?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &sqlDB{db: db, path: path}, nil
+}
+
+// queryer is the part of *sql.DB and *sql.Tx used by both.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+type sqlDB struct {
+	db   *sql.DB
+	path string
+}
+
+func (d *sqlDB) Exec(ctx context.Context, query string, args ...any) (api.SQLResult, error) {
+	if d == nil || d.db == nil {
+		return api.SQLResult{}, errors.New("sqldb.Exec: database is closed")
+	}
+	return exec(ctx, d.db, query, args)
+}
+
+func (d *sqlDB) Query(ctx context.Context, query string, args ...any) (api.SQLRows, error) {
+	if d == nil || d.db == nil {
+		return nil, errors.New("sqldb.Query: database is closed")
+	}
+	return queryRows(ctx, d.db, query, args)
+}
+
+func (d *sqlDB) Begin(ctx context.Context) (api.SQLTx, error) {
+	if d == nil || d.db == nil {
+		return nil, errors.New("sqldb.Begin: database is closed")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlTx{tx: tx}, nil
+}
+
+func (d *sqlDB) Close() error {
+	if d == nil || d.db == nil {
+		return nil
+	}
+	return d.db.Close()
+}
+
+type sqlTx struct {
+	tx *sql.Tx
+}
+
+func (t *sqlTx) Exec(ctx context.Context, query string, args ...any) (api.SQLResult, error) {
+	return exec(ctx, t.tx, query, args)
+}
+
+func (t *sqlTx) Query(ctx context.Context, query string, args ...any) (api.SQLRows, error) {
+	return queryRows(ctx, t.tx, query, args)
+}
+
+func (t *sqlTx) Commit() error { return t.tx.Commit() }
+
+func (t *sqlTx) Rollback() error { return t.tx.Rollback() }
+
+func exec(ctx context.Context, q queryer, query string, args []any) (api.SQLResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	args, err := api.NormalizeSQLArgs(args)
+	if err != nil {
+		return api.SQLResult{}, err
+	}
+	res, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return api.SQLResult{}, err
+	}
+	var out api.SQLResult
+	out.RowsAffected, _ = res.RowsAffected()
+	out.LastInsertID, _ = res.LastInsertId()
+	return out, nil
+}
+
+func queryRows(ctx context.Context, q queryer, query string, args []any) (api.SQLRows, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	args, err := api.NormalizeSQLArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	return &sqlRows{rows: rows, cols: cols}, nil
+}
+
+type sqlRows struct {
+	rows *sql.Rows
+	cols []string
+	vals []any
+	err  error
+}
+
+func (r *sqlRows) Columns() []string { return r.cols }
+
+func (r *sqlRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+	vals := make([]any, len(r.cols))
+	ptrs := make([]any, len(r.cols))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	if err := r.rows.Scan(ptrs...); err != nil {
+		r.err = err
+		return false
+	}
+	for i, v := range vals {
+		if vals[i], r.err = api.NormalizeSQLValue(v); r.err != nil {
+			return false
+		}
+	}
+	r.vals = vals
+	return true
+}
+
+func (r *sqlRows) Values() []any { return r.vals }
+
+func (r *sqlRows) Scan(dest ...any) error {
+	if r.vals == nil {
+		return errors.New("sqldb.Rows.Scan: Scan called without calling Next")
+	}
+	return api.ScanSQLValues(r.vals, dest...)
+}
+
+func (r *sqlRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+func (r *sqlRows) Close() error { return r.rows.Close() }